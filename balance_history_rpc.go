@@ -0,0 +1,255 @@
+package taprootassets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
+	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	tchrpc "github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
+)
+
+// unmarshalBalanceHistoryQuery parses the channel and period filter of a
+// balance history RPC request.
+func unmarshalBalanceHistoryQuery(chanPoint string, startTimestamp,
+	endTimestamp int64) (balancehistory.Query, error) {
+
+	var query balancehistory.Query
+
+	if chanPoint != "" {
+		op, err := wire.NewOutPointFromString(chanPoint)
+		if err != nil {
+			return query, fmt.Errorf("invalid channel point: %w",
+				err)
+		}
+
+		query.ChanPoint = fn.Some(*op)
+	}
+
+	switch {
+	case startTimestamp < 0 || endTimestamp < 0:
+		return query, fmt.Errorf("timestamps must not be negative")
+
+	case endTimestamp != 0 && endTimestamp < startTimestamp:
+		return query, fmt.Errorf("end time must not be before start " +
+			"time")
+	}
+
+	if startTimestamp != 0 {
+		query.StartTime = time.Unix(startTimestamp, 0)
+	}
+	if endTimestamp != 0 {
+		query.EndTime = time.Unix(endTimestamp, 0)
+	}
+
+	return query, nil
+}
+
+// marshalRfqRate marshals an optional asset rate to the RPC form. An unknown
+// rate is marshaled as nil.
+func marshalRfqRate(
+	rate fn.Option[rfqmath.BigIntFixedPoint]) *rfqrpc.FixedPoint {
+
+	return fn.MapOptionZ(
+		rate, func(r rfqmath.BigIntFixedPoint) *rfqrpc.FixedPoint {
+			return &rfqrpc.FixedPoint{
+				Coefficient: r.Coefficient.String(),
+				Scale:       uint32(r.Scale),
+			}
+		},
+	)
+}
+
+// marshalBalanceSnapshot marshals a balance snapshot to the RPC form.
+func marshalBalanceSnapshot(
+	s *balancehistory.Snapshot) *tchrpc.BalanceSnapshot {
+
+	rpcSnapshot := &tchrpc.BalanceSnapshot{
+		ChanPoint:     s.ChanPoint.String(),
+		CommitHeight:  s.CommitHeight,
+		LocalBalance:  s.LocalBalance,
+		RemoteBalance: s.RemoteBalance,
+		Timestamp:     s.Timestamp.Unix(),
+		SettledHtlcs: make(
+			[]*tchrpc.BalanceHistoryHtlc, 0, len(s.SettledHtlcs),
+		),
+	}
+
+	for _, htlc := range s.SettledHtlcs {
+		rpcHtlc := &tchrpc.BalanceHistoryHtlc{
+			HtlcIndex:   htlc.HtlcIndex,
+			Incoming:    htlc.Incoming,
+			AssetAmount: htlc.AssetAmount,
+			Rate:        marshalRfqRate(htlc.Rate),
+		}
+		htlc.RfqID.WhenSome(func(id rfqmsg.ID) {
+			rpcHtlc.RfqId = id[:]
+		})
+
+		rpcSnapshot.SettledHtlcs = append(
+			rpcSnapshot.SettledHtlcs, rpcHtlc,
+		)
+	}
+
+	return rpcSnapshot
+}
+
+// marshalAssetFlow marshals the settled asset HTLCs of a profit and loss entry
+// to the RPC form.
+func marshalAssetFlow(f *balancehistory.AssetFlow) *tchrpc.AssetFlow {
+	return &tchrpc.AssetFlow{
+		NumHtlcs:       f.NumHtlcs,
+		AssetsReceived: f.AssetsReceived,
+		AssetsSent:     f.AssetsSent,
+		NetAssets:      f.NetAssets(),
+		ReceivedMsat:   uint64(f.ReceivedMsat),
+		SentMsat:       uint64(f.SentMsat),
+	}
+}
+
+// fetchBalanceHistory returns the recorded balance snapshots of our asset
+// channels that match the query.
+func (r *rpcServer) fetchBalanceHistory(ctx context.Context,
+	query balancehistory.Query) ([]*balancehistory.Snapshot, error) {
+
+	snapshots, err := r.cfg.BalanceHistoryStore.FetchBalanceSnapshots(
+		ctx, query,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch balance history: %w",
+			err)
+	}
+
+	return snapshots, nil
+}
+
+// AssetChannelBalanceHistory returns the recorded asset balance snapshots of
+// our asset channels, together with the settled asset HTLCs that moved the
+// balance.
+func (r *rpcServer) AssetChannelBalanceHistory(ctx context.Context,
+	req *tchrpc.AssetChannelBalanceHistoryRequest) (
+	*tchrpc.AssetChannelBalanceHistoryResponse, error) {
+
+	query, err := unmarshalBalanceHistoryQuery(
+		req.ChanPoint, req.StartTimestamp, req.EndTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := r.fetchBalanceHistory(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &tchrpc.AssetChannelBalanceHistoryResponse{
+		Snapshots: make([]*tchrpc.BalanceSnapshot, 0, len(snapshots)),
+	}
+	for _, s := range snapshots {
+		resp.Snapshots = append(
+			resp.Snapshots, marshalBalanceSnapshot(s),
+		)
+	}
+
+	return resp, nil
+}
+
+// AssetChannelPnL returns the asset denominated profit and loss of our asset
+// channels over a period of time, both per channel and per RFQ quote.
+func (r *rpcServer) AssetChannelPnL(ctx context.Context,
+	req *tchrpc.AssetChannelPnLRequest) (*tchrpc.AssetChannelPnLResponse,
+	error) {
+
+	query, err := unmarshalBalanceHistoryQuery(
+		req.ChanPoint, req.StartTimestamp, req.EndTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := r.fetchBalanceHistory(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	channelPnLs := balancehistory.ComputeChannelPnL(snapshots)
+	quotePnLs := balancehistory.ComputeQuotePnL(snapshots)
+
+	resp := &tchrpc.AssetChannelPnLResponse{
+		Channels: make([]*tchrpc.ChannelPnL, 0, len(channelPnLs)),
+		Quotes:   make([]*tchrpc.QuotePnL, 0, len(quotePnLs)),
+	}
+	for _, p := range channelPnLs {
+		resp.Channels = append(resp.Channels, &tchrpc.ChannelPnL{
+			ChanPoint:         p.ChanPoint.String(),
+			StartTimestamp:    p.StartTime.Unix(),
+			EndTimestamp:      p.EndTime.Unix(),
+			StartLocalBalance: p.StartLocalBalance,
+			EndLocalBalance:   p.EndLocalBalance,
+			Flow:              marshalAssetFlow(&p.AssetFlow),
+		})
+	}
+	for _, p := range quotePnLs {
+		resp.Quotes = append(resp.Quotes, &tchrpc.QuotePnL{
+			RfqId: fn.CopySlice(p.RfqID[:]),
+			Rate:  marshalRfqRate(p.Rate),
+			Flow:  marshalAssetFlow(&p.AssetFlow),
+		})
+	}
+
+	return resp, nil
+}
+
+// ExportAssetChannelHistory exports the balance history, the profit and loss
+// per channel or the profit and loss per RFQ quote of our asset channels as
+// CSV.
+func (r *rpcServer) ExportAssetChannelHistory(ctx context.Context,
+	req *tchrpc.ExportAssetChannelHistoryRequest) (
+	*tchrpc.ExportAssetChannelHistoryResponse, error) {
+
+	query, err := unmarshalBalanceHistoryQuery(
+		req.ChanPoint, req.StartTimestamp, req.EndTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := r.fetchBalanceHistory(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch req.ExportType {
+	case tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_SNAPSHOTS:
+		err = balancehistory.WriteSnapshotsCSV(&buf, snapshots)
+
+	case tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_CHANNEL_PNL:
+		err = balancehistory.WriteChannelPnLCSV(
+			&buf, balancehistory.ComputeChannelPnL(snapshots),
+		)
+
+	case tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_QUOTE_PNL:
+		err = balancehistory.WriteQuotePnLCSV(
+			&buf, balancehistory.ComputeQuotePnL(snapshots),
+		)
+
+	default:
+		return nil, fmt.Errorf("unknown balance history export type: "+
+			"%v", req.ExportType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to export balance history: %w",
+			err)
+	}
+
+	return &tchrpc.ExportAssetChannelHistoryResponse{
+		Csv: buf.Bytes(),
+	}, nil
+}
@@ -30,8 +30,36 @@ var (
 		"tombstone":   taprpc.ScriptKeyType_SCRIPT_KEY_TOMBSTONE,
 		"channel":     taprpc.ScriptKeyType_SCRIPT_KEY_CHANNEL,
 	}
+
+	// nolint:lll
+	coinSelectStrategyMap = map[string]taprpc.CoinSelectStrategy{
+		"largest-first":    taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_LARGEST_FIRST,
+		"smallest-first":   taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_SMALLEST_FIRST,
+		"branch-and-bound": taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_BRANCH_AND_BOUND,
+		"privacy-aware":    taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PRIVACY_AWARE,
+	}
 )
 
+// parseCoinSelectStrategy parses the coin selection strategy from the command
+// line context. If the user didn't specify a strategy, the default strategy
+// configured in tapd is used.
+func parseCoinSelectStrategy(c *cli.Context) (taprpc.CoinSelectStrategy,
+	error) {
+
+	if !c.IsSet(coinSelectStrategyName) {
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT,
+			nil
+	}
+
+	strategy, ok := coinSelectStrategyMap[c.String(coinSelectStrategyName)]
+	if !ok {
+		return 0, fmt.Errorf("coin select strategy '%v' is unknown",
+			c.String(coinSelectStrategyName))
+	}
+
+	return strategy, nil
+}
+
 // parseScriptKeyType parses the script key type query from the command line
 // context. If the user didn't specify a script key type, the "show all" query
 // type is returned.
@@ -91,8 +119,13 @@ var assetsCommands = []cli.Command{
 			listGroupsCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			sendBatchCommand,
+			scheduleSendCommand,
+			listScheduledSendsCommand,
+			cancelScheduledSendCommand,
 			burnAssetsCommand,
 			listBurnsCommand,
+			consolidateAssetsCommand,
 			listTransfersCommand,
 			fetchMetaCommand,
 			removeUtxoLeaseCommand,
@@ -129,6 +162,11 @@ const (
 	burnOverrideConfirmationName  = "override_confirmation_destroy_assets"
 	scriptKeyTypeName             = "script_key_type"
 	scriptKeyTypeAll              = "all_script_key_types"
+	coinSelectStrategyName        = "coin_select_strategy"
+	maxInputsName                 = "max_inputs"
+	maxTransactionsName           = "max_transactions"
+	dryRunName                    = "dry_run"
+	labelName                     = "label"
 )
 
 var mintAssetCommand = cli.Command{
@@ -238,6 +276,7 @@ var mintAssetCommand = cli.Command{
 		sealBatchCommand,
 		finalizeBatchCommand,
 		cancelBatchCommand,
+		mintScheduleCommand,
 	},
 }
 
@@ -970,6 +1009,14 @@ var sendAssetsCommand = cli.Command{
 			Name:  skipProofCourierPingCheckName,
 			Usage: "if set, skip the proof courier ping check",
 		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "the strategy used to select the asset coins " +
+				"to spend; one of 'largest-first', " +
+				"'smallest-first', 'branch-and-bound' or " +
+				"'privacy-aware'; if not set, the strategy " +
+				"configured in tapd is used",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
@@ -994,16 +1041,45 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	rpcAddrs, err := parseSendAddrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	coinSelectStrategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
+		FeeRate: feeRate,
+		SkipProofCourierPingCheck: ctx.Bool(
+			skipProofCourierPingCheckName,
+		),
+		AddressesWithAmounts: rpcAddrs,
+		CoinSelectStrategy:   coinSelectStrategy,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// parseSendAddrs parses the addresses to send to from the --addr and
+// --addr_with_amount flags of the command line context.
+func parseSendAddrs(ctx *cli.Context) ([]*taprpc.AddressWithAmount, error) {
 	var (
 		network     = ctx.GlobalString("network")
 		chainParams = address.ParamsForChain(network)
 		rpcAddrs    []*taprpc.AddressWithAmount
 	)
-	for _, addrStr := range addrs {
+	for _, addrStr := range ctx.StringSlice(addrName) {
 		addr, err := address.DecodeAddress(addrStr, &chainParams)
 		if err != nil {
-			return fmt.Errorf("unable to decode address %s: %w",
-				addrStr, err)
+			return nil, fmt.Errorf("unable to decode address "+
+				"%s: %w", addrStr, err)
 		}
 
 		rpcAddrs = append(rpcAddrs, &taprpc.AddressWithAmount{
@@ -1011,46 +1087,31 @@ func sendAssets(ctx *cli.Context) error {
 			Amount:  addr.Amount,
 		})
 	}
-	for _, addrWithAmount := range addrsWithAmounts {
+	for _, addrWithAmount := range ctx.StringSlice(addrWithAmountName) {
 		parts := strings.Split(addrWithAmount, ":")
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid address with amount: %s, "+
-				"expected format is '<addr>:<amount>'",
-				addrWithAmount)
+			return nil, fmt.Errorf("invalid address with "+
+				"amount: %s, expected format is "+
+				"'<addr>:<amount>'", addrWithAmount)
 		}
 
 		addrStr := parts[0]
 		userAmount, err := strconv.ParseUint(parts[1], 10, 64)
 		if err != nil {
-			return fmt.Errorf("invalid amount in address with "+
-				"amount: %s, expected a valid uint64", parts[1])
+			return nil, fmt.Errorf("invalid amount in address "+
+				"with amount: %s, expected a valid uint64",
+				parts[1])
 		}
 
 		addr, err := address.DecodeAddress(addrStr, &chainParams)
 		if err != nil {
-			return fmt.Errorf("unable to decode address %s: %w",
-				addrStr, err)
+			return nil, fmt.Errorf("unable to decode address "+
+				"%s: %w", addrStr, err)
 		}
 
-		switch {
-		// Only V2 addresses allow overriding the amount to send.
-		case userAmount > 0 && addr.Version != address.V2:
-			return fmt.Errorf("address %s is not a V2 address, "+
-				"cannot override the amount to send", addrStr)
-
-		// Make sure the user isn't trying to override the amount for
-		// an address that doesn't allow it.
-		case userAmount > 0 && addr.Amount > 0:
-			return fmt.Errorf("address %s has an amount of %d "+
-				"defined, cannot override with %d",
-				addrStr, addr.Amount, userAmount)
-
-		// Also make sure the user did specify an amount for an address
-		// that requires it.
-		case userAmount == 0 && addr.Amount == 0:
-			return fmt.Errorf("address %s does not have an amount "+
-				"defined, must specify an amount to send",
-				addrStr)
+		err = checkAddrAmount(addrStr, addr, userAmount)
+		if err != nil {
+			return nil, err
 		}
 
 		rpcAddrs = append(rpcAddrs, &taprpc.AddressWithAmount{
@@ -1059,18 +1120,34 @@ func sendAssets(ctx *cli.Context) error {
 		})
 	}
 
-	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		FeeRate: feeRate,
-		SkipProofCourierPingCheck: ctx.Bool(
-			skipProofCourierPingCheckName,
-		),
-		AddressesWithAmounts: rpcAddrs,
-	})
-	if err != nil {
-		return fmt.Errorf("unable to send assets: %w", err)
+	return rpcAddrs, nil
+}
+
+// checkAddrAmount makes sure the user specified amount to send to the given
+// address is compatible with the address.
+func checkAddrAmount(addrStr string, addr *address.Tap,
+	userAmount uint64) error {
+
+	switch {
+	// Only V2 addresses allow overriding the amount to send.
+	case userAmount > 0 && addr.Version != address.V2:
+		return fmt.Errorf("address %s is not a V2 address, cannot "+
+			"override the amount to send", addrStr)
+
+	// Make sure the user isn't trying to override the amount for an
+	// address that doesn't allow it.
+	case userAmount > 0 && addr.Amount > 0:
+		return fmt.Errorf("address %s has an amount of %d defined, "+
+			"cannot override with %d", addrStr, addr.Amount,
+			userAmount)
+
+	// Also make sure the user did specify an amount for an address that
+	// requires it.
+	case userAmount == 0 && addr.Amount == 0:
+		return fmt.Errorf("address %s does not have an amount "+
+			"defined, must specify an amount to send", addrStr)
 	}
 
-	printRespJSON(resp)
 	return nil
 }
 
@@ -1174,6 +1251,110 @@ func burnAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var consolidateAssetsCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "merge many small outputs of an asset into a single output",
+	Description: `
+	Merge many small outputs of the same asset into a single output per
+	asset ID by spending them back to the local node. Either the asset ID
+	or the group key of the asset must be specified. If a group key is
+	given, the outputs of all tranches of the group are consolidated.
+
+	Use --dry_run to only show the expected number of inputs and outputs
+	and the expected chain fees without creating any transaction.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to consolidate",
+		},
+		cli.StringFlag{
+			Name:  assetGroupKeyName,
+			Usage: "the group key of the asset to consolidate",
+		},
+		cli.Uint64Flag{
+			Name: maxInputsName,
+			Usage: "the maximum number of asset inputs to " +
+				"merge in a single transaction; if not set, a " +
+				"default of 100 is used",
+		},
+		cli.Uint64Flag{
+			Name: maxTransactionsName,
+			Usage: "the maximum number of transactions to " +
+				"create; if not set, a single transaction is " +
+				"created",
+		},
+		cli.BoolFlag{
+			Name: dryRunName,
+			Usage: "if set, no transaction is created and " +
+				"only the expected result is shown",
+		},
+		cli.StringFlag{
+			Name: labelName,
+			Usage: "an optional label for the consolidation " +
+				"transfers",
+		},
+	},
+	Action: consolidateAssets,
+}
+
+func consolidateAssets(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req := &taprpc.ConsolidateAssetsRequest{
+		MaxInputs:       uint32(ctx.Uint64(maxInputsName)),
+		MaxTransactions: uint32(ctx.Uint64(maxTransactionsName)),
+		DryRun:          ctx.Bool(dryRunName),
+		Label:           ctx.String(labelName),
+	}
+
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(assetGroupKeyName):
+		return fmt.Errorf("only one of --%v and --%v can be set",
+			assetIDName, assetGroupKeyName)
+
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		req.Asset = &taprpc.ConsolidateAssetsRequest_AssetId{
+			AssetId: assetID,
+		}
+
+	case ctx.IsSet(assetGroupKeyName):
+		groupKey, err := hex.DecodeString(
+			ctx.String(assetGroupKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+
+		req.Asset = &taprpc.ConsolidateAssetsRequest_GroupKey{
+			GroupKey: groupKey,
+		}
+
+	default:
+		return fmt.Errorf("either --%v or --%v must be set",
+			assetIDName, assetGroupKeyName)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ConsolidateAssets(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to consolidate assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listBurnsCommand = cli.Command{
 	Name:  "listburns",
 	Usage: "list burnt assets",
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+const (
+	backupFileName = "backup_file"
+)
+
+var backupCommands = []cli.Command{
+	{
+		Name:     "backup",
+		Category: "Database",
+		Usage:    "Back up and restore the tapd asset database.",
+		Subcommands: []cli.Command{
+			createBackupCommand,
+			restoreBackupCommand,
+		},
+	},
+}
+
+var createBackupCommand = cli.Command{
+	Name:  "create",
+	Usage: "create a backup of the asset database",
+	Description: `
+	Create a consistent backup of the asset database of the running tapd.
+	The backup covers all assets, proofs, addresses, transfers, key
+	derivations and supply commitment state. It is written to the given
+	file as a checksummed archive that can be restored into both SQLite and
+	Postgres.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: backupFileName,
+			Usage: "the file to write the backup archive to; use " +
+				"the dash character (-) to write to stdout",
+		},
+	},
+	Action: createBackup,
+}
+
+func createBackup(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.String(backupFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CreateBackup(ctxc, &taprpc.CreateBackupRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to create backup: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupFileName))
+	if err := writeToFile(filePath, resp.Archive); err != nil {
+		return err
+	}
+
+	// We don't print the info if the archive was written to stdout.
+	if filePath != "-" {
+		printRespJSON(resp.Info)
+	}
+
+	return nil
+}
+
+var restoreBackupCommand = cli.Command{
+	Name:  "restore",
+	Usage: "restore a backup of the asset database",
+	Description: `
+	Restore a backup of the asset database into a fresh tapd that uses the
+	same lnd seed as the node the backup was created for. The backup is
+	verified and staged by the running tapd and restored once tapd is
+	restarted. Backups of a node with a different lnd seed are refused, as
+	are restores into a tapd that already holds wallet data.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: backupFileName,
+			Usage: "the file to read the backup archive from; use " +
+				"the dash character (-) to read from stdin",
+		},
+	},
+	Action: restoreBackup,
+}
+
+func restoreBackup(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.String(backupFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupFileName))
+	archive, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read backup file: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RestoreBackup(ctxc, &taprpc.RestoreBackupRequest{
+		Archive: archive,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore backup: %w", err)
+	}
+
+	printRespJSON(resp)
+	fmt.Println("The backup was staged, restart tapd to restore it.")
+
+	return nil
+}
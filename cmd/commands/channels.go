@@ -0,0 +1,324 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	tchrpc "github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+const (
+	outgoingChanIDName = "outgoing_chan_id"
+	incomingChanIDName = "incoming_chan_id"
+	maxAssetFeeName    = "max_asset_fee"
+	maxAttemptsName    = "max_attempts"
+	chanPointName      = "chan_point"
+	startTimestampName = "start_timestamp"
+	endTimestampName   = "end_timestamp"
+	exportTypeName     = "type"
+	outputFileName     = "output_file"
+)
+
+var channelCommands = []cli.Command{
+	{
+		Name:      "channels",
+		ShortName: "ch",
+		Usage:     "Interact with Taproot Asset channels.",
+		Category:  "Channels",
+		Subcommands: []cli.Command{
+			rebalanceChannelsCommand,
+			balanceHistoryCommand,
+			channelPnLCommand,
+			exportHistoryCommand,
+		},
+	},
+}
+
+var rebalanceChannelsCommand = cli.Command{
+	Name:      "rebalance",
+	ShortName: "r",
+	Usage:     "move asset liquidity between two asset channels",
+	Description: `
+	Shift asset liquidity from one of our asset channels into another one
+	with a circular payment. The payment leaves through the outgoing
+	channel, paid for with assets using a sell quote, and comes back through
+	the incoming channel, where the peer converts it to assets using a buy
+	quote. The quote spread and the routing fees are capped by the maximum
+	fee in asset units. Failed attempts are retried with fresh quotes.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to rebalance",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "the group key of the asset to rebalance, " +
+				"mutually exclusive to asset_id",
+		},
+		cli.Uint64Flag{
+			Name: outgoingChanIDName,
+			Usage: "the channel ID of the channel whose local " +
+				"asset balance is reduced",
+		},
+		cli.Uint64Flag{
+			Name: incomingChanIDName,
+			Usage: "the channel ID of the channel whose local " +
+				"asset balance is increased",
+		},
+		cli.Uint64Flag{
+			Name: assetAmountName,
+			Usage: "the number of asset units to receive in the " +
+				"incoming channel",
+		},
+		cli.Uint64Flag{
+			Name: maxAssetFeeName,
+			Usage: "the maximum number of asset units the " +
+				"rebalance may cost, including the quote " +
+				"spread and the routing fees",
+		},
+		cli.Uint64Flag{
+			Name: maxAttemptsName,
+			Usage: "the maximum number of attempts; if unset, " +
+				"three attempts are made",
+		},
+	},
+	Action: rebalanceChannels,
+}
+
+// parseChannelAssetSpecifier parses the mutually exclusive asset ID and group
+// key flags of a channel command.
+func parseChannelAssetSpecifier(ctx *cli.Context) ([]byte, []byte, error) {
+	var (
+		assetID  []byte
+		groupKey []byte
+		err      error
+	)
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(groupKeyName):
+		return nil, nil, fmt.Errorf("cannot set both %s and %s, only "+
+			"one is allowed", assetIDName, groupKeyName)
+
+	case ctx.IsSet(assetIDName):
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode asset "+
+				"ID: %w", err)
+		}
+
+	case ctx.IsSet(groupKeyName):
+		groupKey, err = hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode group "+
+				"key: %w", err)
+		}
+
+	default:
+		return nil, nil, fmt.Errorf("either %s or %s must be set",
+			assetIDName, groupKeyName)
+	}
+
+	return assetID, groupKey, nil
+}
+
+func rebalanceChannels(ctx *cli.Context) error {
+	if !ctx.IsSet(outgoingChanIDName) || !ctx.IsSet(incomingChanIDName) ||
+		!ctx.IsSet(assetAmountName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetID, groupKey, err := parseChannelAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxAttempts := ctx.Uint64(maxAttemptsName)
+	if maxAttempts > uint64(^uint32(0)) {
+		return fmt.Errorf("%s is too large", maxAttemptsName)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getChannelsClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RebalanceAssetChannels(
+		ctxc, &tchrpc.RebalanceAssetChannelsRequest{
+			AssetId:        assetID,
+			GroupKey:       groupKey,
+			OutgoingChanId: ctx.Uint64(outgoingChanIDName),
+			IncomingChanId: ctx.Uint64(incomingChanIDName),
+			AssetAmount:    ctx.Uint64(assetAmountName),
+			MaxAssetFee:    ctx.Uint64(maxAssetFeeName),
+			MaxAttempts:    uint32(maxAttempts),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to rebalance asset channels: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// balanceHistoryFlags are the flags that filter the balance history of the
+// asset channels.
+var balanceHistoryFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: chanPointName,
+		Usage: "the funding outpoint of a single channel to limit " +
+			"the result to, in the form txid:output_index",
+	},
+	cli.Int64Flag{
+		Name: startTimestampName,
+		Usage: "the unix timestamp in seconds of the start of the " +
+			"period; if unset, there is no lower bound",
+	},
+	cli.Int64Flag{
+		Name: endTimestampName,
+		Usage: "the unix timestamp in seconds of the end of the " +
+			"period; if unset, there is no upper bound",
+	},
+}
+
+var balanceHistoryCommand = cli.Command{
+	Name:      "balancehistory",
+	ShortName: "bh",
+	Usage:     "show the asset balance history of asset channels",
+	Description: `
+	Show the recorded asset balance snapshots of our asset channels,
+	together with the settled asset HTLCs that moved the balance. The
+	history can be limited to a single channel and a period of time.
+`,
+	Flags:  balanceHistoryFlags,
+	Action: balanceHistory,
+}
+
+func balanceHistory(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getChannelsClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AssetChannelBalanceHistory(
+		ctxc, &tchrpc.AssetChannelBalanceHistoryRequest{
+			ChanPoint:      ctx.String(chanPointName),
+			StartTimestamp: ctx.Int64(startTimestampName),
+			EndTimestamp:   ctx.Int64(endTimestampName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch balance history: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var channelPnLCommand = cli.Command{
+	Name:      "pnl",
+	ShortName: "p",
+	Usage:     "show the profit and loss of asset channels",
+	Description: `
+	Show the asset denominated profit and loss of our asset channels over a
+	period of time, both per channel and per RFQ quote.
+`,
+	Flags:  balanceHistoryFlags,
+	Action: channelPnL,
+}
+
+func channelPnL(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getChannelsClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AssetChannelPnL(
+		ctxc, &tchrpc.AssetChannelPnLRequest{
+			ChanPoint:      ctx.String(chanPointName),
+			StartTimestamp: ctx.Int64(startTimestampName),
+			EndTimestamp:   ctx.Int64(endTimestampName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch profit and loss: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var exportHistoryCommand = cli.Command{
+	Name:      "exporthistory",
+	ShortName: "e",
+	Usage:     "export the balance history of asset channels as CSV",
+	Description: `
+	Export the balance history of our asset channels as CSV. The type of
+	the export is one of:
+	  - snapshots: every balance snapshot, with one row per settled HTLC
+	  - channel-pnl: the profit and loss per asset channel
+	  - quote-pnl: the profit and loss per RFQ quote
+`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: exportTypeName,
+			Usage: "the type of the export; one of snapshots, " +
+				"channel-pnl or quote-pnl",
+			Value: "snapshots",
+		},
+		cli.StringFlag{
+			Name: outputFileName,
+			Usage: "the file to write the CSV export to; use " +
+				"the dash character (-) to write to stdout",
+			Value: "-",
+		},
+	}, balanceHistoryFlags...),
+	Action: exportHistory,
+}
+
+// parseExportType parses the type of a balance history export, for example
+// channel-pnl for BALANCE_HISTORY_EXPORT_CHANNEL_PNL.
+func parseExportType(
+	exportType string) (tchrpc.BalanceHistoryExportType, error) {
+
+	enumName := "BALANCE_HISTORY_EXPORT_" + strings.ToUpper(
+		strings.ReplaceAll(exportType, "-", "_"),
+	)
+	value, ok := tchrpc.BalanceHistoryExportType_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown export type: %v", exportType)
+	}
+
+	return tchrpc.BalanceHistoryExportType(value), nil
+}
+
+func exportHistory(ctx *cli.Context) error {
+	exportType, err := parseExportType(ctx.String(exportTypeName))
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getChannelsClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ExportAssetChannelHistory(
+		ctxc, &tchrpc.ExportAssetChannelHistoryRequest{
+			ChanPoint:      ctx.String(chanPointName),
+			StartTimestamp: ctx.Int64(startTimestampName),
+			EndTimestamp:   ctx.Int64(endTimestampName),
+			ExportType:     exportType,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export balance history: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(outputFileName))
+
+	return writeToFile(filePath, resp.Csv)
+}
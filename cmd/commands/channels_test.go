@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+
+	tchrpc "github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseExportType tests that every balance history export type can be
+// selected by its command line name.
+func TestParseExportType(t *testing.T) {
+	t.Parallel()
+
+	//nolint:lll
+	testCases := map[string]tchrpc.BalanceHistoryExportType{
+		"snapshots":   tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_SNAPSHOTS,
+		"channel-pnl": tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_CHANNEL_PNL,
+		"quote-pnl":   tchrpc.BalanceHistoryExportType_BALANCE_HISTORY_EXPORT_QUOTE_PNL,
+	}
+	for name, expected := range testCases {
+		exportType, err := parseExportType(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, exportType)
+	}
+
+	_, err := parseExportType("unknown")
+	require.ErrorContains(t, err, "unknown export type")
+}
@@ -123,8 +123,10 @@ func NewApp(actionOpts ...ActionOption) cli.App {
 	app.Commands = append(app.Commands, eventCommands...)
 	app.Commands = append(app.Commands, proofCommands...)
 	app.Commands = append(app.Commands, rfqCommands...)
+	app.Commands = append(app.Commands, channelCommands...)
 	app.Commands = append(app.Commands, universeCommands...)
 	app.Commands = append(app.Commands, devCommands...)
+	app.Commands = append(app.Commands, backupCommands...)
 
 	return *app
 }
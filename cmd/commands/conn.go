@@ -155,6 +155,18 @@ func getRfqClient(ctx *cli.Context) (rfqrpc.RfqClient, func()) {
 	return rfqrpc.NewRfqClient(conn), cleanUp
 }
 
+func getChannelsClient(ctx *cli.Context) (tchrpc.TaprootAssetChannelsClient,
+	func()) {
+
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return tchrpc.NewTaprootAssetChannelsClient(conn), cleanUp
+}
+
 func getClientConn(ctx *cli.Context, skipMacaroons bool) *grpc.ClientConn {
 	// First, we'll get the selected stored profile or an ephemeral one
 	// created from the global options in the CLI context.
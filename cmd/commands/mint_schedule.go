@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	cadenceName        = "cadence"
+	maxTotalSupplyName = "max_total_supply"
+	startTimeName      = "start_time"
+	mintScheduleIDName = "id"
+)
+
+var mintScheduleCommand = cli.Command{
+	Name:  "schedule",
+	Usage: "manage recurring mint schedules",
+	Description: `
+	Manage recurring mint schedules that mint a new tranche of an existing
+	asset group at a fixed cadence, until the maximum total supply of the
+	group is reached.
+	`,
+	Subcommands: []cli.Command{
+		addMintScheduleCommand,
+		listMintSchedulesCommand,
+		cancelMintScheduleCommand,
+	},
+}
+
+var addMintScheduleCommand = cli.Command{
+	Name:  "add",
+	Usage: "add a recurring mint schedule",
+	Description: `
+	Add a recurring mint schedule for an existing asset group controlled
+	by this node. Each tranche is minted in its own batch. Any occurrence
+	of {{tranche}} in the metadata is replaced with the number of the
+	tranche, starting at 1.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the group key of the asset group to mint the " +
+				"tranches into",
+		},
+		cli.Uint64Flag{
+			Name:  assetAmountName,
+			Usage: "the amount to mint in each tranche",
+		},
+		cli.DurationFlag{
+			Name: cadenceName,
+			Usage: "the interval between two tranches (e.g. 24h), " +
+				"must be at least 10m",
+		},
+		cli.Uint64Flag{
+			Name: maxTotalSupplyName,
+			Usage: "the maximum issued supply of the asset group, " +
+				"no tranche is minted that would exceed it",
+		},
+		cli.StringFlag{
+			Name: startTimeName,
+			Usage: "the point in time at or after which the first " +
+				"tranche is minted, in RFC3339 format (e.g. " +
+				"2025-01-31T12:00:00Z); if not set, the first " +
+				"tranche is minted right away",
+		},
+		cli.Uint64Flag{
+			Name:  assetVersionName,
+			Usage: "the version of the assets to mint",
+		},
+		cli.StringFlag{
+			Name:  assetMetaBytesName,
+			Usage: "the raw metadata template of each tranche",
+		},
+		cli.StringFlag{
+			Name: assetMetaFilePathName,
+			Usage: "a path to a file on disk that should be read " +
+				"and used as the metadata template",
+		},
+		cli.StringFlag{
+			Name: assetMetaTypeName,
+			Usage: "the type of the meta data of each tranche, " +
+				"must be either: opaque or json",
+			Value: "opaque",
+		},
+	},
+	Action: addMintSchedule,
+}
+
+func addMintSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || !ctx.IsSet(assetGroupKeyName) ||
+		!ctx.IsSet(assetAmountName) || !ctx.IsSet(cadenceName) ||
+		!ctx.IsSet(maxTotalSupplyName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key")
+	}
+
+	metaType, err := parseMetaType(ctx.String(assetMetaTypeName))
+	if err != nil {
+		return fmt.Errorf("unable to parse meta type: %w", err)
+	}
+
+	var metaTemplate []byte
+	var (
+		metaBytesSet    = ctx.IsSet(assetMetaBytesName)
+		metaFilePathSet = ctx.IsSet(assetMetaFilePathName)
+	)
+	switch {
+	case metaBytesSet && metaFilePathSet:
+		return fmt.Errorf("meta bytes and meta file path cannot both " +
+			"be set")
+
+	case metaBytesSet:
+		metaTemplate = []byte(ctx.String(assetMetaBytesName))
+
+	case metaFilePathSet:
+		metaPath := tapcfg.CleanAndExpandPath(
+			ctx.String(assetMetaFilePathName),
+		)
+		metaTemplate, err = os.ReadFile(metaPath)
+		if err != nil {
+			return fmt.Errorf("unable to read meta file: %w", err)
+		}
+	}
+
+	req := &mintrpc.AddMintScheduleRequest{
+		GroupKey: groupKey,
+		AssetVersion: taprpc.AssetVersion(
+			ctx.Uint64(assetVersionName),
+		),
+		Amount:       ctx.Uint64(assetAmountName),
+		MetaType:     metaType,
+		MetaTemplate: metaTemplate,
+		CadenceSeconds: uint64(
+			ctx.Duration(cadenceName) / time.Second,
+		),
+		MaxTotalSupply: ctx.Uint64(maxTotalSupplyName),
+	}
+
+	if ctx.IsSet(startTimeName) {
+		startTime, err := time.Parse(
+			time.RFC3339, ctx.String(startTimeName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid start time: %w", err)
+		}
+
+		req.StartTimestamp = startTime.Unix()
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AddMintSchedule(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to add mint schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listMintSchedulesCommand = cli.Command{
+	Name:  "list",
+	Usage: "list mint schedules",
+	Description: `
+	List all mint schedules, including completed and cancelled ones.
+	`,
+	Action: listMintSchedules,
+}
+
+func listMintSchedules(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListMintSchedules(
+		ctxc, &mintrpc.ListMintSchedulesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list mint schedules: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelMintScheduleCommand = cli.Command{
+	Name:  "cancel",
+	Usage: "cancel an active mint schedule",
+	Description: `
+	Cancel an active mint schedule. Tranches that were already minted
+	aren't affected.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  mintScheduleIDName,
+			Usage: "the ID of the mint schedule to cancel",
+		},
+	},
+	Action: cancelMintSchedule,
+}
+
+func cancelMintSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || !ctx.IsSet(mintScheduleIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelMintSchedule(
+		ctxc, &mintrpc.CancelMintScheduleRequest{
+			Id: ctx.Int64(mintScheduleIDName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to cancel mint schedule: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,529 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+const (
+	bundleFileName = "bundle_file"
+)
+
+var proofBundleCommand = cli.Command{
+	Name:      "bundle",
+	ShortName: "b",
+	Usage:     "export, check and import proof bundles",
+	Description: `
+	A proof bundle is a single file that contains the full proof files of
+	many assets, together with their meta reveals and the headers of all
+	blocks the proofs are anchored in. Bundles can be kept in cold storage
+	or handed to an auditor, who can check them without a connection to
+	tapd or a chain backend.
+
+	The offline check is not a verification: it makes sure the proofs are
+	consistent with the bundled block headers, but not that those headers
+	are part of the best chain. Importing a bundle into tapd verifies its
+	proofs against the chain backend of tapd.
+	`,
+	Subcommands: []cli.Command{
+		exportProofBundleCommand,
+		checkProofBundleCommand,
+		importProofBundleCommand,
+	},
+}
+
+var exportProofBundleCommand = cli.Command{
+	Name:      "export",
+	ShortName: "e",
+	Usage:     "export the proofs of all assets into a bundle",
+	Description: `
+	Export the proof files of all assets of the wallet, or of all assets of
+	a single asset group, into a proof bundle. If an asset belongs to a
+	group whose anchor asset isn't owned by the wallet anymore, the
+	issuance proof of the group anchor is taken from the local universe, so
+	the bundle can be checked on its own.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundleFileName,
+			Usage: "the file to write the bundle to; use the " +
+				"dash character (-) to write to stdout " +
+				"instead",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "(optional) only export the assets of the " +
+				"group with the given group key",
+		},
+	},
+	Action: exportProofBundle,
+}
+
+func exportProofBundle(ctx *cli.Context) error {
+	if ctx.String(bundleFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var groupKey []byte
+	if ctx.IsSet(groupKeyName) {
+		var err error
+		groupKey, err = hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("unable to decode group key: %w", err)
+		}
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListAssets(ctxc, &taprpc.ListAssetRequest{
+		IncludeLeased: true,
+		GroupKey:      groupKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list assets: %w", err)
+	}
+
+	var (
+		files        = make([]proof.File, 0, len(resp.Assets))
+		groupKeys    = make(map[asset.SerializedKey]*btcec.PublicKey)
+		groupAnchors = fn.NewSet[asset.SerializedKey]()
+	)
+	for _, rpcAsset := range resp.Assets {
+		if rpcAsset.ChainAnchor == nil || rpcAsset.AssetGenesis == nil {
+			continue
+		}
+
+		outPoint, err := wire.NewOutPointFromString(
+			rpcAsset.ChainAnchor.AnchorOutpoint,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to parse anchor outpoint: %w",
+				err)
+		}
+
+		proofResp, err := client.ExportProof(
+			ctxc, &taprpc.ExportProofRequest{
+				AssetId:   rpcAsset.AssetGenesis.AssetId,
+				ScriptKey: rpcAsset.ScriptKey,
+				Outpoint: &taprpc.OutPoint{
+					Txid:        outPoint.Hash[:],
+					OutputIndex: outPoint.Index,
+				},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to export proof of asset "+
+				"%x at %v: %w", rpcAsset.AssetGenesis.AssetId,
+				outPoint, err)
+		}
+
+		var file proof.File
+		err = file.Decode(bytes.NewReader(proofResp.RawProofFile))
+		if err != nil {
+			return fmt.Errorf("unable to decode proof file: %w",
+				err)
+		}
+		files = append(files, file)
+
+		genesisProof, err := file.ProofAt(0)
+		if err != nil {
+			return err
+		}
+		if genesisProof.Asset.GroupKey == nil {
+			continue
+		}
+
+		groupPubKey := genesisProof.Asset.GroupKey.GroupPubKey
+		groupKeys[asset.ToSerialized(&groupPubKey)] = &groupPubKey
+		if genesisProof.GroupKeyReveal != nil {
+			groupAnchors.Add(asset.ToSerialized(&groupPubKey))
+		}
+	}
+
+	// The assets of a group can only be checked together with the
+	// issuance proof of the group's anchor asset.
+	uniClient, cleanUpUni := getUniverseClient(ctx)
+	defer cleanUpUni()
+
+	for key, groupPubKey := range groupKeys {
+		if groupAnchors.Contains(key) {
+			continue
+		}
+
+		anchorFile, err := fetchGroupAnchorFile(
+			ctxc, uniClient, groupPubKey,
+		)
+		if err != nil {
+			return err
+		}
+		files = append(files, *anchorFile)
+	}
+
+	bundle, err := proof.NewBundle(files...)
+	if err != nil {
+		return fmt.Errorf("unable to create proof bundle: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Encode(&buf); err != nil {
+		return fmt.Errorf("unable to encode proof bundle: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(bundleFileName))
+	if err := writeToFile(filePath, buf.Bytes()); err != nil {
+		return err
+	}
+
+	// Don't mix the summary with the bundle if it's written to stdout.
+	if filePath == "-" {
+		return nil
+	}
+
+	printJSON(struct {
+		NumFiles        int `json:"num_proof_files"`
+		NumMetaReveals  int `json:"num_meta_reveals"`
+		NumBlockHeaders int `json:"num_block_headers"`
+	}{
+		NumFiles:        len(bundle.Files),
+		NumMetaReveals:  len(bundle.MetaReveals),
+		NumBlockHeaders: len(bundle.BlockHeaders),
+	})
+
+	return nil
+}
+
+// fetchGroupAnchorFile fetches the issuance proof of the anchor asset of the
+// given group from the local universe and returns it as a proof file.
+func fetchGroupAnchorFile(ctx context.Context, client unirpc.UniverseClient,
+	groupKey *btcec.PublicKey) (*proof.File, error) {
+
+	resp, err := client.AssetLeaves(ctx, &unirpc.ID{
+		Id: &unirpc.ID_GroupKey{
+			GroupKey: groupKey.SerializeCompressed(),
+		},
+		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch issuance proofs of "+
+			"group %x: %w", groupKey.SerializeCompressed(), err)
+	}
+
+	for _, leaf := range resp.Leaves {
+		var issuanceProof proof.Proof
+		err := issuanceProof.Decode(bytes.NewReader(leaf.Proof))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode issuance "+
+				"proof: %w", err)
+		}
+
+		// Only the issuance proof of the group anchor reveals the
+		// group key.
+		if issuanceProof.GroupKeyReveal == nil {
+			continue
+		}
+
+		return proof.NewFile(proof.V0, issuanceProof)
+	}
+
+	return nil, fmt.Errorf("issuance proof of the anchor of group %x not "+
+		"found in local universe, sync the group's issuance universe "+
+		"first", groupKey.SerializeCompressed())
+}
+
+var checkProofBundleCommand = cli.Command{
+	Name:      "check",
+	ShortName: "c",
+	Usage:     "check the consistency of a proof bundle offline",
+	Description: `
+	Check all proof files of a proof bundle against the block headers of
+	the bundle. This command doesn't connect to tapd or a chain backend,
+	it only uses the network given by the global --network flag to check
+	the proof of work of the block headers.
+
+	This check doesn't verify the proofs. The bundle only contains the
+	headers of the blocks the proofs are anchored in, so their proof of
+	work is only checked against the minimum difficulty of the network,
+	which is cheap to forge. The heights and hashes of all bundled blocks
+	are printed. The proofs are only verified once those are compared with
+	a trusted source, for example a full node, or once the bundle is
+	imported into tapd.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundleFileName,
+			Usage: "the path to the bundle on disk; use the dash " +
+				"character (-) to read from stdin instead",
+		},
+	},
+	Action: checkProofBundle,
+}
+
+// bundleBlock is the JSON representation of a block header of a bundle.
+type bundleBlock struct {
+	Height    uint32 `json:"height"`
+	BlockHash string `json:"block_hash"`
+}
+
+// bundleFileResult is the JSON representation of the consistency check result
+// of a single proof file of a bundle.
+type bundleFileResult struct {
+	AssetID    string `json:"asset_id"`
+	ScriptKey  string `json:"script_key"`
+	OutPoint   string `json:"outpoint"`
+	Amount     uint64 `json:"amount"`
+	NumProofs  int    `json:"num_proofs"`
+	Consistent bool   `json:"consistent"`
+	Error      string `json:"error,omitempty"`
+}
+
+func checkProofBundle(ctx *cli.Context) error {
+	if ctx.String(bundleFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	bundle, err := readProofBundle(ctx.String(bundleFileName))
+	if err != nil {
+		return err
+	}
+
+	chainParams := address.ParamsForChain(ctx.GlobalString("network"))
+	results, err := bundle.CheckConsistency(
+		getContext(), chainParams.Params,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid proof bundle: %w", err)
+	}
+
+	var (
+		fileResults = make([]bundleFileResult, 0, len(results))
+		numInvalid  int
+	)
+	for idx, result := range results {
+		file := &bundle.Files[idx]
+		fileResult := bundleFileResult{
+			NumProofs:  file.NumProofs(),
+			Consistent: result.Err == nil,
+		}
+		if result.Err != nil {
+			fileResult.Error = result.Err.Error()
+			numInvalid++
+		}
+
+		lastProof, err := file.LastProof()
+		if err == nil {
+			assetID := lastProof.Asset.ID()
+			fileResult.AssetID = hex.EncodeToString(assetID[:])
+			fileResult.ScriptKey = hex.EncodeToString(
+				lastProof.Asset.ScriptKey.PubKey.
+					SerializeCompressed(),
+			)
+			fileResult.OutPoint = lastProof.OutPoint().String()
+			fileResult.Amount = lastProof.Asset.Amount
+		}
+
+		fileResults = append(fileResults, fileResult)
+	}
+
+	// The proofs are only valid if the bundled blocks are part of the
+	// best chain, which the user has to check against a trusted source.
+	blocks := make([]bundleBlock, 0, len(bundle.BlockHeaders))
+	for _, header := range bundle.BlockHeaders {
+		blocks = append(blocks, bundleBlock{
+			Height:    header.Height,
+			BlockHash: header.Header.BlockHash().String(),
+		})
+	}
+
+	printJSON(struct {
+		NumBlockHeaders int                `json:"num_block_headers"`
+		NumMetaReveals  int                `json:"num_meta_reveals"`
+		Files           []bundleFileResult `json:"proof_files"`
+		Blocks          []bundleBlock      `json:"blocks_to_check"`
+	}{
+		NumBlockHeaders: len(bundle.BlockHeaders),
+		NumMetaReveals:  len(bundle.MetaReveals),
+		Files:           fileResults,
+		Blocks:          blocks,
+	})
+
+	if numInvalid > 0 {
+		return fmt.Errorf("%d of %d proof files are inconsistent",
+			numInvalid, len(results))
+	}
+
+	return nil
+}
+
+var importProofBundleCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "import the proofs of a bundle into the local universe",
+	Description: `
+	Check the consistency of a proof bundle offline and insert all proofs
+	of its proof files into the local universe of tapd, starting with the
+	issuance proofs of group anchors. tapd verifies every proof against its
+	chain backend before it is inserted.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundleFileName,
+			Usage: "the path to the bundle on disk; use the dash " +
+				"character (-) to read from stdin instead",
+		},
+	},
+	Action: importProofBundle,
+}
+
+func importProofBundle(ctx *cli.Context) error {
+	if ctx.String(bundleFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	bundle, err := readProofBundle(ctx.String(bundleFileName))
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	chainParams := address.ParamsForChain(ctx.GlobalString("network"))
+	results, err := bundle.CheckConsistency(
+		ctxc, chainParams.Params,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid proof bundle: %w", err)
+	}
+	for idx, result := range results {
+		if result.Err != nil {
+			return fmt.Errorf("proof file %d of bundle is "+
+				"inconsistent: %w", idx, result.Err)
+		}
+	}
+
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	// The assets of a group can only be inserted once the issuance proof
+	// of the group anchor is known, so we insert those files first.
+	var anchorFiles, otherFiles []*proof.File
+	for idx := range bundle.Files {
+		file := &bundle.Files[idx]
+		genesisProof, err := file.ProofAt(0)
+		if err != nil {
+			return err
+		}
+
+		if genesisProof.GroupKeyReveal != nil {
+			anchorFiles = append(anchorFiles, file)
+			continue
+		}
+		otherFiles = append(otherFiles, file)
+	}
+
+	var numProofs int
+	for _, file := range append(anchorFiles, otherFiles...) {
+		for i := 0; i < file.NumProofs(); i++ {
+			p, err := file.ProofAt(uint32(i))
+			if err != nil {
+				return err
+			}
+
+			rawProof, err := file.RawProofAt(uint32(i))
+			if err != nil {
+				return err
+			}
+
+			_, err = client.InsertProof(ctxc, &unirpc.AssetProof{
+				Key: universeKeyForProof(p),
+				AssetLeaf: &unirpc.AssetLeaf{
+					Proof: rawProof,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert proof for "+
+					"%v: %w", p.OutPoint(), err)
+			}
+			numProofs++
+		}
+	}
+
+	printJSON(struct {
+		NumFiles  int `json:"num_proof_files"`
+		NumProofs int `json:"num_inserted_proofs"`
+	}{
+		NumFiles:  len(bundle.Files),
+		NumProofs: numProofs,
+	})
+
+	return nil
+}
+
+// universeKeyForProof returns the key of the leaf the given proof is stored
+// at in the issuance or transfer universe of its asset.
+func universeKeyForProof(p *proof.Proof) *unirpc.UniverseKey {
+	proofType := unirpc.ProofType_PROOF_TYPE_TRANSFER
+	if p.Asset.IsGenesisAsset() {
+		proofType = unirpc.ProofType_PROOF_TYPE_ISSUANCE
+	}
+
+	id := &unirpc.ID{
+		ProofType: proofType,
+	}
+	if p.Asset.GroupKey != nil {
+		id.Id = &unirpc.ID_GroupKey{
+			GroupKey: p.Asset.GroupKey.GroupPubKey.
+				SerializeCompressed(),
+		}
+	} else {
+		assetID := p.Asset.ID()
+		id.Id = &unirpc.ID_AssetId{
+			AssetId: assetID[:],
+		}
+	}
+
+	outPoint := p.OutPoint()
+	return &unirpc.UniverseKey{
+		Id: id,
+		LeafKey: &unirpc.AssetKey{
+			Outpoint: &unirpc.AssetKey_Op{
+				Op: &unirpc.Outpoint{
+					HashStr: outPoint.Hash.String(),
+					Index:   int32(outPoint.Index),
+				},
+			},
+			ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+				ScriptKeyBytes: p.Asset.ScriptKey.PubKey.
+					SerializeCompressed(),
+			},
+		},
+	}
+}
+
+// readProofBundle reads and decodes the proof bundle at the given path.
+func readProofBundle(path string) (*proof.Bundle, error) {
+	rawBundle, err := readFile(lncfg.CleanAndExpandPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read proof bundle: %w", err)
+	}
+
+	var bundle proof.Bundle
+	if err := bundle.Decode(bytes.NewReader(rawBundle)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof bundle: %w", err)
+	}
+
+	return &bundle, nil
+}
@@ -26,6 +26,7 @@ var proofCommands = []cli.Command{
 			exportProofCommand,
 			proveOwnershipCommand,
 			verifyOwnershipCommand,
+			proofBundleCommand,
 		},
 	},
 }
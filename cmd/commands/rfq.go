@@ -15,6 +15,7 @@ var rfqCommands = []cli.Command{
 		Category:  "Channels",
 		Subcommands: []cli.Command{
 			acceptedQuotesCommand,
+			forwardFeesCommand,
 		},
 	},
 }
@@ -45,3 +46,31 @@ func acceptedQuotes(ctx *cli.Context) error {
 
 	return nil
 }
+
+var forwardFeesCommand = cli.Command{
+	Name:      "forwardfees",
+	ShortName: "f",
+	Usage:     "show the forwarding fees collected by the node",
+	Description: `
+	Lists the forwarding fees in asset units that the node collected for
+	settled asset HTLCs, summed up per asset.
+`,
+	Action: forwardFees,
+}
+
+func forwardFees(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryCollectedForwardFees(
+		ctxc, &rfqrpc.QueryCollectedForwardFeesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query forward fees: %w", err)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
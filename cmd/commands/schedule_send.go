@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	executeAtHeightName = "execute_at_height"
+	executeAtTimeName   = "execute_at_time"
+	scheduledSendIDName = "id"
+	pendingOnlyName     = "pending_only"
+)
+
+var scheduleSendCommand = cli.Command{
+	Name:  "schedulesend",
+	Usage: "schedule an asset send for a future block height or time",
+	Description: `
+	Schedule an asset send to one or more Taproot Asset addresses that is
+	only executed once the given block height and/or point in time is
+	reached. If both are given, the send is executed once both are
+	reached. The asset coins of the send are only selected when it is
+	executed.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: addrName,
+			Usage: "addr to send to; can be specified multiple " +
+				"times to send to multiple addresses of the " +
+				"same asset ID or group at once",
+		},
+		cli.StringSliceFlag{
+			Name: addrWithAmountName,
+			Usage: "addr to send to with an amount; expected " +
+				"format is '--addr_with_amount " +
+				"<addr>:<amount>', can be specified multiple " +
+				"times to send to multiple addresses of the " +
+				"same asset ID or group at once",
+		},
+		cli.Uint64Flag{
+			Name: executeAtHeightName,
+			Usage: "the block height at or after which the send " +
+				"is executed",
+		},
+		cli.StringFlag{
+			Name: executeAtTimeName,
+			Usage: "the point in time at or after which the send " +
+				"is executed, in RFC3339 format (e.g. " +
+				"2025-01-31T12:00:00Z)",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transaction; if not set, the fee " +
+				"rate is estimated when the send is executed",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "an optional label for the send transfer",
+		},
+		cli.BoolFlag{
+			Name:  skipProofCourierPingCheckName,
+			Usage: "if set, skip the proof courier ping check",
+		},
+	},
+	Action: scheduleSend,
+}
+
+func scheduleSend(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	if !ctx.IsSet(executeAtHeightName) && !ctx.IsSet(executeAtTimeName) {
+		return fmt.Errorf("either --%s or --%s must be set",
+			executeAtHeightName, executeAtTimeName)
+	}
+
+	rpcAddrs, err := parseSendAddrs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rpcAddrs) == 0 {
+		return fmt.Errorf("at least one address must be specified")
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &taprpc.ScheduleSendRequest{
+		AddressesWithAmounts: rpcAddrs,
+		FeeRate:              feeRate,
+		Label:                ctx.String(labelName),
+		SkipProofCourierPingCheck: ctx.Bool(
+			skipProofCourierPingCheckName,
+		),
+		ExecuteAtHeight: uint32(ctx.Uint64(executeAtHeightName)),
+	}
+
+	if ctx.IsSet(executeAtTimeName) {
+		executeAt, err := time.Parse(
+			time.RFC3339, ctx.String(executeAtTimeName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid execution time: %w", err)
+		}
+
+		req.ExecuteAtTimestamp = executeAt.Unix()
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ScheduleSend(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to schedule send: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listScheduledSendsCommand = cli.Command{
+	Name:  "listscheduledsends",
+	Usage: "list scheduled asset sends",
+	Description: `
+	List all scheduled asset sends, including the ones that were already
+	executed, failed or were cancelled.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  pendingOnlyName,
+			Usage: "if set, only pending sends are shown",
+		},
+	},
+	Action: listScheduledSends,
+}
+
+func listScheduledSends(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListScheduledSends(
+		ctxc, &taprpc.ListScheduledSendsRequest{
+			PendingOnly: ctx.Bool(pendingOnlyName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list scheduled sends: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelScheduledSendCommand = cli.Command{
+	Name:  "cancelscheduledsend",
+	Usage: "cancel a pending scheduled asset send",
+	Description: `
+	Cancel a pending scheduled asset send. Sends that are already being
+	executed or completed can't be cancelled.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  scheduledSendIDName,
+			Usage: "the ID of the scheduled send to cancel",
+		},
+	},
+	Action: cancelScheduledSend,
+}
+
+func cancelScheduledSend(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || !ctx.IsSet(scheduledSendIDName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelScheduledSend(
+		ctxc, &taprpc.CancelScheduledSendRequest{
+			Id: ctx.Int64(scheduledSendIDName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to cancel scheduled send: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,245 @@
+package commands
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/tapcfg"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/taprpc"
+	"github.com/urfave/cli"
+)
+
+const (
+	csvFileName       = "csv"
+	batchLabelName    = "label"
+	maxRecipientsName = "max_recipients"
+	startChunkName    = "start_chunk"
+	batchStatusName   = "status"
+	batchIDName       = "batch_id"
+)
+
+var sendBatchCommand = cli.Command{
+	Name:  "send-batch",
+	Usage: "send assets to many recipients from a CSV file",
+	Description: `
+	Send assets to many recipients, for example for a payroll or an
+	airdrop. The recipients are read from a CSV file with one
+	'<addr>[,<amount>]' row per recipient. The amount column is only
+	required for V2 addresses that don't specify an amount. An optional
+	header row starting with 'address' and lines starting with '#' are
+	ignored.
+
+	The recipients are grouped by asset and split into chunks of at most
+	--max_recipients recipients. Each chunk is sent in its own anchor
+	transaction and tracked as a separate transfer labeled
+	'<label>-<batch ID>-<chunk number>'. A new batch gets a new random
+	batch ID, which is shown in the output.
+
+	Because the change of a chunk can only be spent once its anchor
+	transaction confirms, a chunk may fail if there aren't enough other
+	confirmed asset outputs. The batch can then be resumed with
+	--batch_id and --start_chunk after the previous chunks confirmed.
+	Chunks of the batch that were already sent are skipped.
+
+	With --status and --batch_id, no assets are sent. Instead the proof
+	delivery status of each recipient of a previously sent batch is
+	shown.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: csvFileName,
+			Usage: "the CSV file with one '<addr>[,<amount>]' " +
+				"row per recipient",
+		},
+		cli.StringFlag{
+			Name: batchLabelName,
+			Usage: "the label of the batch; each chunk is " +
+				"labeled '<label>-<batch ID>-<chunk number>'",
+		},
+		cli.StringFlag{
+			Name: batchIDName,
+			Usage: "the ID of a previously started batch, " +
+				"required to resume it or show its status",
+		},
+		cli.IntFlag{
+			Name: maxRecipientsName,
+			Usage: "the maximum number of recipients per anchor " +
+				"transaction",
+			Value: tapfreighter.DefaultMaxBatchRecipients,
+		},
+		cli.IntFlag{
+			Name: startChunkName,
+			Usage: "the number of the first chunk to send, used " +
+				"to resume a partially sent batch",
+			Value: 1,
+		},
+		cli.BoolFlag{
+			Name: batchStatusName,
+			Usage: "if set, show the proof delivery status of a " +
+				"previously sent batch instead of sending",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the anchor transactions",
+		},
+		cli.BoolFlag{
+			Name:  skipProofCourierPingCheckName,
+			Usage: "if set, skip the proof courier ping check",
+		},
+	},
+	Action: sendBatch,
+}
+
+// parseBatchRecipients parses the recipients of a batch send from the given
+// CSV data.
+func parseBatchRecipients(r io.Reader,
+	chainParams *address.ChainParams) ([]*taprpc.AddressWithAmount, error) {
+
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var recipients []*taprpc.AddressWithAmount
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CSV: %w", err)
+		}
+
+		addrStr := strings.TrimSpace(record[0])
+
+		// Skip an optional header row.
+		if row == 1 && strings.EqualFold(addrStr, "address") {
+			continue
+		}
+
+		if len(record) > 2 {
+			return nil, fmt.Errorf("row %d: expected format is "+
+				"'<addr>[,<amount>]'", row)
+		}
+
+		var userAmount uint64
+		if len(record) == 2 && strings.TrimSpace(record[1]) != "" {
+			userAmount, err = strconv.ParseUint(
+				strings.TrimSpace(record[1]), 10, 64,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid "+
+					"amount: %w", row, err)
+			}
+		}
+
+		addr, err := address.DecodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: unable to decode "+
+				"address %s: %w", row, addrStr, err)
+		}
+
+		err = checkAddrAmount(addrStr, addr, userAmount)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		recipients = append(recipients, &taprpc.AddressWithAmount{
+			TapAddr: addrStr,
+			Amount:  userAmount,
+		})
+	}
+
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("no recipients found in CSV")
+	}
+
+	return recipients, nil
+}
+
+func sendBatch(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || !ctx.IsSet(csvFileName) ||
+		!ctx.IsSet(batchLabelName) {
+
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	maxRecipients := ctx.Int(maxRecipientsName)
+	if maxRecipients < 1 ||
+		maxRecipients > tapfreighter.MaxBatchRecipients {
+
+		return fmt.Errorf("%s must be between 1 and %d",
+			maxRecipientsName, tapfreighter.MaxBatchRecipients)
+	}
+
+	startChunk := ctx.Int(startChunkName)
+	if startChunk < 1 {
+		return fmt.Errorf("%s must be at least 1", startChunkName)
+	}
+
+	batchID := ctx.String(batchIDName)
+	if batchID == "" && (ctx.Bool(batchStatusName) || startChunk > 1) {
+		return fmt.Errorf("%s is required to resume a batch or show "+
+			"its status", batchIDName)
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	csvFile, err := os.Open(tapcfg.CleanAndExpandPath(
+		ctx.String(csvFileName),
+	))
+	if err != nil {
+		return fmt.Errorf("unable to open CSV file: %w", err)
+	}
+	defer csvFile.Close()
+
+	chainParams := address.ParamsForChain(ctx.GlobalString("network"))
+	recipients, err := parseBatchRecipients(csvFile, &chainParams)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SendAssetBatch(ctxc, &taprpc.SendAssetBatchRequest{
+		Recipients:         recipients,
+		Label:              ctx.String(batchLabelName),
+		MaxRecipientsPerTx: uint32(maxRecipients),
+		StartChunk:         uint32(startChunk),
+		FeeRate:            feeRate,
+		SkipProofCourierPingCheck: ctx.Bool(
+			skipProofCourierPingCheckName,
+		),
+		StatusOnly: ctx.Bool(batchStatusName),
+		BatchId:    batchID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to send batch: %w", err)
+	}
+
+	// Show the chunks that were sent so far, so the batch can be resumed
+	// if a chunk failed.
+	printRespJSON(resp)
+
+	if resp.FailedChunk != 0 {
+		return fmt.Errorf("unable to send chunk %d (resume with "+
+			"--%s=%s --%s=%d): %s", resp.FailedChunk, batchIDName,
+			resp.BatchId, startChunkName, resp.FailedChunk,
+			resp.FailureReason)
+	}
+
+	return nil
+}
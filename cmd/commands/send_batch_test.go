@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseBatchRecipients tests that batch send recipients are parsed from
+// CSV data and that invalid rows are rejected.
+func TestParseBatchRecipients(t *testing.T) {
+	t.Parallel()
+
+	params := &address.RegressionNetTap
+	courierAddr := address.RandProofCourierAddr(t)
+
+	randAddr := func() string {
+		addr, _, _ := address.RandAddrWithVersion(
+			t, params, courierAddr, address.V1,
+		)
+
+		addrStr, err := addr.EncodeAddress()
+		require.NoError(t, err)
+
+		return addrStr
+	}
+	addr1 := randAddr()
+	addr2 := randAddr()
+
+	csvData := strings.Join([]string{
+		"address,amount",
+		"# The first recipient.",
+		addr1,
+		fmt.Sprintf("%s,", addr2),
+	}, "\n")
+
+	recipients, err := parseBatchRecipients(
+		strings.NewReader(csvData), params,
+	)
+	require.NoError(t, err)
+	require.Len(t, recipients, 2)
+
+	require.Equal(t, addr1, recipients[0].TapAddr)
+	require.Zero(t, recipients[0].Amount)
+	require.Equal(t, addr2, recipients[1].TapAddr)
+	require.Zero(t, recipients[1].Amount)
+
+	// Overriding the amount of an address that isn't a V2 address isn't
+	// allowed.
+	_, err = parseBatchRecipients(
+		strings.NewReader(fmt.Sprintf("%s,100", addr1)), params,
+	)
+	require.ErrorContains(t, err, "row 1: address")
+
+	_, err = parseBatchRecipients(
+		strings.NewReader(fmt.Sprintf("%s,1,2", addr1)), params,
+	)
+	require.ErrorContains(t, err, "expected format")
+
+	_, err = parseBatchRecipients(
+		strings.NewReader("address,amount\n"), params,
+	)
+	require.ErrorContains(t, err, "no recipients found")
+}
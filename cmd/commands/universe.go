@@ -44,11 +44,14 @@ var universeCommands = []cli.Command{
 			universeDeleteRootCommand,
 			universeLeavesCommand,
 			universeKeysCommand,
+			universeSubtreesCommand,
 			universeProofCommand,
 			universeSyncCommand,
 			universeFederationCommand,
+			universeWriteQuotaCommand,
 			universeInfoCommand,
 			universeStatsCommand,
+			universeSearchCommand,
 			universeSupplyCommitCommand,
 		},
 	},
@@ -362,6 +365,87 @@ func universeKeys(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	subtreeDepthName = "depth"
+	subtreePathName  = "path"
+)
+
+var universeSubtreesCommand = cli.Command{
+	Name:  "subtrees",
+	Usage: "return the subtree roots of a Universe tree",
+	Description: `
+	Query for the roots of the subtrees of a given asset universe tree at the
+	given depth. A subtree is identified by the path from the root of the
+	tree to the subtree root, of which only the first depth bits are used.
+	If no path is given, the subtree of the all-zero path is returned.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to query for",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to show the subtrees for, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.IntFlag{
+			Name: subtreeDepthName,
+			Usage: "the depth of the subtree roots, between 0 " +
+				"(the root of the tree) and 256 (the leaves)",
+		},
+		cli.StringSliceFlag{
+			Name: subtreePathName,
+			Usage: "the hex encoded 32-byte path of a subtree, " +
+				"can be specified multiple times",
+		},
+	},
+	Action: universeSubtrees,
+}
+
+func universeSubtrees(ctx *cli.Context) error {
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	paths := [][]byte{make([]byte, 32)}
+	if ctx.IsSet(subtreePathName) {
+		paths = nil
+		for _, pathStr := range ctx.StringSlice(subtreePathName) {
+			path, err := hex.DecodeString(pathStr)
+			if err != nil {
+				return fmt.Errorf("invalid subtree path: %w",
+					err)
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UniverseSubtrees(
+		ctxc, &unirpc.UniverseSubtreesRequest{
+			Id:    universeID,
+			Depth: int32(ctx.Int(subtreeDepthName)),
+			Paths: paths,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeLeavesCommand = cli.Command{
 	Name:      "leaves",
 	ShortName: "l",
@@ -688,6 +772,33 @@ var universeSyncCommand = cli.Command{
 		},
 	},
 	Action: universeSync,
+	Subcommands: []cli.Command{
+		universeSyncProgressCommand,
+	},
+}
+
+var universeSyncProgressCommand = cli.Command{
+	Name:  "progress",
+	Usage: "show the progress of the scheduled universe syncs",
+	Description: `
+	Show the progress of the scheduled syncs with each universe server that
+	was synced with since tapd was started.
+	`,
+	Action: universeSyncProgress,
+}
+
+func universeSyncProgress(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SyncProgress(ctxc, &unirpc.SyncProgressRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
 }
 
 func universeSync(ctx *cli.Context) error {
@@ -1291,6 +1402,156 @@ func universeFederationGetConfigInfo(ctx *cli.Context) error {
 	return printLocalConfig()
 }
 
+const (
+	issuerKeyName = "issuer_key"
+
+	maxProofsName = "max_proofs"
+
+	maxBytesName = "max_bytes"
+)
+
+var universeWriteQuotaCommand = cli.Command{
+	Name:      "writequota",
+	ShortName: "wq",
+	Usage:     "manage the write allowlist and quotas of issuers",
+	Description: `
+	Manage the issuers that are allowed to insert proofs into the local
+	Universe server, and how many proofs and bytes each issuer may insert.
+	An issuer is identified by its group key for grouped assets, or by the
+	script key the asset was issued to for non-grouped assets. The
+	allowlist is only enforced if universe.require-write-auth is set.
+	`,
+	Subcommands: []cli.Command{
+		universeWriteQuotaSetCommand,
+		universeWriteQuotaListCommand,
+		universeWriteQuotaDeleteCommand,
+	},
+}
+
+var universeWriteQuotaSetCommand = cli.Command{
+	Name:      "set",
+	ShortName: "s",
+	Usage:     "add an issuer to the allowlist or update its quota",
+	Description: `
+	Add an issuer to the write allowlist, or update the limits of the
+	write quota of an issuer that is already on the allowlist. The usage
+	of an existing quota is kept. A limit of zero means unlimited.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: issuerKeyName,
+			Usage: "the hex encoded issuer key, group keys must " +
+				"be given in their 33-byte compressed form",
+		},
+		cli.Uint64Flag{
+			Name: maxProofsName,
+			Usage: "the maximum number of proofs the issuer may " +
+				"insert",
+		},
+		cli.Uint64Flag{
+			Name: maxBytesName,
+			Usage: "the maximum number of raw proof bytes the " +
+				"issuer may insert",
+		},
+	},
+	Action: universeWriteQuotaSet,
+}
+
+func parseIssuerKey(ctx *cli.Context) ([]byte, error) {
+	if ctx.String(issuerKeyName) == "" {
+		return nil, fmt.Errorf("--%v must be set", issuerKeyName)
+	}
+
+	issuerKey, err := hex.DecodeString(ctx.String(issuerKeyName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode issuer key: %w", err)
+	}
+
+	return issuerKey, nil
+}
+
+func universeWriteQuotaSet(ctx *cli.Context) error {
+	issuerKey, err := parseIssuerKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SetWriteQuota(ctxc, &unirpc.SetWriteQuotaRequest{
+		IssuerKey: issuerKey,
+		MaxProofs: ctx.Uint64(maxProofsName),
+		MaxBytes:  ctx.Uint64(maxBytesName),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeWriteQuotaListCommand = cli.Command{
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "list the write quotas of all allowed issuers",
+	Action:    universeWriteQuotaList,
+}
+
+func universeWriteQuotaList(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListWriteQuotas(
+		ctxc, &unirpc.ListWriteQuotasRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeWriteQuotaDeleteCommand = cli.Command{
+	Name:      "delete",
+	ShortName: "d",
+	Usage:     "remove an issuer from the write allowlist",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  issuerKeyName,
+			Usage: "the hex encoded issuer key to remove",
+		},
+	},
+	Action: universeWriteQuotaDelete,
+}
+
+func universeWriteQuotaDelete(ctx *cli.Context) error {
+	issuerKey, err := parseIssuerKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.DeleteWriteQuota(
+		ctxc, &unirpc.DeleteWriteQuotaRequest{
+			IssuerKey: issuerKey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeInfoCommand = cli.Command{
 	Name:      "info",
 	ShortName: "i",
@@ -1506,6 +1767,250 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	namePrefixName = "name_prefix"
+
+	metaTypeFilterName = "meta_type"
+
+	decimalDisplayFilterName = "decimal_display"
+
+	minHeightName = "min_height"
+
+	maxHeightName = "max_height"
+
+	groupedName = "grouped"
+
+	ungroupedName = "ungrouped"
+
+	cursorName = "cursor"
+)
+
+var universeSearchCommand = cli.Command{
+	Name:  "search",
+	Usage: "search the local Universe server for assets or leaves",
+	Description: `
+	Search the assets and leaves known to the local Universe server. All
+	results are paginated with a cursor, the next_cursor of a response can
+	be passed as the --cursor of the next call to fetch the next page.
+	`,
+	Subcommands: []cli.Command{
+		universeSearchAssetsCommand,
+		universeSearchLeavesCommand,
+	},
+}
+
+var universeSearchAssetsCommand = cli.Command{
+	Name:      "assets",
+	ShortName: "a",
+	Usage:     "search for assets by name, type, meta or group",
+	Description: `
+	Search for the assets known to the local Universe server that match all
+	of the given filters. The name prefix is matched case insensitively.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  namePrefixName,
+			Usage: "(optional) the prefix of the asset name",
+		},
+		cli.StringFlag{
+			Name: assetTypeName,
+			Usage: "(optional) the type of the asset, either " +
+				"'normal' or 'collectible'",
+		},
+		cli.StringFlag{
+			Name: metaTypeFilterName,
+			Usage: "(optional) the meta type of the asset, either " +
+				"'opaque', 'json' or a custom numeric type",
+		},
+		cli.Uint64Flag{
+			Name:  decimalDisplayFilterName,
+			Usage: "(optional) the decimal display of the asset",
+		},
+		cli.Uint64Flag{
+			Name: minHeightName,
+			Usage: "(optional) the minimum block height the asset " +
+				"was minted at",
+		},
+		cli.Uint64Flag{
+			Name: maxHeightName,
+			Usage: "(optional) the maximum block height the asset " +
+				"was minted at",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "(optional) the group key of the asset",
+		},
+		cli.BoolFlag{
+			Name:  groupedName,
+			Usage: "only return grouped assets",
+		},
+		cli.BoolFlag{
+			Name:  ungroupedName,
+			Usage: "only return ungrouped assets",
+		},
+		cli.Uint64Flag{
+			Name:  cursorName,
+			Usage: "the cursor returned by the previous page",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the maximum number of results to return",
+		},
+	},
+	Action: universeSearchAssets,
+}
+
+func universeSearchAssets(ctx *cli.Context) error {
+	req := &unirpc.SearchAssetsRequest{
+		NamePrefix:       ctx.String(namePrefixName),
+		MinGenesisHeight: uint32(ctx.Uint64(minHeightName)),
+		MaxGenesisHeight: uint32(ctx.Uint64(maxHeightName)),
+		Cursor:           ctx.Uint64(cursorName),
+		Limit:            int32(ctx.Int64(limitName)),
+	}
+
+	switch ctx.String(assetTypeName) {
+	case "":
+	case "normal":
+		req.AssetTypeFilter = unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL
+
+	case "collectible":
+		req.AssetTypeFilter =
+			unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE
+
+	default:
+		return fmt.Errorf("unknown asset type '%v'",
+			ctx.String(assetTypeName))
+	}
+
+	if ctx.IsSet(metaTypeFilterName) {
+		metaType, err := parseMetaType(ctx.String(metaTypeFilterName))
+		if err != nil {
+			return fmt.Errorf("unable to parse meta type: %w", err)
+		}
+
+		req.FilterMetaType = true
+		req.MetaType = metaType
+	}
+
+	if ctx.IsSet(decimalDisplayFilterName) {
+		req.FilterDecimalDisplay = true
+		req.DecimalDisplay = uint32(
+			ctx.Uint64(decimalDisplayFilterName),
+		)
+	}
+
+	if ctx.String(groupKeyName) != "" {
+		groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("unable to decode group key: %w", err)
+		}
+
+		req.GroupKey = groupKey
+	}
+
+	switch {
+	case ctx.Bool(groupedName) && ctx.Bool(ungroupedName):
+		return fmt.Errorf("cannot set both --%v and --%v",
+			groupedName, ungroupedName)
+
+	case ctx.Bool(groupedName):
+		req.GroupFilter = unirpc.AssetGroupFilter_FILTER_GROUP_GROUPED
+
+	case ctx.Bool(ungroupedName):
+		req.GroupFilter = unirpc.AssetGroupFilter_FILTER_GROUP_UNGROUPED
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SearchAssets(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeSearchLeavesCommand = cli.Command{
+	Name:      "leaves",
+	ShortName: "l",
+	Usage:     "search for leaves by script key or outpoint",
+	Description: `
+	Search for the universe leaves known to the local Universe server that
+	match the given script key and/or anchor outpoint, across all asset
+	universes of the given proof type.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "(optional) the script key of the leaf",
+		},
+		cli.StringFlag{
+			Name: outpointName,
+			Usage: "(optional) the anchor outpoint of the leaf, " +
+				"in the form txid:vout",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "(optional) the type of proof to search for, " +
+				"either 'issuance' or 'transfer'; if not " +
+				"specified, all proof types are searched",
+			Value: universe.ProofTypeUnspecified.String(),
+		},
+		cli.Uint64Flag{
+			Name:  cursorName,
+			Usage: "the cursor returned by the previous page",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the maximum number of results to return",
+		},
+	},
+	Action: universeSearchLeaves,
+}
+
+func universeSearchLeaves(ctx *cli.Context) error {
+	req := &unirpc.SearchLeavesRequest{
+		Outpoint: ctx.String(outpointName),
+		Cursor:   ctx.Uint64(cursorName),
+		Limit:    int32(ctx.Int64(limitName)),
+	}
+
+	if ctx.String(scriptKeyName) != "" {
+		scriptKey, err := hex.DecodeString(ctx.String(scriptKeyName))
+		if err != nil {
+			return fmt.Errorf("unable to decode script key: %w",
+				err)
+		}
+
+		req.ScriptKey = scriptKey
+	}
+
+	proofType, err := universe.ParseStrProofType(ctx.String(proofTypeName))
+	if err != nil {
+		return err
+	}
+	req.ProofType, err = tap.MarshalUniProofType(proofType)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SearchLeaves(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeSupplyCommitCommand = cli.Command{
 	Name:      "supplycommit",
 	ShortName: "sc",
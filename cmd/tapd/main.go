@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"runtime/pprof"
 
+	"github.com/btcsuite/btclog/v2"
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
@@ -22,7 +25,7 @@ func main() {
 
 	// Load the configuration, and parse any command line options. This
 	// function will also set up logging properly.
-	cfg, cfgLogger, err := tapcfg.LoadConfig(shutdownInterceptor)
+	cfg, cfgLogger, args, err := tapcfg.LoadConfig(shutdownInterceptor)
 	if err != nil {
 		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
 			// Print error if not due to help request.
@@ -35,6 +38,16 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Run the subcommand instead of the daemon if one was given.
+	if len(args) > 0 {
+		if err := runCommand(cfg, cfgLogger, args); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	// Enable http profiling server if requested.
 	if cfg.Profile != "" {
 		go func() {
@@ -81,3 +94,47 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// migrateStats is the summary of a database migration that is printed once
+// the copy was verified.
+type migrateStats struct {
+	RowCounts    map[string]int64 `json:"row_counts"`
+	NumTreeRoots int              `json:"num_verified_tree_roots"`
+}
+
+// runCommand runs the tapd subcommand with the given arguments, using the
+// already loaded configuration.
+func runCommand(cfg *tapcfg.Config, cfgLogger btclog.Logger,
+	args []string) error {
+
+	switch args[0] {
+	case tapcfg.MigratePostgresCommand:
+		if len(args) > 1 {
+			return fmt.Errorf("%s doesn't take any arguments",
+				args[0])
+		}
+
+		stats, err := tapcfg.MigrateToPostgres(
+			context.Background(), cfg, cfgLogger,
+		)
+		if err != nil {
+			return err
+		}
+
+		jsonStats, err := json.MarshalIndent(migrateStats{
+			RowCounts:    stats.RowCounts,
+			NumTreeRoots: stats.NumTreeRoots,
+		}, "", "    ")
+		if err != nil {
+			return fmt.Errorf("unable to encode stats: %w", err)
+		}
+
+		fmt.Println(string(jsonStats))
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown command %q, the only supported "+
+			"command is %s", args[0], tapcfg.MigratePostgresCommand)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfeatures"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
@@ -23,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/universe/supplyverifier"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
+	lfn "github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/signal"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
@@ -79,7 +81,7 @@ type DatabaseConfig struct {
 
 	Multiverse *tapdb.MultiverseStore
 
-	FederationDB *tapdb.UniverseFederationDB
+	FederationDB universe.FederationDB
 }
 
 // UniversePublicAccessStatus is a type that indicates the status of public
@@ -184,16 +186,48 @@ type Config struct {
 
 	ProofArchive proof.Archiver
 
+	// ProofCourierStats keeps track of the proof transfer attempts of the
+	// proof couriers.
+	ProofCourierStats *proof.CourierStats
+
 	AssetWallet tapfreighter.Wallet
 
 	CoinSelect *tapfreighter.CoinSelect
 
 	ChainPorter tapfreighter.Porter
 
+	// Consolidator merges many small outputs of an asset into a single
+	// output.
+	Consolidator *tapfreighter.Consolidator
+
+	// SendScheduler executes asset sends once a block height or a point
+	// in time is reached.
+	SendScheduler *tapfreighter.SendScheduler
+
+	// BalanceHistoryRecorder records the asset balance of our asset
+	// channels after each commitment update.
+	BalanceHistoryRecorder *balancehistory.Recorder
+
+	// BalanceHistoryStore gives access to the recorded balance history of
+	// our asset channels.
+	BalanceHistoryStore balancehistory.Store
+
+	// DatabaseBackup creates consistent backups of the asset database
+	// while the daemon is running.
+	DatabaseBackup *tapdb.BackupManager
+
+	// StagedBackupPath is the path the RestoreBackup RPC stages a backup
+	// archive at. A staged backup is restored on the next start.
+	StagedBackupPath string
+
 	// SweepOrphanUtxos toggles sweeping orphaned UTXOs into anchor
 	// transactions for sends and burns.
 	SweepOrphanUtxos bool
 
+	// CoinSelectStrategy is the strategy used to select the asset coins
+	// that fund sends and virtual packets.
+	CoinSelectStrategy tapfreighter.MultiCommitmentSelectStrategy
+
 	// FsmDaemonAdapters is a set of adapters that allow a state machine to
 	// interact with external daemons.
 	FsmDaemonAdapters *lndservices.LndFsmDaemonAdapters
@@ -205,6 +239,10 @@ type Config struct {
 
 	IgnoreChecker *tapdb.CachingIgnoreChecker
 
+	// VerificationCache is an optional cache of already verified proofs
+	// that is used to speed up the verification of proof files.
+	VerificationCache lfn.Option[proof.VerificationCache]
+
 	// SupplyVerifyManager is a service that is used to verify supply
 	// commitments for assets. Supply commitments are issuer published
 	// attestations of the total supply of an asset.
@@ -216,6 +254,23 @@ type Config struct {
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseReplica is the read replica that tails the primary universe
+	// server. This is nil if the universe server isn't a read replica.
+	UniverseReplica *universe.Replica
+
+	// UniverseWriteAuth authenticates proof inserts by their issuers and
+	// enforces their write quotas. This is nil if universe writes don't
+	// require authentication.
+	UniverseWriteAuth *universe.WriteAuthenticator
+
+	// UniverseWriteQuotas stores the write allowlist and quotas of the
+	// universe issuers.
+	UniverseWriteQuotas universe.WriteQuotaStore
+
+	// UniverseWriteAuthSigner authenticates the proofs pushed to remote
+	// universe servers on behalf of the issuers of the local wallet.
+	UniverseWriteAuthSigner universe.WriteAuthSigner
+
 	// UniFedSyncAllAssets is a flag that indicates whether the
 	// universe federation syncer should default to syncing all assets.
 	UniFedSyncAllAssets bool
@@ -224,10 +279,20 @@ type Config struct {
 
 	PriceOracle rfq.PriceOracle
 
+	// StaticPriceOracle is the built-in static price oracle. This is nil
+	// if the static price oracle isn't configured.
+	StaticPriceOracle *rfq.StaticPriceOracle
+
+	// PriceOracleCache is the cache of price oracle responses. This is nil
+	// if the cache is disabled.
+	PriceOracleCache *rfq.CachingPriceOracle
+
 	PriceOracleSendPeerID bool
 
 	UniverseStats universe.Telemetry
 
+	UniverseSearcher universe.Searcher
+
 	AuxLeafSigner *tapchannel.AuxLeafSigner
 
 	AuxFundingController *tapchannel.FundingController
@@ -22,8 +22,21 @@ type ErrFunc[V any] func(context.Context, V) error
 // the first time a function passed returns a non-nil error.  Returns the first
 // non-nil error (if any).
 func ParSlice[V any](ctx context.Context, s []V, f ErrFunc[V]) error {
+	return ParSliceN(ctx, s, runtime.GOMAXPROCS(0), f)
+}
+
+// ParSliceN is identical to ParSlice, but limits the number of active
+// goroutines to the given limit instead of the number of CPUs. A limit that
+// isn't positive falls back to the number of CPUs.
+func ParSliceN[V any](ctx context.Context, s []V, limit int,
+	f ErrFunc[V]) error {
+
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+
 	errGroup, ctx := errgroup.WithContext(ctx)
-	errGroup.SetLimit(runtime.GOMAXPROCS(0))
+	errGroup.SetLimit(limit)
 
 	for _, v := range s {
 		// Snapshot v now so the goroutine sees the intended value
@@ -48,8 +61,21 @@ func ParSlice[V any](ctx context.Context, s []V, f ErrFunc[V]) error {
 func ParSliceErrCollect[V any](ctx context.Context, s []V,
 	f ErrFunc[V]) (map[int]error, error) {
 
+	return ParSliceErrCollectN(ctx, s, runtime.GOMAXPROCS(0), f)
+}
+
+// ParSliceErrCollectN is identical to ParSliceErrCollect, but limits the
+// number of active goroutines to the given limit instead of the number of
+// CPUs. A limit that isn't positive falls back to the number of CPUs.
+func ParSliceErrCollectN[V any](ctx context.Context, s []V, limit int,
+	f ErrFunc[V]) (map[int]error, error) {
+
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+
 	errGroup, groupCtx := errgroup.WithContext(ctx)
-	errGroup.SetLimit(runtime.GOMAXPROCS(0))
+	errGroup.SetLimit(limit)
 
 	var (
 		instanceErrorsMu sync.Mutex
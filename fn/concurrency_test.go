@@ -3,7 +3,9 @@ package fn
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -57,3 +59,46 @@ func TestParSlice(t *testing.T) {
 		})
 	}
 }
+
+// TestParSliceN tests that ParSliceN never runs more goroutines than the given
+// limit.
+func TestParSliceN(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+
+	var (
+		active    atomic.Int32
+		maxActive atomic.Int32
+	)
+	trackActive := func(ctx context.Context, _ int) error {
+		numActive := active.Add(1)
+		defer active.Add(-1)
+
+		for {
+			curMax := maxActive.Load()
+			if numActive <= curMax ||
+				maxActive.CompareAndSwap(curMax, numActive) {
+
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		return nil
+	}
+
+	values := make([]int, 10)
+	err := ParSliceN(context.Background(), values, limit, trackActive)
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxActive.Load(), int32(limit))
+
+	maxActive.Store(0)
+	errs, err := ParSliceErrCollectN(
+		context.Background(), values, limit, trackActive,
+	)
+	require.NoError(t, err)
+	require.Empty(t, errs)
+	require.LessOrEqual(t, maxActive.Load(), int32(limit))
+}
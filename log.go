@@ -10,6 +10,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
@@ -130,6 +131,10 @@ func SetupLoggers(root *build.SubLoggerManager,
 	AddSubLogger(
 		root, tapchannel.Subsystem, interceptor, tapchannel.UseLogger,
 	)
+	AddSubLogger(
+		root, balancehistory.Subsystem, interceptor,
+		balancehistory.UseLogger,
+	)
 	AddSubLogger(
 		root, authmailbox.Subsystem, interceptor, authmailbox.UseLogger,
 	)
@@ -3,7 +3,10 @@ package monitoring
 import (
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"google.golang.org/grpc"
@@ -43,6 +46,18 @@ type PrometheusConfig struct {
 	// asset minter.
 	AssetMinter tapgarden.Planter
 
+	// RfqManager is used to collect any stats that are relevant to the RFQ
+	// quote negotiation and HTLC interception.
+	RfqManager *rfq.Manager
+
+	// CourierStats is used to collect any stats that are relevant to the
+	// proof couriers.
+	CourierStats *proof.CourierStats
+
+	// ChainPorter is used to collect any stats that are relevant to the
+	// asset transfers of the chain porter.
+	ChainPorter tapfreighter.Porter
+
 	// CacheStats is a function that can be used to collect cache stats
 	// from the daemon. This is used to export cache hits and misses for
 	// various caches used in the daemon.
@@ -0,0 +1,127 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	courierAttemptsMetric       = "proof_courier_attempts"
+	courierFailedAttemptsMetric = "proof_courier_failed_attempts"
+	courierBackoffWaitsMetric   = "proof_courier_backoff_waits"
+	courierFailuresMetric       = "proof_courier_failures"
+)
+
+// courierCollector is a Prometheus collector that exports metrics related to
+// the proof transfers of the proof couriers.
+type courierCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	attempts       *prometheus.GaugeVec
+	failedAttempts *prometheus.GaugeVec
+	backoffWaits   *prometheus.GaugeVec
+	failures       *prometheus.GaugeVec
+}
+
+func newCourierCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*courierCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("courier collector prometheus cfg is " +
+			"nil")
+	}
+
+	if cfg.CourierStats == nil {
+		return nil, errors.New("courier collector courier stats is nil")
+	}
+
+	labels := []string{"courier_type", "transfer_type"}
+
+	return &courierCollector{
+		cfg:      cfg,
+		registry: registry,
+		attempts: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: courierAttemptsMetric,
+				Help: "Total number of proof transfer attempts",
+			},
+			labels,
+		),
+		failedAttempts: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: courierFailedAttemptsMetric,
+				Help: "Total number of failed proof transfer " +
+					"attempts",
+			},
+			labels,
+		),
+		backoffWaits: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: courierBackoffWaitsMetric,
+				Help: "Total number of backoff waits between " +
+					"proof transfer attempts",
+			},
+			labels,
+		),
+		failures: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: courierFailuresMetric,
+				Help: "Total number of proof transfers that " +
+					"failed after all attempts",
+			},
+			labels,
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *courierCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	c.attempts.Describe(ch)
+	c.failedAttempts.Describe(ch)
+	c.backoffWaits.Describe(ch)
+	c.failures.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *courierCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectMx.Lock()
+	defer c.collectMx.Unlock()
+
+	for key, counts := range c.cfg.CourierStats.Snapshot() {
+		labels := []string{
+			string(key.CourierType), string(key.TransferType),
+		}
+
+		c.attempts.WithLabelValues(labels...).Set(
+			float64(counts.Attempts),
+		)
+		c.failedAttempts.WithLabelValues(labels...).Set(
+			float64(counts.FailedAttempts),
+		)
+		c.backoffWaits.WithLabelValues(labels...).Set(
+			float64(counts.BackoffWaits),
+		)
+		c.failures.WithLabelValues(labels...).Set(
+			float64(counts.Failures),
+		)
+	}
+
+	c.attempts.Collect(ch)
+	c.failedAttempts.Collect(ch)
+	c.backoffWaits.Collect(ch)
+	c.failures.Collect(ch)
+}
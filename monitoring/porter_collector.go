@@ -0,0 +1,130 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	porterParcelsMetric          = "porter_parcels"
+	porterParcelsCompletedMetric = "porter_parcels_completed"
+	porterParcelsFailedMetric    = "porter_parcels_failed"
+
+	porterConfirmationsMetric    = "porter_anchor_tx_confirmations"
+	porterConfirmationTimeMetric = "porter_anchor_tx_confirmation_seconds"
+)
+
+// porterCollector is a Prometheus collector that exports metrics related to
+// the asset transfers of the chain porter.
+type porterCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	parcels          *prometheus.GaugeVec
+	parcelsCompleted prometheus.Gauge
+	parcelsFailed    prometheus.Gauge
+	confirmations    prometheus.Gauge
+	confirmationTime prometheus.Gauge
+}
+
+func newPorterCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*porterCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("porter collector prometheus cfg is nil")
+	}
+
+	if cfg.ChainPorter == nil {
+		return nil, errors.New("porter collector chain porter is nil")
+	}
+
+	return &porterCollector{
+		cfg:      cfg,
+		registry: registry,
+		parcels: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: porterParcelsMetric,
+				Help: "Number of parcels being processed, " +
+					"by send state",
+			},
+			[]string{"state"},
+		),
+		parcelsCompleted: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: porterParcelsCompletedMetric,
+				Help: "Total number of completed parcels",
+			},
+		),
+		parcelsFailed: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: porterParcelsFailedMetric,
+				Help: "Total number of failed parcels",
+			},
+		),
+		confirmations: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: porterConfirmationsMetric,
+				Help: "Total number of confirmed anchor " +
+					"transactions broadcast by the porter",
+			},
+		),
+		confirmationTime: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: porterConfirmationTimeMetric,
+				Help: "Total time in seconds between the " +
+					"broadcast and the confirmation of " +
+					"anchor transactions",
+			},
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (p *porterCollector) Describe(ch chan<- *prometheus.Desc) {
+	p.collectMx.Lock()
+	defer p.collectMx.Unlock()
+
+	p.parcels.Describe(ch)
+	p.parcelsCompleted.Describe(ch)
+	p.parcelsFailed.Describe(ch)
+	p.confirmations.Describe(ch)
+	p.confirmationTime.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (p *porterCollector) Collect(ch chan<- prometheus.Metric) {
+	p.collectMx.Lock()
+	defer p.collectMx.Unlock()
+
+	stats := p.cfg.ChainPorter.Stats()
+
+	// States without any parcels are removed from the stats, so we reset
+	// the gauges to not report stale values.
+	p.parcels.Reset()
+	for state, numParcels := range stats.ParcelsByState {
+		p.parcels.WithLabelValues(state.String()).Set(
+			float64(numParcels),
+		)
+	}
+
+	p.parcelsCompleted.Set(float64(stats.ParcelsCompleted))
+	p.parcelsFailed.Set(float64(stats.ParcelsFailed))
+	p.confirmations.Set(float64(stats.NumConfirmations))
+	p.confirmationTime.Set(stats.ConfirmationTime.Seconds())
+
+	p.parcels.Collect(ch)
+	p.parcelsCompleted.Collect(ch)
+	p.parcelsFailed.Collect(ch)
+	p.confirmations.Collect(ch)
+	p.confirmationTime.Collect(ch)
+}
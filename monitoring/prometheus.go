@@ -87,6 +87,36 @@ func (p *PrometheusExporter) Start() error {
 	}
 	p.registry.MustRegister(dbCollector)
 
+	// The following collectors are only registered if the subsystems they
+	// collect metrics from are available.
+	if p.config.RfqManager != nil {
+		rfqCollector, err := newRfqCollector(p.config, p.registry)
+		if err != nil {
+			return err
+		}
+		p.registry.MustRegister(rfqCollector)
+	}
+
+	if p.config.CourierStats != nil {
+		courierCollector, err := newCourierCollector(
+			p.config, p.registry,
+		)
+		if err != nil {
+			return err
+		}
+		p.registry.MustRegister(courierCollector)
+	}
+
+	if p.config.ChainPorter != nil {
+		porterCollector, err := newPorterCollector(
+			p.config, p.registry,
+		)
+		if err != nil {
+			return err
+		}
+		p.registry.MustRegister(porterCollector)
+	}
+
 	// Make ensure that all metrics exist when collecting and querying.
 	serverMetrics.InitializeMetrics(p.config.RPCServer)
 
@@ -0,0 +1,148 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	rfqQuotesRequestedMetric = "rfq_quotes_requested"
+	rfqQuotesAcceptedMetric  = "rfq_quotes_accepted"
+	rfqQuotesRejectedMetric  = "rfq_quotes_rejected"
+
+	rfqActivePoliciesMetric   = "rfq_active_policies"
+	rfqHtlcsInterceptedMetric = "rfq_htlcs_intercepted"
+	rfqHtlcsRejectedMetric    = "rfq_htlcs_rejected"
+)
+
+// rfqCollector is a Prometheus collector that exports metrics related to the
+// RFQ quote negotiation and HTLC interception.
+type rfqCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	quotesRequested *prometheus.GaugeVec
+	quotesAccepted  *prometheus.GaugeVec
+	quotesRejected  *prometheus.GaugeVec
+
+	activePolicies   prometheus.Gauge
+	htlcsIntercepted prometheus.Gauge
+	htlcsRejected    prometheus.Gauge
+}
+
+func newRfqCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*rfqCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("rfq collector prometheus cfg is nil")
+	}
+
+	if cfg.RfqManager == nil {
+		return nil, errors.New("rfq collector rfq manager is nil")
+	}
+
+	quoteLabels := []string{"peer", "asset", "direction"}
+
+	return &rfqCollector{
+		cfg:      cfg,
+		registry: registry,
+		quotesRequested: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: rfqQuotesRequestedMetric,
+				Help: "Total number of requested RFQ quotes",
+			},
+			quoteLabels,
+		),
+		quotesAccepted: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: rfqQuotesAcceptedMetric,
+				Help: "Total number of accepted RFQ quotes",
+			},
+			quoteLabels,
+		),
+		quotesRejected: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: rfqQuotesRejectedMetric,
+				Help: "Total number of rejected RFQ quotes",
+			},
+			quoteLabels,
+		),
+		activePolicies: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: rfqActivePoliciesMetric,
+				Help: "Number of active RFQ HTLC policies",
+			},
+		),
+		htlcsIntercepted: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: rfqHtlcsInterceptedMetric,
+				Help: "Total number of intercepted HTLCs",
+			},
+		),
+		htlcsRejected: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: rfqHtlcsRejectedMetric,
+				Help: "Total number of rejected intercepted " +
+					"HTLCs",
+			},
+		),
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (r *rfqCollector) Describe(ch chan<- *prometheus.Desc) {
+	r.collectMx.Lock()
+	defer r.collectMx.Unlock()
+
+	r.quotesRequested.Describe(ch)
+	r.quotesAccepted.Describe(ch)
+	r.quotesRejected.Describe(ch)
+	r.activePolicies.Describe(ch)
+	r.htlcsIntercepted.Describe(ch)
+	r.htlcsRejected.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (r *rfqCollector) Collect(ch chan<- prometheus.Metric) {
+	r.collectMx.Lock()
+	defer r.collectMx.Unlock()
+
+	stats := r.cfg.RfqManager.Stats()
+
+	for key, counts := range stats.Quotes {
+		labels := []string{
+			key.Peer.String(), key.Asset, string(key.Direction),
+		}
+
+		r.quotesRequested.WithLabelValues(labels...).Set(
+			float64(counts.Requested),
+		)
+		r.quotesAccepted.WithLabelValues(labels...).Set(
+			float64(counts.Accepted),
+		)
+		r.quotesRejected.WithLabelValues(labels...).Set(
+			float64(counts.Rejected),
+		)
+	}
+
+	r.activePolicies.Set(float64(stats.ActivePolicies))
+	r.htlcsIntercepted.Set(float64(stats.HtlcsIntercepted))
+	r.htlcsRejected.Set(float64(stats.HtlcsRejected))
+
+	r.quotesRequested.Collect(ch)
+	r.quotesAccepted.Collect(ch)
+	r.quotesRejected.Collect(ch)
+	r.activePolicies.Collect(ch)
+	r.htlcsIntercepted.Collect(ch)
+	r.htlcsRejected.Collect(ch)
+}
@@ -0,0 +1,177 @@
+package mssmt
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubtreeNode is the root node of a subtree of an MS-SMT. A subtree is
+// identified by its depth and the path that leads from the root of the tree to
+// the subtree root. Two trees contain the same set of leaves below a path if
+// the node hashes of their subtree nodes at that path match.
+type SubtreeNode struct {
+	// Depth is the depth of the subtree root within the tree. The root of
+	// the tree is at depth zero.
+	Depth int
+
+	// Path is the path from the root of the tree to the subtree root. Only
+	// the first Depth bits of the path are set, all other bits are zero.
+	Path [hashSize]byte
+
+	// NodeHash is the node hash of the subtree root.
+	NodeHash NodeHash
+
+	// Sum is the sum of all leaves in the subtree.
+	Sum uint64
+
+	// LeafKey is the key of the only leaf in the subtree. This is only set
+	// if the subtree contains exactly one leaf, which means that walking
+	// further down the subtree wouldn't reveal any more information.
+	LeafKey *[hashSize]byte
+}
+
+// IsEmpty returns true if the subtree doesn't contain any leaves.
+func (s SubtreeNode) IsEmpty() bool {
+	return s.NodeHash == EmptyTree[s.Depth].NodeHash()
+}
+
+// SubtreePath returns the path of the subtree at the given depth that contains
+// the given key. All bits of the key below the given depth are cleared.
+func SubtreePath(key [hashSize]byte, depth int) [hashSize]byte {
+	var path [hashSize]byte
+	for i := 0; i < depth; i++ {
+		if bitIndex(uint8(i), &key) == 1 {
+			path[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return path
+}
+
+// ChildPaths returns the paths of all descendants of the subtree at the given
+// path and depth that are located numLevels further down the tree. The number
+// of levels is capped so that the returned paths never exceed the depth of the
+// tree's leaves.
+func ChildPaths(path [hashSize]byte, depth,
+	numLevels int) ([][hashSize]byte, error) {
+
+	if depth < 0 || depth >= MaxTreeLevels {
+		return nil, fmt.Errorf("invalid subtree depth %d", depth)
+	}
+	if numLevels <= 0 {
+		return nil, fmt.Errorf("invalid number of levels %d", numLevels)
+	}
+
+	numLevels = min(numLevels, MaxTreeLevels-depth)
+
+	paths := make([][hashSize]byte, 0, 1<<numLevels)
+	for suffix := 0; suffix < 1<<numLevels; suffix++ {
+		childPath := path
+		for i := 0; i < numLevels; i++ {
+			if (suffix>>i)&1 == 1 {
+				bit := depth + i
+				childPath[bit/8] |= 1 << (bit % 8)
+			}
+		}
+
+		paths = append(paths, childPath)
+	}
+
+	return paths, nil
+}
+
+// subtreeNode walks down the tree along the given path and returns the node at
+// the given depth.
+func subtreeNode(tx TreeStoreViewTx, path [hashSize]byte,
+	depth int) (SubtreeNode, error) {
+
+	result := SubtreeNode{
+		Depth: depth,
+		Path:  SubtreePath(path, depth),
+	}
+
+	// setNode populates the result with the given node that is located at
+	// the subtree depth.
+	setNode := func(node Node) {
+		result.NodeHash = node.NodeHash()
+		result.Sum = node.NodeSum()
+	}
+
+	current, err := tx.RootNode()
+	if err != nil {
+		return result, err
+	}
+
+	for i := 0; i < depth; i++ {
+		// If we reached an empty subtree, then the subtree at the
+		// target depth is empty as well.
+		if IsEqualNode(current, EmptyTree[i]) {
+			setNode(EmptyTree[depth])
+			return result, nil
+		}
+
+		left, right, err := tx.GetChildren(i, current.NodeHash())
+		if err != nil {
+			return result, err
+		}
+		next, _ := stepOrder(i, &path, left, right)
+
+		compactedLeaf, ok := next.(*CompactedLeafNode)
+		if !ok {
+			current = next
+			continue
+		}
+
+		// The compacted leaf represents a subtree with a single leaf.
+		// If the leaf isn't located below our path, then the subtree
+		// at the target depth is empty.
+		leafKey := compactedLeaf.Key()
+		if SubtreePath(leafKey, depth) != result.Path {
+			setNode(EmptyTree[depth])
+			return result, nil
+		}
+
+		// Otherwise, we'll re-create the compacted leaf at the target
+		// depth to obtain the node hash of the subtree there.
+		setNode(NewCompactedLeafNode(
+			depth, &leafKey, compactedLeaf.LeafNode,
+		))
+		result.LeafKey = &leafKey
+
+		return result, nil
+	}
+
+	setNode(current)
+
+	return result, nil
+}
+
+// SubtreeNodes returns the subtree roots at the given depth for each of the
+// given paths, in the same order as the paths. This can be used to find the
+// subtrees in which two trees differ without fetching all their leaves.
+func (t *CompactedTree) SubtreeNodes(ctx context.Context, depth int,
+	paths ...[hashSize]byte) ([]SubtreeNode, error) {
+
+	if depth < 0 || depth > MaxTreeLevels {
+		return nil, fmt.Errorf("invalid subtree depth %d", depth)
+	}
+
+	nodes := make([]SubtreeNode, 0, len(paths))
+	err := t.store.View(ctx, func(tx TreeStoreViewTx) error {
+		for _, path := range paths {
+			node, err := subtreeNode(tx, path, depth)
+			if err != nil {
+				return err
+			}
+
+			nodes = append(nodes, node)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
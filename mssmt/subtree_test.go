@@ -0,0 +1,151 @@
+package mssmt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubtreeNodes tests that the subtree nodes of a compacted tree commit to
+// the leaves below their path, and that they can be used to find the subtrees
+// in which two trees differ.
+func TestSubtreeNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	leaves := randTree(50)
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, item := range leaves {
+		_, err := tree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+	}
+
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	// The subtree at depth zero is the root of the tree.
+	rootNodes, err := tree.SubtreeNodes(ctx, 0, [32]byte{})
+	require.NoError(t, err)
+	require.Len(t, rootNodes, 1)
+	require.Equal(t, root.NodeHash(), rootNodes[0].NodeHash)
+	require.Equal(t, root.NodeSum(), rootNodes[0].Sum)
+
+	// Each subtree must be the branch of its two children, which means
+	// that the subtrees at all depths commit to the same leaves as the
+	// root.
+	for _, depth := range []int{0, 1, 3, 8, 17, 255} {
+		for _, item := range leaves[:5] {
+			path := mssmt.SubtreePath(item.key, depth)
+			parents, err := tree.SubtreeNodes(ctx, depth, path)
+			require.NoError(t, err)
+
+			childPaths, err := mssmt.ChildPaths(path, depth, 1)
+			require.NoError(t, err)
+			require.Len(t, childPaths, 2)
+
+			children, err := tree.SubtreeNodes(
+				ctx, depth+1, childPaths...,
+			)
+			require.NoError(t, err)
+
+			branch := mssmt.NewBranch(
+				mssmt.NewComputedNode(
+					children[0].NodeHash, children[0].Sum,
+				),
+				mssmt.NewComputedNode(
+					children[1].NodeHash, children[1].Sum,
+				),
+			)
+			require.Equal(t, branch.NodeHash(), parents[0].NodeHash)
+			require.Equal(t, branch.NodeSum(), parents[0].Sum)
+			require.False(t, parents[0].IsEmpty())
+		}
+	}
+
+	// With 50 random keys, each key is almost certainly the only one with
+	// its first 32 bits, so the subtree is reduced to the leaf itself.
+	for _, item := range leaves {
+		path := mssmt.SubtreePath(item.key, 32)
+		nodes, err := tree.SubtreeNodes(ctx, 32, path)
+		require.NoError(t, err)
+		require.NotNil(t, nodes[0].LeafKey)
+		require.Equal(t, item.key, *nodes[0].LeafKey)
+		require.Equal(t, item.leaf.NodeSum(), nodes[0].Sum)
+
+		// Flipping the last bit of the path leads to an empty subtree.
+		path[3] ^= 1 << 7
+		nodes, err = tree.SubtreeNodes(ctx, 32, path)
+		require.NoError(t, err)
+		require.True(t, nodes[0].IsEmpty())
+		require.Nil(t, nodes[0].LeafKey)
+	}
+
+	// If we change a single leaf in a copy of the tree, only the subtrees
+	// on the path to that leaf differ.
+	otherTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	require.NoError(t, tree.Copy(ctx, otherTree))
+
+	changed := leaves[0]
+	_, err = otherTree.Insert(ctx, changed.key, randLeaf())
+	require.NoError(t, err)
+
+	for _, depth := range []int{0, 4, 40} {
+		paths := [][32]byte{
+			mssmt.SubtreePath(changed.key, depth),
+		}
+		for _, item := range leaves[1:] {
+			path := mssmt.SubtreePath(item.key, depth)
+			if path != paths[0] {
+				paths = append(paths, path)
+			}
+		}
+
+		nodes, err := tree.SubtreeNodes(ctx, depth, paths...)
+		require.NoError(t, err)
+		otherNodes, err := otherTree.SubtreeNodes(ctx, depth, paths...)
+		require.NoError(t, err)
+
+		require.NotEqual(t, nodes[0].NodeHash, otherNodes[0].NodeHash)
+		for idx := 1; idx < len(paths); idx++ {
+			require.Equal(t, nodes[idx], otherNodes[idx])
+		}
+	}
+
+	_, err = tree.SubtreeNodes(ctx, mssmt.MaxTreeLevels+1, [32]byte{})
+	require.Error(t, err)
+}
+
+// TestChildPaths tests that the child paths of a subtree extend the subtree
+// path by all possible combinations of bits.
+func TestChildPaths(t *testing.T) {
+	t.Parallel()
+
+	path := mssmt.SubtreePath([32]byte{0xff, 0xff}, 6)
+	require.Equal(t, [32]byte{0x3f}, path)
+
+	childPaths, err := mssmt.ChildPaths(path, 6, 3)
+	require.NoError(t, err)
+	require.Len(t, childPaths, 8)
+
+	seen := make(map[[32]byte]struct{})
+	for _, childPath := range childPaths {
+		require.Equal(t, path, mssmt.SubtreePath(childPath, 6))
+		require.Equal(t, childPath, mssmt.SubtreePath(childPath, 9))
+		seen[childPath] = struct{}{}
+	}
+	require.Len(t, seen, 8)
+
+	// The number of levels is capped at the depth of the leaves.
+	childPaths, err = mssmt.ChildPaths(path, mssmt.MaxTreeLevels-1, 4)
+	require.NoError(t, err)
+	require.Len(t, childPaths, 2)
+
+	_, err = mssmt.ChildPaths(path, mssmt.MaxTreeLevels, 1)
+	require.Error(t, err)
+
+	_, err = mssmt.ChildPaths(path, 6, 0)
+	require.Error(t, err)
+}
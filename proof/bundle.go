@@ -0,0 +1,672 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// MaxBundleFiles is the maximum number of proof files that can be
+	// included in a single proof bundle. This is also the maximum number
+	// of meta reveals a bundle can carry.
+	MaxBundleFiles = math.MaxUint16
+
+	// MaxBundleBlockHeaders is the maximum number of block headers that
+	// can be included in a single proof bundle.
+	MaxBundleBlockHeaders = 1_000_000
+
+	// BundleMaxSizeBytes is the maximum size of the proof files or meta
+	// reveals of a single proof bundle.
+	BundleMaxSizeBytes = 4 * FileMaxSizeBytes
+
+	// bundleMedianTimeBlocks is the number of previous blocks which are
+	// used to calculate the median time of a block.
+	bundleMedianTimeBlocks = 11
+)
+
+var (
+	// BundlePrefixMagicBytes are the magic bytes that are prefixed to a
+	// proof bundle when encoding it. This is the ASCII encoding of the
+	// string "TAPB" (Taproot Assets Protocol Bundle) in hex.
+	BundlePrefixMagicBytes = [PrefixMagicBytesLength]byte{
+		0x54, 0x41, 0x50, 0x42,
+	}
+
+	// ErrUnknownBundleVersion is returned when a proof bundle with an
+	// unknown version is being used.
+	ErrUnknownBundleVersion = errors.New("proof: unknown bundle version")
+
+	// ErrBundleHeaderMissing is returned when a block header that is
+	// required for the consistency check of a proof isn't part of the
+	// bundle.
+	ErrBundleHeaderMissing = errors.New("block header missing in bundle")
+
+	// ErrBundleHeaderInvalid is returned when a block header of a bundle
+	// doesn't carry a valid proof of work or doesn't connect to the other
+	// headers of the bundle.
+	ErrBundleHeaderInvalid = errors.New("invalid block header in bundle")
+
+	// ErrBundleTxNotFound is returned when the confirmation height of a
+	// transaction that isn't part of any proof in the bundle is looked
+	// up.
+	ErrBundleTxNotFound = errors.New("transaction not found in bundle")
+
+	// ErrBundleMetaRevealUnknown is returned when a meta reveal of a
+	// bundle doesn't belong to any asset genesis of the bundle.
+	ErrBundleMetaRevealUnknown = errors.New("meta reveal doesn't match " +
+		"any asset in bundle")
+
+	// ErrBundleGroupAnchorMissing is returned when an asset of a group is
+	// checked, but the issuance proof of the group anchor isn't part of
+	// the bundle or is invalid.
+	ErrBundleGroupAnchorMissing = errors.New("group anchor missing in " +
+		"bundle")
+)
+
+// BundleVersion is the version of a proof bundle.
+type BundleVersion uint8
+
+const (
+	// BundleVersionUnknown is the version of a proof bundle that indicates
+	// an unknown version.
+	BundleVersionUnknown BundleVersion = 0
+
+	// BundleV1 is the first version of the proof bundle.
+	BundleV1 BundleVersion = 1
+
+	// LatestBundleVersion is the latest version of the proof bundle.
+	LatestBundleVersion = BundleV1
+)
+
+// BundleBlockHeader is a block header together with its height in the chain.
+type BundleBlockHeader struct {
+	// Height is the height of the block.
+	Height uint32
+
+	// Header is the header of the block.
+	Header wire.BlockHeader
+}
+
+// Bundle is a self-contained collection of proof files that can be kept in
+// cold storage or handed to an auditor. Next to the full proof files, it
+// contains the meta reveals of the assets and the headers of all blocks the
+// proofs are anchored in, so the proofs can be checked for consistency without
+// access to a chain backend.
+//
+// NOTE: A bundle only contains the headers of the blocks its proofs are
+// anchored in, not a contiguous header chain. Checking a bundle offline
+// therefore doesn't verify it, see CheckConsistency.
+type Bundle struct {
+	// Version is the version of the bundle.
+	Version BundleVersion
+
+	// Files are the full proof files of the bundled assets. The issuance
+	// proofs of group anchors are included as files with a single proof.
+	Files []File
+
+	// MetaReveals are the meta reveals of the assets of the bundle.
+	MetaReveals []MetaReveal
+
+	// BlockHeaders are the headers of the blocks the proofs of the bundle
+	// are anchored in, ordered by their height.
+	BlockHeaders []BundleBlockHeader
+
+	// UnknownOddTypes is a map of unknown odd types that were encountered
+	// during decoding. This map is used to preserve unknown types that we
+	// don't know of yet, so we can still encode them back when serializing.
+	// This enables forward compatibility with future versions of the
+	// format as it allows new odd (optional) types to be added without
+	// breaking old clients that don't yet fully understand them.
+	UnknownOddTypes tlv.TypeMap
+}
+
+// NewBundle creates a new proof bundle from the given proof files. The meta
+// reveals and block headers are collected from the proofs of the files,
+// including the proofs of their additional inputs.
+func NewBundle(files ...File) (*Bundle, error) {
+	if len(files) > MaxBundleFiles {
+		return nil, fmt.Errorf("too many proof files: %d, max %d",
+			len(files), MaxBundleFiles)
+	}
+
+	var (
+		headers = make(map[uint32]wire.BlockHeader)
+		reveals = make(map[[asset.MetaHashLen]byte]MetaReveal)
+	)
+	err := forEachBundleProof(files, func(p *Proof) error {
+		header, ok := headers[p.BlockHeight]
+		if ok && header.BlockHash() != p.BlockHeader.BlockHash() {
+			return fmt.Errorf("conflicting block headers at "+
+				"height %d", p.BlockHeight)
+		}
+		headers[p.BlockHeight] = p.BlockHeader
+
+		if p.MetaReveal != nil {
+			reveals[p.MetaReveal.MetaHash()] = *p.MetaReveal
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Version: LatestBundleVersion,
+		Files:   files,
+	}
+	for height, header := range headers {
+		bundle.BlockHeaders = append(
+			bundle.BlockHeaders, BundleBlockHeader{
+				Height: height,
+				Header: header,
+			},
+		)
+	}
+	sort.Slice(bundle.BlockHeaders, func(i, j int) bool {
+		return bundle.BlockHeaders[i].Height <
+			bundle.BlockHeaders[j].Height
+	})
+
+	metaHashes := make([][asset.MetaHashLen]byte, 0, len(reveals))
+	for metaHash := range reveals {
+		metaHashes = append(metaHashes, metaHash)
+	}
+	slices.SortFunc(metaHashes, func(a, b [asset.MetaHashLen]byte) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	for _, metaHash := range metaHashes {
+		bundle.MetaReveals = append(
+			bundle.MetaReveals, reveals[metaHash],
+		)
+	}
+
+	return bundle, nil
+}
+
+// forEachBundleProof calls the given callback for every proof of the given
+// files, including the proofs of their additional inputs.
+func forEachBundleProof(files []File, cb func(p *Proof) error) error {
+	for idx := range files {
+		file := &files[idx]
+		for i := 0; i < file.NumProofs(); i++ {
+			p, err := file.ProofAt(uint32(i))
+			if err != nil {
+				return err
+			}
+
+			if err := cb(p); err != nil {
+				return err
+			}
+
+			err = forEachBundleProof(p.AdditionalInputs, cb)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsUnknownVersion returns true if the bundle has a version that is not
+// recognized by this implementation of tap.
+func (b *Bundle) IsUnknownVersion() bool {
+	return b.Version <= BundleVersionUnknown ||
+		b.Version > LatestBundleVersion
+}
+
+// EncodeRecords returns the encoding records for the Bundle.
+func (b *Bundle) EncodeRecords() []tlv.Record {
+	records := []tlv.Record{
+		BundleVersionRecord(&b.Version),
+		BundleFilesRecord(&b.Files),
+		BundleMetaRevealsRecord(&b.MetaReveals),
+		BundleBlockHeadersRecord(&b.BlockHeaders),
+	}
+
+	// Add any unknown odd types that were encountered during decoding.
+	return asset.CombineRecords(records, b.UnknownOddTypes)
+}
+
+// DecodeRecords returns the decoding records for the Bundle.
+func (b *Bundle) DecodeRecords() []tlv.Record {
+	return []tlv.Record{
+		BundleVersionRecord(&b.Version),
+		BundleFilesRecord(&b.Files),
+		BundleMetaRevealsRecord(&b.MetaReveals),
+		BundleBlockHeadersRecord(&b.BlockHeaders),
+	}
+}
+
+// Encode encodes the bundle into `w`.
+func (b *Bundle) Encode(w io.Writer) error {
+	num, err := w.Write(BundlePrefixMagicBytes[:])
+	if err != nil {
+		return err
+	}
+	if num != PrefixMagicBytesLength {
+		return errors.New("failed to write prefix magic bytes")
+	}
+
+	stream, err := tlv.NewStream(b.EncodeRecords()...)
+	if err != nil {
+		return err
+	}
+	return stream.Encode(w)
+}
+
+// Decode decodes a bundle from `r`.
+func (b *Bundle) Decode(r io.Reader) error {
+	var magicBytes [PrefixMagicBytesLength]byte
+	if _, err := io.ReadFull(r, magicBytes[:]); err != nil {
+		return err
+	}
+
+	if magicBytes != BundlePrefixMagicBytes {
+		return fmt.Errorf("invalid prefix magic bytes, expected %s, "+
+			"got %s", string(BundlePrefixMagicBytes[:]),
+			string(magicBytes[:]))
+	}
+
+	stream, err := tlv.NewStream(b.DecodeRecords()...)
+	if err != nil {
+		return err
+	}
+
+	unknownOddTypes, err := asset.TlvStrictDecode(
+		stream, r, KnownBundleTypes,
+	)
+	if err != nil {
+		return err
+	}
+
+	b.UnknownOddTypes = unknownOddTypes
+
+	return nil
+}
+
+// IsProofBundle returns true if the given blob is an encoded proof bundle.
+func IsProofBundle(blob Blob) bool {
+	if len(blob) < PrefixMagicBytesLength {
+		return false
+	}
+
+	return bytes.Equal(
+		blob[:PrefixMagicBytesLength], BundlePrefixMagicBytes[:],
+	)
+}
+
+// BundleFileResult is the result of the consistency check of a single proof
+// file of a bundle.
+type BundleFileResult struct {
+	// Snapshot is the snapshot of the last state transition of the file.
+	// It is only set if the file is consistent.
+	Snapshot *AssetSnapshot
+
+	// Err is the error the consistency check of the file failed with. It
+	// is nil if the file is consistent.
+	Err error
+}
+
+// CheckConsistency checks the block headers and meta reveals of the bundle and
+// then all proof files against the bundled block headers, so no chain backend
+// is needed. The block headers must carry the minimum proof of work of the
+// given chain, connect to each other where their heights are consecutive and
+// match the checkpoints of the chain. An error is returned if the bundle itself
+// is inconsistent, otherwise the result of each file is returned in the order
+// of the files in the bundle.
+//
+// NOTE: This is not a verification of the bundle. The bundled headers don't
+// form a contiguous chain, so the difficulty they were mined at can't be
+// checked against the retarget rules of the chain. A header that carries the
+// minimum proof of work of the chain passes the checks, and mining such a
+// header is cheap on every network. CheckConsistency only makes sure the
+// proofs are consistent with the bundled headers. The proofs are only verified
+// once the hashes of those headers were compared against a trusted source, for
+// example a full node, or once they are imported into a tapd node, which
+// verifies them against its chain backend.
+func (b *Bundle) CheckConsistency(ctx context.Context,
+	params *chaincfg.Params) ([]BundleFileResult, error) {
+
+	if b.IsUnknownVersion() {
+		return nil, ErrUnknownBundleVersion
+	}
+
+	chainLookup, err := NewBundleChainLookup(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := chainLookup.verifyHeaders(params); err != nil {
+		return nil, err
+	}
+
+	// Every meta reveal must be valid and belong to the genesis of one of
+	// the bundled assets.
+	metaHashes := fn.NewSet[[asset.MetaHashLen]byte]()
+	err = forEachBundleProof(b.Files, func(p *Proof) error {
+		metaHashes.Add(p.Asset.Genesis.MetaHash)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx := range b.MetaReveals {
+		reveal := &b.MetaReveals[idx]
+		if err := reveal.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid meta reveal: %w", err)
+		}
+
+		metaHash := reveal.MetaHash()
+		if !metaHashes.Contains(metaHash) {
+			return nil, fmt.Errorf("%w: meta hash %x",
+				ErrBundleMetaRevealUnknown, metaHash[:])
+		}
+	}
+
+	// Group anchors reveal their group key in their issuance proof, so
+	// they can be checked on their own. All other assets of a group can
+	// only be checked once the group anchor was checked, so we check the
+	// files that start with a group anchor first.
+	var (
+		anchorFiles    []int
+		otherFiles     []int
+		anchorKeys     = make(map[int]asset.SerializedKey)
+		anchorGenesis  = make(map[asset.SerializedKey]asset.Genesis)
+		verifiedGroups = fn.NewSet[asset.SerializedKey]()
+		currentFile    = -1
+	)
+	for idx := range b.Files {
+		file := &b.Files[idx]
+		if file.NumProofs() == 0 {
+			otherFiles = append(otherFiles, idx)
+			continue
+		}
+
+		genesisProof, err := file.ProofAt(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if genesisProof.GroupKeyReveal == nil ||
+			genesisProof.Asset.GroupKey == nil {
+
+			otherFiles = append(otherFiles, idx)
+			continue
+		}
+
+		groupKey := asset.ToSerialized(
+			&genesisProof.Asset.GroupKey.GroupPubKey,
+		)
+		anchorFiles = append(anchorFiles, idx)
+		anchorKeys[idx] = groupKey
+		anchorGenesis[groupKey] = genesisProof.Asset.Genesis
+	}
+
+	vCtx := VerifierCtx{
+		HeaderVerifier: chainLookup.verifyHeader,
+		MerkleVerifier: DefaultMerkleVerifier,
+		GroupVerifier: func(groupKey *btcec.PublicKey) error {
+			key := asset.ToSerialized(groupKey)
+			if verifiedGroups.Contains(key) {
+				return nil
+			}
+
+			// The transfer proofs of a group anchor's file are
+			// checked after its issuance proof, which reveals the
+			// group key.
+			ownKey, ok := anchorKeys[currentFile]
+			if ok && ownKey == key {
+				return nil
+			}
+
+			return fmt.Errorf("%w: group key %x",
+				ErrBundleGroupAnchorMissing, key[:])
+		},
+		GroupAnchorVerifier: func(gen *asset.Genesis,
+			groupKey *asset.GroupKey) error {
+
+			key := asset.ToSerialized(&groupKey.GroupPubKey)
+			anchor, ok := anchorGenesis[key]
+			if !ok || anchor.ID() != gen.ID() {
+				return fmt.Errorf("%w: group key %x",
+					ErrBundleGroupAnchorMissing, key[:])
+			}
+
+			return nil
+		},
+		ChainLookupGen: chainLookup,
+	}
+
+	results := make([]BundleFileResult, len(b.Files))
+	for _, idx := range slices.Concat(anchorFiles, otherFiles) {
+		currentFile = idx
+
+		snapshot, err := b.Files[idx].Verify(ctx, vCtx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			results[idx] = BundleFileResult{
+				Err: err,
+			}
+			continue
+		}
+
+		results[idx] = BundleFileResult{
+			Snapshot: snapshot,
+		}
+		if groupKey, ok := anchorKeys[idx]; ok {
+			verifiedGroups.Add(groupKey)
+		}
+	}
+
+	return results, nil
+}
+
+// BundleChainLookup is a chain lookup that is backed by the block headers and
+// proofs of a proof bundle. It can be used to check the proofs of the bundle
+// against the bundled block headers without access to a chain backend.
+type BundleChainLookup struct {
+	// headers are the block headers of the bundle by their height.
+	headers map[uint32]wire.BlockHeader
+
+	// txHeights are the heights of the blocks the anchor transactions of
+	// the bundle's proofs were confirmed in.
+	txHeights map[chainhash.Hash]uint32
+
+	// bestHeight is the height of the highest block of the bundle.
+	bestHeight uint32
+}
+
+// NewBundleChainLookup creates a new chain lookup that is backed by the given
+// proof bundle.
+func NewBundleChainLookup(b *Bundle) (*BundleChainLookup, error) {
+	if len(b.BlockHeaders) > MaxBundleBlockHeaders {
+		return nil, fmt.Errorf("too many block headers: %d, max %d",
+			len(b.BlockHeaders), MaxBundleBlockHeaders)
+	}
+
+	numHeaders := len(b.BlockHeaders)
+	l := &BundleChainLookup{
+		headers:   make(map[uint32]wire.BlockHeader, numHeaders),
+		txHeights: make(map[chainhash.Hash]uint32),
+	}
+	for _, header := range b.BlockHeaders {
+		if _, ok := l.headers[header.Height]; ok {
+			return nil, fmt.Errorf("%w: duplicate header at "+
+				"height %d", ErrBundleHeaderInvalid,
+				header.Height)
+		}
+
+		l.headers[header.Height] = header.Header
+		l.bestHeight = max(l.bestHeight, header.Height)
+	}
+
+	err := forEachBundleProof(b.Files, func(p *Proof) error {
+		l.txHeights[p.AnchorTx.TxHash()] = p.BlockHeight
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// verifyHeaders makes sure all block headers carry a valid proof of work for
+// the given chain, match the chain's genesis block and checkpoints and connect
+// to the header of the previous height if it is part of the bundle. The proof
+// of work is only checked against the proof of work limit of the chain, not
+// against the difficulty expected at the header's height, so these checks
+// don't prove that a header is part of the best chain.
+func (l *BundleChainLookup) verifyHeaders(params *chaincfg.Params) error {
+	checkpoints := make(map[uint32]chainhash.Hash, len(params.Checkpoints))
+	for _, checkpoint := range params.Checkpoints {
+		checkpoints[uint32(checkpoint.Height)] = *checkpoint.Hash
+	}
+	checkpoints[0] = *params.GenesisHash
+
+	for height, header := range l.headers {
+		blockHash := header.BlockHash()
+
+		target := blockchain.CompactToBig(header.Bits)
+		if target.Sign() <= 0 || target.Cmp(params.PowLimit) > 0 {
+			return fmt.Errorf("%w: target of block %v at height "+
+				"%d out of range", ErrBundleHeaderInvalid,
+				blockHash, height)
+		}
+
+		if blockchain.HashToBig(&blockHash).Cmp(target) > 0 {
+			return fmt.Errorf("%w: hash of block %v at height %d "+
+				"above target", ErrBundleHeaderInvalid,
+				blockHash, height)
+		}
+
+		checkpoint, ok := checkpoints[height]
+		if ok && checkpoint != blockHash {
+			return fmt.Errorf("%w: block %v at height %d doesn't "+
+				"match checkpoint %v", ErrBundleHeaderInvalid,
+				blockHash, height, checkpoint)
+		}
+
+		if height == 0 {
+			continue
+		}
+
+		prevHeader, ok := l.headers[height-1]
+		if ok && prevHeader.BlockHash() != header.PrevBlock {
+			return fmt.Errorf("%w: block %v at height %d doesn't "+
+				"connect to previous block",
+				ErrBundleHeaderInvalid, blockHash, height)
+		}
+	}
+
+	return nil
+}
+
+// verifyHeader is a HeaderVerifier that makes sure the given block header is
+// part of the bundle at the given height.
+func (l *BundleChainLookup) verifyHeader(header wire.BlockHeader,
+	height uint32) error {
+
+	bundleHeader, ok := l.headers[height]
+	if !ok {
+		return fmt.Errorf("%w: height %d", ErrBundleHeaderMissing,
+			height)
+	}
+
+	if bundleHeader.BlockHash() != header.BlockHash() {
+		return fmt.Errorf("%w: block %v at height %d doesn't match "+
+			"bundled block %v", ErrBundleHeaderInvalid,
+			header.BlockHash(), height, bundleHeader.BlockHash())
+	}
+
+	return nil
+}
+
+// TxBlockHeight returns the block height that the given transaction was
+// included in.
+func (l *BundleChainLookup) TxBlockHeight(_ context.Context,
+	txid chainhash.Hash) (uint32, error) {
+
+	height, ok := l.txHeights[txid]
+	if !ok {
+		return 0, fmt.Errorf("%w: %v", ErrBundleTxNotFound, txid)
+	}
+
+	return height, nil
+}
+
+// MeanBlockTimestamp returns the timestamp of the block at the given height as
+// a Unix timestamp in seconds, taking into account the mean time elapsed over
+// the previous 11 blocks. All of these blocks must be part of the bundle.
+func (l *BundleChainLookup) MeanBlockTimestamp(_ context.Context,
+	blockHeight uint32) (time.Time, error) {
+
+	timestamps := make([]int64, 0, bundleMedianTimeBlocks)
+	for i := uint32(0); i < bundleMedianTimeBlocks; i++ {
+		// If we have reached the beginning of the blockchain, we can't
+		// go back any further.
+		if i > blockHeight {
+			break
+		}
+
+		header, ok := l.headers[blockHeight-i]
+		if !ok {
+			return time.Time{}, fmt.Errorf("%w: height %d",
+				ErrBundleHeaderMissing, blockHeight-i)
+		}
+
+		timestamps = append(timestamps, header.Timestamp.Unix())
+	}
+
+	slices.Sort(timestamps)
+
+	// This is the same median calculation as in btcd's
+	// blockchain.CalcPastMedianTime function.
+	medianTimestamp := timestamps[len(timestamps)/2]
+	return time.Unix(medianTimestamp, 0), nil
+}
+
+// CurrentHeight returns the height of the highest block of the bundle.
+func (l *BundleChainLookup) CurrentHeight(context.Context) (uint32, error) {
+	return l.bestHeight, nil
+}
+
+// GenFileChainLookup generates a chain lookup interface for the given proof
+// file that can be used to validate proofs. All files of the bundle share the
+// same lookup.
+func (l *BundleChainLookup) GenFileChainLookup(*File) asset.ChainLookup {
+	return l
+}
+
+// GenProofChainLookup generates a chain lookup interface for the given single
+// proof that can be used to validate proofs. All proofs of the bundle share the
+// same lookup.
+func (l *BundleChainLookup) GenProofChainLookup(*Proof) (asset.ChainLookup,
+	error) {
+
+	return l, nil
+}
+
+var _ asset.ChainLookup = (*BundleChainLookup)(nil)
+var _ ChainLookupGenerator = (*BundleChainLookup)(nil)
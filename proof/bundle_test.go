@@ -0,0 +1,190 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// mineTestHeader sets the difficulty of the given header to the lowest one
+// allowed on regtest and grinds the nonce until the header carries a valid
+// proof of work.
+func mineTestHeader(header *wire.BlockHeader) {
+	params := &chaincfg.RegressionNetParams
+	header.Bits = params.PowLimitBits
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		blockHash := header.BlockHash()
+		if blockchain.HashToBig(&blockHash).Cmp(target) <= 0 {
+			return
+		}
+		header.Nonce++
+	}
+}
+
+// newTestBundleFile creates a proof file with a random genesis proof that is
+// anchored in a block with a valid proof of work at the given height.
+func newTestBundleFile(t *testing.T, height uint32,
+	prevBlock *wire.BlockHeader, metaReveal *MetaReveal) File {
+
+	genesisProof, _ := genRandomGenesisWithProof(
+		t, asset.Collectible, nil, nil, metaReveal == nil, metaReveal,
+		nil, nil, nil, asset.V0,
+	)
+
+	genesisProof.BlockHeight = height
+	if prevBlock != nil {
+		genesisProof.BlockHeader.PrevBlock = prevBlock.BlockHash()
+	}
+	mineTestHeader(&genesisProof.BlockHeader)
+
+	file, err := NewFile(V0, genesisProof)
+	require.NoError(t, err)
+
+	return *file
+}
+
+// TestBundle tests the encoding and offline consistency check of proof
+// bundles.
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	params := &chaincfg.RegressionNetParams
+
+	metaReveal := &MetaReveal{
+		Type: MetaOpaque,
+		Data: []byte("cold storage"),
+	}
+	file1 := newTestBundleFile(t, 100, nil, nil)
+	genesis1, err := file1.ProofAt(0)
+	require.NoError(t, err)
+
+	file2 := newTestBundleFile(t, 101, &genesis1.BlockHeader, metaReveal)
+
+	bundle, err := NewBundle(file1, file2)
+	require.NoError(t, err)
+	require.Len(t, bundle.BlockHeaders, 2)
+	require.EqualValues(t, 100, bundle.BlockHeaders[0].Height)
+	require.Equal(t, []MetaReveal{*metaReveal}, bundle.MetaReveals)
+
+	// The bundle must survive an encoding round trip.
+	var buf bytes.Buffer
+	require.NoError(t, bundle.Encode(&buf))
+	require.True(t, IsProofBundle(buf.Bytes()))
+	require.False(t, IsProofFile(buf.Bytes()))
+
+	var decoded Bundle
+	require.NoError(t, decoded.Decode(bytes.NewReader(buf.Bytes())))
+	require.Equal(t, bundle.Version, decoded.Version)
+	require.Equal(t, bundle.BlockHeaders, decoded.BlockHeaders)
+	require.Equal(t, bundle.MetaReveals, decoded.MetaReveals)
+	require.Len(t, decoded.Files, 2)
+
+	var reEncoded bytes.Buffer
+	require.NoError(t, decoded.Encode(&reEncoded))
+	require.Equal(t, buf.Bytes(), reEncoded.Bytes())
+
+	// All files of the bundle are consistent with the bundled headers.
+	results, err := decoded.CheckConsistency(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for idx, result := range results {
+		require.NoError(t, result.Err)
+
+		lastProof, err := decoded.Files[idx].LastProof()
+		require.NoError(t, err)
+		require.Equal(
+			t, lastProof.Asset.ID(), result.Snapshot.Asset.ID(),
+		)
+	}
+
+	chainLookup, err := NewBundleChainLookup(&decoded)
+	require.NoError(t, err)
+	height, err := chainLookup.TxBlockHeight(
+		ctx, genesis1.AnchorTx.TxHash(),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, height)
+
+	currentHeight, err := chainLookup.CurrentHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 101, currentHeight)
+
+	_, err = chainLookup.MeanBlockTimestamp(ctx, 101)
+	require.ErrorIs(t, err, ErrBundleHeaderMissing)
+
+	// A file whose block header isn't part of the bundle is invalid.
+	missingHeader := decoded
+	missingHeader.BlockHeaders = decoded.BlockHeaders[:1]
+	results, err = missingHeader.CheckConsistency(ctx, params)
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	require.ErrorIs(t, results[1].Err, ErrBundleHeaderMissing)
+
+	// Headers must carry a valid proof of work for the chain.
+	results, err = decoded.CheckConsistency(ctx, &chaincfg.MainNetParams)
+	require.ErrorIs(t, err, ErrBundleHeaderInvalid)
+	require.Nil(t, results)
+
+	// Consecutive headers must connect to each other.
+	unconnected := decoded
+	unconnected.BlockHeaders = append(
+		[]BundleBlockHeader{}, decoded.BlockHeaders...,
+	)
+	unconnected.BlockHeaders[1].Header.PrevBlock[0] ^= 1
+	mineTestHeader(&unconnected.BlockHeaders[1].Header)
+	_, err = unconnected.CheckConsistency(ctx, params)
+	require.ErrorIs(t, err, ErrBundleHeaderInvalid)
+
+	// Meta reveals that don't belong to any asset are refused.
+	unknownMeta := decoded
+	unknownMeta.MetaReveals = []MetaReveal{{
+		Type: MetaOpaque,
+		Data: []byte("unknown"),
+	}}
+	_, err = unknownMeta.CheckConsistency(ctx, params)
+	require.ErrorIs(t, err, ErrBundleMetaRevealUnknown)
+
+	// Bundles of an unknown version are refused.
+	unknownVersion := decoded
+	unknownVersion.Version = 212
+	_, err = unknownVersion.CheckConsistency(ctx, params)
+	require.ErrorIs(t, err, ErrUnknownBundleVersion)
+}
+
+// TestBundleRegtestFile tests that a proof file with transfers that was
+// created on regtest passes the offline consistency check once it's bundled.
+func TestBundleRegtestFile(t *testing.T) {
+	t.Parallel()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	var file File
+	require.NoError(t, file.Decode(bytes.NewReader(proofBytes)))
+
+	bundle, err := NewBundle(file)
+	require.NoError(t, err)
+
+	results, err := bundle.CheckConsistency(
+		context.Background(), &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+}
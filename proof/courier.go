@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/codes"
 	grpcconn "google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -103,6 +104,15 @@ type CourierCfg struct {
 	// LocalArchive is an archive that can be used to fetch proofs from the
 	// local archive.
 	LocalArchive Archiver
+
+	// Stats is an optional set of counters that keeps track of the proof
+	// transfer attempts of all couriers created by the dispatch.
+	Stats *CourierStats
+
+	// UniverseWriteAuth is an optional function that authenticates the
+	// proofs inserted by universe RPC couriers on behalf of the issuer of
+	// the asset, for universe servers that require write authentication.
+	UniverseWriteAuth UniverseWriteAuthFunc
 }
 
 // CourierConnStatus is an enum that represents the different states a courier
@@ -201,10 +211,19 @@ func (u *URLDispatch) NewCourier(ctx context.Context, addr *url.URL,
 	// Create new courier addr based on URL scheme.
 	switch addr.Scheme {
 	case HashmailCourierType:
-		return NewHashMailCourier(
+		courier, err := NewHashMailCourier(
 			ctx, u.cfg.HashMailCfg, u.cfg.TransferLog, addr,
 			lazyConnect,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		courier.backoffHandle.setStats(
+			u.cfg.Stats, HashmailCourierType,
+		)
+
+		return courier, nil
 
 	// The auth mailbox courier is a universe RPC courier that also
 	// interacts with an auth mailbox service for transferring send
@@ -212,10 +231,21 @@ func (u *URLDispatch) NewCourier(ctx context.Context, addr *url.URL,
 	// universe RPC service, so we instantiate the same courier and just
 	// handle the send fragment fetching differently.
 	case UniverseRpcCourierType, AuthMailboxUniRpcCourierType:
-		return NewUniverseRpcCourier(
+		courier, err := NewUniverseRpcCourier(
 			ctx, u.cfg.UniverseRpcCfg, u.cfg.TransferLog,
 			u.cfg.LocalArchive, addr, lazyConnect,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		courier.writeAuth = u.cfg.UniverseWriteAuth
+
+		courier.backoffHandle.setStats(
+			u.cfg.Stats, CourierType(addr.Scheme),
+		)
+
+		return courier, nil
 
 	case MockCourierType:
 		return NewMockProofCourier(), nil
@@ -601,6 +631,23 @@ type BackoffHandler struct {
 	// transferLog is a log for recording proof delivery and retrieval
 	// attempts.
 	transferLog TransferLog
+
+	// stats is an optional set of counters that is updated on each
+	// transfer attempt.
+	stats *CourierStats
+
+	// courierType is the type of the courier the handler belongs to, used
+	// to attribute the counters in stats.
+	courierType CourierType
+}
+
+// setStats sets the counters that are updated on each transfer attempt of the
+// given courier type.
+func (b *BackoffHandler) setStats(stats *CourierStats,
+	courierType CourierType) {
+
+	b.stats = stats
+	b.courierType = courierType
 }
 
 // initialDelay performs an initial delay based on the delivery log to ensure
@@ -711,6 +758,14 @@ func (b *BackoffHandler) Exec(ctx context.Context, proofLocator Locator,
 
 		// Execute the target proof transfer function.
 		errExec = transferFunc()
+		b.stats.update(
+			b.courierType, transferType, func(c *CourierCounts) {
+				c.Attempts++
+				if errExec != nil {
+					c.FailedAttempts++
+				}
+			},
+		)
 		if errExec == nil {
 			// The target function executed successfully, we can
 			// exit the loop.
@@ -733,6 +788,12 @@ func (b *BackoffHandler) Exec(ctx context.Context, proofLocator Locator,
 		)
 		subscriberEvent(waitEvent)
 
+		b.stats.update(
+			b.courierType, transferType, func(c *CourierCounts) {
+				c.BackoffWaits++
+			},
+		)
+
 		log.DebugS(ctx, "Backing off: proof transfer failed",
 			"transfer_type", transferType, "locator",
 			locatorStr, "backoff", backoff, "attempt", i, "err",
@@ -752,6 +813,12 @@ func (b *BackoffHandler) Exec(ctx context.Context, proofLocator Locator,
 		}
 	}
 	if errExec != nil {
+		b.stats.update(
+			b.courierType, transferType, func(c *CourierCounts) {
+				c.Failures++
+			},
+		)
+
 		return fmt.Errorf("proof transfer backoff procedure failed; "+
 			"count retries attempted: %d; %w", numTries, errExec)
 	}
@@ -1207,6 +1274,12 @@ type UniverseRpcCourierCfg struct {
 	ServiceRequestTimeout time.Duration `long:"servicerequestimeout" description:"The maximum duration we'll wait for a courier service to handle our outgoing request during a connection attempt, or when delivering or retrieving a proof."`
 }
 
+// UniverseWriteAuthFunc returns the gRPC metadata that authenticates the insert
+// of the given proof into a universe server by the issuer of the asset. Nil is
+// returned if the local node can't sign for the issuer.
+type UniverseWriteAuthFunc func(ctx context.Context, p *Proof,
+	rawProof []byte) (metadata.MD, error)
+
 // UniverseRpcCourier is a universe RPC proof courier service handle. It
 // implements the Courier interface.
 type UniverseRpcCourier struct {
@@ -1236,6 +1309,10 @@ type UniverseRpcCourier struct {
 	// delivery.
 	backoffHandle *BackoffHandler
 
+	// writeAuth is an optional function that authenticates proof inserts
+	// on behalf of the issuer of the asset.
+	writeAuth UniverseWriteAuthFunc
+
 	// subscribers is a map of components that want to be notified on new
 	// events, keyed by their subscription ID.
 	subscribers map[uint64]*fn.EventReceiver[fn.Event]
@@ -1497,6 +1574,19 @@ func (c *UniverseRpcCourier) DeliverProof(ctx context.Context,
 			LeafKey: assetKey,
 		}
 
+		// If we can sign for the issuer of the asset, we authenticate
+		// the insert in case the universe server requires it.
+		var writeAuthMD metadata.MD
+		if c.writeAuth != nil {
+			writeAuthMD, err = c.writeAuth(
+				ctx, transitionProof, transitionProofBytes,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to authenticate "+
+					"proof insert: %w", err)
+			}
+		}
+
 		// Before attempting to deliver the proof, log that an attempted
 		// delivery is about to occur.
 		loc := Locator{
@@ -1544,6 +1634,11 @@ func (c *UniverseRpcCourier) DeliverProof(ctx context.Context,
 				Key:       &universeKey,
 				AssetLeaf: &assetLeaf,
 			}
+			if writeAuthMD != nil {
+				subCtx = metadata.NewOutgoingContext(
+					subCtx, writeAuthMD,
+				)
+			}
 			_, err = c.client.InsertProof(subCtx, &assetProof)
 			if err != nil {
 				return fmt.Errorf("error inserting proof "+
@@ -0,0 +1,90 @@
+package proof
+
+import "sync"
+
+// CourierStatsKey identifies the proof transfer counters of a single courier
+// type and transfer direction.
+type CourierStatsKey struct {
+	// CourierType is the type of the proof courier, as given by the scheme
+	// of the courier address.
+	CourierType CourierType
+
+	// TransferType is the direction of the proof transfer.
+	TransferType TransferType
+}
+
+// CourierCounts holds the proof transfer counters of a courier type.
+type CourierCounts struct {
+	// Attempts is the number of proof transfer attempts.
+	Attempts uint64
+
+	// FailedAttempts is the number of proof transfer attempts that
+	// returned an error.
+	FailedAttempts uint64
+
+	// BackoffWaits is the number of times the backoff procedure waited
+	// before re-attempting a proof transfer.
+	BackoffWaits uint64
+
+	// Failures is the number of proof transfers that failed after all
+	// attempts were exhausted.
+	Failures uint64
+}
+
+// CourierStats keeps track of the proof transfer counters of all proof
+// couriers created by a courier dispatch. A nil CourierStats is valid and
+// records nothing.
+type CourierStats struct {
+	mtx sync.Mutex
+
+	counts map[CourierStatsKey]*CourierCounts
+}
+
+// NewCourierStats creates a new, empty set of proof courier counters.
+func NewCourierStats() *CourierStats {
+	return &CourierStats{
+		counts: make(map[CourierStatsKey]*CourierCounts),
+	}
+}
+
+// update applies the given update function to the counters of the given
+// courier and transfer type.
+func (c *CourierStats) update(courierType CourierType,
+	transferType TransferType, updateFn func(*CourierCounts)) {
+
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := CourierStatsKey{
+		CourierType:  courierType,
+		TransferType: transferType,
+	}
+	counts, ok := c.counts[key]
+	if !ok {
+		counts = &CourierCounts{}
+		c.counts[key] = counts
+	}
+
+	updateFn(counts)
+}
+
+// Snapshot returns a copy of the proof courier counters.
+func (c *CourierStats) Snapshot() map[CourierStatsKey]CourierCounts {
+	if c == nil {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	counts := make(map[CourierStatsKey]CourierCounts, len(c.counts))
+	for key, c := range c.counts {
+		counts[key] = *c
+	}
+
+	return counts
+}
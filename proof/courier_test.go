@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -146,3 +147,68 @@ func TestCheckUniverseRpcCourierConnection(t *testing.T) {
 		})
 	}
 }
+
+// mockTransferLog is a transfer log that doesn't record any attempts.
+type mockTransferLog struct{}
+
+func (m *mockTransferLog) LogProofTransferAttempt(context.Context, Locator,
+	TransferType) error {
+
+	return nil
+}
+
+func (m *mockTransferLog) QueryProofTransferLog(context.Context, Locator,
+	TransferType) ([]time.Time, error) {
+
+	return nil, nil
+}
+
+// TestBackoffHandlerStats tests that the backoff handler updates the courier
+// stats on each transfer attempt.
+func TestBackoffHandlerStats(t *testing.T) {
+	t.Parallel()
+
+	stats := NewCourierStats()
+	handler := NewBackoffHandler(&BackoffCfg{
+		SkipInitDelay:  true,
+		NumTries:       3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}, &mockTransferLog{})
+	handler.setStats(stats, UniverseRpcCourierType)
+
+	ctx := context.Background()
+	locator := Locator{
+		AssetID:   &asset.ID{1},
+		ScriptKey: *test.RandPubKey(t),
+	}
+	noopEvent := func(fn.Event) {}
+
+	// The first transfer succeeds on the second attempt.
+	numCalls := 0
+	err := handler.Exec(ctx, locator, SendTransferType, func() error {
+		numCalls++
+		if numCalls == 1 {
+			return fmt.Errorf("courier unavailable")
+		}
+
+		return nil
+	}, noopEvent)
+	require.NoError(t, err)
+
+	// The second transfer fails on all attempts.
+	err = handler.Exec(ctx, locator, SendTransferType, func() error {
+		return fmt.Errorf("courier unavailable")
+	}, noopEvent)
+	require.Error(t, err)
+
+	require.Equal(t, map[CourierStatsKey]CourierCounts{{
+		CourierType:  UniverseRpcCourierType,
+		TransferType: SendTransferType,
+	}: {
+		Attempts:       5,
+		FailedAttempts: 4,
+		BackoffWaits:   4,
+		Failures:       1,
+	}}, stats.Snapshot())
+}
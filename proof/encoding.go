@@ -872,3 +872,200 @@ func SendOutputsDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
 	}
 	return tlv.NewTypeForEncodingErr(val, "map[asset.ID]SendOutput")
 }
+
+func BundleVersionEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*BundleVersion); ok {
+		return tlv.EUint8T(w, uint8(*t), buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "BundleVersion")
+}
+
+func BundleVersionDecoder(r io.Reader, val any, buf *[8]byte,
+	l uint64) error {
+
+	if typ, ok := val.(*BundleVersion); ok {
+		var t uint8
+		if err := tlv.DUint8(r, &t, buf, l); err != nil {
+			return err
+		}
+		*typ = BundleVersion(t)
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "BundleVersion", l, 1)
+}
+
+func BundleFilesEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*[]File); ok {
+		numFiles := uint64(len(*t))
+		if err := tlv.WriteVarInt(w, numFiles, buf); err != nil {
+			return err
+		}
+		var fileBuf bytes.Buffer
+		for _, file := range *t {
+			if err := file.Encode(&fileBuf); err != nil {
+				return err
+			}
+			fileBytes := fileBuf.Bytes()
+			err := asset.InlineVarBytesEncoder(w, &fileBytes, buf)
+			if err != nil {
+				return err
+			}
+			fileBuf.Reset()
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]File")
+}
+
+func BundleFilesDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if l > BundleMaxSizeBytes {
+		return tlv.ErrRecordTooLarge
+	}
+
+	if typ, ok := val.(*[]File); ok {
+		numFiles, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		// Avoid OOM by limiting the number of files we accept.
+		if numFiles > MaxBundleFiles {
+			return tlv.ErrRecordTooLarge
+		}
+
+		files := make([]File, 0, numFiles)
+		for i := uint64(0); i < numFiles; i++ {
+			var fileBytes []byte
+			err := asset.InlineVarBytesDecoder(
+				r, &fileBytes, buf, FileMaxSizeBytes,
+			)
+			if err != nil {
+				return err
+			}
+			var file File
+			err = file.Decode(bytes.NewReader(fileBytes))
+			if err != nil {
+				return err
+			}
+			files = append(files, file)
+		}
+		*typ = files
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]File")
+}
+
+func BundleMetaRevealsEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*[]MetaReveal); ok {
+		numReveals := uint64(len(*t))
+		if err := tlv.WriteVarInt(w, numReveals, buf); err != nil {
+			return err
+		}
+		var revealBuf bytes.Buffer
+		for _, reveal := range *t {
+			if err := reveal.Encode(&revealBuf); err != nil {
+				return err
+			}
+			revealBytes := revealBuf.Bytes()
+			err := asset.InlineVarBytesEncoder(w, &revealBytes, buf)
+			if err != nil {
+				return err
+			}
+			revealBuf.Reset()
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]MetaReveal")
+}
+
+func BundleMetaRevealsDecoder(r io.Reader, val any, buf *[8]byte,
+	l uint64) error {
+
+	if l > BundleMaxSizeBytes {
+		return tlv.ErrRecordTooLarge
+	}
+
+	if typ, ok := val.(*[]MetaReveal); ok {
+		numReveals, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		// Avoid OOM by limiting the number of reveals we accept.
+		if numReveals > MaxBundleFiles {
+			return tlv.ErrRecordTooLarge
+		}
+
+		reveals := make([]MetaReveal, 0, numReveals)
+		for i := uint64(0); i < numReveals; i++ {
+			var revealBytes []byte
+			err := asset.InlineVarBytesDecoder(
+				r, &revealBytes, buf, MetaDataMaxSizeBytes,
+			)
+			if err != nil {
+				return err
+			}
+			var reveal MetaReveal
+			err = reveal.Decode(bytes.NewReader(revealBytes))
+			if err != nil {
+				return err
+			}
+			reveals = append(reveals, reveal)
+		}
+		*typ = reveals
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]MetaReveal")
+}
+
+func BundleBlockHeadersEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*[]BundleBlockHeader); ok {
+		numHeaders := uint64(len(*t))
+		if err := tlv.WriteVarInt(w, numHeaders, buf); err != nil {
+			return err
+		}
+		for _, header := range *t {
+			err := tlv.EUint32T(w, header.Height, buf)
+			if err != nil {
+				return err
+			}
+			if err := header.Header.Serialize(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]BundleBlockHeader")
+}
+
+func BundleBlockHeadersDecoder(r io.Reader, val any, buf *[8]byte,
+	l uint64) error {
+
+	if typ, ok := val.(*[]BundleBlockHeader); ok {
+		numHeaders, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		// Avoid OOM by limiting the number of headers we accept.
+		if numHeaders > MaxBundleBlockHeaders {
+			return tlv.ErrRecordTooLarge
+		}
+
+		headers := make([]BundleBlockHeader, 0, numHeaders)
+		for i := uint64(0); i < numHeaders; i++ {
+			var header BundleBlockHeader
+			err := tlv.DUint32(r, &header.Height, buf, 4)
+			if err != nil {
+				return err
+			}
+			if err := header.Header.Deserialize(r); err != nil {
+				return err
+			}
+			headers = append(headers, header)
+		}
+		*typ = headers
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "[]BundleBlockHeader")
+}
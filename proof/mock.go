@@ -3,6 +3,7 @@ package proof
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -1087,6 +1088,63 @@ func (m *mockIgnoreChecker) IsIgnored(_ context.Context,
 	return lfn.Ok(m.ignoreAll || m.ignoredAssetPoints.Contains(assetPoint))
 }
 
+type mockVerificationCache struct {
+	sync.Mutex
+
+	entries map[[sha256.Size]byte]VerifiedProof
+}
+
+func newMockVerificationCache() *mockVerificationCache {
+	return &mockVerificationCache{
+		entries: make(map[[sha256.Size]byte]VerifiedProof),
+	}
+}
+
+func (m *mockVerificationCache) FetchVerified(_ context.Context,
+	proofHashes ...[sha256.Size]byte) (
+	map[[sha256.Size]byte]VerifiedProof, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	result := make(map[[sha256.Size]byte]VerifiedProof)
+	for _, proofHash := range proofHashes {
+		if entry, ok := m.entries[proofHash]; ok {
+			result[proofHash] = entry
+		}
+	}
+
+	return result, nil
+}
+
+func (m *mockVerificationCache) AddVerified(_ context.Context,
+	proofs ...VerifiedProof) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for _, p := range proofs {
+		m.entries[p.ProofHash] = p
+	}
+
+	return nil
+}
+
+func (m *mockVerificationCache) InvalidateFromHeight(_ context.Context,
+	height uint32) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	for proofHash, entry := range m.entries {
+		if entry.BlockHeight >= height {
+			delete(m.entries, proofHash)
+		}
+	}
+
+	return nil
+}
+
 // MockUniverseServer is a mock implementation of the UniverseServer
 // interface. It implements the GetInfo RPC method, which returns an empty
 // InfoResponse.
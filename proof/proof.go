@@ -202,16 +202,41 @@ func CheckMaxFileSize(blob Blob) error {
 // of a re-org.
 type UpdateCallback func([]*Proof) error
 
+// EvictionCallback is a callback that is called when the anchor transaction of
+// watched proofs was evicted from the chain by a re-org. The proofs are updated
+// and the UpdateCallback is called once the transaction confirms again.
+type EvictionCallback func([]*Proof) error
+
+// WatchParams holds the optional parameters for watching proofs for re-orgs.
+type WatchParams struct {
+	// OnEviction is an optional callback that is called when the anchor
+	// transaction of the watched proofs was evicted from the chain.
+	OnEviction EvictionCallback
+}
+
+// WatchOption is a functional option for watching proofs for re-orgs.
+type WatchOption func(*WatchParams)
+
+// WithEvictionCallback is a WatchOption that sets the callback that is called
+// when the anchor transaction of the watched proofs was evicted from the chain.
+func WithEvictionCallback(cb EvictionCallback) WatchOption {
+	return func(p *WatchParams) {
+		p.OnEviction = cb
+	}
+}
+
 // Watcher is used to watch new proofs for their anchor transaction to be
 // confirmed safely with a minimum number of confirmations.
 type Watcher interface {
 	// WatchProofs adds new proofs to the re-org watcher for their anchor
 	// transaction to be watched until it reaches a safe confirmation depth.
-	WatchProofs(newProofs []*Proof, onProofUpdate UpdateCallback) error
+	WatchProofs(newProofs []*Proof, onProofUpdate UpdateCallback,
+		opts ...WatchOption) error
 
 	// MaybeWatch inspects the given proof file for any proofs that are not
 	// yet buried sufficiently deep and adds them to the re-org watcher.
-	MaybeWatch(file *File, onProofUpdate UpdateCallback) error
+	MaybeWatch(file *File, onProofUpdate UpdateCallback,
+		opts ...WatchOption) error
 
 	// ShouldWatch returns true if the proof is for a block that is not yet
 	// sufficiently deep to be considered safe.
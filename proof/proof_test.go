@@ -973,6 +973,105 @@ func TestProofFileVerification(t *testing.T) {
 	require.ErrorIs(t, err, ErrUnknownVersion)
 }
 
+// TestProofFileVerificationCache ensures that proofs found in the verification
+// cache aren't verified again.
+func TestProofFileVerificationCache(t *testing.T) {
+	ctx := context.Background()
+
+	proofHex, err := os.ReadFile(proofFileHexFileName)
+	require.NoError(t, err)
+
+	proofBytes, err := hex.DecodeString(
+		strings.Trim(string(proofHex), "\n"),
+	)
+	require.NoError(t, err)
+
+	f := &File{}
+	err = f.Decode(bytes.NewReader(proofBytes))
+	require.NoError(t, err)
+
+	expected, err := f.Verify(ctx, MockVerifierCtx)
+	require.NoError(t, err)
+
+	// We count the number of block headers that are verified to find out
+	// how many proofs were fully verified.
+	var numHeaders int
+	cache := newMockVerificationCache()
+	vCtx := MockVerifierCtx
+	vCtx.VerificationCache = lfn.Some[VerificationCache](cache)
+	vCtx.HeaderVerifier = func(header wire.BlockHeader,
+		height uint32) error {
+
+		numHeaders++
+		return MockHeaderVerifier(header, height)
+	}
+
+	// Without any cache entries, all proofs are verified and added to the
+	// cache afterward.
+	snapshot, err := f.Verify(ctx, vCtx)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	require.GreaterOrEqual(t, numHeaders, f.NumProofs())
+	require.Len(t, cache.entries, f.NumProofs())
+
+	// The second time, no proof is fully verified again. We only look at
+	// the anchor block header of each proof, as a re-org could have
+	// removed any of them from the main chain.
+	numHeaders = 0
+	snapshot, err = f.Verify(ctx, vCtx)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	require.Equal(t, f.NumProofs(), numHeaders)
+
+	// A file that extends an already verified file only needs to verify
+	// the new proofs. We simulate that by only caching the first proof.
+	lastProof, err := f.LastProof()
+	require.NoError(t, err)
+	firstProof, err := f.ProofAt(0)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.InvalidateFromHeight(
+		ctx, firstProof.BlockHeight+1,
+	))
+	require.Len(t, cache.entries, 1)
+
+	numHeaders = 0
+	snapshot, err = f.Verify(ctx, vCtx)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	require.Less(t, numHeaders, f.NumProofs()+1)
+	require.Len(t, cache.entries, f.NumProofs())
+
+	// Proofs that are skipped because of the cache must still be checked
+	// against the ignore list.
+	vCtx.IgnoreChecker = lfn.Some[IgnoreChecker](newMockIgnoreChecker(
+		false, AssetPoint{
+			OutPoint: firstProof.OutPoint(),
+			ID:       firstProof.Asset.ID(),
+			ScriptKey: asset.ToSerialized(
+				firstProof.Asset.ScriptKey.PubKey,
+			),
+		},
+	))
+	_, err = f.Verify(ctx, vCtx)
+	require.ErrorIs(t, err, ErrProofFileInvalid)
+
+	// Once the cache is invalidated by a re-org, the whole file is
+	// verified again.
+	require.NoError(t, cache.InvalidateFromHeight(ctx, 0))
+	require.Empty(t, cache.entries)
+
+	vCtx.IgnoreChecker = lfn.None[IgnoreChecker]()
+	numHeaders = 0
+	snapshot, err = f.Verify(ctx, vCtx)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	require.GreaterOrEqual(t, numHeaders, f.NumProofs())
+	require.EqualValues(
+		t, lastProof.BlockHeight, snapshot.AnchorBlockHeight,
+	)
+}
+
 // TestProofVerification ensures that the proof encoding and decoding works as
 // expected.
 func TestProofVerification(t *testing.T) {
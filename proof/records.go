@@ -78,6 +78,20 @@ const (
 	// SendFragmentTaprootAssetRootType is the TLV type of the send
 	// fragment's Taproot Asset root. This is used to
 	SendFragmentTaprootAssetRootType tlv.Type = 10
+
+	// BundleVersionType is the TLV type of the proof bundle version.
+	BundleVersionType tlv.Type = 0
+
+	// BundleFilesType is the TLV type of the proof bundle's proof files.
+	BundleFilesType tlv.Type = 2
+
+	// BundleMetaRevealsType is the TLV type of the proof bundle's meta
+	// reveals.
+	BundleMetaRevealsType tlv.Type = 4
+
+	// BundleBlockHeadersType is the TLV type of the proof bundle's block
+	// headers.
+	BundleBlockHeadersType tlv.Type = 6
 )
 
 // KnownProofTypes is a set of all known proof TLV types. This set is asserted
@@ -130,6 +144,12 @@ var KnownSendFragmentTypes = fn.NewSet(
 	SendFragmentOutputsType, SendFragmentTaprootAssetRootType,
 )
 
+// KnownBundleTypes is a set of all known proof bundle TLV types.
+var KnownBundleTypes = fn.NewSet(
+	BundleVersionType, BundleFilesType, BundleMetaRevealsType,
+	BundleBlockHeadersType,
+)
+
 func VersionRecord(version *TransitionVersion) tlv.Record {
 	return tlv.MakeStaticRecord(
 		VersionType, version, 4, VersionEncoder, VersionDecoder,
@@ -597,3 +617,55 @@ func FragmentTaprootAssetRootRecord(root *[sha256.Size]byte) tlv.Record {
 		tlv.EBytes32, tlv.DBytes32,
 	)
 }
+
+func BundleVersionRecord(version *BundleVersion) tlv.Record {
+	return tlv.MakeStaticRecord(
+		BundleVersionType, version, 1, BundleVersionEncoder,
+		BundleVersionDecoder,
+	)
+}
+
+func BundleFilesRecord(files *[]File) tlv.Record {
+	sizeFunc := func() uint64 {
+		var buf bytes.Buffer
+		err := BundleFilesEncoder(&buf, files, &[8]byte{})
+		if err != nil {
+			panic(err)
+		}
+		return uint64(len(buf.Bytes()))
+	}
+	return tlv.MakeDynamicRecord(
+		BundleFilesType, files, sizeFunc, BundleFilesEncoder,
+		BundleFilesDecoder,
+	)
+}
+
+func BundleMetaRevealsRecord(reveals *[]MetaReveal) tlv.Record {
+	sizeFunc := func() uint64 {
+		var buf bytes.Buffer
+		err := BundleMetaRevealsEncoder(&buf, reveals, &[8]byte{})
+		if err != nil {
+			panic(err)
+		}
+		return uint64(len(buf.Bytes()))
+	}
+	return tlv.MakeDynamicRecord(
+		BundleMetaRevealsType, reveals, sizeFunc,
+		BundleMetaRevealsEncoder, BundleMetaRevealsDecoder,
+	)
+}
+
+func BundleBlockHeadersRecord(headers *[]BundleBlockHeader) tlv.Record {
+	sizeFunc := func() uint64 {
+		var buf bytes.Buffer
+		err := BundleBlockHeadersEncoder(&buf, headers, &[8]byte{})
+		if err != nil {
+			panic(err)
+		}
+		return uint64(len(buf.Bytes()))
+	}
+	return tlv.MakeDynamicRecord(
+		BundleBlockHeadersType, headers, sizeFunc,
+		BundleBlockHeadersEncoder, BundleBlockHeadersDecoder,
+	)
+}
@@ -3,6 +3,7 @@ package proof
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"runtime"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -51,6 +53,41 @@ type IgnoreChecker interface {
 	IsIgnored(ctx context.Context, prevID AssetPoint) lfn.Result[bool]
 }
 
+// VerifiedProof is an entry of the proof verification cache. It records that
+// the proof with the given chained hash was fully verified while it was
+// anchored in the given block.
+type VerifiedProof struct {
+	// ProofHash is the chained hash of the proof within its proof file,
+	// which commits to the proof itself and all its ancestors.
+	ProofHash [sha256.Size]byte
+
+	// BlockHash is the hash of the block the proof is anchored in.
+	BlockHash chainhash.Hash
+
+	// BlockHeight is the height of the block the proof is anchored in.
+	BlockHeight uint32
+}
+
+// VerificationCache is used during proof file validation to skip the
+// verification of proofs that were already fully verified before. Because the
+// chained hash of a proof commits to all its ancestors, a file only needs to
+// be verified from the deepest proof found in the cache onwards.
+type VerificationCache interface {
+	// FetchVerified returns the cache entries of the given chained proof
+	// hashes. Hashes that aren't known to the cache are omitted from the
+	// returned map.
+	FetchVerified(ctx context.Context, proofHashes ...[sha256.Size]byte) (
+		map[[sha256.Size]byte]VerifiedProof, error)
+
+	// AddVerified adds the given proofs to the cache.
+	AddVerified(ctx context.Context, proofs ...VerifiedProof) error
+
+	// InvalidateFromHeight removes all entries of proofs that are anchored
+	// in a block at or above the given height. This must be called if the
+	// chain is re-organized.
+	InvalidateFromHeight(ctx context.Context, height uint32) error
+}
+
 // VerifierCtx is a context struct that is used to pass in various interfaces
 // needed during proof verification.
 type VerifierCtx struct {
@@ -65,6 +102,8 @@ type VerifierCtx struct {
 	ChainLookupGen ChainLookupGenerator
 
 	IgnoreChecker lfn.Option[IgnoreChecker]
+
+	VerificationCache lfn.Option[VerificationCache]
 }
 
 // Verifier abstracts away from the task of verifying a proof file blob.
@@ -896,6 +935,13 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		return nil, err
 	}
 
+	return p.snapshot(tapCommitment, splitAsset), nil
+}
+
+// snapshot returns the asset snapshot of a verified proof.
+func (p *Proof) snapshot(tapCommitment *commitment.TapCommitment,
+	splitAsset bool) *AssetSnapshot {
+
 	// 8. At this point we know there is an inclusion proof, which must be
 	// a commitment proof. So we can extract the tapscript preimage directly
 	// from there.
@@ -915,7 +961,43 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 		TapscriptSibling:  tapscriptPreimage,
 		SplitAsset:        splitAsset,
 		MetaReveal:        p.MetaReveal,
-	}, nil
+	}
+}
+
+// cachedSnapshot returns the asset snapshot of a proof that was already fully
+// verified before, without running the expensive parts of the verification
+// again.
+func (p *Proof) cachedSnapshot(vCtx VerifierCtx) (*AssetSnapshot, error) {
+	// We still make sure the block the proof is anchored in is part of the
+	// main chain. The cache is invalidated on re-orgs, so this is only a
+	// cheap safety net.
+	err := vCtx.HeaderVerifier(p.BlockHeader, p.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot commits to the Taproot Asset commitment of the anchor
+	// output, which we can re-create from the inclusion proof.
+	tapCommitment, err := p.verifyInclusionProof()
+	if err != nil {
+		return nil, fmt.Errorf("invalid inclusion proof: %w", err)
+	}
+
+	return p.snapshot(tapCommitment, p.Asset.HasSplitCommitmentWitness()),
+		nil
+}
+
+// ancestorSnapshot returns the asset snapshot of an ancestor of a proof that
+// was already fully verified before. Only the anchor block of the proof is
+// checked to still be part of the main chain. The snapshot is only used for the
+// ignore check, so it doesn't carry the Taproot Asset commitment.
+func (p *Proof) ancestorSnapshot(vCtx VerifierCtx) (*AssetSnapshot, error) {
+	err := vCtx.HeaderVerifier(p.BlockHeader, p.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.snapshot(nil, p.Asset.HasSplitCommitmentWitness()), nil
 }
 
 // VerifyProofIntegrity verifies the integrity of the proof by checking all
@@ -1131,7 +1213,33 @@ func (f *File) Verify(ctx context.Context,
 
 	chainLookup := vCtx.ChainLookupGen.GenFileChainLookup(f)
 
-	var prev *AssetSnapshot
+	// If we have a verification cache, we only need to fully verify the
+	// proofs after the deepest proof that was already verified before.
+	cache := vCtx.VerificationCache.UnwrapOr(nil)
+	cachedIdx, err := f.deepestVerifiedProof(ctx, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		prev     *AssetSnapshot
+		verified []VerifiedProof
+	)
+
+	// Any proofs we fully verify are added to the cache once we're done,
+	// even if a later proof turns out to be invalid.
+	defer func() {
+		if cache == nil || len(verified) == 0 {
+			return
+		}
+
+		err := cache.AddVerified(ctx, verified...)
+		if err != nil {
+			log.Warnf("Unable to add %d proofs to verification "+
+				"cache: %v", len(verified), err)
+		}
+	}()
+
 	for idx := range f.proofs {
 		select {
 		case <-ctx.Done():
@@ -1144,9 +1252,22 @@ func (f *File) Verify(ctx context.Context,
 			return nil, err
 		}
 
-		result, err := decodedProof.Verify(
-			ctx, prev, chainLookup, vCtx,
-		)
+		var result *AssetSnapshot
+		switch {
+		// The ancestors of the deepest cached proof only get their
+		// anchor block re-checked, as a re-org could have removed it
+		// from the main chain since they were verified.
+		case idx < cachedIdx:
+			result, err = decodedProof.ancestorSnapshot(vCtx)
+
+		case idx == cachedIdx:
+			result, err = decodedProof.cachedSnapshot(vCtx)
+
+		default:
+			result, err = decodedProof.Verify(
+				ctx, prev, chainLookup, vCtx,
+			)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1176,8 +1297,46 @@ func (f *File) Verify(ctx context.Context,
 			return prev, ErrProofFileInvalid
 		}
 
+		if idx > cachedIdx {
+			verified = append(verified, VerifiedProof{
+				ProofHash:   f.proofs[idx].hash,
+				BlockHash:   result.AnchorBlockHash,
+				BlockHeight: result.AnchorBlockHeight,
+			})
+		}
+
 		prev = result
 	}
 
 	return prev, nil
 }
+
+// deepestVerifiedProof returns the index of the deepest proof of the file that
+// is found in the given verification cache. If no cache is given or none of the
+// proofs were verified before, -1 is returned.
+func (f *File) deepestVerifiedProof(ctx context.Context,
+	cache VerificationCache) (int, error) {
+
+	if cache == nil || len(f.proofs) == 0 {
+		return -1, nil
+	}
+
+	proofHashes := make([][sha256.Size]byte, len(f.proofs))
+	for idx := range f.proofs {
+		proofHashes[idx] = f.proofs[idx].hash
+	}
+
+	entries, err := cache.FetchVerified(ctx, proofHashes...)
+	if err != nil {
+		return -1, fmt.Errorf("unable to query verification cache: %w",
+			err)
+	}
+
+	for idx := len(proofHashes) - 1; idx >= 0; idx-- {
+		if _, ok := entries[proofHashes[idx]]; ok {
+			return idx, nil
+		}
+	}
+
+	return -1, nil
+}
@@ -0,0 +1,250 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapchannel"
+	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	tchrpc "github.com/lightninglabs/taproot-assets/taprpc/tapchannelrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// rebalancePaymentTimeout is the maximum time a single rebalance
+	// payment attempt may take.
+	rebalancePaymentTimeout = 60 * time.Second
+)
+
+// rpcRebalanceBackend implements the quoter and payer of the asset channel
+// rebalancer on top of the RPC server's RFQ helpers and lnd.
+type rpcRebalanceBackend struct {
+	r *rpcServer
+}
+
+// A compile-time assertion to ensure rpcRebalanceBackend satisfies the
+// tapchannel.RebalanceQuoter interface.
+var _ tapchannel.RebalanceQuoter = (*rpcRebalanceBackend)(nil)
+
+// A compile-time assertion to ensure rpcRebalanceBackend satisfies the
+// tapchannel.RebalancePayer interface.
+var _ tapchannel.RebalancePayer = (*rpcRebalanceBackend)(nil)
+
+// AcquireSellQuote negotiates a quote with the given peer to sell assets for a
+// payment of up to the given amount.
+func (b *rpcRebalanceBackend) AcquireSellQuote(ctx context.Context,
+	specifier asset.Specifier, peer route.Vertex,
+	paymentMaxAmt lnwire.MilliSatoshi,
+	expiry time.Time) (*rfqrpc.PeerAcceptedSellQuote, error) {
+
+	rpcSpecifier := marshalAssetSpecifier(specifier)
+
+	return b.r.acquireSellQuote(
+		ctx, &rpcSpecifier, paymentMaxAmt, expiry, &peer, "",
+	)
+}
+
+// AcquireBuyQuote negotiates a quote with the given peer to buy up to the
+// given amount of asset units.
+func (b *rpcRebalanceBackend) AcquireBuyQuote(ctx context.Context,
+	specifier asset.Specifier, peer route.Vertex, assetMaxAmt uint64,
+	expiry time.Time) (*rfqrpc.PeerAcceptedBuyQuote, error) {
+
+	rpcSpecifier := marshalAssetSpecifier(specifier)
+
+	return b.r.acquireBuyOrder(
+		ctx, &rpcSpecifier, assetMaxAmt, expiry, &peer, "",
+	)
+}
+
+// CreateInvoice creates an invoice over the given amount that can only be paid
+// through the given hop hint.
+func (b *rpcRebalanceBackend) CreateInvoice(ctx context.Context,
+	amt lnwire.MilliSatoshi, hopHint *zpay32.HopHint, expiry time.Duration,
+	memo string) (string, lntypes.Hash, error) {
+
+	rpcCtx, _, rawClient := b.r.cfg.Lnd.Client.RawClientWithMacAuth(ctx)
+	resp, err := rawClient.AddInvoice(rpcCtx, &lnrpc.Invoice{
+		Memo:      memo,
+		ValueMsat: int64(amt),
+		Expiry:    int64(expiry.Seconds()),
+		RouteHints: []*lnrpc.RouteHint{{
+			HopHints: []*lnrpc.HopHint{
+				rfq.Zpay32HopHintToLnrpc(hopHint),
+			},
+		}},
+	})
+	if err != nil {
+		return "", lntypes.Hash{}, fmt.Errorf("error creating "+
+			"invoice: %w", err)
+	}
+
+	payHash, err := lntypes.MakeHash(resp.RHash)
+	if err != nil {
+		return "", lntypes.Hash{}, fmt.Errorf("error parsing payment "+
+			"hash: %w", err)
+	}
+
+	return resp.PaymentRequest, payHash, nil
+}
+
+// PayInvoice pays the invoice through the given outgoing channel, with the
+// last hop being the given peer.
+func (b *rpcRebalanceBackend) PayInvoice(ctx context.Context, payReq string,
+	outgoingChanID uint64, lastHop route.Vertex,
+	feeLimit lnwire.MilliSatoshi,
+	firstHopRecords map[uint64][]byte) (*tapchannel.RebalancePaymentResult,
+	error) {
+
+	timeout := int32(rebalancePaymentTimeout.Seconds())
+
+	rpcCtx, _, routerClient := b.r.cfg.Lnd.Router.RawClientWithMacAuth(ctx)
+	updateStream, err := routerClient.SendPaymentV2(
+		rpcCtx, &routerrpc.SendPaymentRequest{
+			PaymentRequest:        payReq,
+			TimeoutSeconds:        timeout,
+			FeeLimitMsat:          int64(feeLimit),
+			OutgoingChanIds:       []uint64{outgoingChanID},
+			LastHopPubkey:         lastHop[:],
+			AllowSelfPayment:      true,
+			FirstHopCustomRecords: firstHopRecords,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		update, err := updateStream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("payment stream " +
+					"closed before final update")
+			}
+			return nil, fmt.Errorf("failed to receive payment "+
+				"update: %w", err)
+		}
+
+		feeMsat := lnwire.MilliSatoshi(update.FeeMsat)
+
+		switch update.Status {
+		case lnrpc.Payment_SUCCEEDED:
+			preimage, err := lntypes.MakePreimageFromStr(
+				update.PaymentPreimage,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing "+
+					"preimage: %w", err)
+			}
+
+			return &tapchannel.RebalancePaymentResult{
+				Preimage: fn.Some(preimage),
+				FeeMsat:  feeMsat,
+			}, nil
+
+		case lnrpc.Payment_FAILED:
+			return &tapchannel.RebalancePaymentResult{
+				FeeMsat:       feeMsat,
+				FailureReason: update.FailureReason.String(),
+			}, nil
+		}
+	}
+}
+
+// RebalanceAssetChannels shifts asset liquidity from one of our asset channels
+// into another one with a circular payment. The result of each attempt is
+// returned.
+func (r *rpcServer) RebalanceAssetChannels(ctx context.Context,
+	req *tchrpc.RebalanceAssetChannelsRequest) (
+	*tchrpc.RebalanceAssetChannelsResponse, error) {
+
+	if len(req.AssetId) > 0 && len(req.GroupKey) > 0 {
+		return nil, fmt.Errorf("cannot set both asset id and group key")
+	}
+
+	assetID, groupKey, err := parseAssetSpecifier(
+		req.AssetId, "", req.GroupKey, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	specifier, err := asset.NewExclusiveSpecifier(assetID, groupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &rpcRebalanceBackend{r: r}
+	rebalancer := tapchannel.NewRebalancer(tapchannel.RebalancerConfig{
+		Channels:      r.cfg.RfqManager,
+		PolicyFetcher: r.getInboundPolicy,
+		Quoter:        backend,
+		Payer:         backend,
+	})
+
+	attempts, err := rebalancer.Rebalance(ctx, tapchannel.RebalanceReq{
+		AssetSpecifier: specifier,
+		OutgoingChanID: req.OutgoingChanId,
+		IncomingChanID: req.IncomingChanId,
+		AssetAmount:    req.AssetAmount,
+		MaxAssetFee:    req.MaxAssetFee,
+		MaxAttempts:    req.MaxAttempts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to rebalance asset channels: %w",
+			err)
+	}
+
+	resp := &tchrpc.RebalanceAssetChannelsResponse{
+		Attempts: make([]*tchrpc.RebalanceAttempt, 0, len(attempts)),
+	}
+	for _, attempt := range attempts {
+		rpcAttempt := marshalRebalanceAttempt(attempt)
+		resp.Attempts = append(resp.Attempts, rpcAttempt)
+		resp.Success = resp.Success || attempt.Success
+	}
+
+	return resp, nil
+}
+
+// marshalRebalanceAttempt marshals the result of a rebalance attempt to the
+// RPC form.
+func marshalRebalanceAttempt(
+	attempt *tapchannel.RebalanceAttempt) *tchrpc.RebalanceAttempt {
+
+	rpcAttempt := &tchrpc.RebalanceAttempt{
+		Attempt:        attempt.Attempt,
+		InvoiceAmtMsat: uint64(attempt.InvoiceAmt),
+		FeeLimitMsat:   uint64(attempt.FeeLimit),
+		RoutingFeeMsat: uint64(attempt.RoutingFee),
+		AssetFee:       attempt.AssetFee,
+		Success:        attempt.Success,
+	}
+
+	attempt.PaymentHash.WhenSome(func(h lntypes.Hash) {
+		rpcAttempt.PaymentHash = h[:]
+	})
+	attempt.SellQuoteID.WhenSome(func(id rfqmsg.ID) {
+		rpcAttempt.SellQuoteId = id[:]
+	})
+	attempt.BuyQuoteID.WhenSome(func(id rfqmsg.ID) {
+		rpcAttempt.BuyQuoteId = id[:]
+	})
+
+	if attempt.Err != nil {
+		rpcAttempt.Error = attempt.Err.Error()
+	}
+
+	return rpcAttempt
+}
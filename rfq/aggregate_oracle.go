@@ -0,0 +1,446 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultAggregateOracleTimeout is the default timeout for a single
+	// upstream query of the aggregating price oracle.
+	DefaultAggregateOracleTimeout = 5 * time.Second
+
+	// DefaultAggregateMaxDeviationPpm is the default maximum deviation in
+	// parts per million between the rates of the upstream oracles that
+	// still counts as agreement for the quorum policy.
+	DefaultAggregateMaxDeviationPpm = 10_000
+)
+
+// AggregationPolicy defines how the aggregating price oracle combines the
+// rates of its upstream oracles into a single rate.
+type AggregationPolicy uint8
+
+const (
+	// AggregateMedian uses the median of all upstream rates. For an even
+	// number of rates, the lower of the two middle rates is used, so the
+	// result is always a rate that was returned by one of the oracles.
+	AggregateMedian AggregationPolicy = iota
+
+	// AggregateMinBuyMaxSell uses the lowest price for buy queries and the
+	// highest price for sell queries, where the price is measured in BTC
+	// per asset unit. Since rates are expressed in asset units per BTC,
+	// this is the highest rate for buy queries and the lowest rate for
+	// sell queries.
+	AggregateMinBuyMaxSell
+
+	// AggregateQuorum requires the configured quorum of upstream rates to
+	// agree within the maximum deviation. Rates outside of the largest
+	// agreeing set are rejected as outliers and the median of the
+	// agreeing set is used.
+	AggregateQuorum
+)
+
+// String returns the human-readable name of the aggregation policy.
+func (p AggregationPolicy) String() string {
+	switch p {
+	case AggregateMedian:
+		return "median"
+
+	case AggregateMinBuyMaxSell:
+		return "min-buy-max-sell"
+
+	case AggregateQuorum:
+		return "quorum"
+
+	default:
+		return fmt.Sprintf("<unknown(%d)>", p)
+	}
+}
+
+// ParseAggregationPolicy parses an aggregation policy from its human-readable
+// name.
+func ParseAggregationPolicy(name string) (AggregationPolicy, error) {
+	for _, policy := range []AggregationPolicy{
+		AggregateMedian, AggregateMinBuyMaxSell, AggregateQuorum,
+	} {
+		if policy.String() == name {
+			return policy, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown price oracle aggregation policy: %v",
+		name)
+}
+
+// AggregateOracleSource is a single upstream price oracle of the aggregating
+// price oracle.
+type AggregateOracleSource struct {
+	// Name is a human-readable name of the source used for logging, for
+	// example the oracle's address.
+	Name string
+
+	// Oracle is the upstream price oracle.
+	Oracle PriceOracle
+}
+
+// AggregateOracleConfig is the configuration of the AggregatePriceOracle.
+type AggregateOracleConfig struct {
+	// Sources are the upstream price oracles that are queried.
+	Sources []AggregateOracleSource
+
+	// Policy is the policy used to combine the upstream rates.
+	Policy AggregationPolicy
+
+	// Quorum is the minimum number of upstream oracles that must return a
+	// rate. For the quorum policy, this is the minimum number of rates
+	// that must agree within the maximum deviation. If zero, a majority
+	// of the sources is required for the quorum policy and a single rate
+	// is sufficient for the other policies.
+	Quorum int
+
+	// MaxDeviationPpm is the maximum deviation in parts per million
+	// between two rates for them to count as agreeing. This is only used
+	// by the quorum policy.
+	MaxDeviationPpm uint64
+
+	// QueryTimeout is the timeout for a single upstream query. If zero,
+	// DefaultAggregateOracleTimeout is used.
+	QueryTimeout time.Duration
+}
+
+// AggregatePriceOracle is a price oracle that queries several upstream price
+// oracles concurrently and combines their rates according to a configurable
+// policy. Failures of individual upstream oracles are tolerated as long as
+// the quorum is met.
+type AggregatePriceOracle struct {
+	cfg *AggregateOracleConfig
+}
+
+// NewAggregatePriceOracle creates a new aggregating price oracle.
+func NewAggregatePriceOracle(
+	cfg *AggregateOracleConfig) (*AggregatePriceOracle, error) {
+
+	if len(cfg.Sources) == 0 {
+		return nil, fmt.Errorf("at least one price oracle source is " +
+			"required")
+	}
+
+	if cfg.Quorum < 0 || cfg.Quorum > len(cfg.Sources) {
+		return nil, fmt.Errorf("quorum must be between 0 and the "+
+			"number of sources (%d)", len(cfg.Sources))
+	}
+
+	if cfg.QueryTimeout == 0 {
+		cfg.QueryTimeout = DefaultAggregateOracleTimeout
+	}
+
+	return &AggregatePriceOracle{
+		cfg: cfg,
+	}, nil
+}
+
+// quorum returns the minimum number of rates required by the configured
+// policy.
+func (a *AggregatePriceOracle) quorum() int {
+	switch {
+	case a.cfg.Quorum > 0:
+		return a.cfg.Quorum
+
+	case a.cfg.Policy == AggregateQuorum:
+		return len(a.cfg.Sources)/2 + 1
+
+	default:
+		return 1
+	}
+}
+
+// queryFunc is a function that queries a single upstream oracle for either a
+// buy or a sell price.
+type queryFunc func(ctx context.Context,
+	oracle PriceOracle) (*OracleResponse, error)
+
+// sourceRate is a rate returned by an upstream oracle.
+type sourceRate struct {
+	// source is the name of the upstream oracle.
+	source string
+
+	// rate is the returned asset rate.
+	rate rfqmsg.AssetRate
+}
+
+// queryAll queries all upstream oracles concurrently and returns the rates of
+// the oracles that responded successfully, sorted by ascending rate, as well
+// as the errors of the oracles that failed.
+func (a *AggregatePriceOracle) queryAll(ctx context.Context,
+	query queryFunc) ([]sourceRate, []string) {
+
+	var (
+		mtx    sync.Mutex
+		wg     sync.WaitGroup
+		rates  []sourceRate
+		errMsg []string
+	)
+	for _, source := range a.cfg.Sources {
+		wg.Add(1)
+		go func(source AggregateOracleSource) {
+			defer wg.Done()
+
+			queryCtx, cancel := context.WithTimeout(
+				ctx, a.cfg.QueryTimeout,
+			)
+			defer cancel()
+
+			resp, err := query(queryCtx, source.Oracle)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			switch {
+			case err != nil:
+				errMsg = append(errMsg, fmt.Sprintf("%s: %v",
+					source.Name, err))
+
+			case resp.Err != nil:
+				errMsg = append(errMsg, fmt.Sprintf("%s: %v",
+					source.Name, resp.Err))
+
+			default:
+				rates = append(rates, sourceRate{
+					source: source.Name,
+					rate:   resp.AssetRate,
+				})
+			}
+		}(source)
+	}
+	wg.Wait()
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		return compareRates(rates[i].rate.Rate, rates[j].rate.Rate) < 0
+	})
+	sort.Strings(errMsg)
+
+	return rates, errMsg
+}
+
+// aggregate queries all upstream oracles and combines their rates according
+// to the configured policy.
+func (a *AggregatePriceOracle) aggregate(ctx context.Context, isBuy bool,
+	query queryFunc) (*OracleResponse, error) {
+
+	rates, errMsgs := a.queryAll(ctx, query)
+	for _, errMsg := range errMsgs {
+		log.Warnf("Price oracle source failed: %v", errMsg)
+	}
+
+	quorum := a.quorum()
+	if len(rates) < quorum {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("only %d of %d price oracles "+
+					"returned a rate, need %d: %s",
+					len(rates), len(a.cfg.Sources), quorum,
+					strings.Join(errMsgs, "; ")),
+			},
+		}, nil
+	}
+
+	var result sourceRate
+	switch a.cfg.Policy {
+	case AggregateMedian:
+		result = medianRate(rates)
+
+	case AggregateMinBuyMaxSell:
+		// The rates are sorted by ascending asset units per BTC, so the
+		// last rate is the lowest price per asset unit.
+		if isBuy {
+			result = rates[len(rates)-1]
+		} else {
+			result = rates[0]
+		}
+
+	case AggregateQuorum:
+		agreeing, err := largestAgreeingSet(
+			rates, a.cfg.MaxDeviationPpm,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(agreeing) < quorum {
+			return &OracleResponse{
+				Err: &OracleError{
+					Msg: fmt.Sprintf("only %d of %d "+
+						"price oracle rates agree "+
+						"within %d ppm, need %d",
+						len(agreeing), len(rates),
+						a.cfg.MaxDeviationPpm, quorum),
+				},
+			}, nil
+		}
+
+		if len(agreeing) < len(rates) {
+			log.Warnf("Rejected %d outlier price oracle rate(s)",
+				len(rates)-len(agreeing))
+		}
+
+		// The outliers are no longer taken into account.
+		rates = agreeing
+		result = medianRate(rates)
+
+	default:
+		return nil, fmt.Errorf("unknown aggregation policy: %v",
+			a.cfg.Policy)
+	}
+
+	// The aggregated rate can't be valid for longer than the shortest
+	// lived rate that was taken into account.
+	expiry := result.rate.Expiry
+	for _, r := range rates {
+		if r.rate.Expiry.Before(expiry) {
+			expiry = r.rate.Expiry
+		}
+	}
+
+	log.Debugf("Aggregated %d price oracle rate(s) using policy %v: "+
+		"rate=%v (source=%s), expiry=%v", len(rates), a.cfg.Policy,
+		result.rate.Rate, result.source, expiry)
+
+	return &OracleResponse{
+		AssetRate: rfqmsg.NewAssetRate(result.rate.Rate, expiry),
+	}, nil
+}
+
+// QuerySellPrice queries all upstream oracles for a sell price and returns the
+// aggregated rate.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (a *AggregatePriceOracle) QuerySellPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate],
+	counterparty fn.Option[route.Vertex], metadata string,
+	intent PriceQueryIntent) (*OracleResponse, error) {
+
+	return a.aggregate(ctx, false, func(ctx context.Context,
+		oracle PriceOracle) (*OracleResponse, error) {
+
+		return oracle.QuerySellPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint, counterparty, metadata, intent,
+		)
+	})
+}
+
+// QueryBuyPrice queries all upstream oracles for a buy price and returns the
+// aggregated rate.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (a *AggregatePriceOracle) QueryBuyPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate],
+	counterparty fn.Option[route.Vertex], metadata string,
+	intent PriceQueryIntent) (*OracleResponse, error) {
+
+	return a.aggregate(ctx, true, func(ctx context.Context,
+		oracle PriceOracle) (*OracleResponse, error) {
+
+		return oracle.QueryBuyPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint, counterparty, metadata, intent,
+		)
+	})
+}
+
+// Ensure that AggregatePriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*AggregatePriceOracle)(nil)
+
+// compareRates compares two fixed point rates and returns -1, 0 or 1 if the
+// first rate is less than, equal to or greater than the second rate.
+func compareRates(a, b rfqmath.BigIntFixedPoint) int {
+	scale := max(a.Scale, b.Scale)
+	aScaled, bScaled := a.ScaleTo(scale), b.ScaleTo(scale)
+
+	switch {
+	case aScaled.Coefficient.Equals(bScaled.Coefficient):
+		return 0
+
+	case aScaled.Coefficient.Gt(bScaled.Coefficient):
+		return 1
+
+	default:
+		return -1
+	}
+}
+
+// medianRate returns the median of the given rates, which must be sorted in
+// ascending order. For an even number of rates, the lower of the two middle
+// rates is returned.
+func medianRate(rates []sourceRate) sourceRate {
+	return rates[(len(rates)-1)/2]
+}
+
+// largestAgreeingSet returns the largest set of rates that all lie within the
+// maximum deviation of a common center rate. The rates must be sorted in
+// ascending order and the returned set is sorted in ascending order as well.
+// If several sets have the same size, the one whose center is closest to the
+// median of all rates is returned.
+func largestAgreeingSet(rates []sourceRate,
+	maxDeviationPpm uint64) ([]sourceRate, error) {
+
+	tolerance := rfqmath.NewBigIntFromUint64(maxDeviationPpm)
+	median := (len(rates) - 1) / 2
+
+	var (
+		best       []sourceRate
+		bestCenter int
+	)
+	for center := range rates {
+		var agreeing []sourceRate
+		for _, r := range rates {
+			ok, err := rates[center].rate.Rate.WithinTolerance(
+				r.rate.Rate, tolerance,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compare "+
+					"price oracle rates: %w", err)
+			}
+
+			if ok {
+				agreeing = append(agreeing, r)
+			}
+		}
+
+		centerDist := abs(center - median)
+		bestDist := abs(bestCenter - median)
+		switch {
+		case len(agreeing) > len(best):
+			best, bestCenter = agreeing, center
+
+		case len(agreeing) == len(best) && centerDist < bestDist:
+			best, bestCenter = agreeing, center
+		}
+	}
+
+	return best, nil
+}
+
+// abs returns the absolute value of the given integer.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
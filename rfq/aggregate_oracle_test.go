@@ -0,0 +1,243 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPriceOracle returns a mock price oracle that fails all queries.
+func failingPriceOracle() *MockPriceOracle {
+	oracle := &MockPriceOracle{}
+
+	anyArgs := []interface{}{
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	}
+	oracle.On("QueryBuyPrice", anyArgs...).Return(
+		nil, fmt.Errorf("connection refused"),
+	)
+	oracle.On("QuerySellPrice", anyArgs...).Return(
+		&OracleResponse{
+			Err: &OracleError{Msg: "unsupported asset"},
+		}, nil,
+	)
+
+	return oracle
+}
+
+// TestAggregatePriceOracle tests that the aggregating price oracle combines the
+// rates of its sources according to the configured policy.
+func TestAggregatePriceOracle(t *testing.T) {
+	t.Parallel()
+
+	rateSource := func(rate, expirySeconds uint64) AggregateOracleSource {
+		return AggregateOracleSource{
+			Name:   fmt.Sprintf("rate-%d", rate),
+			Oracle: NewMockPriceOracle(expirySeconds, rate),
+		}
+	}
+	failingSource := AggregateOracleSource{
+		Name:   "failing",
+		Oracle: failingPriceOracle(),
+	}
+
+	testCases := []struct {
+		name            string
+		sources         []AggregateOracleSource
+		policy          AggregationPolicy
+		quorum          int
+		maxDeviationPpm uint64
+		expectedBuy     uint64
+		expectedSell    uint64
+		expectedErr     string
+	}{{
+		name: "median odd",
+		sources: []AggregateOracleSource{
+			rateSource(300, 60), rateSource(100, 60),
+			rateSource(200, 60),
+		},
+		policy:       AggregateMedian,
+		expectedBuy:  200,
+		expectedSell: 200,
+	}, {
+		name: "median even uses lower middle",
+		sources: []AggregateOracleSource{
+			rateSource(400, 60), rateSource(100, 60),
+			rateSource(300, 60), rateSource(200, 60),
+		},
+		policy:       AggregateMedian,
+		expectedBuy:  200,
+		expectedSell: 200,
+	}, {
+		name: "median tolerates failure",
+		sources: []AggregateOracleSource{
+			rateSource(100, 60), failingSource,
+			rateSource(200, 60), rateSource(300, 60),
+		},
+		policy:       AggregateMedian,
+		quorum:       3,
+		expectedBuy:  200,
+		expectedSell: 200,
+	}, {
+		name: "median quorum not met",
+		sources: []AggregateOracleSource{
+			rateSource(100, 60), failingSource,
+		},
+		policy:      AggregateMedian,
+		quorum:      2,
+		expectedErr: "only 1 of 2 price oracles returned a rate",
+	}, {
+		name: "min buy max sell",
+		sources: []AggregateOracleSource{
+			rateSource(100, 60), rateSource(300, 60),
+			rateSource(200, 60),
+		},
+		policy:       AggregateMinBuyMaxSell,
+		expectedBuy:  300,
+		expectedSell: 100,
+	}, {
+		name: "quorum rejects outlier",
+		sources: []AggregateOracleSource{
+			rateSource(100_000, 60), rateSource(100_500, 60),
+			rateSource(250_000, 60), rateSource(99_800, 60),
+		},
+		policy:          AggregateQuorum,
+		maxDeviationPpm: 10_000,
+		expectedBuy:     100_000,
+		expectedSell:    100_000,
+	}, {
+		name: "quorum tolerates failure",
+		sources: []AggregateOracleSource{
+			rateSource(100_000, 60), failingSource,
+			rateSource(100_100, 60),
+		},
+		policy:          AggregateQuorum,
+		maxDeviationPpm: 10_000,
+		expectedBuy:     100_000,
+		expectedSell:    100_000,
+	}, {
+		name: "quorum without agreement",
+		sources: []AggregateOracleSource{
+			rateSource(100_000, 60), rateSource(200_000, 60),
+			rateSource(300_000, 60),
+		},
+		policy:          AggregateQuorum,
+		maxDeviationPpm: 10_000,
+		expectedErr:     "only 1 of 3 price oracle rates agree",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oracle, err := NewAggregatePriceOracle(
+				&AggregateOracleConfig{
+					Sources:         tc.sources,
+					Policy:          tc.policy,
+					Quorum:          tc.quorum,
+					MaxDeviationPpm: tc.maxDeviationPpm,
+				},
+			)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			specifier := asset.NewSpecifierFromId(asset.ID{1})
+
+			buyResp, err := oracle.QueryBuyPrice(
+				ctx, specifier, fn.Some[uint64](1),
+				fn.None[lnwire.MilliSatoshi](),
+				fn.None[rfqmsg.AssetRate](),
+				fn.None[route.Vertex](), "", IntentPayInvoice,
+			)
+			require.NoError(t, err)
+
+			sellResp, err := oracle.QuerySellPrice(
+				ctx, specifier, fn.Some[uint64](1),
+				fn.None[lnwire.MilliSatoshi](),
+				fn.None[rfqmsg.AssetRate](),
+				fn.None[route.Vertex](), "", IntentRecvPayment,
+			)
+			require.NoError(t, err)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(
+					t, buyResp.Err, tc.expectedErr,
+				)
+				return
+			}
+
+			require.Nil(t, buyResp.Err)
+			require.Nil(t, sellResp.Err)
+
+			require.True(t, rfqmath.NewBigIntFixedPoint(
+				tc.expectedBuy, 0,
+			).Equals(buyResp.AssetRate.Rate))
+			require.True(t, rfqmath.NewBigIntFixedPoint(
+				tc.expectedSell, 0,
+			).Equals(sellResp.AssetRate.Rate))
+		})
+	}
+}
+
+// TestAggregatePriceOracleExpiry tests that the aggregated rate expires with
+// the shortest lived rate that was taken into account.
+func TestAggregatePriceOracleExpiry(t *testing.T) {
+	t.Parallel()
+
+	oracle, err := NewAggregatePriceOracle(&AggregateOracleConfig{
+		Sources: []AggregateOracleSource{{
+			Name:   "long",
+			Oracle: NewMockPriceOracle(3600, 100_000),
+		}, {
+			Name:   "short",
+			Oracle: NewMockPriceOracle(60, 100_050),
+		}, {
+			Name:   "outlier",
+			Oracle: NewMockPriceOracle(1, 500_000),
+		}},
+		Policy:          AggregateQuorum,
+		MaxDeviationPpm: 10_000,
+	})
+	require.NoError(t, err)
+
+	resp, err := oracle.QueryBuyPrice(
+		context.Background(), asset.NewSpecifierFromId(asset.ID{1}),
+		fn.Some[uint64](1), fn.None[lnwire.MilliSatoshi](),
+		fn.None[rfqmsg.AssetRate](), fn.None[route.Vertex](), "",
+		IntentPayInvoice,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resp.Err)
+
+	// The outlier's expiry is ignored, but the shorter expiry of the
+	// agreeing rates is used.
+	expiry := resp.AssetRate.Expiry
+	require.True(t, expiry.After(time.Now().Add(30*time.Second)))
+	require.True(t, expiry.Before(time.Now().Add(2*time.Minute)))
+}
+
+// TestParseAggregationPolicy tests that all aggregation policies can be parsed
+// from their string representation.
+func TestParseAggregationPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []AggregationPolicy{
+		AggregateMedian, AggregateMinBuyMaxSell, AggregateQuorum,
+	} {
+		parsed, err := ParseAggregationPolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+
+	_, err := ParseAggregationPolicy("average")
+	require.ErrorContains(t, err, "unknown price oracle aggregation")
+}
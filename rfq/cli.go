@@ -2,6 +2,9 @@ package rfq
 
 import (
 	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -34,6 +37,111 @@ type CliConfig struct {
 
 	// TODO(ffranr): Remove in favour of MockOracleAssetsPerBTC.
 	MockOracleSatsPerAsset uint64 `long:"mockoraclesatsperasset" description:"Mock price oracle static satoshis per asset unit rate (for example number of satoshis to pay for one USD cent if one asset unit represents a USD cent); whole numbers only, use either this or mockoracleassetsperbtc depending on required precision"`
+
+	StaticOracleFile string `long:"staticoraclefile" description:"Path to a JSON file with fixed buy and sell rates per asset ID or group key that are served by the built-in static price oracle. The file is reloaded when tapd receives a SIGHUP signal. Cannot be used together with priceoracleaddress"`
+
+	StaticOracleRates []string `long:"staticoraclerate" description:"A fixed rate served by the built-in static price oracle, in the format <asset_id|group_key>:<buy_rate>:<sell_rate> with the rates in asset units per BTC (decimals allowed). Can be specified multiple times. Cannot be used together with priceoracleaddress"`
+
+	StaticOracleExpiry time.Duration `long:"staticoracleexpiry" description:"The lifetime of a rate returned by the static price oracle for rates configured with staticoraclerate"`
+
+	AggregateOracleAddresses []string `long:"aggregateoracleaddress" description:"Price oracle gRPC server address (rfqrpc://<hostname>:<port>) that is queried by the aggregating price oracle. Can be specified multiple times. If static rates are configured, the static price oracle is used as an additional source. Cannot be used together with priceoracleaddress"`
+
+	AggregateOraclePolicy string `long:"aggregateoraclepolicy" description:"The policy used to combine the rates of the aggregated price oracles" choice:"median" choice:"min-buy-max-sell" choice:"quorum"`
+
+	AggregateOracleQuorum int `long:"aggregateoraclequorum" description:"The minimum number of aggregated price oracles that must return a rate (or, for the quorum policy, agree on a rate). If 0, a majority is required for the quorum policy and a single rate otherwise"`
+
+	AggregateOracleMaxDeviationPpm uint64 `long:"aggregateoraclemaxdeviationppm" description:"The maximum deviation in parts per million between two price oracle rates for them to agree under the quorum policy"`
+
+	AggregateOracleTimeout time.Duration `long:"aggregateoracletimeout" description:"The timeout for a single query to an aggregated price oracle"`
+
+	PriceOracleCacheTTL time.Duration `long:"priceoraclecachettl" description:"The maximum time a price oracle response is cached and reused for quote requests for the same asset, intent and amount range. A response is never reused after its rate expires. Set to 0 to disable the cache"`
+
+	PriceOracleCacheSize int `long:"priceoraclecachesize" description:"The maximum number of price oracle responses held by the cache"`
+
+	PeerRequestRateLimit rate.Limit `long:"peerrequestratelimit" description:"The maximum sustained number of incoming quote requests per second that are accepted from a single peer. Requests above this rate are rejected. Set to 0 to disable rate limiting"`
+
+	PeerRequestBurst int `long:"peerrequestburst" description:"The maximum number of incoming quote requests a single peer can send in a burst before peerrequestratelimit applies"`
+
+	ForwardFees []string `long:"forwardfee" description:"A fee in asset units charged for forwarding asset HTLCs paid to this node under an accepted sell quote or paid by this node under an accepted buy quote, in the format <asset_id|group_key>:<base_fee>:<fee_rate_ppm>. A fee for an asset ID takes precedence over a fee for its group key. Can be specified multiple times"`
+}
+
+// UseStaticOracle returns true if the static price oracle is configured.
+func (c *CliConfig) UseStaticOracle() bool {
+	return c.StaticOracleFile != "" || len(c.StaticOracleRates) > 0
+}
+
+// StaticOracleConfig parses the static price oracle options into a static
+// oracle config.
+func (c *CliConfig) StaticOracleConfig() (*StaticOracleConfig, error) {
+	rates := make([]StaticOracleRate, 0, len(c.StaticOracleRates))
+	for _, rateStr := range c.StaticOracleRates {
+		rate, err := ParseStaticOracleRate(
+			rateStr, c.StaticOracleExpiry,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return &StaticOracleConfig{
+		RatesFile:      c.StaticOracleFile,
+		Rates:          rates,
+		ReloadOnSighup: c.StaticOracleFile != "",
+	}, nil
+}
+
+// ForwardFeePolicies parses the configured forwarding fees.
+func (c *CliConfig) ForwardFeePolicies() (ForwardFeePolicies, error) {
+	policies := make(ForwardFeePolicies, 0, len(c.ForwardFees))
+	for _, feeStr := range c.ForwardFees {
+		policy, err := ParseForwardFeePolicy(feeStr)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// UseAggregateOracle returns true if the aggregating price oracle is
+// configured.
+func (c *CliConfig) UseAggregateOracle() bool {
+	return len(c.AggregateOracleAddresses) > 0
+}
+
+// numAggregateSources returns the number of sources of the aggregating price
+// oracle.
+func (c *CliConfig) numAggregateSources() int {
+	numSources := len(c.AggregateOracleAddresses)
+	if c.UseStaticOracle() {
+		numSources++
+	}
+
+	return numSources
+}
+
+// AggregateOracleConfig parses the aggregating price oracle options into an
+// aggregating oracle config. The sources must be added by the caller.
+func (c *CliConfig) AggregateOracleConfig() (*AggregateOracleConfig, error) {
+	policy := AggregateMedian
+	if c.AggregateOraclePolicy != "" {
+		var err error
+		policy, err = ParseAggregationPolicy(c.AggregateOraclePolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AggregateOracleConfig{
+		Policy:          policy,
+		Quorum:          c.AggregateOracleQuorum,
+		MaxDeviationPpm: c.AggregateOracleMaxDeviationPpm,
+		QueryTimeout:    c.AggregateOracleTimeout,
+	}, nil
 }
 
 // Validate returns an error if the configuration is invalid.
@@ -84,6 +192,83 @@ func (c *CliConfig) Validate() error {
 			MinAssetsPerBTC)
 	}
 
+	// The static price oracle replaces the external price oracle, so
+	// only one of them can be configured.
+	if c.UseStaticOracle() && c.PriceOracleAddress != "" {
+		return fmt.Errorf("staticoraclefile and staticoraclerate " +
+			"cannot be used together with priceoracleaddress")
+	}
+
+	// The aggregating price oracle queries its own list of price oracles
+	// instead of the single external price oracle.
+	if c.UseAggregateOracle() && c.PriceOracleAddress != "" {
+		return fmt.Errorf("aggregateoracleaddress cannot be used " +
+			"together with priceoracleaddress")
+	}
+
+	for _, addr := range c.AggregateOracleAddresses {
+		_, err := ParsePriceOracleAddress(addr)
+		if err != nil {
+			return fmt.Errorf("invalid aggregate price oracle "+
+				"service URI address: %w", err)
+		}
+	}
+
+	if _, err := c.AggregateOracleConfig(); err != nil {
+		return err
+	}
+
+	if c.AggregateOracleQuorum < 0 {
+		return fmt.Errorf("aggregateoraclequorum must not be negative")
+	}
+
+	if c.UseAggregateOracle() &&
+		c.AggregateOracleQuorum > c.numAggregateSources() {
+
+		return fmt.Errorf("aggregateoraclequorum (%d) exceeds the "+
+			"number of aggregated price oracles (%d)",
+			c.AggregateOracleQuorum, c.numAggregateSources())
+	}
+
+	if c.AggregateOracleTimeout < 0 {
+		return fmt.Errorf("aggregateoracletimeout must not be " +
+			"negative")
+	}
+
+	if c.StaticOracleExpiry < 0 {
+		return fmt.Errorf("staticoracleexpiry must not be negative")
+	}
+
+	// Make sure the inline static rates can be parsed, so we fail early
+	// on a typo.
+	for _, rateStr := range c.StaticOracleRates {
+		_, err := ParseStaticOracleRate(rateStr, c.StaticOracleExpiry)
+		if err != nil {
+			return fmt.Errorf("invalid staticoraclerate: %w", err)
+		}
+	}
+
+	if c.PriceOracleCacheTTL < 0 {
+		return fmt.Errorf("priceoraclecachettl must not be negative")
+	}
+
+	if c.PriceOracleCacheSize < 0 {
+		return fmt.Errorf("priceoraclecachesize must not be negative")
+	}
+
+	if c.PeerRequestRateLimit < 0 {
+		return fmt.Errorf("peerrequestratelimit must not be negative")
+	}
+
+	if c.PeerRequestRateLimit > 0 && c.PeerRequestBurst < 1 {
+		return fmt.Errorf("peerrequestburst must be at least 1 if " +
+			"peerrequestratelimit is set")
+	}
+
+	if _, err := c.ForwardFeePolicies(); err != nil {
+		return fmt.Errorf("invalid forwardfee: %w", err)
+	}
+
 	// Ensure that if the price oracle address not the mock price oracle
 	// service address then it must be a valid gRPC address.
 	if c.PriceOracleAddress != "" &&
@@ -0,0 +1,312 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+)
+
+// ForwardFeePolicy is the fee in asset units that our node charges for
+// forwarding the asset HTLCs of an asset that are paid to us under one of our
+// accepted sell quotes or that we pay under one of our accepted buy quotes.
+type ForwardFeePolicy struct {
+	// AssetSpecifier is the asset ID or group key the fee applies to.
+	AssetSpecifier asset.Specifier
+
+	// Fee is the fee that is charged.
+	Fee rfqmsg.ForwardFee
+}
+
+// ForwardFeePolicies is the set of forwarding fee policies of our node.
+type ForwardFeePolicies []ForwardFeePolicy
+
+// FeeFor returns the forwarding fee that applies to quotes for the given
+// asset specifier. A policy for an asset ID takes precedence over a policy for
+// the group key of the specifier. Fees that don't charge anything aren't
+// returned.
+func (p ForwardFeePolicies) FeeFor(
+	specifier asset.Specifier) fn.Option[rfqmsg.ForwardFee] {
+
+	var idFee, groupFee fn.Option[rfqmsg.ForwardFee]
+	for _, policy := range p {
+		if policy.Fee.IsZero() {
+			continue
+		}
+
+		policyID, err := policy.AssetSpecifier.UnwrapIdOrErr()
+		if err == nil {
+			specifier.WhenId(func(id asset.ID) {
+				if id == policyID {
+					idFee = fn.Some(policy.Fee)
+				}
+			})
+
+			continue
+		}
+
+		policyKey, err := policy.AssetSpecifier.UnwrapGroupKeyOrErr()
+		if err != nil {
+			continue
+		}
+		specifier.WhenGroupPubKey(func(key btcec.PublicKey) {
+			if key.IsEqual(policyKey) {
+				groupFee = fn.Some(policy.Fee)
+			}
+		})
+	}
+
+	if idFee.IsSome() {
+		return idFee
+	}
+
+	return groupFee
+}
+
+// ParseForwardFeePolicy parses a forwarding fee policy in the format
+// <asset_id|group_key>:<base_fee>:<fee_rate_ppm>, with the base fee in asset
+// units.
+func ParseForwardFeePolicy(policyStr string) (ForwardFeePolicy, error) {
+	parts := strings.Split(policyStr, ":")
+	if len(parts) != 3 {
+		return ForwardFeePolicy{}, fmt.Errorf("invalid forward fee "+
+			"%q, expected <asset_id|group_key>:<base_fee>:"+
+			"<fee_rate_ppm>", policyStr)
+	}
+
+	var assetID, groupKey string
+	if len(parts[0]) == btcec.PubKeyBytesLenCompressed*2 {
+		groupKey = parts[0]
+	} else {
+		assetID = parts[0]
+	}
+
+	specifier, err := parseStaticRateSpecifier(assetID, groupKey)
+	if err != nil {
+		return ForwardFeePolicy{}, err
+	}
+
+	baseFee, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return ForwardFeePolicy{}, fmt.Errorf("invalid base fee: %w",
+			err)
+	}
+
+	feeRate, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return ForwardFeePolicy{}, fmt.Errorf("invalid fee rate: %w",
+			err)
+	}
+
+	fee := rfqmsg.ForwardFee{
+		BaseFee:    baseFee,
+		FeeRatePpm: feeRate,
+	}
+	if err := fee.Validate(); err != nil {
+		return ForwardFeePolicy{}, fmt.Errorf("invalid fee rate: %w",
+			err)
+	}
+
+	return ForwardFeePolicy{
+		AssetSpecifier: specifier,
+		Fee:            fee,
+	}, nil
+}
+
+// CollectedForwardFees is the sum of the forwarding fees that were collected
+// for an asset.
+type CollectedForwardFees struct {
+	// AssetSpecifier is the asset specifier of the quotes the fees were
+	// collected under.
+	AssetSpecifier asset.Specifier
+
+	// NumHtlcs is the number of settled HTLCs a fee was collected for.
+	NumHtlcs uint64
+
+	// TotalFees is the sum of the collected fees in asset units.
+	TotalFees uint64
+}
+
+// ForwardFeeStore is an interface that allows the order handler to persist
+// the forwarding fees it collected, so that the totals survive a restart.
+type ForwardFeeStore interface {
+	// AddCollectedForwardFee adds the fee in asset units that was collected
+	// for a settled HTLC to the fees collected for the given asset.
+	AddCollectedForwardFee(ctx context.Context, specifier asset.Specifier,
+		fee uint64) error
+
+	// FetchCollectedForwardFees returns the fees collected per asset.
+	FetchCollectedForwardFees(
+		ctx context.Context) ([]CollectedForwardFees, error)
+}
+
+const (
+	// maxPendingForwardFeeAge is the maximum time we keep the fee of an
+	// accepted HTLC around while waiting for it to be settled or failed.
+	// This matches the maximum CLTV delta lnd accepts for an outgoing
+	// HTLC, so an HTLC that is still pending after this time was resolved
+	// without us receiving the event.
+	maxPendingForwardFeeAge = 2016 * 10 * time.Minute
+)
+
+// pendingForwardFee is the forwarding fee of an accepted HTLC that wasn't
+// settled yet.
+type pendingForwardFee struct {
+	specifier asset.Specifier
+	fee       uint64
+
+	// addedAt is the time the HTLC was accepted.
+	addedAt time.Time
+}
+
+// forwardFeeLedger keeps track of the forwarding fees of accepted HTLCs and
+// sums them up per asset once the HTLCs are settled.
+type forwardFeeLedger struct {
+	mtx sync.Mutex
+
+	// pending maps the circuit keys of the accepted HTLCs to their fees.
+	// A forwarded HTLC that swaps one asset for another carries a fee for
+	// each of the two quotes.
+	pending map[models.CircuitKey][]pendingForwardFee
+
+	// collected maps the string representation of an asset specifier to
+	// the fees collected for it.
+	collected map[string]*CollectedForwardFees
+}
+
+// newForwardFeeLedger creates a new empty forwarding fee ledger.
+func newForwardFeeLedger() *forwardFeeLedger {
+	return &forwardFeeLedger{
+		pending:   make(map[models.CircuitKey][]pendingForwardFee),
+		collected: make(map[string]*CollectedForwardFees),
+	}
+}
+
+// addPending records the fee of a newly accepted HTLC.
+func (l *forwardFeeLedger) addPending(circuitKey models.CircuitKey,
+	specifier asset.Specifier, fee uint64, now time.Time) {
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.pending[circuitKey] = append(
+		l.pending[circuitKey], pendingForwardFee{
+			specifier: specifier,
+			fee:       fee,
+			addedAt:   now,
+		},
+	)
+}
+
+// settle marks the fee of the HTLC as collected and returns the fees that were
+// collected, so they can be persisted.
+func (l *forwardFeeLedger) settle(
+	circuitKey models.CircuitKey) []pendingForwardFee {
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	pendingFees, ok := l.pending[circuitKey]
+	if !ok {
+		return nil
+	}
+	delete(l.pending, circuitKey)
+
+	for _, pending := range pendingFees {
+		collected := l.collectedFor(pending.specifier)
+		collected.NumHtlcs++
+		collected.TotalFees += pending.fee
+	}
+
+	return pendingFees
+}
+
+// restore adds the given previously collected fees to the collected fees of
+// the ledger.
+func (l *forwardFeeLedger) restore(fees []CollectedForwardFees) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for _, fee := range fees {
+		collected := l.collectedFor(fee.AssetSpecifier)
+		collected.NumHtlcs += fee.NumHtlcs
+		collected.TotalFees += fee.TotalFees
+	}
+}
+
+// collectedFor returns the collected fees of the given asset, creating an
+// empty entry if none exists yet.
+//
+// NOTE: The caller must hold the ledger mutex.
+func (l *forwardFeeLedger) collectedFor(
+	specifier asset.Specifier) *CollectedForwardFees {
+
+	key := specifier.String()
+	collected, ok := l.collected[key]
+	if !ok {
+		collected = &CollectedForwardFees{
+			AssetSpecifier: specifier,
+		}
+		l.collected[key] = collected
+	}
+
+	return collected
+}
+
+// prunePending removes the fees of HTLCs that were accepted before the given
+// time and returns the number of removed HTLCs. This makes sure the fees of
+// HTLCs whose settle or fail event we missed don't pile up.
+func (l *forwardFeeLedger) prunePending(before time.Time) int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	var numPruned int
+	for circuitKey, pendingFees := range l.pending {
+		// All fees of an HTLC are added when it is accepted, so the
+		// first one tells us when that was.
+		if len(pendingFees) == 0 ||
+			pendingFees[0].addedAt.Before(before) {
+
+			delete(l.pending, circuitKey)
+			numPruned++
+		}
+	}
+
+	return numPruned
+}
+
+// fail removes the fee of the failed HTLC.
+func (l *forwardFeeLedger) fail(circuitKey models.CircuitKey) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	delete(l.pending, circuitKey)
+}
+
+// collectedFees returns the fees collected per asset, sorted by the asset
+// specifier.
+func (l *forwardFeeLedger) collectedFees() []CollectedForwardFees {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	fees := make([]CollectedForwardFees, 0, len(l.collected))
+	for _, collected := range l.collected {
+		fees = append(fees, *collected)
+	}
+
+	sort.Slice(fees, func(i, j int) bool {
+		return fees[i].AssetSpecifier.String() <
+			fees[j].AssetSpecifier.String()
+	})
+
+	return fees
+}
@@ -0,0 +1,255 @@
+package rfq
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseForwardFeePolicy tests the parsing of forwarding fee policies.
+func TestParseForwardFeePolicy(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	groupKeyHex := hex.EncodeToString(groupKey.SerializeCompressed())
+
+	testCases := []struct {
+		name        string
+		policy      string
+		expected    ForwardFeePolicy
+		expectedErr string
+	}{{
+		name:   "asset id",
+		policy: assetID.String() + ":10:1000",
+		expected: ForwardFeePolicy{
+			AssetSpecifier: asset.NewSpecifierFromId(assetID),
+			Fee: rfqmsg.ForwardFee{
+				BaseFee:    10,
+				FeeRatePpm: 1000,
+			},
+		},
+	}, {
+		name:   "group key",
+		policy: groupKeyHex + ":0:500",
+		expected: ForwardFeePolicy{
+			AssetSpecifier: asset.NewSpecifierFromGroupKey(
+				*groupKey,
+			),
+			Fee: rfqmsg.ForwardFee{
+				FeeRatePpm: 500,
+			},
+		},
+	}, {
+		name:        "missing part",
+		policy:      assetID.String() + ":10",
+		expectedErr: "expected <asset_id|group_key>",
+	}, {
+		name:        "invalid base fee",
+		policy:      assetID.String() + ":-1:10",
+		expectedErr: "invalid base fee",
+	}, {
+		name:        "invalid fee rate",
+		policy:      assetID.String() + ":1:abc",
+		expectedErr: "invalid fee rate",
+	}, {
+		name:        "fee rate too high",
+		policy:      assetID.String() + ":1:1000000",
+		expectedErr: "invalid fee rate",
+	}, {
+		name:        "invalid asset id",
+		policy:      "abcd:1:1",
+		expectedErr: "invalid ID length",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := ParseForwardFeePolicy(tc.policy)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, policy)
+		})
+	}
+}
+
+// TestForwardFeePoliciesFeeFor tests that the fee for an asset ID takes
+// precedence over the fee for its group key.
+func TestForwardFeePoliciesFeeFor(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	otherID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	idFee := rfqmsg.ForwardFee{BaseFee: 5}
+	groupFee := rfqmsg.ForwardFee{FeeRatePpm: 2000}
+
+	policies := ForwardFeePolicies{{
+		AssetSpecifier: asset.NewSpecifierFromGroupKey(*groupKey),
+		Fee:            groupFee,
+	}, {
+		AssetSpecifier: asset.NewSpecifierFromId(assetID),
+		Fee:            idFee,
+	}, {
+		AssetSpecifier: asset.NewSpecifierFromId(otherID),
+	}}
+
+	// A specifier with both the asset ID and the group key uses the asset
+	// ID fee.
+	specifier, err := asset.NewSpecifier(&assetID, groupKey, nil, true)
+	require.NoError(t, err)
+	fee := policies.FeeFor(specifier)
+	require.Equal(t, &idFee, fee.UnwrapToPtr())
+
+	// A group key specifier uses the group key fee.
+	fee = policies.FeeFor(asset.NewSpecifierFromGroupKey(*groupKey))
+	require.Equal(t, &groupFee, fee.UnwrapToPtr())
+
+	// Zero fees and unknown assets don't charge anything.
+	require.True(t, policies.FeeFor(
+		asset.NewSpecifierFromId(otherID),
+	).IsNone())
+	require.True(t, policies.FeeFor(
+		asset.NewSpecifierFromId(asset.RandID(t)),
+	).IsNone())
+}
+
+// TestForwardFeeLedger tests that only the fees of settled HTLCs are
+// collected.
+func TestForwardFeeLedger(t *testing.T) {
+	t.Parallel()
+
+	specifier1 := asset.NewSpecifierFromId(asset.RandID(t))
+	specifier2 := asset.NewSpecifierFromId(asset.RandID(t))
+
+	circuitKey := func(htlcID uint64) models.CircuitKey {
+		return models.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: htlcID,
+		}
+	}
+
+	now := time.Now()
+	ledger := newForwardFeeLedger()
+	ledger.addPending(circuitKey(1), specifier1, 10, now)
+	ledger.addPending(circuitKey(2), specifier1, 20, now)
+	ledger.addPending(circuitKey(3), specifier2, 30, now)
+	ledger.addPending(circuitKey(4), specifier2, 40, now)
+
+	// An asset-to-asset forward carries a fee for both of its quotes.
+	ledger.addPending(circuitKey(6), specifier1, 1, now)
+	ledger.addPending(circuitKey(6), specifier2, 2, now)
+
+	// Nothing is collected before the HTLCs are settled.
+	require.Empty(t, ledger.collectedFees())
+
+	ledger.settle(circuitKey(1))
+	ledger.settle(circuitKey(2))
+	ledger.fail(circuitKey(3))
+	ledger.settle(circuitKey(4))
+
+	// The settled fees are returned so they can be persisted.
+	settled := ledger.settle(circuitKey(6))
+	require.Len(t, settled, 2)
+
+	// Settling an unknown or already settled HTLC is a no-op.
+	require.Empty(t, ledger.settle(circuitKey(1)))
+	require.Empty(t, ledger.settle(circuitKey(5)))
+
+	expected := map[string]CollectedForwardFees{
+		specifier1.String(): {
+			AssetSpecifier: specifier1,
+			NumHtlcs:       3,
+			TotalFees:      31,
+		},
+		specifier2.String(): {
+			AssetSpecifier: specifier2,
+			NumHtlcs:       2,
+			TotalFees:      42,
+		},
+	}
+
+	collected := ledger.collectedFees()
+	require.Len(t, collected, 2)
+	require.Less(
+		t, collected[0].AssetSpecifier.String(),
+		collected[1].AssetSpecifier.String(),
+	)
+	for _, fees := range collected {
+		require.Equal(t, expected[fees.AssetSpecifier.String()], fees)
+	}
+	require.Empty(t, ledger.pending)
+}
+
+// TestForwardFeeLedgerPruneAndRestore tests that the fees of stale pending
+// HTLCs are pruned and that previously collected fees are restored.
+func TestForwardFeeLedgerPruneAndRestore(t *testing.T) {
+	t.Parallel()
+
+	specifier := asset.NewSpecifierFromId(asset.RandID(t))
+	circuitKey := func(htlcID uint64) models.CircuitKey {
+		return models.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: htlcID,
+		}
+	}
+
+	now := time.Now()
+	ledger := newForwardFeeLedger()
+	ledger.addPending(circuitKey(1), specifier, 10, now.Add(-time.Hour))
+	ledger.addPending(circuitKey(2), specifier, 20, now)
+
+	// Only the HTLC that was accepted before the cutoff is pruned.
+	require.Equal(t, 1, ledger.prunePending(now.Add(-time.Minute)))
+	require.Len(t, ledger.pending, 1)
+
+	// A pruned HTLC can't be settled anymore.
+	require.Empty(t, ledger.settle(circuitKey(1)))
+
+	// Restored fees are added to the fees collected since.
+	ledger.restore([]CollectedForwardFees{{
+		AssetSpecifier: specifier,
+		NumHtlcs:       5,
+		TotalFees:      500,
+	}})
+	ledger.settle(circuitKey(2))
+
+	require.Equal(t, []CollectedForwardFees{{
+		AssetSpecifier: specifier,
+		NumHtlcs:       6,
+		TotalFees:      520,
+	}}, ledger.collectedFees())
+}
+
+// TestOrderHandlerRestoreForwardFees tests that the order handler restores the
+// collected forwarding fees from its store.
+func TestOrderHandlerRestoreForwardFees(t *testing.T) {
+	t.Parallel()
+
+	collected := []CollectedForwardFees{{
+		AssetSpecifier: asset.NewSpecifierFromId(asset.RandID(t)),
+		NumHtlcs:       3,
+		TotalFees:      300,
+	}}
+
+	handler, err := NewOrderHandler(OrderHandlerCfg{
+		ForwardFeeStore: &mockQuoteStore{
+			collectedFees: collected,
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, handler.restoreForwardFees())
+	require.Equal(t, collected, handler.CollectedForwardFees())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -39,6 +40,10 @@ const (
 	// CacheCleanupInterval is the interval at which local runtime caches
 	// are cleaned up.
 	CacheCleanupInterval = 30 * time.Second
+
+	// QuotePruneInterval is the interval at which expired quotes are
+	// removed from the persistent quote store.
+	QuotePruneInterval = 10 * time.Minute
 )
 
 // ChannelLister is an interface that provides a list of channels that are
@@ -82,6 +87,56 @@ type GroupLookup interface {
 		error)
 }
 
+// AcceptedQuotes is a collection of accepted quotes, grouped by the party that
+// accepted them and the direction of the quote.
+type AcceptedQuotes struct {
+	// PeerBuy holds buy quotes that our node requested and that were
+	// accepted by a peer.
+	PeerBuy []rfqmsg.BuyAccept
+
+	// PeerSell holds sell quotes that our node requested and that were
+	// accepted by a peer.
+	PeerSell []rfqmsg.SellAccept
+
+	// LocalBuy holds buy quotes that a peer requested and that were
+	// accepted by our node.
+	LocalBuy []rfqmsg.BuyAccept
+
+	// LocalSell holds sell quotes that a peer requested and that were
+	// accepted by our node.
+	LocalSell []rfqmsg.SellAccept
+}
+
+// QuoteStore is an interface that allows the RFQ manager to persist accepted
+// quotes, so that quotes which were negotiated before a restart can still be
+// used afterward.
+type QuoteStore interface {
+	// StoreBuyAccept persists an accepted buy quote. The local flag
+	// indicates whether the quote was accepted by our node (true) or by a
+	// peer (false).
+	StoreBuyAccept(ctx context.Context, accept rfqmsg.BuyAccept,
+		local bool) error
+
+	// StoreSellAccept persists an accepted sell quote. The local flag
+	// indicates whether the quote was accepted by our node (true) or by a
+	// peer (false).
+	StoreSellAccept(ctx context.Context, accept rfqmsg.SellAccept,
+		local bool) error
+
+	// FetchAcceptedQuotes returns all accepted quotes that have not yet
+	// expired at the given time.
+	FetchAcceptedQuotes(ctx context.Context,
+		now time.Time) (*AcceptedQuotes, error)
+
+	// PruneExpiredQuotes deletes all accepted quotes that have expired at
+	// the given time and returns the number of deleted quotes.
+	PruneExpiredQuotes(ctx context.Context, now time.Time) (int64, error)
+
+	// ForwardFeeStore persists the forwarding fees collected under the
+	// accepted quotes.
+	ForwardFeeStore
+}
+
 // ManagerCfg is a struct that holds the configuration parameters for the RFQ
 // manager.
 type ManagerCfg struct {
@@ -113,6 +168,11 @@ type ManagerCfg struct {
 	// into the manager once lnd and tapd are hooked together.
 	AliasManager ScidAliasManager
 
+	// QuoteStore is the persistent store for accepted quotes. Quotes and
+	// their associated policies are restored from this store on startup.
+	// If this is nil, accepted quotes are only held in memory.
+	QuoteStore QuoteStore
+
 	// AuxChannelNegotiator is responsible for producing the extra tlv blob
 	// that is encapsulated in the init and reestablish peer messages. This
 	// helps us communicate custom feature bits with our peer.
@@ -141,6 +201,20 @@ type ManagerCfg struct {
 	// key of the peer) to the price oracle when requesting a price rate.
 	SendPeerId bool
 
+	// PeerRequestLimit is the maximum sustained rate of incoming quote
+	// requests per second that is accepted from a single peer. If zero,
+	// incoming quote requests aren't rate limited.
+	PeerRequestLimit rate.Limit
+
+	// PeerRequestBurst is the maximum number of incoming quote requests
+	// that a single peer can send in a burst.
+	PeerRequestBurst int
+
+	// ForwardFees are the fees in asset units that we charge for the
+	// asset HTLCs that are paid to us under our accepted sell quotes or
+	// that we pay under our accepted buy quotes.
+	ForwardFees ForwardFeePolicies
+
 	// ErrChan is the main error channel which will be used to report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -220,6 +294,10 @@ type Manager struct {
 	// subsystemErrChan is the error channel populated by subsystems.
 	subsystemErrChan chan error
 
+	// quoteMetrics keeps track of the number of requested, accepted and
+	// rejected quotes.
+	quoteMetrics *quoteMetrics
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -244,6 +322,8 @@ func NewManager(cfg ManagerCfg) (*Manager, error) {
 
 		subsystemErrChan: make(chan error, 10),
 
+		quoteMetrics: newQuoteMetrics(),
+
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -264,6 +344,7 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 		SpecifierChecker:  m.AssetMatchesSpecifier,
 		NoOpHTLCs:         m.cfg.NoOpHTLCs,
 		AuxChanNegotiator: m.cfg.AuxChanNegotiator,
+		ForwardFeeStore:   m.cfg.QuoteStore,
 	})
 	if err != nil {
 		return fmt.Errorf("error initializing RFQ order handler: %w",
@@ -299,6 +380,10 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 		SkipAcceptQuotePriceCheck: m.cfg.SkipAcceptQuotePriceCheck,
 		SendPriceHint:             m.cfg.SendPriceHint,
 		SendPeerId:                m.cfg.SendPeerId,
+		PeerRequestLimit:          m.cfg.PeerRequestLimit,
+		PeerRequestBurst:          m.cfg.PeerRequestBurst,
+		ForwardFees:               m.cfg.ForwardFees,
+		AuxChanNegotiator:         m.cfg.AuxChanNegotiator,
 		ErrChan:                   m.subsystemErrChan,
 	})
 	if err != nil {
@@ -338,6 +423,16 @@ func (m *Manager) Start() error {
 			return
 		}
 
+		// Now that the order handler is running, we can restore any
+		// quotes that were accepted before a restart, together with
+		// their HTLC policies.
+		err = m.restoreAcceptedQuotes(ctx)
+		if err != nil {
+			startErr = fmt.Errorf("unable to restore accepted "+
+				"quotes: %w", err)
+			return
+		}
+
 		// Start the manager's main event loop in a separate goroutine.
 		m.Wg.Add(1)
 		go func() {
@@ -403,12 +498,101 @@ func (m *Manager) stopSubsystems() error {
 	return nil
 }
 
+// restoreAcceptedQuotes loads all unexpired accepted quotes from the quote
+// store and re-registers them with the manager and the order handler.
+func (m *Manager) restoreAcceptedQuotes(ctx context.Context) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	quotes, err := m.cfg.QuoteStore.FetchAcceptedQuotes(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("error fetching accepted quotes: %w", err)
+	}
+
+	for _, accept := range quotes.PeerBuy {
+		m.peerAcceptedBuyQuotes.Store(accept.ShortChannelId(), accept)
+	}
+
+	for _, accept := range quotes.PeerSell {
+		m.peerAcceptedSellQuotes.Store(accept.ShortChannelId(), accept)
+	}
+
+	for _, accept := range quotes.LocalBuy {
+		m.orderHandler.RegisterAssetSalePolicy(accept)
+		m.localAcceptedBuyQuotes.Store(accept.ShortChannelId(), accept)
+	}
+
+	for _, accept := range quotes.LocalSell {
+		m.orderHandler.RegisterAssetPurchasePolicy(accept)
+		m.localAcceptedSellQuotes.Store(accept.ShortChannelId(), accept)
+	}
+
+	log.Infof("Restored accepted quotes: peer_buy=%d, peer_sell=%d, "+
+		"local_buy=%d, local_sell=%d", len(quotes.PeerBuy),
+		len(quotes.PeerSell), len(quotes.LocalBuy),
+		len(quotes.LocalSell))
+
+	return nil
+}
+
+// storeBuyAccept persists an accepted buy quote, if a quote store is
+// configured.
+func (m *Manager) storeBuyAccept(accept rfqmsg.BuyAccept, local bool) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	return m.cfg.QuoteStore.StoreBuyAccept(ctx, accept, local)
+}
+
+// storeSellAccept persists an accepted sell quote, if a quote store is
+// configured.
+func (m *Manager) storeSellAccept(accept rfqmsg.SellAccept, local bool) error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	return m.cfg.QuoteStore.StoreSellAccept(ctx, accept, local)
+}
+
+// pruneExpiredQuotes removes expired quotes from the quote store, if one is
+// configured.
+func (m *Manager) pruneExpiredQuotes() error {
+	if m.cfg.QuoteStore == nil {
+		return nil
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	numPruned, err := m.cfg.QuoteStore.PruneExpiredQuotes(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Pruned %d expired quotes from quote store", numPruned)
+
+	return nil
+}
+
 // handleIncomingMessage handles an incoming message. These are messages that
 // have been received from a peer.
 func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 	// Perform type specific handling of the incoming message.
 	switch msg := incomingMsg.(type) {
 	case *rfqmsg.BuyRequest:
+		m.quoteMetrics.recordRequest(
+			msg.ID, msg.Peer, msg.AssetSpecifier,
+			QuoteDirectionIncoming,
+		)
+
 		err := m.negotiator.HandleIncomingBuyRequest(*msg)
 		if err != nil {
 			return fmt.Errorf("error handling incoming buy "+
@@ -428,20 +612,39 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			)
 
 			if invalidQuoteEvent.IsSome() {
+				m.quoteMetrics.recordReject(
+					msg.ID, msg.Peer,
+					QuoteDirectionOutgoing,
+				)
 				return
 			}
 
+			m.quoteMetrics.recordAccept(
+				msg.ID, msg.Peer, msg.Request.AssetSpecifier,
+				QuoteDirectionOutgoing,
+			)
+
 			// The quote request has been accepted. Store accepted
 			// quote so that it can be used to send a payment by our
 			// lightning node.
 			scid := msg.ShortChannelId()
 			m.peerAcceptedBuyQuotes.Store(scid, msg)
 
+			// Persist the quote so that it can still be used
+			// after a restart.
+			err := m.storeBuyAccept(msg, false)
+			if err != nil {
+				m.handleError(
+					fmt.Errorf("error storing buy "+
+						"accept: %w", err),
+				)
+			}
+
 			// Since we're going to buy assets from our peer, we
 			// need to make sure we can identify the incoming asset
 			// payment by the SCID alias through which it comes in
 			// and compare it to the one in the invoice.
-			err := m.addScidAlias(
+			err = m.addScidAlias(
 				uint64(msg.ShortChannelId()),
 				msg.Request.AssetSpecifier, msg.Peer,
 			)
@@ -462,6 +665,11 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		m.negotiator.HandleIncomingBuyAccept(*msg, finaliseCallback)
 
 	case *rfqmsg.SellRequest:
+		m.quoteMetrics.recordRequest(
+			msg.ID, msg.Peer, msg.AssetSpecifier,
+			QuoteDirectionIncoming,
+		)
+
 		err := m.negotiator.HandleIncomingSellRequest(*msg)
 		if err != nil {
 			return fmt.Errorf("error handling incoming sell "+
@@ -481,15 +689,34 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 			)
 
 			if invalidQuoteEvent.IsSome() {
+				m.quoteMetrics.recordReject(
+					msg.ID, msg.Peer,
+					QuoteDirectionOutgoing,
+				)
 				return
 			}
 
+			m.quoteMetrics.recordAccept(
+				msg.ID, msg.Peer, msg.Request.AssetSpecifier,
+				QuoteDirectionOutgoing,
+			)
+
 			// The quote request has been accepted. Store accepted
 			// quote so that it can be used to send a payment by our
 			// lightning node.
 			scid := msg.ShortChannelId()
 			m.peerAcceptedSellQuotes.Store(scid, msg)
 
+			// Persist the quote so that it can still be used
+			// after a restart.
+			err := m.storeSellAccept(msg, false)
+			if err != nil {
+				m.handleError(
+					fmt.Errorf("error storing sell "+
+						"accept: %w", err),
+				)
+			}
+
 			// Notify subscribers of the incoming peer accepted
 			// asset sell quote.
 			event := NewPeerAcceptedSellQuoteEvent(&msg)
@@ -504,6 +731,10 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		event := NewIncomingRejectQuoteEvent(msg)
 		m.publishSubscriberEvent(event)
 
+		m.quoteMetrics.recordReject(
+			msg.ID.Val, msg.Peer, QuoteDirectionOutgoing,
+		)
+
 	default:
 		return fmt.Errorf("unhandled incoming message type: %T", msg)
 	}
@@ -516,7 +747,24 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 	// Perform type specific handling of the outgoing message.
 	switch msg := outgoingMsg.(type) {
+	case *rfqmsg.BuyRequest:
+		m.quoteMetrics.recordRequest(
+			msg.ID, msg.Peer, msg.AssetSpecifier,
+			QuoteDirectionOutgoing,
+		)
+
+	case *rfqmsg.SellRequest:
+		m.quoteMetrics.recordRequest(
+			msg.ID, msg.Peer, msg.AssetSpecifier,
+			QuoteDirectionOutgoing,
+		)
+
 	case *rfqmsg.BuyAccept:
+		m.quoteMetrics.recordAccept(
+			msg.ID, msg.Peer, msg.Request.AssetSpecifier,
+			QuoteDirectionIncoming,
+		)
+
 		// A peer sent us an asset buy quote request in an attempt to
 		// buy an asset from us. Having accepted the request, but before
 		// we inform our peer of our decision, we inform the order
@@ -528,10 +776,17 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// need to look it up for a direct peer payment.
 		m.localAcceptedBuyQuotes.Store(msg.ShortChannelId(), *msg)
 
+		// The quote and its policy must survive a restart, so we also
+		// persist it.
+		err := m.storeBuyAccept(*msg, true)
+		if err != nil {
+			return fmt.Errorf("error storing buy accept: %w", err)
+		}
+
 		// Since our peer is going to buy assets from us, we need to
 		// make sure we can identify the forwarded asset payment by the
 		// outgoing SCID alias within the onion packet.
-		err := m.addScidAlias(
+		err = m.addScidAlias(
 			uint64(msg.ShortChannelId()),
 			msg.Request.AssetSpecifier, msg.Peer,
 		)
@@ -540,6 +795,11 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		}
 
 	case *rfqmsg.SellAccept:
+		m.quoteMetrics.recordAccept(
+			msg.ID, msg.Peer, msg.Request.AssetSpecifier,
+			QuoteDirectionIncoming,
+		)
+
 		// A peer sent us an asset sell quote request in an attempt to
 		// sell an asset to us. Having accepted the request, but before
 		// we inform our peer of our decision, we inform the order
@@ -550,6 +810,18 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 		// We want to store that we accepted the sell quote, in case we
 		// need to look it up for a direct peer payment.
 		m.localAcceptedSellQuotes.Store(msg.ShortChannelId(), *msg)
+
+		// The quote and its policy must survive a restart, so we also
+		// persist it.
+		err := m.storeSellAccept(*msg, true)
+		if err != nil {
+			return fmt.Errorf("error storing sell accept: %w", err)
+		}
+
+	case *rfqmsg.Reject:
+		m.quoteMetrics.recordReject(
+			msg.ID.Val, msg.Peer, QuoteDirectionIncoming,
+		)
 	}
 
 	// Send the outgoing message to the peer.
@@ -625,6 +897,9 @@ func (m *Manager) addScidAlias(scidAlias uint64, assetSpecifier asset.Specifier,
 
 // mainEventLoop is the main event loop of the RFQ manager.
 func (m *Manager) mainEventLoop() {
+	pruneTicker := time.NewTicker(QuotePruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		// Handle incoming message.
@@ -657,6 +932,18 @@ func (m *Manager) mainEventLoop() {
 			// Handle a HTLC accept event. Notify any subscribers.
 			m.publishSubscriberEvent(acceptHtlcEvent)
 
+		// Periodically remove expired quotes from the quote store.
+		case <-pruneTicker.C:
+			err := m.pruneExpiredQuotes()
+			if err != nil {
+				m.handleError(
+					fmt.Errorf("failed to prune expired "+
+						"quotes: %w", err),
+				)
+			}
+
+			m.quoteMetrics.prunePending(pendingQuoteMetricsTimeout)
+
 		// Handle subsystem errors.
 		case err := <-m.subsystemErrChan:
 			// Report the subsystem error to the main server, in
@@ -923,6 +1210,36 @@ func (m *Manager) LocalAcceptedSellQuotes() SellAcceptMap {
 	return sellQuotesCopy
 }
 
+// QuoteRate returns the asset rate of the accepted quote with the given ID,
+// regardless of which side requested or accepted it. Quotes that were already
+// pruned are not found.
+func (m *Manager) QuoteRate(id rfqmsg.ID) fn.Option[rfqmath.BigIntFixedPoint] {
+	scid := SerialisedScid(id.Scid())
+
+	if accept, ok := m.peerAcceptedBuyQuotes.Load(scid); ok &&
+		accept.ID == id {
+
+		return fn.Some(accept.AssetRate.Rate)
+	}
+	if accept, ok := m.peerAcceptedSellQuotes.Load(scid); ok &&
+		accept.ID == id {
+
+		return fn.Some(accept.AssetRate.Rate)
+	}
+	if accept, ok := m.localAcceptedBuyQuotes.Load(scid); ok &&
+		accept.ID == id {
+
+		return fn.Some(accept.AssetRate.Rate)
+	}
+	if accept, ok := m.localAcceptedSellQuotes.Load(scid); ok &&
+		accept.ID == id {
+
+		return fn.Some(accept.AssetRate.Rate)
+	}
+
+	return fn.None[rfqmath.BigIntFixedPoint]()
+}
+
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
 // be notified of any new events that are broadcast.
 //
@@ -1308,6 +1625,33 @@ func Zpay32HopHintToLnrpc(h *zpay32.HopHint) *lnrpc.HopHint {
 	}
 }
 
+// Stats returns a snapshot of the RFQ manager's quote and HTLC metrics.
+func (m *Manager) Stats() ManagerStats {
+	stats := ManagerStats{
+		Quotes: m.quoteMetrics.snapshot(),
+	}
+
+	// The order handler is only available once the manager was started.
+	if m.orderHandler != nil {
+		stats.ActivePolicies = m.orderHandler.NumActivePolicies()
+		stats.HtlcsIntercepted, stats.HtlcsRejected =
+			m.orderHandler.HtlcStats()
+	}
+
+	return stats
+}
+
+// CollectedForwardFees returns the forwarding fees in asset units that were
+// collected per asset.
+func (m *Manager) CollectedForwardFees() []CollectedForwardFees {
+	// The order handler is only available once the manager was started.
+	if m.orderHandler == nil {
+		return nil
+	}
+
+	return m.orderHandler.CollectedForwardFees()
+}
+
 // publishSubscriberEvent publishes an event to all subscribers.
 func (m *Manager) publishSubscriberEvent(event fn.Event) {
 	// Iterate over the subscribers and deliver the event to each one.
@@ -1410,6 +1754,10 @@ const (
 	// PriceOracleQueryErrQuoteRespStatus indicates that an error occurred
 	// when querying the price oracle whilst evaluating the quote response.
 	PriceOracleQueryErrQuoteRespStatus QuoteRespStatus = 2
+
+	// InvalidForwardFeeQuoteRespStatus indicates that the forwarding fee
+	// in the quote response can't be charged on the requested amount.
+	InvalidForwardFeeQuoteRespStatus QuoteRespStatus = 3
 )
 
 // InvalidQuoteRespEvent is an event that is broadcast when the RFQ manager
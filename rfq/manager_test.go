@@ -4,13 +4,17 @@ import (
 	"context"
 	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	tpchmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -351,3 +355,139 @@ func pubKeyFromUint64(num uint64) *btcec.PublicKey {
 	_ = scalar.SetByteSlice(buf)
 	return secp256k1.NewPrivateKey(scalar).PubKey()
 }
+
+// mockQuoteStore is a mock implementation of the QuoteStore interface that
+// returns a fixed set of accepted quotes.
+type mockQuoteStore struct {
+	quotes        AcceptedQuotes
+	collectedFees []CollectedForwardFees
+}
+
+func (m *mockQuoteStore) StoreBuyAccept(context.Context, rfqmsg.BuyAccept,
+	bool) error {
+
+	return nil
+}
+
+func (m *mockQuoteStore) StoreSellAccept(context.Context, rfqmsg.SellAccept,
+	bool) error {
+
+	return nil
+}
+
+func (m *mockQuoteStore) FetchAcceptedQuotes(context.Context,
+	time.Time) (*AcceptedQuotes, error) {
+
+	return &m.quotes, nil
+}
+
+func (m *mockQuoteStore) PruneExpiredQuotes(context.Context,
+	time.Time) (int64, error) {
+
+	return 0, nil
+}
+
+func (m *mockQuoteStore) AddCollectedForwardFee(context.Context,
+	asset.Specifier, uint64) error {
+
+	return nil
+}
+
+func (m *mockQuoteStore) FetchCollectedForwardFees(
+	context.Context) ([]CollectedForwardFees, error) {
+
+	return m.collectedFees, nil
+}
+
+// TestRestoreAcceptedQuotes tests that accepted quotes are restored from the
+// quote store and that policies are registered for locally accepted quotes.
+func TestRestoreAcceptedQuotes(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Now().Add(time.Hour)
+	rate := rfqmsg.NewAssetRate(
+		rfqmath.NewBigIntFixedPoint(100_000, 0), expiry,
+	)
+	specifier := asset.NewSpecifierFromId(testAssetID1)
+
+	newBuyAccept := func(peer route.Vertex) rfqmsg.BuyAccept {
+		req, err := rfqmsg.NewBuyRequest(
+			peer, specifier, 1000, fn.None[rfqmsg.AssetRate](), "",
+		)
+		require.NoError(t, err)
+
+		return *rfqmsg.NewBuyAcceptFromRequest(*req, rate)
+	}
+	newSellAccept := func(peer route.Vertex) rfqmsg.SellAccept {
+		req, err := rfqmsg.NewSellRequest(
+			peer, specifier, 1_000_000, fn.None[rfqmsg.AssetRate](),
+			"",
+		)
+		require.NoError(t, err)
+
+		return *rfqmsg.NewSellAcceptFromRequest(*req, rate)
+	}
+
+	// The quotes we accepted carry the forwarding fee that we advertised
+	// at the time, which must be restored even if the configured fee has
+	// changed since.
+	forwardFee := fn.Some(rfqmsg.ForwardFee{
+		BaseFee:    5,
+		FeeRatePpm: 1_000,
+	})
+	localBuy := newBuyAccept(peer2)
+	localBuy.ForwardFee = forwardFee
+	localSell := newSellAccept(peer2)
+	localSell.ForwardFee = forwardFee
+
+	store := &mockQuoteStore{
+		quotes: AcceptedQuotes{
+			PeerBuy:   []rfqmsg.BuyAccept{newBuyAccept(peer1)},
+			PeerSell:  []rfqmsg.SellAccept{newSellAccept(peer1)},
+			LocalBuy:  []rfqmsg.BuyAccept{localBuy},
+			LocalSell: []rfqmsg.SellAccept{localSell},
+		},
+	}
+
+	manager, err := NewManager(ManagerCfg{
+		QuoteStore: store,
+		ForwardFees: ForwardFeePolicies{{
+			AssetSpecifier: specifier,
+			Fee:            rfqmsg.ForwardFee{BaseFee: 100},
+		}},
+	})
+	require.NoError(t, err)
+
+	manager.orderHandler, err = NewOrderHandler(OrderHandlerCfg{})
+	require.NoError(t, err)
+
+	err = manager.restoreAcceptedQuotes(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, manager.PeerAcceptedBuyQuotes(), 1)
+	require.Len(t, manager.PeerAcceptedSellQuotes(), 1)
+	require.Len(t, manager.LocalAcceptedBuyQuotes(), 1)
+	require.Len(t, manager.LocalAcceptedSellQuotes(), 1)
+
+	// Only the quotes accepted by our node should result in a policy being
+	// registered with the order handler.
+	policy, ok := manager.orderHandler.policies.Load(
+		localBuy.ShortChannelId(),
+	)
+	require.True(t, ok)
+	require.IsType(t, &AssetSalePolicy{}, policy)
+	salePolicy := policy.(*AssetSalePolicy)
+	require.Equal(t, forwardFee, salePolicy.ForwardFee)
+
+	policy, ok = manager.orderHandler.policies.Load(
+		localSell.ShortChannelId(),
+	)
+	require.True(t, ok)
+	require.IsType(t, &AssetPurchasePolicy{}, policy)
+	purchasePolicy := policy.(*AssetPurchasePolicy)
+	require.Equal(t, forwardFee, purchasePolicy.ForwardFee)
+
+	peerBuy := store.quotes.PeerBuy[0]
+	_, ok = manager.orderHandler.policies.Load(peerBuy.ShortChannelId())
+	require.False(t, ok)
+}
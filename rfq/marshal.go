@@ -49,6 +49,7 @@ func MarshalAcceptedSellQuote(
 		AssetAmount:          numAssetUnits.ScaleTo(0).ToUint64(),
 		MinTransportableMsat: uint64(minTransportableMSat),
 		PriceOracleMetadata:  accept.Request.PriceOracleMetadata,
+		ForwardFee:           MarshalForwardFee(accept.ForwardFee),
 	}
 
 	// Populate asset ID and/or group key based on the asset specifier.
@@ -96,6 +97,7 @@ func MarshalAcceptedBuyQuote(q rfqmsg.BuyAccept) *rfqrpc.PeerAcceptedBuyQuote {
 		Expiry:                uint64(q.AssetRate.Expiry.Unix()),
 		MinTransportableUnits: minTransportableUnits,
 		PriceOracleMetadata:   q.Request.PriceOracleMetadata,
+		ForwardFee:            MarshalForwardFee(q.ForwardFee),
 	}
 
 	// Populate asset ID and/or group key based on the asset specifier.
@@ -121,6 +123,59 @@ func MarshalAcceptedBuyQuote(q rfqmsg.BuyAccept) *rfqrpc.PeerAcceptedBuyQuote {
 	return quote
 }
 
+// MarshalForwardFee marshals an optional forwarding fee to its RPC
+// representation. An unset fee is marshaled as nil.
+func MarshalForwardFee(
+	forwardFee fn.Option[rfqmsg.ForwardFee]) *rfqrpc.ForwardFee {
+
+	return fn.MapOptionZ(
+		forwardFee, func(fee rfqmsg.ForwardFee) *rfqrpc.ForwardFee {
+			return &rfqrpc.ForwardFee{
+				BaseFee:    fee.BaseFee,
+				FeeRatePpm: fee.FeeRatePpm,
+			}
+		},
+	)
+}
+
+// UnmarshalForwardFee unmarshals an optional forwarding fee from its RPC
+// representation.
+func UnmarshalForwardFee(
+	rpcFee *rfqrpc.ForwardFee) fn.Option[rfqmsg.ForwardFee] {
+
+	if rpcFee == nil {
+		return fn.None[rfqmsg.ForwardFee]()
+	}
+
+	return fn.Some(rfqmsg.ForwardFee{
+		BaseFee:    rpcFee.BaseFee,
+		FeeRatePpm: rpcFee.FeeRatePpm,
+	})
+}
+
+// MarshalCollectedForwardFees marshals the collected forwarding fees of an
+// asset to their RPC representation.
+func MarshalCollectedForwardFees(
+	fees CollectedForwardFees) *rfqrpc.CollectedForwardFees {
+
+	rpcFees := &rfqrpc.CollectedForwardFees{
+		AssetSpec: &rfqrpc.AssetSpec{},
+		NumHtlcs:  fees.NumHtlcs,
+		TotalFees: fees.TotalFees,
+	}
+
+	fees.AssetSpecifier.WhenId(func(assetID asset.ID) {
+		rpcFees.AssetSpec.Id = fn.CopySlice(assetID[:])
+	})
+	fees.AssetSpecifier.WhenGroupPubKey(func(groupKey btcec.PublicKey) {
+		rpcFees.AssetSpec.GroupPubKey = schnorr.SerializePubKey(
+			&groupKey,
+		)
+	})
+
+	return rpcFees
+}
+
 // MarshalInvalidQuoteRespEvent marshals an invalid quote response event to
 // its rpc representation.
 func MarshalInvalidQuoteRespEvent(
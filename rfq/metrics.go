@@ -0,0 +1,210 @@
+package rfq
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// pendingQuoteMetricsTimeout is the time after which an unanswered
+	// quote request is no longer tracked for metrics purposes.
+	pendingQuoteMetricsTimeout = time.Hour
+)
+
+// QuoteDirection describes which side of a quote negotiation initiated the
+// quote request.
+type QuoteDirection string
+
+const (
+	// QuoteDirectionIncoming is used for quote requests that were sent to
+	// us by a peer.
+	QuoteDirectionIncoming QuoteDirection = "incoming"
+
+	// QuoteDirectionOutgoing is used for quote requests that we sent to a
+	// peer.
+	QuoteDirectionOutgoing QuoteDirection = "outgoing"
+)
+
+// QuoteMetricsKey identifies the quote counters of a single peer, asset and
+// quote direction.
+type QuoteMetricsKey struct {
+	// Peer is the peer the quotes were negotiated with.
+	Peer route.Vertex
+
+	// Asset is the hex encoded asset ID or, if the quote was negotiated
+	// for an asset group, the hex encoded group key.
+	Asset string
+
+	// Direction describes which side initiated the quote requests.
+	Direction QuoteDirection
+}
+
+// QuoteCounts holds the number of requested, accepted and rejected quotes.
+type QuoteCounts struct {
+	// Requested is the number of quote requests.
+	Requested uint64
+
+	// Accepted is the number of accepted quote requests.
+	Accepted uint64
+
+	// Rejected is the number of rejected quote requests.
+	Rejected uint64
+}
+
+// ManagerStats is a snapshot of the RFQ manager's metrics.
+type ManagerStats struct {
+	// Quotes are the quote counters per peer, asset and direction.
+	Quotes map[QuoteMetricsKey]QuoteCounts
+
+	// ActivePolicies is the number of HTLC policies that are currently
+	// registered with the order handler.
+	ActivePolicies int
+
+	// HtlcsIntercepted is the number of HTLCs that matched an RFQ policy.
+	HtlcsIntercepted uint64
+
+	// HtlcsRejected is the number of HTLCs that were failed because they
+	// didn't comply with their RFQ policy.
+	HtlcsRejected uint64
+}
+
+// pendingQuoteMetric is a quote request that hasn't been answered yet.
+type pendingQuoteMetric struct {
+	key       QuoteMetricsKey
+	requested time.Time
+}
+
+// quoteMetrics keeps track of the quote counters of the RFQ manager.
+type quoteMetrics struct {
+	mtx sync.Mutex
+
+	// counts are the quote counters.
+	counts map[QuoteMetricsKey]*QuoteCounts
+
+	// pending are the unanswered quote requests, keyed by their ID. This
+	// allows us to attribute a reject message to a peer and asset.
+	pending map[rfqmsg.ID]pendingQuoteMetric
+}
+
+// newQuoteMetrics creates a new, empty set of quote counters.
+func newQuoteMetrics() *quoteMetrics {
+	return &quoteMetrics{
+		counts:  make(map[QuoteMetricsKey]*QuoteCounts),
+		pending: make(map[rfqmsg.ID]pendingQuoteMetric),
+	}
+}
+
+// assetMetricsLabel returns the asset label of the given asset specifier.
+func assetMetricsLabel(specifier asset.Specifier) string {
+	var label string
+	specifier.WhenGroupPubKey(func(key btcec.PublicKey) {
+		label = hex.EncodeToString(key.SerializeCompressed())
+	})
+	specifier.WhenId(func(id asset.ID) {
+		label = id.String()
+	})
+
+	return label
+}
+
+// countsLocked returns the counters for the given key, creating them if they
+// don't exist yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (q *quoteMetrics) countsLocked(key QuoteMetricsKey) *QuoteCounts {
+	counts, ok := q.counts[key]
+	if !ok {
+		counts = &QuoteCounts{}
+		q.counts[key] = counts
+	}
+
+	return counts
+}
+
+// recordRequest records a new quote request.
+func (q *quoteMetrics) recordRequest(id rfqmsg.ID, peer route.Vertex,
+	specifier asset.Specifier, direction QuoteDirection) {
+
+	key := QuoteMetricsKey{
+		Peer:      peer,
+		Asset:     assetMetricsLabel(specifier),
+		Direction: direction,
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.countsLocked(key).Requested++
+	q.pending[id] = pendingQuoteMetric{
+		key:       key,
+		requested: time.Now(),
+	}
+}
+
+// recordAccept records that the quote request with the given ID was
+// accepted.
+func (q *quoteMetrics) recordAccept(id rfqmsg.ID, peer route.Vertex,
+	specifier asset.Specifier, direction QuoteDirection) {
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	delete(q.pending, id)
+	q.countsLocked(QuoteMetricsKey{
+		Peer:      peer,
+		Asset:     assetMetricsLabel(specifier),
+		Direction: direction,
+	}).Accepted++
+}
+
+// recordReject records that the quote request with the given ID was
+// rejected. Rejects of untracked requests are attributed to an unknown asset.
+func (q *quoteMetrics) recordReject(id rfqmsg.ID, peer route.Vertex,
+	direction QuoteDirection) {
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	key := QuoteMetricsKey{
+		Peer:      peer,
+		Direction: direction,
+	}
+	if pending, ok := q.pending[id]; ok {
+		key = pending.key
+		delete(q.pending, id)
+	}
+
+	q.countsLocked(key).Rejected++
+}
+
+// prunePending removes unanswered quote requests that are older than the
+// given timeout.
+func (q *quoteMetrics) prunePending(timeout time.Duration) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for id, pending := range q.pending {
+		if time.Since(pending.requested) > timeout {
+			delete(q.pending, id)
+		}
+	}
+}
+
+// snapshot returns a copy of the quote counters.
+func (q *quoteMetrics) snapshot() map[QuoteMetricsKey]QuoteCounts {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	counts := make(map[QuoteMetricsKey]QuoteCounts, len(q.counts))
+	for key, c := range q.counts {
+		counts[key] = *c
+	}
+
+	return counts
+}
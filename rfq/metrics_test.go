@@ -0,0 +1,72 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuoteMetrics tests that quote requests, accepts and rejects are
+// attributed to the correct peer, asset and direction.
+func TestQuoteMetrics(t *testing.T) {
+	t.Parallel()
+
+	var (
+		metrics   = newQuoteMetrics()
+		peer      = route.Vertex{1}
+		assetID   = asset.ID{2}
+		specifier = asset.NewSpecifierFromId(assetID)
+	)
+
+	incomingKey := QuoteMetricsKey{
+		Peer:      peer,
+		Asset:     assetID.String(),
+		Direction: QuoteDirectionIncoming,
+	}
+	outgoingKey := incomingKey
+	outgoingKey.Direction = QuoteDirectionOutgoing
+
+	// Two incoming requests, of which one is accepted and one rejected.
+	metrics.recordRequest(
+		rfqmsg.ID{1}, peer, specifier, QuoteDirectionIncoming,
+	)
+	metrics.recordRequest(
+		rfqmsg.ID{2}, peer, specifier, QuoteDirectionIncoming,
+	)
+	metrics.recordAccept(
+		rfqmsg.ID{1}, peer, specifier, QuoteDirectionIncoming,
+	)
+	metrics.recordReject(rfqmsg.ID{2}, peer, QuoteDirectionIncoming)
+
+	// One outgoing request that isn't answered yet.
+	metrics.recordRequest(
+		rfqmsg.ID{3}, peer, specifier, QuoteDirectionOutgoing,
+	)
+
+	// A reject for an unknown request is counted without an asset.
+	metrics.recordReject(rfqmsg.ID{4}, peer, QuoteDirectionOutgoing)
+
+	counts := metrics.snapshot()
+	require.Len(t, counts, 3)
+	require.Equal(t, QuoteCounts{
+		Requested: 2,
+		Accepted:  1,
+		Rejected:  1,
+	}, counts[incomingKey])
+	require.Equal(t, QuoteCounts{Requested: 1}, counts[outgoingKey])
+	require.Equal(t, QuoteCounts{Rejected: 1}, counts[QuoteMetricsKey{
+		Peer:      peer,
+		Direction: QuoteDirectionOutgoing,
+	}])
+
+	// Only the unanswered request is still pending, until it is pruned.
+	require.Len(t, metrics.pending, 1)
+	metrics.prunePending(time.Hour)
+	require.Len(t, metrics.pending, 1)
+	metrics.prunePending(0)
+	require.Empty(t, metrics.pending)
+}
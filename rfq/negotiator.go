@@ -10,9 +10,11 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmath"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapfeatures"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -25,6 +27,11 @@ const (
 	//
 	// NOTE: This value is set to 5% (50,000 ppm).
 	DefaultAcceptPriceDeviationPpm = 50_000
+
+	// DefaultPeerRequestBurst is the default number of incoming quote
+	// requests a single peer can send in a burst before the request rate
+	// limit applies.
+	DefaultPeerRequestBurst = 20
 )
 
 // NegotiatorCfg holds the configuration for the negotiator.
@@ -57,6 +64,28 @@ type NegotiatorCfg struct {
 	// key of the peer) to the price oracle when requesting a price rate.
 	SendPeerId bool
 
+	// PeerRequestLimit is the maximum sustained rate of incoming quote
+	// requests per second that is accepted from a single peer. Requests
+	// above this rate are rejected. If zero, requests aren't rate
+	// limited.
+	PeerRequestLimit rate.Limit
+
+	// PeerRequestBurst is the maximum number of incoming quote requests
+	// that a single peer can send in a burst before the rate limit
+	// applies.
+	PeerRequestBurst int
+
+	// ForwardFees are the fees in asset units that we charge for the
+	// asset HTLCs that are paid to us under our accepted sell quotes or
+	// that we pay under our accepted buy quotes. The fee is advertised in
+	// the accept message.
+	ForwardFees ForwardFeePolicies
+
+	// AuxChanNegotiator is used to query the feature bits that are
+	// supported by a peer. We only advertise a forwarding fee to peers
+	// that understand it.
+	AuxChanNegotiator *tapfeatures.AuxChannelNegotiator
+
 	// ErrChan is a channel that is populated with errors by this subsystem.
 	ErrChan chan<- error
 }
@@ -86,6 +115,11 @@ type Negotiator struct {
 	// asset buy offers.
 	assetGroupBuyOffers lnutils.SyncMap[asset.SerializedKey, BuyOffer]
 
+	// peerLimiters is a map (keyed on peer) that holds the rate limiters
+	// of incoming quote requests. Limiters of idle peers are evicted
+	// periodically.
+	peerLimiters lnutils.SyncMap[route.Vertex, *rate.Limiter]
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -104,6 +138,8 @@ func NewNegotiator(cfg NegotiatorCfg) (*Negotiator, error) {
 		assetGroupBuyOffers: lnutils.SyncMap[
 			asset.SerializedKey, BuyOffer]{},
 
+		peerLimiters: lnutils.SyncMap[route.Vertex, *rate.Limiter]{},
+
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -301,6 +337,55 @@ func (n *Negotiator) querySellFromPriceOracle(assetSpecifier asset.Specifier,
 	return &oracleResponse.AssetRate, nil
 }
 
+// allowPeerRequest returns true if an incoming quote request from the given
+// peer is within the peer's request rate limit.
+func (n *Negotiator) allowPeerRequest(peer route.Vertex) bool {
+	if n.cfg.PeerRequestLimit == 0 {
+		return true
+	}
+
+	limiter, _ := n.peerLimiters.LoadOrStore(peer, rate.NewLimiter(
+		n.cfg.PeerRequestLimit, n.cfg.PeerRequestBurst,
+	))
+
+	return limiter.Allow()
+}
+
+// pruneIdlePeerLimiters evicts the rate limiters of all peers that haven't
+// sent a quote request for long enough that their limiter is full again. A
+// peer that sends a new request gets a fresh limiter, which is full as well,
+// so the rate limit isn't relaxed by evicting it.
+func (n *Negotiator) pruneIdlePeerLimiters(now time.Time) {
+	n.peerLimiters.Range(func(peer route.Vertex,
+		limiter *rate.Limiter) bool {
+
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			n.peerLimiters.Delete(peer)
+		}
+
+		return true
+	})
+}
+
+// forwardFeeFor returns the forwarding fee we charge the given peer for quotes
+// of the given asset. Peers that don't support forwarding fees would neither
+// add the fee to the HTLCs they send nor expect it to be deducted from the
+// HTLCs they receive, so we don't charge them any fee.
+func (n *Negotiator) forwardFeeFor(peer route.Vertex,
+	specifier asset.Specifier) fn.Option[rfqmsg.ForwardFee] {
+
+	if n.cfg.AuxChanNegotiator == nil {
+		return fn.None[rfqmsg.ForwardFee]()
+	}
+
+	peerFeatures := n.cfg.AuxChanNegotiator.GetPeerFeatures(peer)
+	if !peerFeatures.HasFeature(tapfeatures.ForwardFeesOptional) {
+		return fn.None[rfqmsg.ForwardFee]()
+	}
+
+	return n.cfg.ForwardFees.FeeFor(specifier)
+}
+
 // HandleIncomingBuyRequest handles an incoming asset buy quote request.
 func (n *Negotiator) HandleIncomingBuyRequest(
 	request rfqmsg.BuyRequest) error {
@@ -329,6 +414,19 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 		return nil
 	}
 
+	// Reject the quote request if the peer exceeds its request rate
+	// limit.
+	if !n.allowPeerRequest(request.Peer) {
+		log.Warnf("Rejecting buy request from peer %x: request rate "+
+			"limit exceeded", request.Peer[:])
+
+		msg := rfqmsg.NewReject(
+			request.Peer, request.ID, rfqmsg.ErrRateLimited,
+		)
+		go sendOutgoingMsg(msg)
+		return nil
+	}
+
 	// Ensure that we have a suitable sell offer for the asset that is being
 	// requested. Here we can handle the case where this node does not wish
 	// to sell a particular asset.
@@ -386,8 +484,12 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 			return
 		}
 
-		// Construct and send a buy accept message.
+		// Construct and send a buy accept message. If we charge a
+		// forwarding fee for the asset, we advertise it in the message.
 		msg := rfqmsg.NewBuyAcceptFromRequest(request, *assetRate)
+		msg.ForwardFee = n.forwardFeeFor(
+			request.Peer, request.AssetSpecifier,
+		)
 		sendOutgoingMsg(msg)
 	}()
 
@@ -423,6 +525,19 @@ func (n *Negotiator) HandleIncomingSellRequest(
 		return nil
 	}
 
+	// Reject the quote request if the peer exceeds its request rate
+	// limit.
+	if !n.allowPeerRequest(request.Peer) {
+		log.Warnf("Rejecting sell request from peer %x: request rate "+
+			"limit exceeded", request.Peer[:])
+
+		msg := rfqmsg.NewReject(
+			request.Peer, request.ID, rfqmsg.ErrRateLimited,
+		)
+		go sendOutgoingMsg(msg)
+		return nil
+	}
+
 	// The sell request is attempting to sell some amount of an asset to our
 	// node. Here we ensure that we have a suitable buy offer for the asset.
 	// A buy offer is the criteria that this node uses to determine whether
@@ -487,8 +602,12 @@ func (n *Negotiator) HandleIncomingSellRequest(
 			return
 		}
 
-		// Construct and send a sell accept message.
+		// Construct and send a sell accept message. If we charge a
+		// forwarding fee for the asset, we advertise it in the message.
 		msg := rfqmsg.NewSellAcceptFromRequest(request, *assetRate)
+		msg.ForwardFee = n.forwardFeeFor(
+			request.Peer, request.AssetSpecifier,
+		)
 		sendOutgoingMsg(msg)
 	}()
 
@@ -618,6 +737,26 @@ func (n *Negotiator) HandleIncomingBuyAccept(msg rfqmsg.BuyAccept,
 		return
 	}
 
+	// Ensure that the forwarding fee our peer charges can be computed for
+	// the maximum asset amount of our request without overflowing.
+	var feeErr error
+	msg.ForwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		_, feeErr = fee.FeeForAmount(msg.Request.AssetMaxAmt)
+	})
+	if feeErr != nil {
+		log.Debugf("Buy accept quote forward fee is invalid: %v",
+			feeErr)
+
+		invalidQuoteRespEvent := NewInvalidQuoteRespEvent(
+			&msg, InvalidForwardFeeQuoteRespStatus,
+		)
+		finalise(msg, fn.Some[InvalidQuoteRespEvent](
+			*invalidQuoteRespEvent,
+		))
+
+		return
+	}
+
 	if n.cfg.SkipAcceptQuotePriceCheck {
 		// Skip the price check.
 		finalise(msg, fn.None[InvalidQuoteRespEvent]())
@@ -785,6 +924,29 @@ func (n *Negotiator) HandleIncomingSellAccept(msg rfqmsg.SellAccept,
 		return
 	}
 
+	// Ensure that the forwarding fee our peer charges can be added to the
+	// maximum asset amount of our request without overflowing.
+	var feeErr error
+	msg.ForwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		maxAssetAmt := rfqmath.MilliSatoshiToUnits(
+			msg.Request.PaymentMaxAmt, msg.AssetRate.Rate,
+		)
+		_, feeErr = fee.AmountWithFee(maxAssetAmt.ScaleTo(0).ToUint64())
+	})
+	if feeErr != nil {
+		log.Debugf("Sell accept quote forward fee is invalid: %v",
+			feeErr)
+
+		invalidQuoteRespEvent := NewInvalidQuoteRespEvent(
+			&msg, InvalidForwardFeeQuoteRespStatus,
+		)
+		finalise(msg, fn.Some[InvalidQuoteRespEvent](
+			*invalidQuoteRespEvent,
+		))
+
+		return
+	}
+
 	if n.cfg.SkipAcceptQuotePriceCheck {
 		// Skip the price check.
 		finalise(msg, fn.None[InvalidQuoteRespEvent]())
@@ -1180,6 +1342,26 @@ func (n *Negotiator) Start() error {
 	var startErr error
 	n.startOnce.Do(func() {
 		log.Info("Starting subsystem: negotiator")
+
+		// Periodically evict the rate limiters of idle peers, so the
+		// map doesn't grow with every peer that ever sent a request.
+		n.Wg.Add(1)
+		go func() {
+			defer n.Wg.Done()
+
+			cleanupTicker := time.NewTicker(CacheCleanupInterval)
+			defer cleanupTicker.Stop()
+
+			for {
+				select {
+				case <-cleanupTicker.C:
+					n.pruneIdlePeerLimiters(time.Now())
+
+				case <-n.Quit:
+					return
+				}
+			}
+		}()
 	})
 	return startErr
 }
@@ -8,10 +8,12 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmath"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapfeatures"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 // testCaseIncomingSellAccept is a test case for the handling of an incoming
@@ -385,3 +387,183 @@ func TestHandleIncomingBuyAccept(t *testing.T) {
 		}
 	}
 }
+
+// TestIncomingRequestRateLimit tests that incoming quote requests of a peer
+// are rejected once the peer exceeds its request rate limit, without affecting
+// other peers.
+func TestIncomingRequestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	outgoingMsgs := make(chan rfqmsg.OutgoingMsg, 10)
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		PriceOracle:      NewMockPriceOracle(3600, 100_000),
+		OutgoingMessages: outgoingMsgs,
+		PeerRequestLimit: rate.Every(time.Hour),
+		PeerRequestBurst: 2,
+		ErrChan:          make(chan error, 10),
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, negotiator.Stop())
+	}()
+
+	var (
+		assetSpecifier = asset.NewSpecifierFromId(asset.ID{1, 2, 3})
+		limitedPeer    = route.Vertex{1}
+		otherPeer      = route.Vertex{2}
+	)
+	buyRequest := func(peer route.Vertex, id byte) rfqmsg.BuyRequest {
+		return rfqmsg.BuyRequest{
+			Peer:           peer,
+			ID:             rfqmsg.ID{id},
+			AssetSpecifier: assetSpecifier,
+			AssetMaxAmt:    1000,
+			AssetRateHint:  fn.None[rfqmsg.AssetRate](),
+		}
+	}
+
+	// The first two requests of the peer are within the burst, the third
+	// one exceeds the limit. The other peer isn't affected.
+	requests := []rfqmsg.BuyRequest{
+		buyRequest(limitedPeer, 1), buyRequest(limitedPeer, 2),
+		buyRequest(limitedPeer, 3), buyRequest(otherPeer, 4),
+	}
+	for _, req := range requests {
+		require.NoError(t, negotiator.HandleIncomingBuyRequest(req))
+	}
+
+	var (
+		numAccepts int
+		rejects    []*rfqmsg.Reject
+	)
+	for range requests {
+		select {
+		case msg := <-outgoingMsgs:
+			switch m := msg.(type) {
+			case *rfqmsg.BuyAccept:
+				numAccepts++
+
+			case *rfqmsg.Reject:
+				rejects = append(rejects, m)
+
+			default:
+				t.Fatalf("unexpected message: %T", msg)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for outgoing message")
+		}
+	}
+
+	require.Equal(t, 3, numAccepts)
+	require.Len(t, rejects, 1)
+	require.Equal(t, limitedPeer, rejects[0].Peer)
+	require.Equal(t, rfqmsg.ID{3}, rejects[0].ID.Val)
+	require.Equal(t, rfqmsg.ErrRateLimited, rejects[0].Err.Val)
+}
+
+// TestPruneIdlePeerLimiters tests that only the rate limiters of peers whose
+// limiter is full again are evicted.
+func TestPruneIdlePeerLimiters(t *testing.T) {
+	t.Parallel()
+
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		PeerRequestLimit: rate.Every(time.Minute),
+		PeerRequestBurst: 2,
+	})
+	require.NoError(t, err)
+
+	var (
+		idlePeer   = route.Vertex{1}
+		activePeer = route.Vertex{2}
+	)
+	require.True(t, negotiator.allowPeerRequest(idlePeer))
+	require.True(t, negotiator.allowPeerRequest(activePeer))
+	require.True(t, negotiator.allowPeerRequest(activePeer))
+	require.False(t, negotiator.allowPeerRequest(activePeer))
+
+	// After one minute, the limiter of the peer that sent one request is
+	// full again, the one of the peer that exhausted its burst isn't.
+	negotiator.pruneIdlePeerLimiters(time.Now().Add(time.Minute))
+
+	_, ok := negotiator.peerLimiters.Load(idlePeer)
+	require.False(t, ok)
+	_, ok = negotiator.peerLimiters.Load(activePeer)
+	require.True(t, ok)
+
+	// Once the other limiter is full as well, it is evicted too.
+	negotiator.pruneIdlePeerLimiters(time.Now().Add(2 * time.Minute))
+
+	_, ok = negotiator.peerLimiters.Load(activePeer)
+	require.False(t, ok)
+}
+
+// TestForwardFeeFeatureBit tests that the forwarding fee is only advertised to
+// peers that signal support for it.
+func TestForwardFeeFeatureBit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assetSpecifier = asset.NewSpecifierFromId(asset.ID{1, 2, 3})
+		featurePeer    = route.Vertex{1}
+		legacyPeer     = route.Vertex{2}
+		forwardFee     = rfqmsg.ForwardFee{
+			BaseFee:    5,
+			FeeRatePpm: 1_000,
+		}
+	)
+
+	// The feature peer advertises the same features as our node, the
+	// legacy peer doesn't advertise any.
+	chanNegotiator := tapfeatures.NewAuxChannelNegotiator()
+	initRecords, err := chanNegotiator.GetInitRecords(featurePeer)
+	require.NoError(t, err)
+	err = chanNegotiator.ProcessInitRecords(featurePeer, initRecords)
+	require.NoError(t, err)
+
+	outgoingMsgs := make(chan rfqmsg.OutgoingMsg, 10)
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		PriceOracle:      NewMockPriceOracle(3600, 100_000),
+		OutgoingMessages: outgoingMsgs,
+		ForwardFees: ForwardFeePolicies{{
+			AssetSpecifier: assetSpecifier,
+			Fee:            forwardFee,
+		}},
+		AuxChanNegotiator: chanNegotiator,
+		ErrChan:           make(chan error, 10),
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, negotiator.Stop())
+	}()
+
+	for _, peer := range []route.Vertex{featurePeer, legacyPeer} {
+		err := negotiator.HandleIncomingBuyRequest(rfqmsg.BuyRequest{
+			Peer:           peer,
+			ID:             rfqmsg.ID{peer[0]},
+			AssetSpecifier: assetSpecifier,
+			AssetMaxAmt:    1000,
+			AssetRateHint:  fn.None[rfqmsg.AssetRate](),
+		})
+		require.NoError(t, err)
+	}
+
+	accepts := make(map[route.Vertex]*rfqmsg.BuyAccept)
+	for range 2 {
+		select {
+		case msg := <-outgoingMsgs:
+			accept, ok := msg.(*rfqmsg.BuyAccept)
+			require.True(t, ok, "unexpected message: %T", msg)
+			accepts[accept.Peer] = accept
+
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for outgoing message")
+		}
+	}
+
+	require.Len(t, accepts, 2)
+	require.Equal(
+		t, fn.Some(forwardFee), accepts[featurePeer].ForwardFee,
+	)
+	require.True(t, accepts[legacyPeer].ForwardFee.IsNone())
+}
@@ -0,0 +1,283 @@
+package rfq
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultOracleCacheMaxEntries is the default maximum number of
+	// responses held by the price oracle cache.
+	DefaultOracleCacheMaxEntries = 10_000
+
+	// oracleCacheStatsName is the name under which the hit and miss
+	// counters of the price oracle cache are reported.
+	oracleCacheStatsName = "rfq_price_oracle"
+)
+
+// OracleCacheConfig is the configuration of the CachingPriceOracle.
+type OracleCacheConfig struct {
+	// TTL is the maximum amount of time a response is served from the
+	// cache. A response is never served after the expiry of its asset
+	// rate, even if the TTL hasn't passed yet.
+	TTL time.Duration
+
+	// MaxEntries is the maximum number of responses held by the cache. If
+	// zero, DefaultOracleCacheMaxEntries is used.
+	MaxEntries int
+
+	// Clock is the clock used to determine whether a cached response is
+	// still valid.
+	Clock clock.Clock
+}
+
+// oracleCacheKey is the key of a cached price oracle response. Only queries
+// for the exact same amounts share the same key, as the oracle may return a
+// different rate, or refuse the query, depending on the amount.
+type oracleCacheKey struct {
+	// isBuy is true for buy price queries and false for sell price
+	// queries.
+	isBuy bool
+
+	// assetSpecifier is the string representation of the queried asset
+	// specifier.
+	assetSpecifier string
+
+	// intent is the intent of the query.
+	intent PriceQueryIntent
+
+	// assetMaxAmt is the queried maximum asset amount, if set.
+	assetMaxAmt fn.Option[uint64]
+
+	// paymentMaxAmt is the queried maximum payment amount, if set.
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi]
+
+	// counterparty is the peer the price is queried for, if it is sent to
+	// the oracle.
+	counterparty route.Vertex
+
+	// metadata is the optional metadata sent to the oracle.
+	metadata string
+}
+
+// oracleCacheEntry is a cached price oracle response.
+type oracleCacheEntry struct {
+	// resp is the cached response.
+	resp OracleResponse
+
+	// validUntil is the time until which the response is served from the
+	// cache.
+	validUntil time.Time
+}
+
+// CachingPriceOracle is a price oracle that caches the responses of another
+// price oracle. Responses are keyed by the direction of the query, the asset
+// specifier, the intent and the exact queried amounts, and are served until either the configured TTL passes or the returned asset
+// rate is about to expire. Error responses are never cached.
+type CachingPriceOracle struct {
+	cfg *OracleCacheConfig
+
+	// oracle is the underlying price oracle.
+	oracle PriceOracle
+
+	// entriesMtx guards the entries map.
+	entriesMtx sync.Mutex
+
+	// entries are the cached responses.
+	entries map[oracleCacheKey]oracleCacheEntry
+
+	// hits and misses count the cache hits and misses.
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewCachingPriceOracle creates a new caching price oracle that wraps the
+// given price oracle.
+func NewCachingPriceOracle(oracle PriceOracle,
+	cfg *OracleCacheConfig) *CachingPriceOracle {
+
+	if cfg.MaxEntries == 0 {
+		cfg.MaxEntries = DefaultOracleCacheMaxEntries
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = clock.NewDefaultClock()
+	}
+
+	return &CachingPriceOracle{
+		cfg:     cfg,
+		oracle:  oracle,
+		entries: make(map[oracleCacheKey]oracleCacheEntry),
+	}
+}
+
+// newOracleCacheKey creates the cache key for a price oracle query.
+func newOracleCacheKey(isBuy bool, assetSpecifier asset.Specifier,
+	assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	counterparty fn.Option[route.Vertex], metadata string,
+	intent PriceQueryIntent) oracleCacheKey {
+
+	return oracleCacheKey{
+		isBuy:          isBuy,
+		assetSpecifier: assetSpecifier.String(),
+		intent:         intent,
+		assetMaxAmt:    assetMaxAmt,
+		paymentMaxAmt:  paymentMaxAmt,
+		counterparty:   counterparty.UnwrapOr(route.Vertex{}),
+		metadata:       metadata,
+	}
+}
+
+// lookup returns the cached response for the given key, if there is a valid
+// one.
+func (c *CachingPriceOracle) lookup(key oracleCacheKey) (*OracleResponse,
+	bool) {
+
+	c.entriesMtx.Lock()
+	defer c.entriesMtx.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !c.cfg.Clock.Now().Before(entry.validUntil) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	resp := entry.resp
+	return &resp, true
+}
+
+// store adds the given response to the cache, if it is valid long enough to
+// be served from the cache.
+func (c *CachingPriceOracle) store(key oracleCacheKey, resp *OracleResponse) {
+	now := c.cfg.Clock.Now()
+
+	// We never serve a rate that is about to expire, as the negotiator
+	// would reject it anyway.
+	validUntil := now.Add(c.cfg.TTL)
+	rateValidUntil := resp.AssetRate.Expiry.Add(
+		-minAssetRatesExpiryLifetime * time.Second,
+	)
+	if rateValidUntil.Before(validUntil) {
+		validUntil = rateValidUntil
+	}
+	if !now.Before(validUntil) {
+		return
+	}
+
+	c.entriesMtx.Lock()
+	defer c.entriesMtx.Unlock()
+
+	// If the cache is full, we first evict all stale entries. If it is
+	// still full after that, we don't cache the response.
+	if len(c.entries) >= c.cfg.MaxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.validUntil) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) >= c.cfg.MaxEntries {
+		return
+	}
+
+	c.entries[key] = oracleCacheEntry{
+		resp:       *resp,
+		validUntil: validUntil,
+	}
+}
+
+// query serves a price oracle query from the cache or, on a cache miss, from
+// the underlying price oracle.
+func (c *CachingPriceOracle) query(key oracleCacheKey,
+	queryOracle func() (*OracleResponse, error)) (*OracleResponse, error) {
+
+	if resp, ok := c.lookup(key); ok {
+		c.hits.Add(1)
+		return resp, nil
+	}
+	c.misses.Add(1)
+
+	resp, err := queryOracle()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Err == nil {
+		c.store(key, resp)
+	}
+
+	return resp, nil
+}
+
+// QuerySellPrice returns the sell price for the given asset, either from the
+// cache or from the underlying price oracle.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (c *CachingPriceOracle) QuerySellPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate],
+	counterparty fn.Option[route.Vertex], metadata string,
+	intent PriceQueryIntent) (*OracleResponse, error) {
+
+	key := newOracleCacheKey(
+		false, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+		counterparty, metadata, intent,
+	)
+
+	return c.query(key, func() (*OracleResponse, error) {
+		return c.oracle.QuerySellPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint, counterparty, metadata, intent,
+		)
+	})
+}
+
+// QueryBuyPrice returns the buy price for the given asset, either from the
+// cache or from the underlying price oracle.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (c *CachingPriceOracle) QueryBuyPrice(ctx context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	assetRateHint fn.Option[rfqmsg.AssetRate],
+	counterparty fn.Option[route.Vertex], metadata string,
+	intent PriceQueryIntent) (*OracleResponse, error) {
+
+	key := newOracleCacheKey(
+		true, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+		counterparty, metadata, intent,
+	)
+
+	return c.query(key, func() (*OracleResponse, error) {
+		return c.oracle.QueryBuyPrice(
+			ctx, assetSpecifier, assetMaxAmt, paymentMaxAmt,
+			assetRateHint, counterparty, metadata, intent,
+		)
+	})
+}
+
+// CollectCacheStats collects the hit and miss counters of the price oracle
+// cache and adds them to the provided hits and misses maps.
+func (c *CachingPriceOracle) CollectCacheStats(hits,
+	misses map[string]int64) {
+
+	hits[oracleCacheStatsName] = c.hits.Load()
+	misses[oracleCacheStatsName] = c.misses.Load()
+}
+
+// Ensure that CachingPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*CachingPriceOracle)(nil)
@@ -0,0 +1,121 @@
+package rfq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCachingPriceOracle tests that the caching price oracle serves responses
+// from the cache for queries with the same amounts and honors the TTL and
+// the expiry of the cached rates.
+func TestCachingPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	testClock := clock.NewTestClock(now)
+
+	var (
+		rateExpiry = now.Add(2 * time.Minute)
+		assetRate  = rfqmsg.NewAssetRate(
+			rfqmath.NewBigIntFixedPoint(100_000, 0), rateExpiry,
+		)
+		specifier = asset.NewSpecifierFromId(asset.ID{1})
+	)
+
+	anyArgs := []interface{}{
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	}
+	oracle := &MockPriceOracle{}
+	oracle.On("QueryBuyPrice", anyArgs...).Return(
+		&OracleResponse{AssetRate: assetRate}, nil,
+	)
+	oracle.On("QuerySellPrice", anyArgs...).Return(
+		&OracleResponse{
+			Err: &OracleError{Msg: "unsupported asset"},
+		}, nil,
+	)
+
+	cache := NewCachingPriceOracle(oracle, &OracleCacheConfig{
+		TTL:   time.Minute,
+		Clock: testClock,
+	})
+
+	queryBuy := func(assetAmt uint64) {
+		resp, err := cache.QueryBuyPrice(
+			ctx, specifier, fn.Some(assetAmt),
+			fn.None[lnwire.MilliSatoshi](),
+			fn.None[rfqmsg.AssetRate](), fn.None[route.Vertex](),
+			"", IntentRecvPayment,
+		)
+		require.NoError(t, err)
+		require.Nil(t, resp.Err)
+		require.True(t, assetRate.Rate.Equals(resp.AssetRate.Rate))
+	}
+	assertStats := func(expectedHits, expectedMisses int64,
+		expectedCalls int) {
+
+		hits := make(map[string]int64)
+		misses := make(map[string]int64)
+		cache.CollectCacheStats(hits, misses)
+
+		require.Equal(t, expectedHits, hits[oracleCacheStatsName])
+		require.Equal(t, expectedMisses, misses[oracleCacheStatsName])
+		oracle.AssertNumberOfCalls(t, "QueryBuyPrice", expectedCalls)
+	}
+
+	// The first query is a miss, the second query with the same amount is
+	// a hit.
+	queryBuy(1000)
+	queryBuy(1000)
+	assertStats(1, 1, 1)
+
+	// Even a slightly different amount is a miss, as the oracle might
+	// not accept it at the same rate.
+	queryBuy(1001)
+	assertStats(1, 2, 2)
+
+	// Error responses are never cached.
+	for i := 0; i < 2; i++ {
+		resp, err := cache.QuerySellPrice(
+			ctx, specifier, fn.Some[uint64](1000),
+			fn.None[lnwire.MilliSatoshi](),
+			fn.None[rfqmsg.AssetRate](), fn.None[route.Vertex](),
+			"", IntentPayInvoice,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Err)
+	}
+	oracle.AssertNumberOfCalls(t, "QuerySellPrice", 2)
+	assertStats(1, 4, 2)
+
+	// Once the TTL has passed, the response is fetched again. The new
+	// response is only cached until shortly before the rate expires.
+	testClock.SetTime(now.Add(time.Minute))
+	queryBuy(1000)
+	assertStats(1, 5, 3)
+
+	testClock.SetTime(rateExpiry.Add(
+		-minAssetRatesExpiryLifetime*time.Second - time.Second,
+	))
+	queryBuy(1000)
+	assertStats(2, 5, 3)
+
+	testClock.SetTime(rateExpiry.Add(
+		-minAssetRatesExpiryLifetime * time.Second,
+	))
+	queryBuy(1000)
+	assertStats(2, 6, 4)
+}
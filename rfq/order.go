@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -119,6 +120,12 @@ type AssetSalePolicy struct {
 	// AskAssetRate is the quote's asking asset unit to BTC conversion rate.
 	AskAssetRate rfqmath.BigIntFixedPoint
 
+	// ForwardFee is the fee in asset units that we charge for each HTLC
+	// that we pay under the quote, if any. The fee is deducted from the
+	// asset amount we send and was advertised to our peer in the buy
+	// accept message.
+	ForwardFee fn.Option[rfqmsg.ForwardFee]
+
 	// htlcToAmt maps the unique HTLC identifiers to the effective amount
 	// that they carry.
 	htlcToAmt map[models.CircuitKey]lnwire.MilliSatoshi
@@ -150,6 +157,7 @@ func NewAssetSalePolicy(quote rfqmsg.BuyAccept, noop bool,
 		AcceptedQuoteId:        quote.ID,
 		MaxOutboundAssetAmount: quote.Request.AssetMaxAmt,
 		AskAssetRate:           quote.AssetRate.Rate,
+		ForwardFee:             quote.ForwardFee,
 		expiry:                 uint64(quote.AssetRate.Expiry.Unix()),
 		htlcToAmt:              htlcToAmtMap,
 		NoOpHTLCs:              noop,
@@ -194,9 +202,15 @@ func (c *AssetSalePolicy) CheckHtlcCompliance(_ context.Context,
 	// Convert the maximum asset amount to msat using the asset-to-BTC rate
 	// from the quote, and ensure it is less than the HTLC outbound amount
 	// (msat).
-	maxAssetAmount := rfqmath.NewBigIntFixedPoint(
-		c.MaxOutboundAssetAmount, 0,
-	)
+	//
+	// If we charge a forwarding fee, the maximum applies to the assets we
+	// send after deducting the fee. So the HTLCs may carry the maximum
+	// plus the fee.
+	maxOutAssetAmt, err := c.maxGrossAssetAmount()
+	if err != nil {
+		return err
+	}
+	maxAssetAmount := rfqmath.NewBigIntFixedPoint(maxOutAssetAmt, 0)
 	policyMaxOutMsat := rfqmath.UnitsToMilliSatoshi(
 		maxAssetAmount, c.AskAssetRate,
 	)
@@ -207,6 +221,20 @@ func (c *AssetSalePolicy) CheckHtlcCompliance(_ context.Context,
 			htlc.AmountOutMsat, policyMaxOutMsat)
 	}
 
+	// If we charge a forwarding fee, we deduct it from the asset amount
+	// that corresponds to the outgoing amount. So that amount needs to
+	// cover at least the fee.
+	outAssetAmt := c.outgoingAssetAmount(htlc)
+	fee, err := c.forwardFee(htlc)
+	if err != nil {
+		return err
+	}
+	if outAssetAmt < fee {
+		return fmt.Errorf("HTLC asset amount is less than the "+
+			"forwarding fee (htlc_asset_amount=%d, "+
+			"forward_fee=%d)", outAssetAmt, fee)
+	}
+
 	// Lastly, check to ensure that the policy has not expired.
 	if time.Now().Unix() > int64(c.expiry) {
 		return fmt.Errorf("policy has expired (expiry_unix_ts=%d)",
@@ -289,11 +317,19 @@ func (c *AssetSalePolicy) GenerateInterceptorResponse(
 	}
 
 	// Compute the outgoing asset amount given the msat outgoing amount and
-	// the asset to BTC rate.
-	outgoingAssetAmount := rfqmath.MilliSatoshiToUnits(
-		htlc.AmountOutMsat, c.AskAssetRate,
-	)
-	amt := outgoingAssetAmount.ScaleTo(0).ToUint64()
+	// the asset to BTC rate. The forwarding fee we charge stays with us,
+	// so we deduct it from the assets we send. The compliance check
+	// already made sure the amount covers the fee.
+	amt := c.outgoingAssetAmount(htlc)
+	fee, err := c.forwardFee(htlc)
+	if err != nil {
+		return nil, err
+	}
+	if amt < fee {
+		return nil, fmt.Errorf("HTLC asset amount %d is less than "+
+			"the forwarding fee %d", amt, fee)
+	}
+	amt -= fee
 
 	// Include the asset balance in the HTLC record.
 	htlcBalance := rfqmsg.NewAssetBalance(assetID, amt)
@@ -325,6 +361,52 @@ func (c *AssetSalePolicy) GenerateInterceptorResponse(
 	}, nil
 }
 
+// maxGrossAssetAmount returns the maximum outbound asset amount of the policy
+// plus the forwarding fee we charge for it.
+func (c *AssetSalePolicy) maxGrossAssetAmount() (uint64, error) {
+	grossAmt := c.MaxOutboundAssetAmount
+	err := fn.MapOptionZ(c.ForwardFee, func(fee rfqmsg.ForwardFee) error {
+		var err error
+		grossAmt, err = fee.GrossAmount(c.MaxOutboundAssetAmount)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to add forwarding fee to policy "+
+			"maximum: %w", err)
+	}
+
+	return grossAmt, nil
+}
+
+// outgoingAssetAmount returns the asset amount that corresponds to the
+// outgoing amount of the given HTLC.
+func (c *AssetSalePolicy) outgoingAssetAmount(
+	htlc lndclient.InterceptedHtlc) uint64 {
+
+	outAssetAmt := rfqmath.MilliSatoshiToUnits(
+		htlc.AmountOutMsat, c.AskAssetRate,
+	)
+
+	return outAssetAmt.ScaleTo(0).ToUint64()
+}
+
+// forwardFee returns the forwarding fee in asset units that we charge for the
+// given HTLC. The fee is computed on the asset amount that corresponds to the
+// outgoing amount of the HTLC. An error is returned if the fee overflows.
+func (c *AssetSalePolicy) forwardFee(
+	htlc lndclient.InterceptedHtlc) (uint64, error) {
+
+	var (
+		feeAmt uint64
+		err    error
+	)
+	c.ForwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		feeAmt, err = fee.FeeForAmount(c.outgoingAssetAmount(htlc))
+	})
+
+	return feeAmt, err
+}
+
 // Ensure that AssetSalePolicy implements the Policy interface.
 var _ Policy = (*AssetSalePolicy)(nil)
 
@@ -360,6 +442,11 @@ type AssetPurchasePolicy struct {
 	// PaymentMaxAmt is the maximum agreed BTC payment.
 	PaymentMaxAmt lnwire.MilliSatoshi
 
+	// ForwardFee is the fee in asset units that we charge for each HTLC
+	// that is paid to us under the quote, if any. The fee was advertised
+	// to our peer in the sell accept message.
+	ForwardFee fn.Option[rfqmsg.ForwardFee]
+
 	// htlcToAmt maps the unique HTLC identifiers to the effective amount
 	// that they carry.
 	htlcToAmt map[models.CircuitKey]lnwire.MilliSatoshi
@@ -379,6 +466,7 @@ func NewAssetPurchasePolicy(quote rfqmsg.SellAccept) *AssetPurchasePolicy {
 		AcceptedQuoteId: quote.ID,
 		BidAssetRate:    quote.AssetRate.Rate,
 		PaymentMaxAmt:   quote.Request.PaymentMaxAmt,
+		ForwardFee:      quote.ForwardFee,
 		expiry:          uint64(quote.AssetRate.Expiry.Unix()),
 		htlcToAmt:       htlcToAmtMap,
 	}
@@ -421,6 +509,21 @@ func (c *AssetPurchasePolicy) CheckHtlcCompliance(ctx context.Context,
 		return fmt.Errorf("error summing asset balance: %w", err)
 	}
 
+	// If we charge a forwarding fee, the HTLC must carry the fee on top of
+	// the asset amount that corresponds to the outgoing amount. We keep the
+	// fee and only account for the remaining assets below.
+	feeAmt, err := c.forwardFee(htlc)
+	if err != nil {
+		return err
+	}
+	fee := rfqmath.NewBigIntFromUint64(feeAmt)
+	if !assetAmt.Gte(fee) {
+		return fmt.Errorf("HTLC asset amount is less than the "+
+			"forwarding fee (htlc_asset_amount=%s, "+
+			"forward_fee=%s)", assetAmt.String(), fee.String())
+	}
+	assetAmt = assetAmt.Sub(fee)
+
 	// Due to rounding errors, we may slightly underreport the incoming
 	// value of the asset. So we increase it by exactly one asset unit to
 	// ensure that we do not reject the HTLC in the "inbound amount cannot
@@ -526,6 +629,19 @@ func (c *AssetPurchasePolicy) GenerateInterceptorResponse(
 	const roundingCorrection = 1
 	htlcAssetAmount := htlcRecord.Amounts.Val.Sum() + roundingCorrection
 
+	// The forwarding fee we charge stays with us, so it doesn't count
+	// towards the amount that lnd forwards. The compliance check already
+	// made sure the HTLC carries at least the fee.
+	fee, err := c.forwardFee(htlc)
+	if err != nil {
+		return nil, err
+	}
+	if htlcAssetAmount < fee {
+		return nil, fmt.Errorf("HTLC asset amount %d is less than "+
+			"the forwarding fee %d", htlcAssetAmount, fee)
+	}
+	htlcAssetAmount -= fee
+
 	assetAmt := rfqmath.NewBigIntFixedPoint(htlcAssetAmount, 0)
 	incomingHtlcMsats := rfqmath.UnitsToMilliSatoshi(
 		assetAmt, c.BidAssetRate,
@@ -537,6 +653,80 @@ func (c *AssetPurchasePolicy) GenerateInterceptorResponse(
 	}, nil
 }
 
+// forwardFee returns the forwarding fee in asset units that we charge for the
+// given HTLC. The fee is computed on the asset amount that corresponds to the
+// outgoing amount of the HTLC. An error is returned if the fee overflows.
+func (c *AssetPurchasePolicy) forwardFee(
+	htlc lndclient.InterceptedHtlc) (uint64, error) {
+
+	var (
+		feeAmt uint64
+		err    error
+	)
+	c.ForwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		outAssetAmt := rfqmath.MilliSatoshiToUnits(
+			htlc.AmountOutMsat, c.BidAssetRate,
+		)
+
+		feeAmt, err = fee.FeeForAmount(
+			outAssetAmt.ScaleTo(0).ToUint64(),
+		)
+	})
+
+	return feeAmt, err
+}
+
+// forwardFeesOf returns the forwarding fees in asset units that we charge for
+// the HTLC under the given policy. An asset-to-asset forward carries a fee for
+// both the purchase and the sale side. Fees that are zero aren't returned.
+func forwardFeesOf(policy Policy,
+	htlc lndclient.InterceptedHtlc) ([]pendingForwardFee, error) {
+
+	var (
+		purchasePolicy *AssetPurchasePolicy
+		salePolicy     *AssetSalePolicy
+	)
+	switch p := policy.(type) {
+	case *AssetPurchasePolicy:
+		purchasePolicy = p
+
+	case *AssetSalePolicy:
+		salePolicy = p
+
+	case *AssetForwardPolicy:
+		purchasePolicy = p.incomingPolicy
+		salePolicy = p.outgoingPolicy
+	}
+
+	var fees []pendingForwardFee
+	if purchasePolicy != nil {
+		fee, err := purchasePolicy.forwardFee(htlc)
+		if err != nil {
+			return nil, err
+		}
+		if fee > 0 {
+			fees = append(fees, pendingForwardFee{
+				specifier: purchasePolicy.AssetSpecifier,
+				fee:       fee,
+			})
+		}
+	}
+	if salePolicy != nil {
+		fee, err := salePolicy.forwardFee(htlc)
+		if err != nil {
+			return nil, err
+		}
+		if fee > 0 {
+			fees = append(fees, pendingForwardFee{
+				specifier: salePolicy.AssetSpecifier,
+				fee:       fee,
+			})
+		}
+	}
+
+	return fees, nil
+}
+
 // Ensure that AssetPurchasePolicy implements the Policy interface.
 var _ Policy = (*AssetPurchasePolicy)(nil)
 
@@ -707,6 +897,10 @@ type OrderHandlerCfg struct {
 	// that is encapsulated in the init and reestablish peer messages. This
 	// helps us communicate custom feature bits with our peer.
 	AuxChanNegotiator *tapfeatures.AuxChannelNegotiator
+
+	// ForwardFeeStore is the persistent store for the forwarding fees we
+	// collected. If this is nil, collected fees are only held in memory.
+	ForwardFeeStore ForwardFeeStore
 }
 
 // OrderHandler orchestrates management of accepted quote bundles. It monitors
@@ -728,6 +922,18 @@ type OrderHandler struct {
 	// data available, so we need to cache this info.
 	htlcToPolicy lnutils.SyncMap[models.CircuitKey, Policy]
 
+	// htlcsIntercepted is the number of HTLCs that were passed to the
+	// order handler by the HTLC interceptor.
+	htlcsIntercepted atomic.Uint64
+
+	// htlcsRejected is the number of intercepted HTLCs that were failed by
+	// the order handler.
+	htlcsRejected atomic.Uint64
+
+	// forwardFees keeps track of the forwarding fees we charge for the
+	// accepted HTLCs.
+	forwardFees *forwardFeeLedger
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -736,8 +942,9 @@ type OrderHandler struct {
 // NewOrderHandler creates a new struct instance.
 func NewOrderHandler(cfg OrderHandlerCfg) (*OrderHandler, error) {
 	return &OrderHandler{
-		cfg:      cfg,
-		policies: lnutils.SyncMap[SerialisedScid, Policy]{},
+		cfg:         cfg,
+		policies:    lnutils.SyncMap[SerialisedScid, Policy]{},
+		forwardFees: newForwardFeeLedger(),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -759,6 +966,8 @@ func (h *OrderHandler) handleIncomingHtlc(ctx context.Context,
 		htlc.OutgoingChannelID.ToUint64(), htlc.AmountInMsat,
 		htlc.AmountOutMsat)
 
+	h.htlcsIntercepted.Add(1)
+
 	// Look up a policy for the HTLC. If a policy does not exist, we resume
 	// the HTLC. This is because the HTLC may be relevant to another
 	// interceptor service. We only reject HTLCs that are relevant to the
@@ -777,6 +986,8 @@ func (h *OrderHandler) handleIncomingHtlc(ctx context.Context,
 		// some outgoing sats amount that we would eventually never
 		// receive. The HTLC must be failed.
 		if noopActive {
+			h.htlcsRejected.Add(1)
+
 			return &lndclient.InterceptedHtlcResponse{
 				Action: lndclient.InterceptorActionFail,
 			}, nil
@@ -800,6 +1011,23 @@ func (h *OrderHandler) handleIncomingHtlc(ctx context.Context,
 		log.Warnf("HTLC does not comply with policy: %v "+
 			"(HTLC=%v, policy=%v)", err, htlc, policy)
 
+		h.htlcsRejected.Add(1)
+
+		return &lndclient.InterceptedHtlcResponse{
+			Action: lndclient.InterceptorActionFail,
+		}, nil
+	}
+
+	// The compliance checks already made sure the forwarding fees of the
+	// HTLC can be computed, but we still don't want to accept an HTLC we
+	// can't account the fees of.
+	forwardFees, err := forwardFeesOf(policy, htlc)
+	if err != nil {
+		log.Warnf("Unable to compute forward fees of HTLC: %v "+
+			"(HTLC=%v, policy=%v)", err, htlc, policy)
+
+		h.htlcsRejected.Add(1)
+
 		return &lndclient.InterceptedHtlcResponse{
 			Action: lndclient.InterceptorActionFail,
 		}, nil
@@ -811,6 +1039,15 @@ func (h *OrderHandler) handleIncomingHtlc(ctx context.Context,
 	// accepted HTLC.
 	policy.TrackAcceptedHtlc(htlc.IncomingCircuitKey, htlc.AmountOutMsat)
 
+	// If we charge forwarding fees for the HTLC, we remember them until
+	// the HTLC is either settled or failed.
+	for _, fee := range forwardFees {
+		h.forwardFees.addPending(
+			htlc.IncomingCircuitKey, fee.specifier, fee.fee,
+			time.Now(),
+		)
+	}
+
 	log.Debug("HTLC complies with policy. Broadcasting accept event.")
 	h.cfg.AcceptHtlcEvents <- NewAcceptHtlcEvent(htlc, policy)
 
@@ -849,6 +1086,7 @@ func (h *OrderHandler) mainEventLoop() {
 			log.Debug("Cleaning up any stale policy from the " +
 				"order handler")
 			h.cleanupStalePolicies()
+			h.cleanupStaleForwardFees()
 
 		case <-h.Quit:
 			log.Debug("Received quit signal. Stopping negotiator " +
@@ -875,9 +1113,10 @@ func (h *OrderHandler) subscribeHtlcs(ctx context.Context) error {
 				continue
 			}
 
-			// Retrieve the two instances that may be relevant.
+			// Retrieve the instances that may be relevant.
 			failEvent := event.GetForwardFailEvent()
 			linkFail := event.GetLinkFailEvent()
+			settleEvent := event.GetSettleEvent()
 
 			// Craft the circuit key that identifies this HTLC.
 			circuitKey := models.CircuitKey{
@@ -888,9 +1127,19 @@ func (h *OrderHandler) subscribeHtlcs(ctx context.Context) error {
 			}
 
 			switch {
+			case settleEvent != nil:
+				// The HTLC was settled, so we collected the
+				// forwarding fee we charged for it.
+				settled := h.forwardFees.settle(circuitKey)
+				h.storeForwardFees(ctx, settled)
+
 			case failEvent != nil:
 				fallthrough
 			case linkFail != nil:
+				// We didn't collect any forwarding fee for a
+				// failed HTLC.
+				h.forwardFees.fail(circuitKey)
+
 				// Fetch the policy that is related to this
 				// HTLC.
 				policy, found := h.htlcToPolicy.LoadAndDelete(
@@ -918,6 +1167,13 @@ func (h *OrderHandler) subscribeHtlcs(ctx context.Context) error {
 func (h *OrderHandler) Start() error {
 	var startErr error
 	h.startOnce.Do(func() {
+		// Restore the forwarding fees we collected before the last
+		// restart.
+		startErr = h.restoreForwardFees()
+		if startErr != nil {
+			return
+		}
+
 		// Start the main event loop in a separate goroutine.
 		h.Wg.Add(1)
 		go func() {
@@ -1127,6 +1383,89 @@ func (h *OrderHandler) cleanupStalePolicies() {
 	}
 }
 
+// cleanupStaleForwardFees removes the fees of HTLCs that were accepted so long
+// ago that they must have been resolved without us receiving the event.
+func (h *OrderHandler) cleanupStaleForwardFees() {
+	numPruned := h.forwardFees.prunePending(
+		time.Now().Add(-maxPendingForwardFeeAge),
+	)
+
+	if numPruned > 0 {
+		log.Debugf("Removed stale pending forward fees from the order "+
+			"handler: (count=%d)", numPruned)
+	}
+}
+
+// restoreForwardFees loads the forwarding fees we collected from the forward
+// fee store, if one is configured.
+func (h *OrderHandler) restoreForwardFees() error {
+	if h.cfg.ForwardFeeStore == nil {
+		return nil
+	}
+
+	ctx, cancel := h.WithCtxQuit()
+	defer cancel()
+
+	fees, err := h.cfg.ForwardFeeStore.FetchCollectedForwardFees(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching collected forward fees: %w",
+			err)
+	}
+
+	h.forwardFees.restore(fees)
+
+	return nil
+}
+
+// storeForwardFees persists the given fees of a settled HTLC, if a forward fee
+// store is configured. The fees are already accounted for in memory, so a
+// failure is only logged.
+func (h *OrderHandler) storeForwardFees(ctx context.Context,
+	fees []pendingForwardFee) {
+
+	if h.cfg.ForwardFeeStore == nil {
+		return
+	}
+
+	for _, fee := range fees {
+		err := h.cfg.ForwardFeeStore.AddCollectedForwardFee(
+			ctx, fee.specifier, fee.fee,
+		)
+		if err != nil {
+			log.Errorf("Unable to store collected forward fee of "+
+				"%d for %s: %v", fee.fee,
+				fee.specifier.String(), err)
+		}
+	}
+}
+
+// NumActivePolicies returns the number of registered policies that haven't
+// expired yet.
+func (h *OrderHandler) NumActivePolicies() int {
+	var numActive int
+	h.policies.ForEach(func(_ SerialisedScid, policy Policy) error {
+		if !policy.HasExpired() {
+			numActive++
+		}
+
+		return nil
+	})
+
+	return numActive
+}
+
+// HtlcStats returns the number of intercepted HTLCs and the number of
+// intercepted HTLCs that were rejected.
+func (h *OrderHandler) HtlcStats() (uint64, uint64) {
+	return h.htlcsIntercepted.Load(), h.htlcsRejected.Load()
+}
+
+// CollectedForwardFees returns the forwarding fees that were collected per
+// asset for settled HTLCs.
+func (h *OrderHandler) CollectedForwardFees() []CollectedForwardFees {
+	return h.forwardFees.collectedFees()
+}
+
 // Stop stops the handler.
 func (h *OrderHandler) Stop() error {
 	h.stopOnce.Do(func() {
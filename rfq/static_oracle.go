@@ -0,0 +1,533 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultStaticRateExpiry is the default lifetime of an asset rate
+	// returned by the static price oracle.
+	DefaultStaticRateExpiry = 10 * time.Minute
+)
+
+// StaticOracleRate is a fixed buy and sell rate for a single asset, as served
+// by the StaticPriceOracle.
+type StaticOracleRate struct {
+	// AssetSpecifier is the asset ID or group key of the asset the rates
+	// apply to.
+	AssetSpecifier asset.Specifier
+
+	// BuyRate is the asset units per BTC rate returned for buy (purchase)
+	// queries.
+	BuyRate rfqmath.BigIntFixedPoint
+
+	// SellRate is the asset units per BTC rate returned for sell (sale)
+	// queries.
+	SellRate rfqmath.BigIntFixedPoint
+
+	// Expiry is the lifetime of a rate returned by the oracle.
+	Expiry time.Duration
+
+	// MaxAssetAmt is the maximum asset amount a rate is returned for. If
+	// zero, the asset amount isn't limited.
+	MaxAssetAmt uint64
+
+	// MaxPaymentAmt is the maximum payment amount a rate is returned for.
+	// If zero, the payment amount isn't limited.
+	MaxPaymentAmt lnwire.MilliSatoshi
+}
+
+// StaticOracleConfig is the configuration of the StaticPriceOracle.
+type StaticOracleConfig struct {
+	// RatesFile is the optional path to a JSON file that contains the
+	// rates served by the oracle. The file is re-read on Reload.
+	RatesFile string
+
+	// Rates is a list of rates that are configured directly. Rates read
+	// from the RatesFile take precedence over these.
+	Rates []StaticOracleRate
+
+	// ReloadOnSighup indicates that the rates file should be reloaded
+	// when the process receives a SIGHUP signal.
+	ReloadOnSighup bool
+}
+
+// StaticPriceOracle is a price oracle that serves fixed asset rates from the
+// tapd configuration or a rates file. This is useful for simple fixed-rate
+// setups (e.g. stablecoins) that don't want to operate a separate price oracle
+// service.
+type StaticPriceOracle struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *StaticOracleConfig
+
+	// ratesMtx guards the rate maps below.
+	ratesMtx sync.RWMutex
+
+	// idRates are the rates keyed by asset ID.
+	idRates map[asset.ID]*StaticOracleRate
+
+	// groupRates are the rates keyed by serialized group key.
+	groupRates map[asset.SerializedKey]*StaticOracleRate
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewStaticPriceOracle creates a new static price oracle and loads its
+// initial set of rates.
+func NewStaticPriceOracle(cfg *StaticOracleConfig) (*StaticPriceOracle,
+	error) {
+
+	s := &StaticPriceOracle{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Start starts the oracle's SIGHUP listener, if enabled.
+func (s *StaticPriceOracle) Start() error {
+	s.startOnce.Do(func() {
+		if !s.cfg.ReloadOnSighup {
+			return
+		}
+
+		log.Info("Starting static price oracle SIGHUP listener")
+
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+
+		s.Wg.Add(1)
+		go func() {
+			defer s.Wg.Done()
+			defer signal.Stop(sighup)
+
+			for {
+				select {
+				case <-sighup:
+					log.Info("Received SIGHUP, reloading " +
+						"static price oracle rates")
+
+					// We keep serving the previous rates if
+					// the new ones can't be loaded.
+					if err := s.Reload(); err != nil {
+						log.Errorf("Unable to reload "+
+							"static price oracle "+
+							"rates: %v", err)
+					}
+
+				case <-s.Quit:
+					return
+				}
+			}
+		}()
+	})
+
+	return nil
+}
+
+// Stop stops the oracle's SIGHUP listener.
+func (s *StaticPriceOracle) Stop() error {
+	s.stopOnce.Do(func() {
+		close(s.Quit)
+		s.Wg.Wait()
+	})
+
+	return nil
+}
+
+// Reload (re-)reads the rates file and replaces the rates served by the
+// oracle. If the rates can't be loaded, the previous rates are kept and an
+// error is returned.
+func (s *StaticPriceOracle) Reload() error {
+	rates := make([]StaticOracleRate, 0, len(s.cfg.Rates))
+	rates = append(rates, s.cfg.Rates...)
+
+	if s.cfg.RatesFile != "" {
+		fileRates, err := ReadStaticOracleRatesFile(s.cfg.RatesFile)
+		if err != nil {
+			return err
+		}
+
+		rates = append(rates, fileRates...)
+	}
+
+	idRates := make(map[asset.ID]*StaticOracleRate)
+	groupRates := make(map[asset.SerializedKey]*StaticOracleRate)
+	for idx := range rates {
+		rate := &rates[idx]
+
+		// Later rates (i.e. the ones from the file) override earlier
+		// ones for the same asset.
+		switch {
+		case rate.AssetSpecifier.HasId():
+			rate.AssetSpecifier.WhenId(func(id asset.ID) {
+				idRates[id] = rate
+			})
+
+		case rate.AssetSpecifier.HasGroupPubKey():
+			rate.AssetSpecifier.WhenGroupPubKey(
+				func(key btcec.PublicKey) {
+					groupKey := asset.ToSerialized(&key)
+					groupRates[groupKey] = rate
+				},
+			)
+
+		default:
+			return fmt.Errorf("static rate %d has no asset ID or "+
+				"group key", idx)
+		}
+	}
+
+	s.ratesMtx.Lock()
+	s.idRates = idRates
+	s.groupRates = groupRates
+	s.ratesMtx.Unlock()
+
+	log.Infof("Loaded %d static price oracle rate(s)", len(rates))
+
+	return nil
+}
+
+// lookupRate returns the rate for the given asset specifier. A rate for the
+// asset ID takes precedence over a rate for the group key.
+func (s *StaticPriceOracle) lookupRate(
+	assetSpecifier asset.Specifier) (*StaticOracleRate, bool) {
+
+	s.ratesMtx.RLock()
+	defer s.ratesMtx.RUnlock()
+
+	if id, err := assetSpecifier.UnwrapIdOrErr(); err == nil {
+		if rate, ok := s.idRates[id]; ok {
+			return rate, true
+		}
+	}
+
+	groupKey, err := assetSpecifier.UnwrapGroupKeyOrErr()
+	if err == nil {
+		rate, ok := s.groupRates[asset.ToSerialized(groupKey)]
+		return rate, ok
+	}
+
+	return nil, false
+}
+
+// queryPrice returns the buy or sell rate for the given asset, if the
+// requested amounts are within the configured limits.
+func (s *StaticPriceOracle) queryPrice(assetSpecifier asset.Specifier,
+	assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	isBuy bool) (*OracleResponse, error) {
+
+	rate, ok := s.lookupRate(assetSpecifier)
+	if !ok {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("no static rate for asset %s",
+					&assetSpecifier),
+			},
+		}, nil
+	}
+
+	assetAmt := assetMaxAmt.UnwrapOr(0)
+	if rate.MaxAssetAmt != 0 && assetAmt > rate.MaxAssetAmt {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("asset amount %d exceeds "+
+					"maximum of %d", assetAmt,
+					rate.MaxAssetAmt),
+			},
+		}, nil
+	}
+
+	paymentAmt := paymentMaxAmt.UnwrapOr(0)
+	if rate.MaxPaymentAmt != 0 && paymentAmt > rate.MaxPaymentAmt {
+		return &OracleResponse{
+			Err: &OracleError{
+				Msg: fmt.Sprintf("payment amount %v exceeds "+
+					"maximum of %v", paymentAmt,
+					rate.MaxPaymentAmt),
+			},
+		}, nil
+	}
+
+	assetRate := rate.SellRate
+	if isBuy {
+		assetRate = rate.BuyRate
+	}
+
+	expiry := time.Now().Add(rate.Expiry).UTC()
+
+	return &OracleResponse{
+		AssetRate: rfqmsg.NewAssetRate(assetRate, expiry),
+	}, nil
+}
+
+// QuerySellPrice returns the configured sell price for the given asset.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (s *StaticPriceOracle) QuerySellPrice(_ context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate], _ fn.Option[route.Vertex], _ string,
+	_ PriceQueryIntent) (*OracleResponse, error) {
+
+	return s.queryPrice(assetSpecifier, assetMaxAmt, paymentMaxAmt, false)
+}
+
+// QueryBuyPrice returns the configured buy price for the given asset.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (s *StaticPriceOracle) QueryBuyPrice(_ context.Context,
+	assetSpecifier asset.Specifier, assetMaxAmt fn.Option[uint64],
+	paymentMaxAmt fn.Option[lnwire.MilliSatoshi],
+	_ fn.Option[rfqmsg.AssetRate], _ fn.Option[route.Vertex], _ string,
+	_ PriceQueryIntent) (*OracleResponse, error) {
+
+	return s.queryPrice(assetSpecifier, assetMaxAmt, paymentMaxAmt, true)
+}
+
+// Ensure that StaticPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*StaticPriceOracle)(nil)
+
+// staticRatesFile is the JSON encoding of a static price oracle rates file.
+type staticRatesFile struct {
+	Rates []staticRateEntry `json:"rates"`
+}
+
+// staticRateEntry is the JSON encoding of a single static rate.
+type staticRateEntry struct {
+	AssetID        string `json:"asset_id"`
+	GroupKey       string `json:"group_key"`
+	BuyRate        string `json:"buy_rate"`
+	SellRate       string `json:"sell_rate"`
+	ExpirySeconds  uint64 `json:"expiry_seconds"`
+	MaxAssetAmount uint64 `json:"max_asset_amount"`
+	MaxPaymentMsat uint64 `json:"max_payment_msat"`
+}
+
+// ReadStaticOracleRatesFile reads and parses a static price oracle rates file.
+// The file is a JSON document of the following form:
+//
+//	{
+//	  "rates": [{
+//	    "asset_id": "<hex asset ID>",
+//	    "group_key": "<hex group key, alternative to asset_id>",
+//	    "buy_rate": "<asset units per BTC, e.g. 9500000.25>",
+//	    "sell_rate": "<asset units per BTC>",
+//	    "expiry_seconds": 600,
+//	    "max_asset_amount": 0,
+//	    "max_payment_msat": 0
+//	  }]
+//	}
+func ReadStaticOracleRatesFile(path string) ([]StaticOracleRate, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read static rates file: %w",
+			err)
+	}
+
+	var file staticRatesFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unable to decode static rates file: "+
+			"%w", err)
+	}
+
+	rates := make([]StaticOracleRate, 0, len(file.Rates))
+	for idx, entry := range file.Rates {
+		specifier, err := parseStaticRateSpecifier(
+			entry.AssetID, entry.GroupKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("rate %d: %w", idx, err)
+		}
+
+		buyRate, err := ParseFixedPointRate(entry.BuyRate)
+		if err != nil {
+			return nil, fmt.Errorf("rate %d: invalid buy rate: %w",
+				idx, err)
+		}
+
+		sellRate, err := ParseFixedPointRate(entry.SellRate)
+		if err != nil {
+			return nil, fmt.Errorf("rate %d: invalid sell rate: %w",
+				idx, err)
+		}
+
+		expiry := DefaultStaticRateExpiry
+		if entry.ExpirySeconds != 0 {
+			expiry = time.Duration(entry.ExpirySeconds) *
+				time.Second
+		}
+
+		rates = append(rates, StaticOracleRate{
+			AssetSpecifier: specifier,
+			BuyRate:        buyRate,
+			SellRate:       sellRate,
+			Expiry:         expiry,
+			MaxAssetAmt:    entry.MaxAssetAmount,
+			MaxPaymentAmt: lnwire.MilliSatoshi(
+				entry.MaxPaymentMsat,
+			),
+		})
+	}
+
+	return rates, nil
+}
+
+// ParseStaticOracleRate parses a static rate from its config representation,
+// which is <asset_id|group_key>:<buy_rate>:<sell_rate>. The key is
+// interpreted as a group key if it is 33 bytes long, and as an asset ID
+// otherwise. The rates are decimal asset units per BTC.
+func ParseStaticOracleRate(rateStr string,
+	expiry time.Duration) (StaticOracleRate, error) {
+
+	parts := strings.Split(rateStr, ":")
+	if len(parts) != 3 {
+		return StaticOracleRate{}, fmt.Errorf("invalid static rate "+
+			"%q, expected <asset_id|group_key>:<buy_rate>:"+
+			"<sell_rate>", rateStr)
+	}
+
+	var assetID, groupKey string
+	if len(parts[0]) == btcec.PubKeyBytesLenCompressed*2 {
+		groupKey = parts[0]
+	} else {
+		assetID = parts[0]
+	}
+
+	specifier, err := parseStaticRateSpecifier(assetID, groupKey)
+	if err != nil {
+		return StaticOracleRate{}, err
+	}
+
+	buyRate, err := ParseFixedPointRate(parts[1])
+	if err != nil {
+		return StaticOracleRate{}, fmt.Errorf("invalid buy rate: %w",
+			err)
+	}
+
+	sellRate, err := ParseFixedPointRate(parts[2])
+	if err != nil {
+		return StaticOracleRate{}, fmt.Errorf("invalid sell rate: %w",
+			err)
+	}
+
+	if expiry == 0 {
+		expiry = DefaultStaticRateExpiry
+	}
+
+	return StaticOracleRate{
+		AssetSpecifier: specifier,
+		BuyRate:        buyRate,
+		SellRate:       sellRate,
+		Expiry:         expiry,
+	}, nil
+}
+
+// parseStaticRateSpecifier parses the hex encoded asset ID or group key of a
+// static rate into an asset specifier. Exactly one of them must be set.
+func parseStaticRateSpecifier(assetID,
+	groupKey string) (asset.Specifier, error) {
+
+	switch {
+	case assetID != "" && groupKey != "":
+		return asset.Specifier{}, fmt.Errorf("only one of asset ID " +
+			"or group key can be set")
+
+	case assetID != "":
+		idBytes, err := hex.DecodeString(assetID)
+		if err != nil {
+			return asset.Specifier{}, fmt.Errorf("invalid asset "+
+				"ID: %w", err)
+		}
+
+		id, err := asset.NewIDFromBytes(idBytes)
+		if err != nil {
+			return asset.Specifier{}, err
+		}
+
+		return asset.NewSpecifierFromId(id), nil
+
+	case groupKey != "":
+		keyBytes, err := hex.DecodeString(groupKey)
+		if err != nil {
+			return asset.Specifier{}, fmt.Errorf("invalid group "+
+				"key: %w", err)
+		}
+
+		key, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return asset.Specifier{}, fmt.Errorf("invalid group "+
+				"key: %w", err)
+		}
+
+		return asset.NewSpecifierFromGroupKey(*key), nil
+
+	default:
+		return asset.Specifier{}, fmt.Errorf("either asset ID or " +
+			"group key must be set")
+	}
+}
+
+// ParseFixedPointRate parses a positive decimal number (e.g. "9500000.25")
+// into a fixed point rate. The scale of the result is the number of decimal
+// places of the input.
+func ParseFixedPointRate(rateStr string) (rfqmath.BigIntFixedPoint, error) {
+	var zero rfqmath.BigIntFixedPoint
+
+	intPart, fracPart, _ := strings.Cut(strings.TrimSpace(rateStr), ".")
+	if intPart == "" && fracPart == "" {
+		return zero, fmt.Errorf("rate must not be empty")
+	}
+	if len(fracPart) > 255 {
+		return zero, fmt.Errorf("rate has too many decimal places")
+	}
+
+	digits := intPart + fracPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return zero, fmt.Errorf("invalid rate %q", rateStr)
+		}
+	}
+
+	coefficient, ok := new(big.Int).SetString(digits, 10)
+	if !ok || coefficient.Sign() == 0 {
+		return zero, fmt.Errorf("rate must be a positive number, "+
+			"got %q", rateStr)
+	}
+
+	return rfqmath.BigIntFixedPoint{
+		Coefficient: rfqmath.NewBigInt(coefficient),
+		Scale:       uint8(len(fracPart)),
+	}, nil
+}
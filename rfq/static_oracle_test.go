@@ -0,0 +1,173 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseFixedPointRate tests the parsing of decimal rates into fixed point
+// numbers.
+func TestParseFixedPointRate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		rate        string
+		expected    rfqmath.BigIntFixedPoint
+		expectedErr string
+	}{{
+		rate:     "9500000",
+		expected: rfqmath.NewBigIntFixedPoint(9_500_000, 0),
+	}, {
+		rate:     "9500000.25",
+		expected: rfqmath.NewBigIntFixedPoint(950_000_025, 2),
+	}, {
+		rate:     "0.001",
+		expected: rfqmath.NewBigIntFixedPoint(1, 3),
+	}, {
+		rate:        "",
+		expectedErr: "must not be empty",
+	}, {
+		rate:        "-1",
+		expectedErr: "invalid rate",
+	}, {
+		rate:        "1.2.3",
+		expectedErr: "invalid rate",
+	}, {
+		rate:        "0.000",
+		expectedErr: "must be a positive number",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.rate, func(t *testing.T) {
+			rate, err := ParseFixedPointRate(tc.rate)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, tc.expected.Equals(rate))
+			require.Equal(t, tc.expected.Scale, rate.Scale)
+		})
+	}
+}
+
+// TestStaticPriceOracle tests that the static price oracle returns the
+// configured rates, respects the configured limits and reloads the rates file.
+func TestStaticPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		groupedID   = asset.RandID(t)
+		ungroupedID = asset.RandID(t)
+		unknownID   = asset.RandID(t)
+		groupKey    = test.RandPubKey(t)
+	)
+	groupKeyHex := hex.EncodeToString(groupKey.SerializeCompressed())
+
+	// The group key rate is configured inline, while the asset ID rate is
+	// read from the rates file.
+	groupRate, err := ParseStaticOracleRate(
+		fmt.Sprintf("%s:100000:110000.5", groupKeyHex), time.Minute,
+	)
+	require.NoError(t, err)
+
+	writeRatesFile := func(path, buyRate string) {
+		content := fmt.Sprintf(`{"rates": [{
+			"asset_id": "%x",
+			"buy_rate": "%s",
+			"sell_rate": "210000",
+			"expiry_seconds": 60,
+			"max_asset_amount": 1000,
+			"max_payment_msat": 5000
+		}]}`, ungroupedID[:], buyRate)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	ratesFile := filepath.Join(t.TempDir(), "rates.json")
+	writeRatesFile(ratesFile, "200000")
+
+	oracle, err := NewStaticPriceOracle(&StaticOracleConfig{
+		RatesFile: ratesFile,
+		Rates:     []StaticOracleRate{groupRate},
+	})
+	require.NoError(t, err)
+
+	query := func(specifier asset.Specifier, assetAmt uint64,
+		paymentAmt lnwire.MilliSatoshi, buy bool) *OracleResponse {
+
+		queryFn := oracle.QuerySellPrice
+		if buy {
+			queryFn = oracle.QueryBuyPrice
+		}
+
+		resp, err := queryFn(
+			ctx, specifier, fn.Some(assetAmt), fn.Some(paymentAmt),
+			fn.None[rfqmsg.AssetRate](), fn.None[route.Vertex](),
+			"", IntentRecvPayment,
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	requireRate := func(resp *OracleResponse, coefficient uint64,
+		scale uint8) {
+
+		require.Nil(t, resp.Err)
+
+		expected := rfqmath.NewBigIntFixedPoint(coefficient, scale)
+		require.True(t, expected.Equals(resp.AssetRate.Rate))
+		require.True(t, resp.AssetRate.Expiry.After(time.Now()))
+	}
+
+	// A grouped asset ID without its own rate falls back to the group key
+	// rate.
+	grouped := asset.NewSpecifierOptionalGroupPubKey(groupedID, groupKey)
+	requireRate(query(grouped, 10, 0, true), 100_000, 0)
+	requireRate(query(grouped, 10, 0, false), 1_100_005, 1)
+
+	ungrouped := asset.NewSpecifierFromId(ungroupedID)
+	requireRate(query(ungrouped, 10, 0, true), 200_000, 0)
+	requireRate(query(ungrouped, 10, 0, false), 210_000, 0)
+
+	// Unknown assets and amounts above the limits result in an oracle
+	// error.
+	resp := query(asset.NewSpecifierFromId(unknownID), 10, 0, true)
+	require.ErrorContains(t, resp.Err, "no static rate")
+
+	resp = query(ungrouped, 1001, 0, true)
+	require.ErrorContains(t, resp.Err, "asset amount")
+
+	resp = query(ungrouped, 10, 5001, false)
+	require.ErrorContains(t, resp.Err, "payment amount")
+
+	// After updating the rates file, the new rate is only returned once
+	// the oracle was reloaded.
+	writeRatesFile(ratesFile, "300000")
+	requireRate(query(ungrouped, 10, 0, true), 200_000, 0)
+
+	require.NoError(t, oracle.Reload())
+	requireRate(query(ungrouped, 10, 0, true), 300_000, 0)
+
+	// An invalid rates file is rejected and the previous rates are kept.
+	writeRatesFile(ratesFile, "invalid")
+	require.Error(t, oracle.Reload())
+	requireRate(query(ungrouped, 10, 0, true), 300_000, 0)
+}
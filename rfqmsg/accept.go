@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -36,6 +37,19 @@ type acceptWireMsgData struct {
 
 	// OutAssetRate is the out-asset to BTC rate.
 	OutAssetRate tlv.RecordT[tlv.TlvType10, TlvFixedPoint]
+
+	// ForwardFeeBase is the base fee in asset units that is charged for
+	// each asset HTLC that is forwarded under the quote.
+	//
+	// NOTE: This field is optional.
+	ForwardFeeBase tlv.OptionalRecordT[tlv.TlvType11, uint64]
+
+	// ForwardFeeRate is the proportional fee in parts per million of the
+	// asset amount that is charged for each asset HTLC that is forwarded
+	// under the quote.
+	//
+	// NOTE: This field is optional.
+	ForwardFeeRate tlv.OptionalRecordT[tlv.TlvType13, uint64]
 }
 
 // newAcceptWireMsgDataFromBuy creates a new acceptWireMsgData from a buy
@@ -60,15 +74,21 @@ func newAcceptWireMsgDataFromBuy(q BuyAccept) (acceptWireMsgData, error) {
 		NewTlvFixedPointFromBigInt(MilliSatPerBtc),
 	)
 
-	// Encode message data component as TLV bytes.
-	return acceptWireMsgData{
+	msgData := acceptWireMsgData{
 		Version:      version,
 		ID:           id,
 		Expiry:       expiry,
 		Sig:          sig,
 		InAssetRate:  inAssetRate,
 		OutAssetRate: outAssetRate,
-	}, nil
+	}
+
+	// Advertise the forwarding fee we deduct from the asset HTLCs we send
+	// to the peer under this quote, if we charge one.
+	msgData.setForwardFee(q.ForwardFee)
+
+	// Encode message data component as TLV bytes.
+	return msgData, nil
 }
 
 // newAcceptWireMsgDataFromSell creates a new acceptWireMsgData from a sell
@@ -93,15 +113,33 @@ func newAcceptWireMsgDataFromSell(q SellAccept) (acceptWireMsgData, error) {
 	rate := NewTlvFixedPointFromBigInt(q.AssetRate.Rate)
 	outAssetRate := tlv.NewRecordT[tlv.TlvType10](rate)
 
-	// Encode message data component as TLV bytes.
-	return acceptWireMsgData{
+	msgData := acceptWireMsgData{
 		Version:      version,
 		ID:           id,
 		Expiry:       expiry,
 		Sig:          sig,
 		InAssetRate:  inAssetRate,
 		OutAssetRate: outAssetRate,
-	}, nil
+	}
+
+	// Advertise the forwarding fee the peer has to add to the asset HTLCs
+	// it sends under this quote, if we charge one.
+	msgData.setForwardFee(q.ForwardFee)
+
+	// Encode message data component as TLV bytes.
+	return msgData, nil
+}
+
+// setForwardFee sets the optional forwarding fee records of the message.
+func (m *acceptWireMsgData) setForwardFee(forwardFee fn.Option[ForwardFee]) {
+	forwardFee.WhenSome(func(fee ForwardFee) {
+		m.ForwardFeeBase = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType11](fee.BaseFee),
+		)
+		m.ForwardFeeRate = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType13](fee.FeeRatePpm),
+		)
+	})
 }
 
 // Validate ensures that the quote accept message is valid.
@@ -117,6 +155,15 @@ func (m *acceptWireMsgData) Validate() error {
 		return fmt.Errorf("expiry must be set to a future time")
 	}
 
+	// Ensure that an advertised forwarding fee can be charged.
+	var feeErr error
+	m.forwardFee().WhenSome(func(fee ForwardFee) {
+		feeErr = fee.Validate()
+	})
+	if feeErr != nil {
+		return fmt.Errorf("invalid forward fee: %w", feeErr)
+	}
+
 	return nil
 }
 
@@ -137,6 +184,17 @@ func (m *acceptWireMsgData) Encode(w io.Writer) error {
 		m.OutAssetRate.Record(),
 	}
 
+	m.ForwardFeeBase.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType11, uint64]) {
+			records = append(records, r.Record())
+		},
+	)
+	m.ForwardFeeRate.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType13, uint64]) {
+			records = append(records, r.Record())
+		},
+	)
+
 	tlv.SortRecords(records)
 
 	// Create the tlv stream.
@@ -150,6 +208,10 @@ func (m *acceptWireMsgData) Encode(w io.Writer) error {
 
 // Decode deserializes the acceptWireMsgData from the given io.Reader.
 func (m *acceptWireMsgData) Decode(r io.Reader) error {
+	// Define zero values for optional fields.
+	forwardFeeBase := m.ForwardFeeBase.Zero()
+	forwardFeeRate := m.ForwardFeeRate.Zero()
+
 	// Create a tlv stream with all the fields.
 	tlvStream, err := tlv.NewStream(
 		m.Version.Record(),
@@ -158,20 +220,49 @@ func (m *acceptWireMsgData) Decode(r io.Reader) error {
 		m.Sig.Record(),
 		m.InAssetRate.Record(),
 		m.OutAssetRate.Record(),
+
+		forwardFeeBase.Record(),
+		forwardFeeRate.Record(),
 	)
 	if err != nil {
 		return err
 	}
 
 	// Decode the reader's contents into the tlv stream.
-	_, err = tlvStream.DecodeWithParsedTypes(r)
+	tlvMap, err := tlvStream.DecodeWithParsedTypes(r)
 	if err != nil {
 		return err
 	}
 
+	// Set optional fields if they are present.
+	if _, ok := tlvMap[forwardFeeBase.TlvType()]; ok {
+		m.ForwardFeeBase = tlv.SomeRecordT(forwardFeeBase)
+	}
+	if _, ok := tlvMap[forwardFeeRate.TlvType()]; ok {
+		m.ForwardFeeRate = tlv.SomeRecordT(forwardFeeRate)
+	}
+
 	return nil
 }
 
+// forwardFee returns the forwarding fee that is advertised in the message, if
+// any.
+func (m *acceptWireMsgData) forwardFee() fn.Option[ForwardFee] {
+	if m.ForwardFeeBase.IsNone() && m.ForwardFeeRate.IsNone() {
+		return fn.None[ForwardFee]()
+	}
+
+	var fee ForwardFee
+	m.ForwardFeeBase.WhenSome(func(r tlv.RecordT[tlv.TlvType11, uint64]) {
+		fee.BaseFee = r.Val
+	})
+	m.ForwardFeeRate.WhenSome(func(r tlv.RecordT[tlv.TlvType13, uint64]) {
+		fee.FeeRatePpm = r.Val
+	})
+
+	return fn.Some(fee)
+}
+
 // Bytes encodes the structure into a TLV stream and returns the bytes.
 func (m *acceptWireMsgData) Bytes() ([]byte, error) {
 	var b bytes.Buffer
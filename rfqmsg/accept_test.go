@@ -2,10 +2,15 @@ package rfqmsg
 
 import (
 	"bytes"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +26,7 @@ type acceptEncodeDecodeTC struct {
 	sig          [64]byte
 	inAssetRate  TlvFixedPoint
 	outAssetRate TlvFixedPoint
+	forwardFee   *ForwardFee
 }
 
 // MsgData generates a acceptWireMsgData instance from the test case.
@@ -32,7 +38,7 @@ func (tc acceptEncodeDecodeTC) MsgData() acceptWireMsgData {
 	inAssetRate := tlv.NewRecordT[tlv.TlvType8](tc.inAssetRate)
 	outAssetRate := tlv.NewRecordT[tlv.TlvType10](tc.outAssetRate)
 
-	return acceptWireMsgData{
+	msgData := acceptWireMsgData{
 		Version:      version,
 		ID:           id,
 		Expiry:       expiry,
@@ -40,6 +46,21 @@ func (tc acceptEncodeDecodeTC) MsgData() acceptWireMsgData {
 		InAssetRate:  inAssetRate,
 		OutAssetRate: outAssetRate,
 	}
+
+	if tc.forwardFee != nil {
+		msgData.ForwardFeeBase = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType11](
+				tc.forwardFee.BaseFee,
+			),
+		)
+		msgData.ForwardFeeRate = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType13](
+				tc.forwardFee.FeeRatePpm,
+			),
+		)
+	}
+
+	return msgData
 }
 
 // TestAcceptMsgDataEncodeDecode tests acceptWireMsgData encoding/decoding.
@@ -83,6 +104,19 @@ func TestAcceptMsgDataEncodeDecode(t *testing.T) {
 			inAssetRate:  inAssetRate,
 			outAssetRate: outAssetRate,
 		},
+		{
+			testName:     "forward fee set",
+			version:      V1,
+			id:           id,
+			expiry:       expiry,
+			sig:          randSig,
+			inAssetRate:  inAssetRate,
+			outAssetRate: outAssetRate,
+			forwardFee: &ForwardFee{
+				BaseFee:    10,
+				FeeRatePpm: 2_500,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -103,6 +137,211 @@ func TestAcceptMsgDataEncodeDecode(t *testing.T) {
 			// Assert that the decoded message is equal to
 			// the original message.
 			require.Equal(tt, msgData, decodedMsgData)
+
+			// Make sure the forwarding fee is extracted
+			// correctly.
+			fee := decodedMsgData.forwardFee()
+			require.Equal(tt, tc.forwardFee, fee.UnwrapToPtr())
+		})
+	}
+}
+
+// TestForwardFee tests the computation of the forwarding fee of an asset
+// amount.
+func TestForwardFee(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		fee         ForwardFee
+		assetAmt    uint64
+		expectedFee uint64
+		expectErr   bool
+	}{{
+		name:        "no fee",
+		assetAmt:    1_000,
+		expectedFee: 0,
+	}, {
+		name:        "base fee only",
+		fee:         ForwardFee{BaseFee: 5},
+		assetAmt:    1_000,
+		expectedFee: 5,
+	}, {
+		name:        "base and proportional fee",
+		fee:         ForwardFee{BaseFee: 5, FeeRatePpm: 10_000},
+		assetAmt:    1_000,
+		expectedFee: 15,
+	}, {
+		name:        "proportional fee rounded down",
+		fee:         ForwardFee{FeeRatePpm: 1_000},
+		assetAmt:    999,
+		expectedFee: 0,
+	}, {
+		name:        "large amount doesn't overflow",
+		fee:         ForwardFee{FeeRatePpm: 500_000},
+		assetAmt:    math.MaxUint64,
+		expectedFee: math.MaxUint64 / 2,
+	}, {
+		name:      "base fee overflows",
+		fee:       ForwardFee{BaseFee: math.MaxUint64, FeeRatePpm: 1},
+		assetAmt:  1_000_000,
+		expectErr: true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := tc.fee.FeeForAmount(tc.assetAmt)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFee, fee)
+			isZero := tc.fee == ForwardFee{}
+			require.Equal(t, isZero, tc.fee.IsZero())
+		})
+	}
+}
+
+// TestForwardFeeAmountWithFee tests that adding the forwarding fee to an asset
+// amount is rejected if the sum overflows.
+func TestForwardFeeAmountWithFee(t *testing.T) {
+	t.Parallel()
+
+	fee := ForwardFee{BaseFee: 5, FeeRatePpm: 10_000}
+
+	amt, err := fee.AmountWithFee(1_000)
+	require.NoError(t, err)
+	require.EqualValues(t, 1_015, amt)
+
+	_, err = fee.AmountWithFee(math.MaxUint64 - 10)
+	require.Error(t, err)
+
+	_, err = ForwardFee{BaseFee: 1}.AmountWithFee(math.MaxUint64)
+	require.Error(t, err)
+}
+
+// TestBuyAcceptForwardFee tests that the forwarding fee of a buy accept
+// survives the round trip through the wire message.
+func TestBuyAcceptForwardFee(t *testing.T) {
+	t.Parallel()
+
+	request := BuyRequest{
+		Peer:           route.Vertex{1, 2, 3},
+		Version:        V1,
+		ID:             ID(test.RandBytes(32)),
+		AssetSpecifier: asset.NewSpecifierFromId(asset.RandID(t)),
+		AssetMaxAmt:    1_000,
+	}
+	assetRate := NewAssetRate(
+		rfqmath.NewBigIntFixedPoint(42_000, 0),
+		time.Now().Add(time.Hour),
+	)
+
+	testCases := []struct {
+		name       string
+		forwardFee fn.Option[ForwardFee]
+	}{{
+		name:       "no forward fee",
+		forwardFee: fn.None[ForwardFee](),
+	}, {
+		name: "forward fee set",
+		forwardFee: fn.Some(ForwardFee{
+			BaseFee:    10,
+			FeeRatePpm: 2_500,
+		}),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accept := NewBuyAcceptFromRequest(request, assetRate)
+			accept.ForwardFee = tc.forwardFee
+
+			wireMsg, err := accept.ToWire()
+			require.NoError(t, err)
+
+			var msgData acceptWireMsgData
+			err = msgData.Decode(bytes.NewReader(wireMsg.Data))
+			require.NoError(t, err)
+			require.NoError(t, msgData.Validate())
+
+			decoded, err := newBuyAcceptFromWireMsg(
+				wireMsg, msgData, request,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.forwardFee, decoded.ForwardFee)
 		})
 	}
 }
+
+// TestForwardFeeValidate tests that a fee rate that would consume the whole
+// asset amount is rejected.
+func TestForwardFeeValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ForwardFee{BaseFee: 10}.Validate())
+	require.NoError(t, ForwardFee{FeeRatePpm: 999_999}.Validate())
+	require.Error(t, ForwardFee{FeeRatePpm: 1_000_000}.Validate())
+
+	_, err := ForwardFee{FeeRatePpm: 1_000_000}.GrossAmount(1)
+	require.Error(t, err)
+}
+
+// TestForwardFeeGrossAndMaxAmount tests that the gross amount is the smallest
+// amount that leaves the net amount after deducting the fee, and that the max
+// amount is the largest amount that can be paid together with its fee out of
+// a budget.
+func TestForwardFeeGrossAndMaxAmount(t *testing.T) {
+	t.Parallel()
+
+	fees := []ForwardFee{
+		{},
+		{BaseFee: 5},
+		{FeeRatePpm: 1_000},
+		{BaseFee: 5, FeeRatePpm: 10_000},
+		{BaseFee: 1, FeeRatePpm: 333_333},
+	}
+	amounts := []uint64{0, 1, 5, 6, 999, 1_000, 123_456, 10_000_000}
+
+	for _, fee := range fees {
+		feeFor := func(amt uint64) uint64 {
+			f, err := fee.FeeForAmount(amt)
+			require.NoError(t, err)
+
+			return f
+		}
+
+		for _, amt := range amounts {
+			gross, err := fee.GrossAmount(amt)
+			require.NoError(t, err)
+			require.GreaterOrEqual(
+				t, gross-feeFor(gross), amt,
+			)
+
+			smaller := gross - 1
+			if gross > 0 && smaller >= feeFor(smaller) {
+				require.Less(
+					t, smaller-feeFor(smaller),
+					amt,
+				)
+			}
+
+			maxAmt := fee.MaxAmountFor(amt)
+			if amt >= fee.BaseFee {
+				require.LessOrEqual(
+					t, maxAmt+feeFor(maxAmt), amt,
+				)
+			}
+
+			larger := maxAmt + 1
+			require.Greater(
+				t, larger+feeFor(larger), amt,
+			)
+		}
+	}
+
+	// The gross amount must not overflow silently.
+	_, err := ForwardFee{BaseFee: 1}.GrossAmount(math.MaxUint64)
+	require.Error(t, err)
+}
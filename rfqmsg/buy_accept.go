@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
@@ -32,6 +33,14 @@ type BuyAccept struct {
 	// AssetRate is the accepted asset to BTC rate.
 	AssetRate AssetRate
 
+	// ForwardFee is the fee in asset units that the accepting peer charges
+	// for forwarding the asset HTLCs that are paid under this quote. The
+	// accepting peer deducts the fee from the asset amount it sends, so
+	// the receiver needs to add it to the amount of its invoice.
+	//
+	// NOTE: This field is optional.
+	ForwardFee fn.Option[ForwardFee]
+
 	// sig is a signature over the serialized contents of the message.
 	sig [64]byte
 }
@@ -68,12 +77,13 @@ func newBuyAcceptFromWireMsg(wireMsg WireMessage,
 	expiry := time.Unix(int64(msgData.Expiry.Val), 0).UTC()
 
 	return &BuyAccept{
-		Peer:      wireMsg.Peer,
-		Request:   request,
-		Version:   msgData.Version.Val,
-		ID:        msgData.ID.Val,
-		AssetRate: NewAssetRate(assetRate, expiry),
-		sig:       msgData.Sig.Val,
+		Peer:       wireMsg.Peer,
+		Request:    request,
+		Version:    msgData.Version.Val,
+		ID:         msgData.ID.Val,
+		AssetRate:  NewAssetRate(assetRate, expiry),
+		ForwardFee: msgData.forwardFee(),
+		sig:        msgData.Sig.Val,
 	}, nil
 }
 
@@ -124,8 +134,14 @@ func (q *BuyAccept) MsgID() ID {
 
 // String returns a human-readable string representation of the message.
 func (q *BuyAccept) String() string {
-	return fmt.Sprintf("BuyAccept(peer=%x, id=%x, asset_rate=%s, scid=%d)",
-		q.Peer[:], q.ID[:], q.AssetRate.String(), q.ShortChannelId())
+	forwardFee := "none"
+	q.ForwardFee.WhenSome(func(fee ForwardFee) {
+		forwardFee = fee.String()
+	})
+
+	return fmt.Sprintf("BuyAccept(peer=%x, id=%x, asset_rate=%s, "+
+		"scid=%d, forward_fee=%s)", q.Peer[:], q.ID[:],
+		q.AssetRate.String(), q.ShortChannelId(), forwardFee)
 }
 
 // Ensure that the message type implements the OutgoingMsg interface.
@@ -0,0 +1,138 @@
+package rfqmsg
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	// feeRateParts is the number of parts a fee rate in parts per million
+	// is relative to.
+	feeRateParts = 1_000_000
+)
+
+// ForwardFee is the fee in asset units that an edge node charges for
+// forwarding an asset HTLC under an accepted quote. Under a sell quote, the fee
+// is charged on top of the asset amount that corresponds to the forwarded BTC
+// amount. Under a buy quote, it is deducted from the asset amount that
+// corresponds to the received BTC amount. The fee is denominated in units of
+// the asset of the quote.
+type ForwardFee struct {
+	// BaseFee is the fixed fee in asset units that is charged for each
+	// forwarded HTLC.
+	BaseFee uint64
+
+	// FeeRatePpm is the proportional fee in parts per million of the
+	// forwarded asset amount.
+	FeeRatePpm uint64
+}
+
+// FeeForAmount returns the forwarding fee in asset units for forwarding the
+// given asset amount. An error is returned if the fee doesn't fit into a
+// uint64.
+func (f ForwardFee) FeeForAmount(assetAmt uint64) (uint64, error) {
+	// We use big integers for the fee, so large asset amounts or base fees
+	// can't silently overflow.
+	fee := new(big.Int).SetUint64(assetAmt)
+	fee.Mul(fee, new(big.Int).SetUint64(f.FeeRatePpm))
+	fee.Div(fee, big.NewInt(feeRateParts))
+	fee.Add(fee, new(big.Int).SetUint64(f.BaseFee))
+
+	if !fee.IsUint64() {
+		return 0, fmt.Errorf("forward fee for asset amount %d "+
+			"overflows", assetAmt)
+	}
+
+	return fee.Uint64(), nil
+}
+
+// AmountWithFee returns the given asset amount plus the forwarding fee for
+// that amount. An error is returned if the sum doesn't fit into a uint64.
+func (f ForwardFee) AmountWithFee(assetAmt uint64) (uint64, error) {
+	fee, err := f.FeeForAmount(assetAmt)
+	if err != nil {
+		return 0, err
+	}
+
+	if assetAmt > math.MaxUint64-fee {
+		return 0, fmt.Errorf("asset amount %d plus forward fee %d "+
+			"overflows", assetAmt, fee)
+	}
+
+	return assetAmt + fee, nil
+}
+
+// Validate returns an error if the fee rate would consume the whole asset
+// amount.
+func (f ForwardFee) Validate() error {
+	if f.FeeRatePpm >= feeRateParts {
+		return fmt.Errorf("fee rate of %d ppm must be less than %d ppm",
+			f.FeeRatePpm, feeRateParts)
+	}
+
+	return nil
+}
+
+// GrossAmount returns the smallest asset amount from which at least the given
+// net amount remains after deducting the fee for that amount.
+func (f ForwardFee) GrossAmount(netAmt uint64) (uint64, error) {
+	if err := f.Validate(); err != nil {
+		return 0, err
+	}
+
+	// The gross amount g needs to cover the net amount and the base fee,
+	// so g - floor(g * rate / parts) >= net + base. Since the left side is
+	// ceil(g * (parts - rate) / parts), this holds for every g with
+	// g * (parts - rate) > (net + base - 1) * parts.
+	required := new(big.Int).SetUint64(netAmt)
+	required.Add(required, new(big.Int).SetUint64(f.BaseFee))
+	if required.Sign() == 0 {
+		return 0, nil
+	}
+
+	gross := required.Sub(required, big.NewInt(1))
+	gross.Mul(gross, big.NewInt(feeRateParts))
+	gross.Div(gross, big.NewInt(feeRateParts-int64(f.FeeRatePpm)))
+	gross.Add(gross, big.NewInt(1))
+
+	if !gross.IsUint64() {
+		return 0, fmt.Errorf("gross amount for net amount %d "+
+			"overflows", netAmt)
+	}
+
+	return gross.Uint64(), nil
+}
+
+// MaxAmountFor returns the largest asset amount that can be paid together with
+// the fee for that amount out of the given budget.
+func (f ForwardFee) MaxAmountFor(budget uint64) uint64 {
+	if budget < f.BaseFee {
+		return 0
+	}
+
+	// The amount a plus its fee must not exceed the budget, so
+	// floor(a * (parts + rate) / parts) <= budget - base. This holds for
+	// every a with a * (parts + rate) < (budget - base + 1) * parts.
+	amt := new(big.Int).SetUint64(budget - f.BaseFee)
+	amt.Add(amt, big.NewInt(1))
+	amt.Mul(amt, big.NewInt(feeRateParts))
+	amt.Sub(amt, big.NewInt(1))
+
+	divisor := new(big.Int).SetUint64(f.FeeRatePpm)
+	divisor.Add(divisor, big.NewInt(feeRateParts))
+	amt.Div(amt, divisor)
+
+	return amt.Uint64()
+}
+
+// IsZero returns true if the forwarding fee doesn't charge anything.
+func (f ForwardFee) IsZero() bool {
+	return f.BaseFee == 0 && f.FeeRatePpm == 0
+}
+
+// String returns a human-readable string representation of the fee.
+func (f ForwardFee) String() string {
+	return fmt.Sprintf("ForwardFee(base=%d, rate_ppm=%d)", f.BaseFee,
+		f.FeeRatePpm)
+}
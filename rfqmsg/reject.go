@@ -90,6 +90,13 @@ var (
 		Code: 1,
 		Msg:  "price oracle unavailable",
 	}
+
+	// ErrRateLimited is the error code for when the quote is rejected
+	// because the peer sent too many quote requests.
+	ErrRateLimited = RejectErr{
+		Code: 2,
+		Msg:  "too many quote requests",
+	}
 )
 
 const (
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
@@ -32,6 +33,13 @@ type SellAccept struct {
 	// AssetRate is the accepted asset to BTC rate.
 	AssetRate AssetRate
 
+	// ForwardFee is the fee in asset units that the accepting peer charges
+	// for forwarding the asset HTLCs that are sent under this quote. The
+	// sender of the HTLCs needs to add the fee to the asset amount.
+	//
+	// NOTE: This field is optional.
+	ForwardFee fn.Option[ForwardFee]
+
 	// sig is a signature over the serialized contents of the message.
 	sig [64]byte
 }
@@ -72,12 +80,13 @@ func newSellAcceptFromWireMsg(wireMsg WireMessage,
 	// Note that the `Request` field is populated later in the RFQ stream
 	// service.
 	return &SellAccept{
-		Peer:      wireMsg.Peer,
-		Request:   request,
-		Version:   msgData.Version.Val,
-		ID:        msgData.ID.Val,
-		AssetRate: NewAssetRate(assetRate, expiry),
-		sig:       msgData.Sig.Val,
+		Peer:       wireMsg.Peer,
+		Request:    request,
+		Version:    msgData.Version.Val,
+		ID:         msgData.ID.Val,
+		AssetRate:  NewAssetRate(assetRate, expiry),
+		ForwardFee: msgData.forwardFee(),
+		sig:        msgData.Sig.Val,
 	}, nil
 }
 
@@ -129,9 +138,14 @@ func (q *SellAccept) MsgID() ID {
 
 // String returns a human-readable string representation of the message.
 func (q *SellAccept) String() string {
+	forwardFee := "none"
+	q.ForwardFee.WhenSome(func(fee ForwardFee) {
+		forwardFee = fee.String()
+	})
+
 	return fmt.Sprintf("SellAccept(peer=%x, id=%x, asset_rate=%s, "+
-		"scid=%d)", q.Peer[:], q.ID[:], q.AssetRate.String(),
-		q.ShortChannelId())
+		"scid=%d, forward_fee=%s)", q.Peer[:], q.ID[:],
+		q.AssetRate.String(), q.ShortChannelId(), forwardFee)
 }
 
 // Ensure that the message type implements the OutgoingMsg interface.
@@ -12,6 +12,7 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -76,6 +77,7 @@ import (
 	"golang.org/x/exp/maps"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
@@ -1027,6 +1029,128 @@ func (r *rpcServer) ListBatches(_ context.Context,
 	}, nil
 }
 
+// AddMintSchedule adds a recurring mint schedule that mints a new tranche of an
+// existing asset group at a fixed cadence.
+func (r *rpcServer) AddMintSchedule(_ context.Context,
+	req *mintrpc.AddMintScheduleRequest) (*mintrpc.AddMintScheduleResponse,
+	error) {
+
+	groupKey, err := btcec.ParsePubKey(req.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key: %w", err)
+	}
+
+	assetVersion, err := rpcutils.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	metaType, err := proof.IsValidMetaType(req.MetaType)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CadenceSeconds > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf("cadence too large")
+	}
+
+	if req.StartTimestamp < 0 {
+		return nil, fmt.Errorf("start timestamp must not be negative")
+	}
+
+	schedule := &tapgarden.MintSchedule{
+		GroupKey:       *groupKey,
+		AssetVersion:   assetVersion,
+		Amount:         req.Amount,
+		MetaType:       metaType,
+		MetaTemplate:   req.MetaTemplate,
+		Cadence:        time.Duration(req.CadenceSeconds) * time.Second,
+		MaxTotalSupply: req.MaxTotalSupply,
+	}
+	if req.StartTimestamp > 0 {
+		schedule.NextMintTime = time.Unix(req.StartTimestamp, 0).UTC()
+	}
+
+	newSchedule, err := r.cfg.AssetMinter.AddMintSchedule(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add mint schedule: %w", err)
+	}
+
+	rpcSchedule, err := marshalMintSchedule(newSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.AddMintScheduleResponse{
+		MintSchedule: rpcSchedule,
+	}, nil
+}
+
+// ListMintSchedules lists all mint schedules, including completed and
+// cancelled ones.
+func (r *rpcServer) ListMintSchedules(_ context.Context,
+	_ *mintrpc.ListMintSchedulesRequest) (
+	*mintrpc.ListMintSchedulesResponse, error) {
+
+	schedules, err := r.cfg.AssetMinter.ListMintSchedules()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list mint schedules: %w",
+			err)
+	}
+
+	rpcSchedules, err := fn.MapErr(schedules, marshalMintSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.ListMintSchedulesResponse{
+		MintSchedules: rpcSchedules,
+	}, nil
+}
+
+// CancelMintSchedule cancels an active mint schedule.
+func (r *rpcServer) CancelMintSchedule(_ context.Context,
+	req *mintrpc.CancelMintScheduleRequest) (
+	*mintrpc.CancelMintScheduleResponse, error) {
+
+	err := r.cfg.AssetMinter.CancelMintSchedule(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel mint schedule: %w",
+			err)
+	}
+
+	return &mintrpc.CancelMintScheduleResponse{}, nil
+}
+
+// marshalMintSchedule turns a mint schedule into its RPC counterpart.
+func marshalMintSchedule(
+	schedule *tapgarden.MintSchedule) (*mintrpc.MintSchedule, error) {
+
+	assetVersion, err := rpcutils.MarshalAssetVersion(
+		schedule.AssetVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.MintSchedule{
+		Id:                schedule.ID,
+		GroupKey:          schedule.GroupKey.SerializeCompressed(),
+		AssetVersion:      assetVersion,
+		Amount:            schedule.Amount,
+		MetaType:          taprpc.AssetMetaType(schedule.MetaType),
+		MetaTemplate:      schedule.MetaTemplate,
+		CadenceSeconds:    uint64(schedule.Cadence / time.Second),
+		MaxTotalSupply:    schedule.MaxTotalSupply,
+		NextMintTimestamp: schedule.NextMintTime.Unix(),
+		NumTranches:       schedule.NumTranches,
+		TotalMinted:       schedule.TotalMinted,
+		State:             mintrpc.MintScheduleState(schedule.State),
+		LastError:         schedule.LastError,
+		CreationTimestamp: schedule.CreationTime.Unix(),
+	}, nil
+}
+
 // checkBalanceOverflow ensures that the new asset amount will not overflow
 // the max allowed asset (or asset group) balance.
 func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
@@ -2366,6 +2490,14 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 			err)
 	}
 
+	coinSelectStrategy, err := unmarshalCoinSelectStrategy(
+		req.CoinSelectStrategy, r.cfg.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing coin select strategy: "+
+			"%w", err)
+	}
+
 	var fundedVPkt *tapfreighter.FundedVPacket
 	switch {
 	case req.GetPsbt() != nil:
@@ -2388,7 +2520,9 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		}
 
 		desc.ScriptKeyType = scriptKeyType
-		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(ctx, desc, vPkt)
+		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
+			ctx, desc, vPkt, coinSelectStrategy,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding packet: %w", err)
 		}
@@ -2474,7 +2608,8 @@ func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
 		}
 
 		fundedVPkt, err = r.cfg.AssetWallet.FundAddressSend(
-			ctx, scriptKeyType, prevIDs, tapAddrs...,
+			ctx, scriptKeyType, prevIDs, coinSelectStrategy,
+			tapAddrs...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error funding address send: "+
@@ -2544,6 +2679,35 @@ func unmarshalCoinSelectType(
 	}
 }
 
+// unmarshalCoinSelectStrategy converts an RPC coin selection strategy into the
+// strategy used by the asset wallet. If the RPC strategy isn't set, the given
+// default strategy is returned.
+func unmarshalCoinSelectStrategy(strategy taprpc.CoinSelectStrategy,
+	defaultStrategy tapfreighter.MultiCommitmentSelectStrategy) (
+	tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	switch strategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT:
+		return defaultStrategy, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_LARGEST_FIRST:
+		return tapfreighter.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_SMALLEST_FIRST:
+		return tapfreighter.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_BRANCH_AND_BOUND:
+		return tapfreighter.BranchAndBound, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_PRIVACY_AWARE:
+		return tapfreighter.PrivacyAware, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin select strategy: %d",
+			strategy)
+	}
+}
+
 // SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
 // commitments of the inputs and outputs.
 func (r *rpcServer) SignVirtualPsbt(ctx context.Context,
@@ -3610,6 +3774,13 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 		return nil, err
 	}
 
+	coinSelectStrategy, err := unmarshalCoinSelectStrategy(
+		req.CoinSelectStrategy, r.cfg.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	label := req.Label
 	if req.Label == "" {
 		label = fmt.Sprintf("rpcsendasset-%s", tapAddrs[0].String())
@@ -3618,7 +3789,7 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 	resp, err := r.cfg.ChainPorter.RequestShipment(
 		tapfreighter.NewAddressParcel(
 			feeRate, label, req.SkipProofCourierPingCheck,
-			tapAddrs...,
+			coinSelectStrategy, tapAddrs...,
 		),
 	)
 	if err != nil {
@@ -3636,6 +3807,351 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 	}, nil
 }
 
+// SendAssetBatch sends assets to many recipients. The recipients are grouped
+// by asset and split into chunks, each of which is sent in its own anchor
+// transaction and labeled '<label>-<batch ID>-<chunk number>'.
+func (r *rpcServer) SendAssetBatch(ctx context.Context,
+	req *taprpc.SendAssetBatchRequest) (*taprpc.SendAssetBatchResponse,
+	error) {
+
+	if len(req.Recipients) == 0 {
+		return nil, fmt.Errorf("at least one recipient is required")
+	}
+	if req.Label == "" {
+		return nil, fmt.Errorf("label must be specified")
+	}
+
+	recipients := make([]*tapfreighter.BatchRecipient, len(req.Recipients))
+	for idx, rpcAddr := range req.Recipients {
+		// Each address is validated on its own, as the recipients of a
+		// batch may receive different assets.
+		tapAddrs, err := parseAndValidateAddresses(
+			[]*taprpc.AddressWithAmount{rpcAddr},
+			&r.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient %d: %w", idx,
+				err)
+		}
+
+		recipients[idx] = &tapfreighter.BatchRecipient{
+			TapAddr: rpcAddr.TapAddr,
+			Addr:    tapAddrs[0],
+			Amount:  rpcAddr.Amount,
+		}
+	}
+
+	// A batch can only be resumed or looked up with the ID it was
+	// started with, so transfers of other batches that happen to use the
+	// same label are never matched.
+	batchID := req.BatchId
+	switch {
+	case batchID == "" && (req.StatusOnly || req.StartChunk > 1):
+		return nil, fmt.Errorf("batch ID must be specified to resume " +
+			"a batch or query its status")
+
+	case batchID == "":
+		var err error
+		batchID, err = tapfreighter.NewBatchID()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxRecipients := int(req.MaxRecipientsPerTx)
+	if maxRecipients == 0 {
+		maxRecipients = tapfreighter.DefaultMaxBatchRecipients
+	}
+	chunks, err := tapfreighter.ChunkBatchRecipients(
+		recipients, maxRecipients, req.Label, batchID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	startChunk := max(int(req.StartChunk), 1)
+	if startChunk > len(chunks) {
+		return nil, fmt.Errorf("start chunk must be between 1 and %d",
+			len(chunks))
+	}
+
+	// For an existing batch, we look up the transfers of the chunks that
+	// were already sent.
+	sentChunks := make(map[string]*taprpc.AssetTransfer)
+	if req.BatchId != "" {
+		parcels, err := r.cfg.AssetStore.QueryParcels(ctx, nil, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query parcels: %w",
+				err)
+		}
+
+		chunkLabels := fn.NewSet(fn.Map(
+			chunks, func(c *tapfreighter.BatchChunk) string {
+				return c.Label
+			},
+		)...)
+		for _, parcel := range parcels {
+			if !chunkLabels.Contains(parcel.Label) {
+				continue
+			}
+
+			transfer, err := marshalOutboundParcel(parcel)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal "+
+					"parcel: %w", err)
+			}
+
+			sentChunks[parcel.Label] = transfer
+		}
+	}
+
+	// In status only mode, we only report the transfers of the batch.
+	resp := &taprpc.SendAssetBatchResponse{
+		BatchId: batchID,
+	}
+	if req.StatusOnly {
+		for _, chunk := range chunks {
+			resp.Chunks = append(resp.Chunks, marshalBatchChunk(
+				chunk, sentChunks[chunk.Label],
+			))
+		}
+
+		return resp, nil
+	}
+
+	for _, chunk := range chunks {
+		if chunk.Number < startChunk {
+			continue
+		}
+
+		// A chunk that already has a transfer must not be paid twice
+		// when a batch is resumed.
+		if transfer, ok := sentChunks[chunk.Label]; ok {
+			rpcsLog.Infof("Skipping chunk %d of batch %s, it was "+
+				"already sent", chunk.Number, batchID)
+
+			resp.Chunks = append(
+				resp.Chunks, marshalBatchChunk(chunk, transfer),
+			)
+
+			continue
+		}
+
+		rpcAddrs := make(
+			[]*taprpc.AddressWithAmount, len(chunk.Recipients),
+		)
+		for idx, recipient := range chunk.Recipients {
+			rpcAddrs[idx] = &taprpc.AddressWithAmount{
+				TapAddr: recipient.TapAddr,
+				Amount:  recipient.Amount,
+			}
+		}
+
+		skipPingCheck := req.SkipProofCourierPingCheck
+		sendResp, err := r.SendAsset(ctx, &taprpc.SendAssetRequest{
+			FeeRate:                   req.FeeRate,
+			Label:                     chunk.Label,
+			SkipProofCourierPingCheck: skipPingCheck,
+			AddressesWithAmounts:      rpcAddrs,
+		})
+		if err != nil {
+			// We return the chunks that were sent so far, so the
+			// batch can be resumed with the failed chunk.
+			rpcsLog.Errorf("Unable to send chunk %d of batch "+
+				"%s: %v", chunk.Number, batchID, err)
+
+			resp.FailedChunk = uint32(chunk.Number)
+			resp.FailureReason = err.Error()
+
+			return resp, nil
+		}
+
+		resp.Chunks = append(resp.Chunks, marshalBatchChunk(
+			chunk, sendResp.Transfer,
+		))
+	}
+
+	return resp, nil
+}
+
+// marshalBatchChunk turns a batch send chunk into its RPC counterpart. The
+// anchor transaction and the proof delivery status of the recipients are
+// taken from the given transfer, if it is known.
+func marshalBatchChunk(chunk *tapfreighter.BatchChunk,
+	transfer *taprpc.AssetTransfer) *taprpc.BatchSendChunk {
+
+	rpcChunk := &taprpc.BatchSendChunk{
+		ChunkNumber: uint32(chunk.Number),
+		Label:       chunk.Label,
+		Recipients: make(
+			[]*taprpc.BatchSendRecipient, len(chunk.Recipients),
+		),
+	}
+
+	if transfer != nil {
+		anchorTxHash, err := chainhash.NewHash(transfer.AnchorTxHash)
+		if err == nil {
+			rpcChunk.AnchorTxid = anchorTxHash.String()
+		}
+	}
+
+	for idx, recipient := range chunk.Recipients {
+		rpcRecipient := &taprpc.BatchSendRecipient{
+			TapAddr: recipient.TapAddr,
+			Amount:  recipient.SendAmount(),
+		}
+
+		if transfer != nil {
+			for _, out := range transfer.Outputs {
+				if out.TapAddr != recipient.TapAddr {
+					continue
+				}
+
+				rpcRecipient.ProofDeliveryStatus =
+					out.ProofDeliveryStatus
+			}
+		}
+
+		rpcChunk.Recipients[idx] = rpcRecipient
+	}
+
+	return rpcChunk
+}
+
+// ScheduleSend schedules an asset send that is only executed once a block
+// height and/or a point in time is reached.
+func (r *rpcServer) ScheduleSend(ctx context.Context,
+	req *taprpc.ScheduleSendRequest) (*taprpc.ScheduleSendResponse, error) {
+
+	if req.ExecuteAtTimestamp < 0 {
+		return nil, fmt.Errorf("execute at timestamp must not be " +
+			"negative")
+	}
+
+	send := &tapfreighter.ScheduledSend{
+		Label:                     req.Label,
+		FeeRate:                   chainfee.SatPerKWeight(req.FeeRate),
+		SkipProofCourierPingCheck: req.SkipProofCourierPingCheck,
+		Recipients: fn.Map(
+			req.AddressesWithAmounts, unmarshalScheduledRecipient,
+		),
+	}
+	if req.ExecuteAtHeight > 0 {
+		send.ExecuteAtHeight = fn.Some(req.ExecuteAtHeight)
+	}
+	if req.ExecuteAtTimestamp > 0 {
+		send.ExecuteAtTime = fn.Some(
+			time.Unix(req.ExecuteAtTimestamp, 0).UTC(),
+		)
+	}
+
+	// A fee rate below the minimum relay fee would only make the send
+	// fail at execution time, so we check it now.
+	if send.FeeRate > 0 {
+		_, err := checkFeeRateSanity(
+			ctx, send.FeeRate, r.cfg.Lnd.WalletKit,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newSend, err := r.cfg.SendScheduler.ScheduleSend(ctx, send)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule send: %w", err)
+	}
+
+	return &taprpc.ScheduleSendResponse{
+		ScheduledSend: marshalScheduledSend(newSend),
+	}, nil
+}
+
+// ListScheduledSends lists all scheduled asset sends.
+func (r *rpcServer) ListScheduledSends(ctx context.Context,
+	req *taprpc.ListScheduledSendsRequest) (
+	*taprpc.ListScheduledSendsResponse, error) {
+
+	state := fn.None[tapfreighter.ScheduledSendState]()
+	if req.PendingOnly {
+		state = fn.Some(tapfreighter.ScheduledSendPending)
+	}
+
+	sends, err := r.cfg.SendScheduler.ListScheduledSends(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list scheduled sends: %w",
+			err)
+	}
+
+	return &taprpc.ListScheduledSendsResponse{
+		ScheduledSends: fn.Map(sends, marshalScheduledSend),
+	}, nil
+}
+
+// CancelScheduledSend cancels a pending scheduled asset send.
+func (r *rpcServer) CancelScheduledSend(ctx context.Context,
+	req *taprpc.CancelScheduledSendRequest) (
+	*taprpc.CancelScheduledSendResponse, error) {
+
+	err := r.cfg.SendScheduler.CancelScheduledSend(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.CancelScheduledSendResponse{}, nil
+}
+
+// marshalScheduledSend turns a scheduled send into its RPC counterpart.
+func marshalScheduledSend(
+	send *tapfreighter.ScheduledSend) *taprpc.ScheduledSend {
+
+	state := taprpc.ScheduledSendState(send.State)
+	rpcSend := &taprpc.ScheduledSend{
+		Id:                        send.ID,
+		Label:                     send.Label,
+		FeeRate:                   uint32(send.FeeRate),
+		SkipProofCourierPingCheck: send.SkipProofCourierPingCheck,
+		ExecuteAtHeight:           send.ExecuteAtHeight.UnwrapOr(0),
+		State:                     state,
+		FailureReason:             send.FailureReason,
+		CreationTimestamp:         send.CreationTime.Unix(),
+		UpdateTimestamp:           send.UpdateTime.Unix(),
+		NumAttempts:               send.NumAttempts,
+		Recipients: fn.Map(
+			send.Recipients, marshalScheduledRecipient,
+		),
+	}
+	send.ExecuteAtTime.WhenSome(func(t time.Time) {
+		rpcSend.ExecuteAtTimestamp = t.Unix()
+	})
+	send.AnchorTxHash.WhenSome(func(h chainhash.Hash) {
+		rpcSend.AnchorTxid = h.String()
+	})
+
+	return rpcSend
+}
+
+// unmarshalScheduledRecipient turns an RPC address with amount into a
+// scheduled send recipient.
+func unmarshalScheduledRecipient(
+	a *taprpc.AddressWithAmount) tapfreighter.ScheduledSendRecipient {
+
+	return tapfreighter.ScheduledSendRecipient{
+		TapAddr: a.TapAddr,
+		Amount:  a.Amount,
+	}
+}
+
+// marshalScheduledRecipient turns a scheduled send recipient into an RPC
+// address with amount.
+func marshalScheduledRecipient(
+	r tapfreighter.ScheduledSendRecipient) *taprpc.AddressWithAmount {
+
+	return &taprpc.AddressWithAmount{
+		TapAddr: r.TapAddr,
+		Amount:  r.Amount,
+	}
+}
+
 // parseAndValidateAddresses parses the addresses with amounts from the RPC
 // request and validates them against the chain parameters. It also ensures
 // that all addresses are of the same asset specifier, as the wallet only
@@ -6612,30 +7128,114 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 	return resp, nil
 }
 
-func marshalAssetLeaf(ctx context.Context, keys rpcutils.KeyLookup,
-	assetLeaf *universe.Leaf,
-	decDisplay fn.Option[uint32]) (*unirpc.AssetLeaf, error) {
+// UniverseSubtrees returns the roots of the subtrees of a Universe tree at the
+// given depth, one for each of the given paths.
+func (r *rpcServer) UniverseSubtrees(ctx context.Context,
+	req *unirpc.UniverseSubtreesRequest) (*unirpc.UniverseSubtreesResponse,
+	error) {
 
-	// Decode the single proof to extract on-chain anchor info.
-	p, err := proof.Decode(assetLeaf.RawProof)
-	if err != nil {
-		return nil, err
+	if req == nil {
+		return nil, fmt.Errorf("request must be set")
 	}
-	chainAsset, err := p.ToChainAsset()
+
+	universeID, err := UnmarshalUniID(req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Marshal as a chain asset to include chain_anchor details.
-	rpcAsset, err := rpcutils.MarshalChainAsset(
-		ctx, chainAsset, decDisplay, true, keys,
-	)
-	if err != nil {
-		return nil, err
+	// If the proof type wasn't specified, then we'll return an error as we
+	// don't know which tree to actually query.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
 	}
 
-	return &unirpc.AssetLeaf{
-		Asset: rpcAsset,
+	if req.Depth < 0 || req.Depth > mssmt.MaxTreeLevels {
+		return nil, fmt.Errorf("invalid subtree depth: %d", req.Depth)
+	}
+
+	if len(req.Paths) > universe.RequestPageSize {
+		return nil, fmt.Errorf("too many subtree paths: %d, max %d",
+			len(req.Paths), universe.RequestPageSize)
+	}
+
+	paths := make([][32]byte, len(req.Paths))
+	for i, path := range req.Paths {
+		if len(path) != len(paths[i]) {
+			return nil, fmt.Errorf("invalid subtree path length: "+
+				"%d", len(path))
+		}
+
+		copy(paths[i][:], path)
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	subtrees, err := r.cfg.UniverseArchive.UniverseSubtrees(
+		ctx, universe.UniverseSubtreesQuery{
+			Id:    universeID,
+			Depth: int(req.Depth),
+			Paths: paths,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseSubtreesResponse{
+		Subtrees: fn.Map(subtrees, marshalUniverseSubtree),
+	}, nil
+}
+
+// marshalUniverseSubtree converts a Universe subtree root to its RPC
+// counterpart.
+func marshalUniverseSubtree(
+	subtree universe.UniverseSubtree) *unirpc.UniverseSubtree {
+
+	rpcSubtree := &unirpc.UniverseSubtree{
+		Depth:    int32(subtree.Depth),
+		Path:     fn.ByteSlice(subtree.Path),
+		NodeHash: fn.ByteSlice(subtree.NodeHash),
+		Sum:      subtree.Sum,
+	}
+
+	if subtree.LeafKey != nil {
+		rpcSubtree.LeafKey = fn.ByteSlice(*subtree.LeafKey)
+	}
+	if subtree.Key != nil {
+		rpcSubtree.AssetKey = marshalLeafKey(subtree.Key)
+	}
+
+	return rpcSubtree
+}
+
+func marshalAssetLeaf(ctx context.Context, keys rpcutils.KeyLookup,
+	assetLeaf *universe.Leaf,
+	decDisplay fn.Option[uint32]) (*unirpc.AssetLeaf, error) {
+
+	// Decode the single proof to extract on-chain anchor info.
+	p, err := proof.Decode(assetLeaf.RawProof)
+	if err != nil {
+		return nil, err
+	}
+	chainAsset, err := p.ToChainAsset()
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshal as a chain asset to include chain_anchor details.
+	rpcAsset, err := rpcutils.MarshalChainAsset(
+		ctx, chainAsset, decDisplay, true, keys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.AssetLeaf{
+		Asset: rpcAsset,
 		Proof: assetLeaf.RawProof,
 	}, nil
 }
@@ -7171,6 +7771,15 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 		return nil, err
 	}
 
+	// A read replica doesn't insert proofs locally, but forwards them to
+	// the primary universe server instead. The proof is then inserted
+	// locally with the next sync from the primary.
+	if r.cfg.UniverseReplica != nil {
+		return r.forwardInsertProof(
+			ctx, req.Key, universeID, leafKey, assetLeaf,
+		)
+	}
+
 	// Ensure proof insert is enabled for the given universe.
 	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
@@ -7182,30 +7791,138 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 			"given universe")
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	insert := func() (*universe.Proof, error) {
+		// Check the rate limiter to see if we need to wait at all. If
+		// not then this'll be a noop.
+		if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Debugf("[InsertProof]: inserting proof at "+
+			"(universeID=%v, leafKey=%x)",
+			universeID.StringForLog(), leafKey.UniverseKey())
+
+		newUniverseState, err := r.cfg.UniverseArchive.UpsertProofLeaf(
+			ctx, universeID, leafKey, assetLeaf,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		universeRootHash := newUniverseState.UniverseRoot.NodeHash()
+		rpcsLog.Debugf("[InsertProof]: proof inserted, new universe "+
+			"root: %x", universeRootHash[:])
+
+		return newUniverseState, nil
+	}
+
+	if r.cfg.UniverseWriteAuth == nil {
+		newUniverseState, err := insert()
+		if err != nil {
+			return nil, err
+		}
+
+		return r.marshalUniverseProofLeaf(
+			ctx, req.Key, newUniverseState,
+		)
+	}
+
+	// If universe writes require authentication, the insert must be signed
+	// by the issuer of the asset and the issuer must have write quota
+	// left. The quota is only consumed once the proof was validated and
+	// newly inserted, so rejected proofs and proofs that are already known
+	// don't count against it.
+	auth, err := universe.WriteAuthFromIncomingContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
-		"(universeID=%v, leafKey=%x)", universeID.StringForLog(),
-		leafKey.UniverseKey())
+	var newUniverseState *universe.Proof
+	insertNew := func() (bool, error) {
+		knownProof, err := r.knownUniverseProof(
+			ctx, universeID, leafKey,
+		)
+		if err != nil {
+			return false, err
+		}
 
-	newUniverseState, err := r.cfg.UniverseArchive.UpsertProofLeaf(
-		ctx, universeID, leafKey, assetLeaf,
+		newUniverseState, err = insert()
+		if err != nil {
+			return false, err
+		}
+
+		// The archive returns the known proof if the new one doesn't
+		// replace it.
+		return !bytes.Equal(
+			knownProof, newUniverseState.Leaf.RawProof,
+		), nil
+	}
+	err = r.cfg.UniverseWriteAuth.AuthorizedInsert(
+		ctx, universeID, leafKey, assetLeaf, auth, insertNew,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
-	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
-		universeRootHash[:])
-
 	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
 }
 
+// knownUniverseProof returns the raw proof the local universe knows at the
+// given leaf key, or nil if there is none.
+func (r *rpcServer) knownUniverseProof(ctx context.Context,
+	id universe.Identifier, key universe.LeafKey) ([]byte, error) {
+
+	proofs, err := r.cfg.UniverseArchive.FetchProofLeaf(ctx, id, key)
+	switch {
+	case errors.Is(err, universe.ErrNoUniverseProofFound):
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+
+	case len(proofs) == 0:
+		return nil, nil
+	}
+
+	return proofs[0].Leaf.RawProof, nil
+}
+
+// forwardInsertProof forwards a proof insert to the primary universe server of
+// the local read replica.
+func (r *rpcServer) forwardInsertProof(ctx context.Context,
+	uniKey *unirpc.UniverseKey, universeID universe.Identifier,
+	leafKey universe.LeafKey,
+	assetLeaf *universe.Leaf) (*unirpc.AssetProofResponse, error) {
+
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	primary := r.cfg.UniverseReplica.Primary()
+	rpcsLog.Debugf("[InsertProof]: forwarding proof to primary %v "+
+		"(universeID=%v, leafKey=%x)", primary.HostStr(),
+		universeID.StringForLog(), leafKey.UniverseKey())
+
+	// The primary authenticates the insert if it requires write
+	// authentication, so we pass on the issuer's signature.
+	auth, err := universe.WriteAuthFromIncomingContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		ctx = metadata.NewOutgoingContext(ctx, auth.Metadata())
+	}
+
+	newUniverseState, err := r.cfg.UniverseReplica.UpsertProofLeaf(
+		ctx, universeID, leafKey, assetLeaf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.marshalUniverseProofLeaf(ctx, uniKey, newUniverseState)
+}
+
 // PushProof attempts to query the local universe for a proof specified by a
 // UniverseKey. If found, a connection is made to a remote Universe server to
 // attempt to upload the asset leaf.
@@ -7227,6 +7944,12 @@ func (r *rpcServer) PushProof(ctx context.Context,
 			"and id")
 	}
 
+	// A read replica forwards the push to its primary universe server,
+	// which has the authoritative copy of the proof.
+	if r.cfg.UniverseReplica != nil {
+		return r.forwardPushProof(ctx, req)
+	}
+
 	remoteUniAddr := unmarshalUniverseServer(req.Server)
 	universeID, leafKey, err := unmarshalUniverseKey(req.Key)
 	if err != nil {
@@ -7256,6 +7979,22 @@ func (r *rpcServer) PushProof(ctx context.Context,
 		return nil, err
 	}
 
+	// If we issued the asset, we authenticate the insert in case the
+	// remote server requires it.
+	if r.cfg.UniverseWriteAuthSigner != nil {
+		auth, err := r.cfg.UniverseWriteAuthSigner.SignWriteAuth(
+			ctx, universeID, leafKey, localProof.Leaf,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if auth != nil {
+			ctx = metadata.NewOutgoingContext(
+				ctx, auth.Metadata(),
+			)
+		}
+	}
+
 	rpcsLog.Debugf("[PushProof]: pushing proof to universe "+
 		"(universeID=%v, server=%v", universeID.StringForLog(),
 		remoteUniAddr)
@@ -7276,15 +8015,72 @@ func (r *rpcServer) PushProof(ctx context.Context,
 	}, nil
 }
 
+// forwardPushProof forwards a proof push request to the primary universe
+// server of the local read replica.
+func (r *rpcServer) forwardPushProof(ctx context.Context,
+	req *unirpc.PushProofRequest) (*unirpc.PushProofResponse, error) {
+
+	// Universe server IDs are local to each server, so we can only forward
+	// requests that target the remote server by its host.
+	if req.Server.Host == "" {
+		return nil, fmt.Errorf("universe host must be specified when " +
+			"pushing proofs through a read replica")
+	}
+
+	primary := r.cfg.UniverseReplica.Primary()
+	conn, err := ConnectUniverse(primary)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe "+
+			"primary: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			rpcsLog.Warnf("Unable to close universe primary "+
+				"connection: %v", err)
+		}
+	}()
+
+	rpcsLog.Debugf("[PushProof]: forwarding push to primary %v "+
+		"(server=%v)", primary.HostStr(), req.Server.Host)
+
+	return conn.PushProof(ctx, req)
+}
+
 // Info returns a set of information about the current state of the Universe
 // and allows a caller to check that a universe server is reachable and
 // configured correctly to allow proof courier access without macaroons.
 func (r *rpcServer) Info(ctx context.Context,
 	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
 
-	return &unirpc.InfoResponse{
+	resp := &unirpc.InfoResponse{
 		RuntimeId: r.cfg.RuntimeID,
-	}, nil
+	}
+
+	// The Info RPC can be publicly readable, so we don't expose the
+	// primary or the sync error of a replica here.
+	if r.cfg.UniverseReplica != nil {
+		status := r.cfg.UniverseReplica.Status()
+		resp.Replica = &unirpc.ReplicaStatus{
+			CaughtUp:          status.CaughtUp,
+			LastSyncTimestamp: unixOrZero(status.LastSyncEnd),
+			LastSyncFailed:    status.LastSyncErr != nil,
+		}
+		resp.Replica.LastFullSyncTimestamp = unixOrZero(
+			status.LastFullSync,
+		)
+	}
+
+	return resp, nil
+}
+
+// unixOrZero returns the Unix timestamp of the given time, or zero if the time
+// isn't set. A zero time would otherwise result in a negative timestamp.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
 }
 
 // unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
@@ -7395,6 +8191,13 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 
 	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
 
+	// A read replica only syncs with its primary universe server, which
+	// it does on its own.
+	if r.cfg.UniverseReplica != nil {
+		return nil, fmt.Errorf("%w: unable to sync with %v",
+			universe.ErrReadOnlyReplica, uniAddr.HostStr())
+	}
+
 	// Obtain the general and universe specific federation sync configs.
 	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
 	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
@@ -7421,6 +8224,54 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 	return r.marshalUniverseDiff(ctx, universeDiff)
 }
 
+// SyncProgress returns the progress of the scheduled syncs with each Universe
+// server that was synced with since tapd was started.
+func (r *rpcServer) SyncProgress(_ context.Context,
+	_ *unirpc.SyncProgressRequest) (*unirpc.SyncProgressResponse, error) {
+
+	progress := r.cfg.UniverseSyncer.SyncProgress()
+
+	return &unirpc.SyncProgressResponse{
+		Servers: fn.Map(progress, marshalServerSyncProgress),
+	}, nil
+}
+
+// marshalServerSyncProgress converts the sync progress with a single Universe
+// server to its RPC counterpart.
+func marshalServerSyncProgress(
+	p universe.ServerSyncProgress) *unirpc.ServerSyncProgress {
+
+	var state unirpc.ServerSyncState
+	switch p.State {
+	case universe.ServerSyncActive:
+		state = unirpc.ServerSyncState_SERVER_SYNC_STATE_ACTIVE
+
+	case universe.ServerSyncBackoff:
+		state = unirpc.ServerSyncState_SERVER_SYNC_STATE_BACKOFF
+
+	default:
+		state = unirpc.ServerSyncState_SERVER_SYNC_STATE_IDLE
+	}
+
+	var lastSyncErr string
+	if p.LastSyncErr != nil {
+		lastSyncErr = p.LastSyncErr.Error()
+	}
+
+	return &unirpc.ServerSyncProgress{
+		ServerHost:             p.Server.HostStr(),
+		State:                  state,
+		RootsTotal:             int64(p.RootsTotal),
+		RootsSynced:            int64(p.RootsSynced),
+		LeavesSynced:           int64(p.LeavesSynced),
+		LastSyncStartTimestamp: unixOrZero(p.LastSyncStart),
+		LastSyncEndTimestamp:   unixOrZero(p.LastSyncEnd),
+		LastSyncError:          lastSyncErr,
+		ConsecutiveFailures:    int64(p.ConsecutiveFailures),
+		NextAttemptTimestamp:   unixOrZero(p.NextAttempt),
+	}
+}
+
 func marshalUniverseServer(
 	server universe.ServerAddr) *unirpc.UniverseFederationServer {
 
@@ -7510,6 +8361,103 @@ func (r *rpcServer) DeleteFederationServer(ctx context.Context,
 	return &unirpc.DeleteFederationServerResponse{}, nil
 }
 
+// parseIssuerKey parses the key of a universe issuer. Group keys are given in
+// their 33-byte compressed form, script keys can also be given in their 32-byte
+// x-only form.
+func parseIssuerKey(keyBytes []byte) (*btcec.PublicKey, error) {
+	switch len(keyBytes) {
+	case schnorr.PubKeyBytesLen:
+		return schnorr.ParsePubKey(keyBytes)
+
+	case btcec.PubKeyBytesLenCompressed:
+		return btcec.ParsePubKey(keyBytes)
+
+	default:
+		return nil, fmt.Errorf("unknown issuer key length: %v",
+			len(keyBytes))
+	}
+}
+
+// marshalWriteQuota marshals a universe write quota into its RPC counterpart.
+func marshalWriteQuota(quota universe.WriteQuota) *unirpc.WriteQuota {
+	return &unirpc.WriteQuota{
+		IssuerKey:  quota.IssuerKey.SerializeCompressed(),
+		MaxProofs:  quota.MaxProofs,
+		MaxBytes:   quota.MaxBytes,
+		UsedProofs: quota.UsedProofs,
+		UsedBytes:  quota.UsedBytes,
+	}
+}
+
+// SetWriteQuota adds an issuer to the write allowlist of the local universe
+// server, or updates the limits of its existing write quota.
+func (r *rpcServer) SetWriteQuota(ctx context.Context,
+	req *unirpc.SetWriteQuotaRequest) (*unirpc.SetWriteQuotaResponse,
+	error) {
+
+	issuerKey, err := parseIssuerKey(req.IssuerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer key: %w", err)
+	}
+
+	err = r.cfg.UniverseWriteQuotas.UpsertWriteQuota(
+		ctx, universe.WriteQuota{
+			IssuerKey: issuerKey,
+			MaxProofs: req.MaxProofs,
+			MaxBytes:  req.MaxBytes,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := r.cfg.UniverseWriteQuotas.FetchWriteQuota(
+		ctx, issuerKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SetWriteQuotaResponse{
+		Quota: marshalWriteQuota(*quota),
+	}, nil
+}
+
+// ListWriteQuotas lists the write quotas of all issuers on the write
+// allowlist of the local universe server.
+func (r *rpcServer) ListWriteQuotas(ctx context.Context,
+	_ *unirpc.ListWriteQuotasRequest) (*unirpc.ListWriteQuotasResponse,
+	error) {
+
+	quotas, err := r.cfg.UniverseWriteQuotas.ListWriteQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.ListWriteQuotasResponse{
+		Quotas: fn.Map(quotas, marshalWriteQuota),
+	}, nil
+}
+
+// DeleteWriteQuota removes an issuer from the write allowlist of the local
+// universe server.
+func (r *rpcServer) DeleteWriteQuota(ctx context.Context,
+	req *unirpc.DeleteWriteQuotaRequest) (*unirpc.DeleteWriteQuotaResponse,
+	error) {
+
+	issuerKey, err := parseIssuerKey(req.IssuerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer key: %w", err)
+	}
+
+	err = r.cfg.UniverseWriteQuotas.DeleteWriteQuota(ctx, issuerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.DeleteWriteQuotaResponse{}, nil
+}
+
 // SetFederationSyncConfig sets the configuration of the universe federation
 // sync.
 func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
@@ -7938,6 +8886,175 @@ func (r *rpcServer) QueryEvents(ctx context.Context,
 	return rpcStats, nil
 }
 
+// SearchAssets returns a page of the assets known to the Universe that match
+// all of the given filters.
+func (r *rpcServer) SearchAssets(ctx context.Context,
+	req *unirpc.SearchAssetsRequest) (*unirpc.SearchAssetsResponse, error) {
+
+	query := universe.AssetSearchQuery{
+		Cursor: req.Cursor,
+		Limit:  int(req.Limit),
+	}
+
+	if req.NamePrefix != "" {
+		query.NamePrefix = fn.Some(req.NamePrefix)
+	}
+
+	switch req.AssetTypeFilter {
+	case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
+		query.AssetType = fn.Some(asset.Normal)
+
+	case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
+		query.AssetType = fn.Some(asset.Collectible)
+	}
+
+	if req.FilterMetaType {
+		metaType, err := proof.IsValidMetaType(req.MetaType)
+		if err != nil {
+			return nil, err
+		}
+
+		query.MetaType = fn.Some(metaType)
+	}
+
+	if req.FilterDecimalDisplay {
+		query.DecimalDisplay = fn.Some(req.DecimalDisplay)
+	}
+	if req.MinGenesisHeight != 0 {
+		query.MinGenesisHeight = fn.Some(req.MinGenesisHeight)
+	}
+	if req.MaxGenesisHeight != 0 {
+		query.MaxGenesisHeight = fn.Some(req.MaxGenesisHeight)
+	}
+
+	if len(req.GroupKey) != 0 {
+		groupKey, err := btcec.ParsePubKey(req.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+
+		query.GroupKey = groupKey
+	}
+
+	switch req.GroupFilter {
+	case unirpc.AssetGroupFilter_FILTER_GROUP_GROUPED:
+		query.Grouped = fn.Some(true)
+
+	case unirpc.AssetGroupFilter_FILTER_GROUP_UNGROUPED:
+		query.Grouped = fn.Some(false)
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := r.cfg.UniverseSearcher.SearchAssets(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SearchAssetsResponse{
+		Assets:     fn.Map(result.Assets, marshalSearchedAsset),
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// marshalSearchedAsset converts an asset that matched an asset search to its
+// RPC counterpart.
+func marshalSearchedAsset(
+	entry universe.AssetSearchEntry) *unirpc.SearchedAsset {
+
+	rpcAsset := &unirpc.SearchedAsset{
+		AssetId:        fn.ByteSlice(entry.AssetID),
+		AssetName:      entry.AssetName,
+		AssetType:      taprpc.AssetType(entry.AssetType),
+		GenesisPoint:   entry.GenesisPoint.String(),
+		AnchorPoint:    entry.AnchorPoint.String(),
+		GenesisHeight:  entry.GenesisHeight,
+		DecimalDisplay: entry.DecimalDisplay,
+	}
+
+	if entry.GroupKey != nil {
+		rpcAsset.GroupKey = entry.GroupKey.SerializeCompressed()
+	}
+
+	entry.MetaType.WhenSome(func(metaType proof.MetaType) {
+		rpcAsset.HasMetaType = true
+		rpcAsset.MetaType = taprpc.AssetMetaType(metaType)
+	})
+
+	return rpcAsset
+}
+
+// SearchLeaves returns a page of the leaves across all Universes that have the
+// given script key or outpoint.
+func (r *rpcServer) SearchLeaves(ctx context.Context,
+	req *unirpc.SearchLeavesRequest) (*unirpc.SearchLeavesResponse, error) {
+
+	proofType, err := UnmarshalUniProofType(req.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := universe.LeafSearchQuery{
+		ProofType: proofType,
+		Cursor:    req.Cursor,
+		Limit:     int(req.Limit),
+	}
+
+	if len(req.ScriptKey) != 0 {
+		query.ScriptKey, err = parseUserKey(req.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid script key: %w", err)
+		}
+	}
+
+	if req.Outpoint != "" {
+		query.OutPoint, err = wire.NewOutPointFromString(req.Outpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outpoint: %w", err)
+		}
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := r.cfg.UniverseSearcher.SearchLeaves(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := fn.MapErr(
+		result.Leaves, func(entry universe.LeafSearchEntry) (
+			*unirpc.SearchedLeaf, error) {
+
+			uniID, err := MarshalUniID(entry.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			return &unirpc.SearchedLeaf{
+				Id:      uniID,
+				LeafKey: marshalLeafKey(entry.Key),
+				AssetId: fn.ByteSlice(entry.AssetID),
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.SearchLeavesResponse{
+		Leaves:     leaves,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
 // RemoveUTXOLease removes the lease/lock/reservation of the given managed
 // UTXO.
 func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
@@ -8580,6 +9697,21 @@ func (r *rpcServer) QueryPeerAcceptedQuotes(_ context.Context,
 	}, nil
 }
 
+// QueryCollectedForwardFees is used to query for the forwarding fees in asset
+// units that our node collected for settled asset HTLCs.
+func (r *rpcServer) QueryCollectedForwardFees(_ context.Context,
+	_ *rfqrpc.QueryCollectedForwardFeesRequest) (
+	*rfqrpc.QueryCollectedForwardFeesResponse, error) {
+
+	collectedFees := r.cfg.RfqManager.CollectedForwardFees()
+
+	return &rfqrpc.QueryCollectedForwardFeesResponse{
+		CollectedFees: fn.Map(
+			collectedFees, rfq.MarshalCollectedForwardFees,
+		),
+	}, nil
+}
+
 // marshallRfqEvent marshals an RFQ event into the RPC form.
 func marshallRfqEvent(eventInterface fn.Event) (*rfqrpc.RfqEvent, error) {
 	timestamp := eventInterface.Timestamp().UTC().UnixMicro()
@@ -9723,8 +10855,25 @@ func validateInvoiceAmount(acceptedQuote *rfqrpc.PeerAcceptedBuyQuote,
 		}
 
 	default:
+		// If the edge node charges a forwarding fee, it deducts the fee
+		// from the assets it sends us. So the invoice needs to pay for
+		// the requested amount plus the fee.
+		grossUnits := invoiceUnits
+		forwardFee := rfq.UnmarshalForwardFee(acceptedQuote.ForwardFee)
+		err = fn.MapOptionZ(
+			forwardFee, func(fee rfqmsg.ForwardFee) error {
+				var err error
+				grossUnits, err = fee.GrossAmount(invoiceUnits)
+				return err
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("error adding forward fee: %w",
+				err)
+		}
+
 		// Convert the asset amount into a fixed-point.
-		assetAmount := rfqmath.NewBigIntFixedPoint(invoiceUnits, 0)
+		assetAmount := rfqmath.NewBigIntFixedPoint(grossUnits, 0)
 
 		// Calculate the invoice amount in msat.
 		newInvoiceAmtMsat = rfqmath.UnitsToMilliSatoshi(
@@ -10453,6 +11602,152 @@ func (r *rpcServer) RegisterTransfer(ctx context.Context,
 	}, nil
 }
 
+// ConsolidateAssets merges many small outputs of the same asset into a single
+// output per asset ID by spending them back to the local node.
+func (r *rpcServer) ConsolidateAssets(ctx context.Context,
+	req *taprpc.ConsolidateAssetsRequest) (*taprpc.ConsolidateAssetsResponse,
+	error) {
+
+	var specifier asset.Specifier
+	switch {
+	case len(req.GetAssetId()) > 0:
+		if len(req.GetAssetId()) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.GetAssetId())
+		specifier = asset.NewSpecifierFromId(assetID)
+
+	case len(req.GetGroupKey()) > 0:
+		groupKey, err := btcec.ParsePubKey(req.GetGroupKey())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group key: %w",
+				err)
+		}
+
+		specifier = asset.NewSpecifierFromGroupKey(*groupKey)
+
+	default:
+		return nil, fmt.Errorf("asset ID or group key must be specified")
+	}
+
+	result, consolidateErr := r.cfg.Consolidator.Consolidate(
+		ctx, &tapfreighter.ConsolidationRequest{
+			AssetSpecifier:  specifier,
+			MaxInputs:       int(req.MaxInputs),
+			MaxTransactions: int(req.MaxTransactions),
+			DryRun:          req.DryRun,
+			Label:           req.Label,
+		},
+	)
+
+	// A consolidation that fails after the first transaction still
+	// returns the transfers that were already broadcast, so we report
+	// them together with the error.
+	if consolidateErr != nil && result == nil {
+		return nil, fmt.Errorf("unable to consolidate assets: %w",
+			consolidateErr)
+	}
+
+	resp := &taprpc.ConsolidateAssetsResponse{
+		NumTransactions: uint32(result.NumTransactions),
+		NumInputs:       uint32(result.NumInputs),
+		NumAnchorInputs: uint32(result.NumAnchorInputs),
+		NumOutputs:      uint32(result.NumOutputs),
+		TotalAmount:     result.TotalAmount,
+		SatPerKw:        uint32(result.FeeRate),
+		ChainFeesSats:   int64(result.ChainFees),
+		Transfers: make(
+			[]*taprpc.AssetTransfer, len(result.Transfers),
+		),
+	}
+	for idx := range result.Transfers {
+		rpcTransfer, err := marshalOutboundParcel(
+			result.Transfers[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling outbound "+
+				"parcel: %w", err)
+		}
+
+		resp.Transfers[idx] = rpcTransfer
+	}
+
+	if consolidateErr != nil {
+		resp.Error = consolidateErr.Error()
+	}
+
+	return resp, nil
+}
+
+// CreateBackup creates a consistent backup of the asset database while the
+// daemon is running.
+func (r *rpcServer) CreateBackup(ctx context.Context,
+	_ *taprpc.CreateBackupRequest) (*taprpc.CreateBackupResponse, error) {
+
+	var archive bytes.Buffer
+	header, err := r.cfg.DatabaseBackup.CreateBackup(ctx, &archive)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create backup: %w", err)
+	}
+
+	rpcsLog.Infof("Created database backup of %d bytes", archive.Len())
+
+	return &taprpc.CreateBackupResponse{
+		Archive: archive.Bytes(),
+		Info:    marshalBackupHeader(header),
+	}, nil
+}
+
+// RestoreBackup verifies a backup archive and stages it to be restored on the
+// next start of the daemon.
+func (r *rpcServer) RestoreBackup(ctx context.Context,
+	req *taprpc.RestoreBackupRequest) (*taprpc.RestoreBackupResponse,
+	error) {
+
+	header, err := r.cfg.DatabaseBackup.VerifyBackup(
+		bytes.NewReader(req.Archive),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup: %w", err)
+	}
+
+	// We refuse the backup now already if it can't be restored on the
+	// next start. The check is repeated when the backup is restored.
+	if err := r.cfg.DatabaseBackup.CheckNoWalletData(ctx); err != nil {
+		return nil, err
+	}
+
+	// We write the archive to a temporary file first, so a partially
+	// written archive is never picked up on the next start.
+	tempPath := r.cfg.StagedBackupPath + ".tmp"
+	if err := os.WriteFile(tempPath, req.Archive, 0600); err != nil {
+		return nil, fmt.Errorf("unable to write backup: %w", err)
+	}
+	if err := os.Rename(tempPath, r.cfg.StagedBackupPath); err != nil {
+		return nil, fmt.Errorf("unable to stage backup: %w", err)
+	}
+
+	rpcsLog.Infof("Staged database backup created at %v, restart tapd "+
+		"to restore it", header.CreationTime)
+
+	return &taprpc.RestoreBackupResponse{
+		Info: marshalBackupHeader(header),
+	}, nil
+}
+
+// marshalBackupHeader turns a backup header into its RPC counterpart.
+func marshalBackupHeader(header *tapdb.BackupHeader) *taprpc.BackupInfo {
+	return &taprpc.BackupInfo{
+		Version:           header.Version,
+		DatabaseVersion:   uint32(header.DatabaseVersion),
+		Backend:           header.Backend,
+		NodeKey:           header.NodeKey,
+		CreationTimestamp: header.CreationTime.Unix(),
+	}
+}
+
 // ProofVerifierCtx returns a proof.VerifierCtx that can be used to verify
 // proofs in the RPC server.
 func (r *rpcServer) ProofVerifierCtx(ctx context.Context) proof.VerifierCtx {
@@ -10461,10 +11756,11 @@ func (r *rpcServer) ProofVerifierCtx(ctx context.Context) proof.VerifierCtx {
 
 	var ignoreChecker proof.IgnoreChecker = r.cfg.IgnoreChecker
 	return proof.VerifierCtx{
-		HeaderVerifier: headerVerifier,
-		MerkleVerifier: proof.DefaultMerkleVerifier,
-		GroupVerifier:  groupVerifier,
-		ChainLookupGen: r.cfg.ChainBridge,
-		IgnoreChecker:  lfn.Some(ignoreChecker),
+		HeaderVerifier:    headerVerifier,
+		MerkleVerifier:    proof.DefaultMerkleVerifier,
+		GroupVerifier:     groupVerifier,
+		ChainLookupGen:    r.cfg.ChainBridge,
+		IgnoreChecker:     lfn.Some(ignoreChecker),
+		VerificationCache: r.cfg.VerificationCache,
 	}
 }
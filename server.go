@@ -195,11 +195,36 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %w", err)
 	}
 
+	if err := s.cfg.SendScheduler.Start(); err != nil {
+		return fmt.Errorf("unable to start send scheduler: %w", err)
+	}
+
+	if err := s.cfg.BalanceHistoryRecorder.Start(); err != nil {
+		return fmt.Errorf("unable to start balance history "+
+			"recorder: %w", err)
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %w", err)
 	}
 
+	if s.cfg.UniverseReplica != nil {
+		if err := s.cfg.UniverseReplica.Start(); err != nil {
+			return fmt.Errorf("unable to start universe "+
+				"replica: %w", err)
+		}
+	}
+
+	// Start the static price oracle, if configured, before the RFQ
+	// manager starts querying it.
+	if s.cfg.StaticPriceOracle != nil {
+		if err := s.cfg.StaticPriceOracle.Start(); err != nil {
+			return fmt.Errorf("unable to start static price "+
+				"oracle: %w", err)
+		}
+	}
+
 	// Start the request for quote (RFQ) manager.
 	if err := s.cfg.RfqManager.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ manager: %w", err)
@@ -242,8 +267,10 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 	}
 
 	// If the server is configured to sync all assets by default, we'll set
-	// the universe federation to allow public access.
-	if s.cfg.UniFedSyncAllAssets {
+	// the universe federation to allow public access. A read replica
+	// mirrors all universes of its primary anyway and its sync configs
+	// can't be changed.
+	if s.cfg.UniFedSyncAllAssets && s.cfg.UniverseReplica == nil {
 		err := s.cfg.UniverseFederation.SetConfigSyncAllAssets()
 		if err != nil {
 			return fmt.Errorf("unable to set public access "+
@@ -458,6 +485,12 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// minter.
 		s.cfg.Prometheus.AssetMinter = s.cfg.AssetMinter
 
+		// Provide Prometheus collectors with access to the RFQ
+		// manager, the proof courier stats and the chain porter.
+		s.cfg.Prometheus.RfqManager = s.cfg.RfqManager
+		s.cfg.Prometheus.CourierStats = s.cfg.ProofCourierStats
+		s.cfg.Prometheus.ChainPorter = s.cfg.ChainPorter
+
 		s.cfg.Prometheus.CacheStats = func(hits map[string]int64,
 			misses map[string]int64) {
 
@@ -465,6 +498,12 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 				hits, misses,
 			)
 			s.cfg.IgnoreChecker.CollectCacheStats(hits, misses)
+
+			if s.cfg.PriceOracleCache != nil {
+				s.cfg.PriceOracleCache.CollectCacheStats(
+					hits, misses,
+				)
+			}
 		}
 
 		promExporter, err := monitoring.NewPrometheusExporter(
@@ -752,6 +791,14 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.SendScheduler.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.BalanceHistoryRecorder.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.ChainPorter.Stop(); err != nil {
 		return err
 	}
@@ -760,10 +807,22 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if s.cfg.UniverseReplica != nil {
+		if err := s.cfg.UniverseReplica.Stop(); err != nil {
+			return err
+		}
+	}
+
 	if err := s.cfg.RfqManager.Stop(); err != nil {
 		return err
 	}
 
+	if s.cfg.StaticPriceOracle != nil {
+		if err := s.cfg.StaticPriceOracle.Stop(); err != nil {
+			return err
+		}
+	}
+
 	// Stop FSM daemon adapters.
 	if err := s.cfg.FsmDaemonAdapters.Stop(); err != nil {
 		return fmt.Errorf("unable to stop FSM daemon adapters: %w",
@@ -926,9 +985,20 @@ func (s *Server) ApplyHtlcView(
 
 	// The aux leaf creator is fully stateless, and we don't need to wait
 	// for the server to be started before being able to use it.
-	return tapchannel.ApplyHtlcView(
+	result := tapchannel.ApplyHtlcView(
 		s.chainParams, in, s.cfg.AuxChanNegotiator,
 	)
+
+	// The balance history recorder only queues the new commitment, so we
+	// also don't need to wait for the server to be started here. The
+	// snapshots are persisted once the recorder is started.
+	result.WhenOk(func(blob lfn.Option[tlv.Blob]) {
+		blob.WhenSome(func(b tlv.Blob) {
+			s.cfg.BalanceHistoryRecorder.RecordCommitment(in, b)
+		})
+	})
+
+	return result
 }
 
 // InlineParseCustomData replaces any custom data binary blob in the given RPC
@@ -27,6 +27,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -173,6 +175,10 @@ var (
 
 	defaultSqliteDatabaseFileName = "tapd.db"
 
+	// stagedBackupFileName is the name of the file in the network
+	// directory a database backup is staged at by the RestoreBackup RPC.
+	stagedBackupFileName = "restore.backup"
+
 	// defaultLndMacaroon is the default macaroon file we use if the old,
 	// deprecated --lnd.macaroondir config option is used.
 	defaultLndMacaroon = "admin.macaroon"
@@ -298,6 +304,10 @@ type WalletConfig struct {
 	// SweepOrphanUtxos, when true, sweeps orphaned UTXOs into anchor
 	// transactions created during sends and burns.
 	SweepOrphanUtxos bool `long:"sweep-orphan-utxos" description:"Sweep orphaned UTXOs into anchor transactions created during sends and burns. Disabled by default."`
+
+	// CoinSelectStrategy is the strategy used to select the asset coins
+	// that fund sends and virtual packets.
+	CoinSelectStrategy string `long:"coin-select-strategy" description:"The strategy used to select the asset coins that fund sends and virtual packets." choice:"largest-first" choice:"smallest-first" choice:"branch-and-bound" choice:"privacy-aware"`
 }
 
 // UniverseConfig is the config that houses any Universe related config
@@ -323,9 +333,15 @@ type UniverseConfig struct {
 
 	MultiverseCaches *tapdb.MultiverseCacheConfig `group:"multiverse-caches" namespace:"multiverse-caches"`
 
+	Sync *universe.SyncSchedulerConfig `group:"sync" namespace:"sync"`
+
+	Replica *universe.ReplicaConfig `group:"replica" namespace:"replica"`
+
 	SupplyIgnoreCacheSize uint64 `long:"supply-ignore-cache-size" description:"The maximum number of entries in the supply ignore checker's negative lookup LRU cache."`
 
 	DisableSupplyVerifierChainWatch bool `long:"disable-supply-verifier-chain-watch" description:"Disable chain outpoint watching in supply verifier. If true, the supply verifier will not start state machines to watch on-chain outputs for spends. This option is intended for universe servers, where supply verification should only occur for commitments submitted by peers, not via on-chain spend detection."`
+
+	RequireWriteAuth bool `long:"require-write-auth" description:"If set, proof inserts are only accepted if they are signed by the issuer of the asset (the group key for grouped assets, the script key the asset was issued to otherwise) and within the write quota of the issuer. Issuers without a write quota are rejected."`
 }
 
 // AddrBookConfig is the config that houses any address Book related config
@@ -380,6 +396,7 @@ type Config struct {
 	DatabaseBackend string                `long:"databasebackend" description:"The database backend to use for storing all asset related data." choice:"sqlite" choice:"postgres"`
 	Sqlite          *tapdb.SqliteConfig   `group:"sqlite" namespace:"sqlite"`
 	Postgres        *tapdb.PostgresConfig `group:"postgres" namespace:"postgres"`
+	RestoreBackup   string                `long:"restorebackup" description:"The path to a database backup archive that should be restored into the empty database on startup. The backup must have been created for the same lnd node. Remove the option again once the backup was restored."`
 
 	Universe *UniverseConfig `group:"universe" namespace:"universe"`
 
@@ -492,13 +509,20 @@ func DefaultConfig() Config {
 			MultiverseCaches: fn.Ptr(
 				tapdb.DefaultMultiverseCacheConfig(),
 			),
+			Sync: fn.Ptr(
+				universe.DefaultSyncSchedulerConfig(),
+			),
+			Replica: fn.Ptr(
+				universe.DefaultReplicaConfig(),
+			),
 			MboxAuthTimeout:                 defaultMailboxAuthTimeout,
 			SupplyIgnoreCacheSize:           tapdb.DefaultNegativeLookupCacheSize,
 			DisableSupplyVerifierChainWatch: false,
 		},
 		Wallet: &WalletConfig{
-			PsbtMaxFeeRatio:  DefaultPsbtMaxFeeRatio,
-			SweepOrphanUtxos: false,
+			PsbtMaxFeeRatio:    DefaultPsbtMaxFeeRatio,
+			SweepOrphanUtxos:   false,
+			CoinSelectStrategy: tapfreighter.PreferMaxAmount.String(),
 		},
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
@@ -508,26 +532,35 @@ func DefaultConfig() Config {
 		},
 		Experimental: &ExperimentalConfig{
 			Rfq: rfq.CliConfig{
-				AcceptPriceDeviationPpm: rfq.DefaultAcceptPriceDeviationPpm,
+				AcceptPriceDeviationPpm:        rfq.DefaultAcceptPriceDeviationPpm,
+				StaticOracleExpiry:             rfq.DefaultStaticRateExpiry,
+				AggregateOraclePolicy:          rfq.AggregateMedian.String(),
+				AggregateOracleMaxDeviationPpm: rfq.DefaultAggregateMaxDeviationPpm,
+				AggregateOracleTimeout:         rfq.DefaultAggregateOracleTimeout,
+				PriceOracleCacheSize:           rfq.DefaultOracleCacheMaxEntries,
+				PeerRequestBurst:               rfq.DefaultPeerRequestBurst,
 			},
 		},
 	}
 }
 
 // LoadConfig initializes and parses the config using a config file and command
-// line options.
+// line options. The command line arguments that aren't options, such as the
+// name of a subcommand, are returned as well.
 //
 // The configuration proceeds as follows:
 //  1. Start with a default config with sane settings
 //  2. Pre-parse the command line to check for an alternative config file
 //  3. Load configuration file overwriting defaults with any specified options
 //  4. Parse CLI options and overwrite/add any specified options
-func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error) {
+func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger,
+	[]string, error) {
+
 	// Pre-parse the command line options to pick up an alternative config
 	// file.
 	preCfg := DefaultConfig()
 	if _, err := flags.Parse(&preCfg); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Show the version and exit if the version flag was specified.
@@ -560,8 +593,8 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 	// exist under that path to avoid surprises.
 	case configFilePath != DefaultConfigFile:
 		if !fileExists(configFilePath) {
-			return nil, nil, fmt.Errorf("specified config file does "+
-				"not exist in %s", configFilePath)
+			return nil, nil, nil, fmt.Errorf("specified config "+
+				"file does not exist in %s", configFilePath)
 		}
 	}
 
@@ -575,7 +608,7 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 		// immediately, otherwise we can proceed as possibly the config
 		// file doesn't exist which is OK.
 		if _, ok := err.(*flags.IniError); ok {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		configFileError = err
@@ -584,8 +617,9 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 	// Finally, parse the remaining command line options again to ensure
 	// they take precedence.
 	flagParser := flags.NewParser(&cfg, flags.Default)
-	if _, err := flagParser.Parse(); err != nil {
-		return nil, nil, err
+	args, err := flagParser.Parse()
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	cfgLogger := cfg.LogMgr.GenSubLogger("CONF", nil)
@@ -599,7 +633,7 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 		}
 
 		cfgLogger.Warnf("Error validating config: %v", err)
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Initialize the log manager with the actual logging configuration. We
@@ -632,7 +666,7 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 	)
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err.Error())
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Parse, validate, and set debug log level(s).
@@ -640,7 +674,7 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 	if err != nil {
 		str := "error parsing debug level: %v"
 		cfgLogger.Warnf(str, err)
-		return nil, nil, fmt.Errorf(str, err)
+		return nil, nil, nil, fmt.Errorf(str, err)
 	}
 
 	// Warn about missing config file only after all other configuration is
@@ -650,7 +684,7 @@ func LoadConfig(interceptor signal.Interceptor) (*Config, btclog.Logger, error)
 		cfgLogger.Warnf("%v", configFileError)
 	}
 
-	return cleanCfg, cfgLogger, nil
+	return cleanCfg, cfgLogger, args, nil
 }
 
 // usageError is an error type that signals a problem with the supplied flags.
@@ -716,6 +750,7 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 	cfg.RpcConf.TLSKeyPath = CleanAndExpandPath(cfg.RpcConf.TLSKeyPath)
 	cfg.LogDir = CleanAndExpandPath(cfg.LogDir)
 	cfg.RpcConf.MacaroonPath = CleanAndExpandPath(cfg.RpcConf.MacaroonPath)
+	cfg.RestoreBackup = CleanAndExpandPath(cfg.RestoreBackup)
 
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
@@ -969,6 +1004,27 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 			"range of 0.00 to 1.00")
 	}
 
+	// Make sure the universe sync scheduler config is sane.
+	err = cfg.Universe.Sync.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid universe sync config: %w", err)
+	}
+
+	// Make sure the universe replica config is sane.
+	err = cfg.Universe.Replica.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid universe replica config: %w",
+			err)
+	}
+
+	// Make sure the configured coin selection strategy is known.
+	_, err = tapfreighter.ParseCoinSelectStrategy(
+		cfg.Wallet.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid coin-select-strategy: %w", err)
+	}
+
 	// All good, return the sanitized result.
 	return &cfg, nil
 }
@@ -0,0 +1,64 @@
+package tapcfg
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btclog/v2"
+	"github.com/lightninglabs/taproot-assets/tapdb"
+)
+
+const (
+	// MigratePostgresCommand is the name of the tapd subcommand that copies
+	// the SQLite database of tapd into an empty Postgres database.
+	MigratePostgresCommand = "migrate-postgres"
+)
+
+// MigrateToPostgres copies every table of the SQLite database configured with
+// the sqlite.* options into the empty Postgres database configured with the
+// postgres.* options. tapd must be stopped and the SQLite database must be at
+// the migration version of this release, which is the case once tapd of the
+// same release has been started with it. The Postgres database is migrated to
+// the same version before the data is copied. The SQLite database is left
+// unchanged.
+func MigrateToPostgres(ctx context.Context, cfg *Config,
+	cfgLogger btclog.Logger) (*tapdb.DatabaseCopyStats, error) {
+
+	// Opening a SQLite database that doesn't exist would create a new,
+	// empty one.
+	sqliteCfg := *cfg.Sqlite
+	if _, err := os.Stat(sqliteCfg.DatabaseFileName); err != nil {
+		return nil, fmt.Errorf("unable to open SQLite database: %w",
+			err)
+	}
+
+	// The source database is only read, so it must already be at the
+	// latest migration version.
+	sqliteCfg.SkipMigrations = true
+
+	cfgLogger.Infof("Opening sqlite3 database at: %v",
+		sqliteCfg.DatabaseFileName)
+	srcDB, err := tapdb.NewSqliteStore(&sqliteCfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open SQLite database: %w",
+			err)
+	}
+	defer srcDB.Close()
+
+	cfgLogger.Infof("Opening postgres database at: %v",
+		cfg.Postgres.DSN(true))
+	dstDB, err := tapdb.NewPostgresStore(cfg.Postgres)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open Postgres database: %w",
+			err)
+	}
+	defer dstDB.Close()
+
+	stats, err := tapdb.CopyDatabase(ctx, srcDB, dstDB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate database: %w", err)
+	}
+
+	return stats, nil
+}
@@ -6,7 +6,10 @@ import (
 	"database/sql"
 	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btclog/v2"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
@@ -18,6 +21,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapchannel"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
 	"github.com/lightninglabs/taproot-assets/tapdb"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapfeatures"
@@ -85,6 +89,53 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		return nil, fmt.Errorf("unable to open database: %w", err)
 	}
 
+	// Backups of the database are tied to the identity key of the lnd
+	// node, which is derived from the same seed as all asset keys.
+	nodeKey, err := btcec.ParsePubKey(lndServices.NodePubkey[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse lnd node key: %w", err)
+	}
+	dbBackup := tapdb.NewBackupManager(db, nodeKey)
+
+	// If requested, we restore a backup into the fresh database before
+	// any of the subsystems get a chance to write to it.
+	if cfg.RestoreBackup != "" {
+		cfgLogger.Infof("Restoring database backup from %v",
+			cfg.RestoreBackup)
+
+		header, err := restoreDatabaseBackup(
+			dbBackup, cfg.RestoreBackup,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to restore database "+
+				"backup: %w", err)
+		}
+
+		cfgLogger.Infof("Restored database backup created at %v",
+			header.CreationTime)
+	}
+
+	// A backup that was staged with the RestoreBackup RPC replaces the
+	// content of the database, as long as it doesn't contain any wallet
+	// data yet.
+	stagedBackupPath := filepath.Join(cfg.networkDir, stagedBackupFileName)
+	if fileExists(stagedBackupPath) {
+		cfgLogger.Infof("Restoring staged database backup from %v",
+			stagedBackupPath)
+
+		header, err := replaceWithStagedBackup(
+			dbBackup, stagedBackupPath,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to restore staged "+
+				"database backup, remove %v to start without "+
+				"restoring it: %w", stagedBackupPath, err)
+		}
+
+		cfgLogger.Infof("Restored staged database backup created at %v",
+			header.CreationTime)
+	}
+
 	defaultClock := clock.NewDefaultClock()
 	rksDB := tapdb.NewTransactionExecutor(
 		db, func(tx *sql.Tx) tapdb.KeyStore {
@@ -216,6 +267,20 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	})
 
 	ignoreCheckerOpt := lfn.Some[proof.IgnoreChecker](ignoreChecker)
+
+	// The proof verification cache allows us to only verify the new proofs
+	// of proof files that we've already (partially) verified before.
+	verifiedProofStore := tapdb.NewVerifiedProofStore(
+		tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.VerifiedProofQueries {
+				return db.WithTx(tx)
+			},
+		), defaultClock,
+	)
+	verificationCacheOpt := lfn.Some[proof.VerificationCache](
+		verifiedProofStore,
+	)
+
 	uniArchiveCfg := universe.ArchiveConfig{
 		// nolint: lll
 		NewBaseTree: func(id universe.Identifier) universe.StorageBackend {
@@ -230,6 +295,8 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		Multiverse:           multiverse,
 		UniverseStats:        universeStats,
 		IgnoreChecker:        ignoreCheckerOpt,
+		VerificationCache:    verificationCacheOpt,
+		ReadOnly:             cfg.Universe.Replica.Enabled(),
 	}
 
 	federationStore := tapdb.NewTransactionExecutor(db,
@@ -241,6 +308,13 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		federationStore, defaultClock,
 	)
 
+	// A read replica only syncs with its primary universe server, so its
+	// federation can't be changed.
+	var uniFederationDB universe.FederationDB = federationDB
+	if cfg.Universe.Replica.Enabled() {
+		uniFederationDB = universe.NewReadOnlyFederationDB(federationDB)
+	}
+
 	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %w", err)
@@ -381,10 +455,12 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 
 	reOrgWatcher := tapgarden.NewReOrgWatcher(&tapgarden.ReOrgWatcherConfig{
-		ChainBridge:   chainBridge,
-		GroupVerifier: groupVerifier,
-		ProofArchive:  proofArchive,
-		IgnoreChecker: ignoreCheckerOpt,
+		ChainBridge:       chainBridge,
+		GroupVerifier:     groupVerifier,
+		ProofArchive:      proofArchive,
+		AnchorTxStore:     assetStore,
+		IgnoreChecker:     ignoreCheckerOpt,
+		VerificationCache: verificationCacheOpt,
 		NonBuriedAssetFetcher: func(ctx context.Context,
 			minHeight int32) ([]*asset.ChainAsset, error) {
 
@@ -400,11 +476,22 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	uniArchive := universe.NewArchive(uniArchiveCfg)
 
+	// The issuer signer authenticates the proofs of the assets we issued
+	// when we push them to universe servers that require it.
+	universeIssuerSigner := universe.NewIssuerSigner(
+		universe.IssuerSignerCfg{
+			Signer:                 lndServices.Signer,
+			KeyStore:               assetMintingStore,
+			FetchIssuanceScriptKey: uniArchive.IssuanceScriptKey,
+		},
+	)
+
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     uniArchive,
 		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
 		LocalRegistrar:      uniArchive,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		Scheduler:           *cfg.Universe.Sync,
 	})
 
 	var runtimeIDBytes [8]byte
@@ -414,23 +501,86 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 
 	runtimeID := int64(binary.BigEndian.Uint64(runtimeIDBytes[:]))
+
+	// A read replica doesn't have a federation of its own, so we don't add
+	// the static federation members to its read-only federation.
+	staticFederationMembers := federationMembers
+	if cfg.Universe.Replica.Enabled() {
+		staticFederationMembers = nil
+	}
+
 	universeFederation := universe.NewFederationEnvoy(
 		universe.FederationConfig{
-			FederationDB:            federationDB,
+			FederationDB:            uniFederationDB,
 			UniverseSyncer:          universeSyncer,
 			LocalRegistrar:          uniArchive,
 			SyncInterval:            cfg.Universe.SyncInterval,
 			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
-			StaticFederationMembers: federationMembers,
+			StaticFederationMembers: staticFederationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
 					runtimeID, universe.DefaultTimeout,
 					addr,
 				)
 			},
-			ErrChan: mainErrChan,
+			ErrChan:         mainErrChan,
+			DisableSync:     cfg.Universe.Replica.Enabled(),
+			WriteAuthSigner: universeIssuerSigner,
+		},
+	)
+
+	// If the universe server runs as a read replica, it tails the primary
+	// universe server instead of syncing with the federation. The archive
+	// everyone else uses is read-only in that case, so the replica syncs
+	// into its own writable archive on top of the same database.
+	var universeReplica *universe.Replica
+	if cfg.Universe.Replica.Enabled() {
+		replicaArchiveCfg := uniArchiveCfg
+		replicaArchiveCfg.ReadOnly = false
+		replicaArchive := universe.NewArchive(replicaArchiveCfg)
+
+		replicaSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
+			LocalDiffEngine:     replicaArchive,
+			NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
+			LocalRegistrar:      replicaArchive,
+			SyncBatchSize:       defaultUniverseSyncBatchSize,
+			Scheduler:           *cfg.Universe.Sync,
+		})
+
+		universeReplica = universe.NewReplica(universe.ReplicaCfg{
+			Primary: universe.NewServerAddrFromStr(
+				cfg.Universe.Replica.Primary,
+			),
+			Syncer:             replicaSyncer,
+			NewRemoteRegistrar: tap.NewRpcUniverseRegistrar,
+			SyncInterval:       cfg.Universe.Replica.SyncInterval,
+			WriteAuthSigner:    universeIssuerSigner,
+		})
+	}
+
+	// The minter registers new issuances with the universe. A read replica
+	// forwards them to its primary, as its own archive is read-only.
+	var mintUniverse universe.BatchRegistrar = universeFederation
+	if universeReplica != nil {
+		mintUniverse = universeReplica
+	}
+
+	// If universe writes require authentication, issuers must sign their
+	// proof inserts and are held to their write quotas. The quotas can be
+	// managed before the check is enabled.
+	quotaDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.UniverseWriteQuotaQueries {
+			return db.WithTx(tx)
 		},
 	)
+	universeWriteQuotas := tapdb.NewUniverseWriteQuotaStore(quotaDB)
+
+	var universeWriteAuth *universe.WriteAuthenticator
+	if cfg.Universe.RequireWriteAuth {
+		universeWriteAuth = universe.NewWriteAuthenticator(
+			universeWriteQuotas, uniArchive.IssuanceScriptKey,
+		)
+	}
 
 	addrBookConfig := address.BookConfig{
 		Store:        tapdbAddrBook,
@@ -446,6 +596,12 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	virtualTxSigner := lndservices.NewLndRpcVirtualTxSigner(lndServices)
 	coinSelect := tapfreighter.NewCoinSelect(assetStore)
+	coinSelectStrategy, err := tapfreighter.ParseCoinSelectStrategy(
+		cfg.Wallet.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
 		CoinSelector:     coinSelect,
 		AssetProofs:      proofArchive,
@@ -465,18 +621,24 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	// Addresses can have different proof couriers configured, but both
 	// types of couriers that currently exist will receive this config upon
 	// initialization.
+	proofCourierStats := proof.NewCourierStats()
 	proofCourierDispatcher := proof.NewCourierDispatch(&proof.CourierCfg{
-		HashMailCfg:    cfg.HashMailCourier,
-		UniverseRpcCfg: cfg.UniverseRpcCourier,
-		TransferLog:    assetStore,
-		LocalArchive:   proofArchive,
+		HashMailCfg:       cfg.HashMailCourier,
+		UniverseRpcCfg:    cfg.UniverseRpcCourier,
+		TransferLog:       assetStore,
+		LocalArchive:      proofArchive,
+		Stats:             proofCourierStats,
+		UniverseWriteAuth: universeIssuerSigner.ProofWriteAuth,
 	})
 
 	multiNotifier := proof.NewMultiArchiveNotifier(assetStore, multiverse)
 
 	// Determine whether we should use the mock price oracle service or a
 	// real price oracle service.
-	var priceOracle rfq.PriceOracle
+	var (
+		priceOracle       rfq.PriceOracle
+		staticPriceOracle *rfq.StaticPriceOracle
+	)
 
 	rfqCfg := cfg.Experimental.Rfq
 	switch rfqCfg.PriceOracleAddress {
@@ -494,9 +656,40 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		}
 
 	case "":
-		// Leave the price oracle as nil, which will cause the RFQ
-		// manager to reject all incoming RFQ requests. It will also
-		// skip setting suggested prices for outgoing quote requests.
+		// If static rates are configured, we serve them from the
+		// built-in static price oracle.
+		if rfqCfg.UseStaticOracle() {
+			staticCfg, err := rfqCfg.StaticOracleConfig()
+			if err != nil {
+				return nil, err
+			}
+
+			staticPriceOracle, err = rfq.NewStaticPriceOracle(
+				staticCfg,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create "+
+					"static price oracle: %w", err)
+			}
+			priceOracle = staticPriceOracle
+		}
+
+		// If multiple price oracles are configured, we combine their
+		// rates with the aggregating price oracle. The static price
+		// oracle is then used as one of the sources.
+		if rfqCfg.UseAggregateOracle() {
+			priceOracle, err = newAggregatePriceOracle(
+				rfqCfg, staticPriceOracle,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// If neither is configured, the price oracle is left as nil,
+		// which will cause the RFQ manager to reject all incoming RFQ
+		// requests. It will also skip setting suggested prices for
+		// outgoing quote requests.
 
 	default:
 		priceOracle, err = rfq.NewRpcPriceOracle(
@@ -508,9 +701,33 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		}
 	}
 
+	// Cache the price oracle responses, if enabled, so that quote
+	// requests from many peers don't each cause an oracle round-trip.
+	var priceOracleCache *rfq.CachingPriceOracle
+	if priceOracle != nil && rfqCfg.PriceOracleCacheTTL > 0 {
+		priceOracleCache = rfq.NewCachingPriceOracle(
+			priceOracle, &rfq.OracleCacheConfig{
+				TTL:        rfqCfg.PriceOracleCacheTTL,
+				MaxEntries: rfqCfg.PriceOracleCacheSize,
+			},
+		)
+		priceOracle = priceOracleCache
+	}
+
 	// Construct the AuxChannelNegotiator.
 	auxChanNegotiator := tapfeatures.NewAuxChannelNegotiator()
 
+	rfqQuoteStore := tapdb.NewRfqQuoteStore(tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.RfqStore {
+			return db.WithTx(tx)
+		},
+	))
+
+	forwardFees, err := rfqCfg.ForwardFeePolicies()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse forward fees: %w", err)
+	}
+
 	// Construct the RFQ manager.
 	rfqManager, err := rfq.NewManager(rfq.ManagerCfg{
 		PeerMessenger:             msgTransportClient,
@@ -521,10 +738,14 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		GroupLookup:               tapdbAddrBook,
 		AuxChanNegotiator:         auxChanNegotiator,
 		AliasManager:              lndRouterClient,
+		QuoteStore:                rfqQuoteStore,
 		AcceptPriceDeviationPpm:   rfqCfg.AcceptPriceDeviationPpm,
 		SkipAcceptQuotePriceCheck: rfqCfg.SkipAcceptQuotePriceCheck,
 		SendPriceHint:             rfqCfg.SendPriceHint,
 		SendPeerId:                rfqCfg.PriceOracleSendPeerId,
+		PeerRequestLimit:          rfqCfg.PeerRequestRateLimit,
+		PeerRequestBurst:          rfqCfg.PeerRequestBurst,
+		ForwardFees:               forwardFees,
 		NoOpHTLCs:                 cfg.Channel.NoopHTLCs,
 		ErrChan:                   mainErrChan,
 	})
@@ -586,6 +807,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			StateLog:           supplyCommitStore,
 			ChainParams:        *tapChainParams.Params,
 			IgnoreCheckerCache: ignoreChecker,
+			ReadOnly:           cfg.Universe.Replica.Enabled(),
 		},
 	)
 
@@ -640,6 +862,57 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		},
 	)
 
+	consolidator := tapfreighter.NewConsolidator(
+		&tapfreighter.ConsolidatorConfig{
+			CoinSelector: coinSelect,
+			AssetWallet:  assetWallet,
+			ChainPorter:  chainPorter,
+			ChainBridge:  chainBridge,
+			Wallet:       walletAnchor,
+		},
+	)
+
+	sendScheduleStore := tapdb.NewSendScheduleStore(
+		tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.ScheduledSendQueries {
+				return db.WithTx(tx)
+			},
+		),
+	)
+	sendScheduler := tapfreighter.NewSendScheduler(
+		&tapfreighter.SendSchedulerConfig{
+			Store:              sendScheduleStore,
+			ChainBridge:        chainBridge,
+			ChainPorter:        chainPorter,
+			ChainParams:        &tapChainParams,
+			CoinSelectStrategy: coinSelectStrategy,
+			Clock:              defaultClock,
+			ErrChan:            mainErrChan,
+		},
+	)
+
+	balanceHistoryStore := tapdb.NewChannelBalanceStore(
+		tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.BalanceHistoryQueries {
+				return db.WithTx(tx)
+			},
+		),
+	)
+	balanceHistoryRecorder := balancehistory.NewRecorder(
+		&balancehistory.Config{
+			Store:      balanceHistoryStore,
+			RateLookup: rfqManager,
+		},
+	)
+
+	mintScheduleStore := tapdb.NewMintScheduleStore(
+		tapdb.NewTransactionExecutor(
+			db, func(tx *sql.Tx) tapdb.MintScheduleQueries {
+				return db.WithTx(tx)
+			},
+		),
+	)
+
 	auxFundingController := tapchannel.NewFundingController(
 		tapchannel.FundingControllerCfg{
 			HeaderVerifier:     headerVerifier,
@@ -732,16 +1005,19 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				GenTxBuilder:          &tapscript.GroupTxBuilder{},
 				TxValidator:           &tap.ValidatorV0{},
 				ProofFiles:            proofFileStore,
-				Universe:              universeFederation,
+				Universe:              mintUniverse,
 				ProofWatcher:          reOrgWatcher,
 				UniversePushBatchSize: defaultUniverseSyncBatchSize,
 				IgnoreChecker:         ignoreCheckerOpt,
+				VerificationCache:     verificationCacheOpt,
 				MintSupplyCommitter:   supplyCommitManager,
 				DelegationKeyChecker:  addrBook,
 			},
-			ChainParams:  tapChainParams,
-			ProofUpdates: proofArchive,
-			ErrChan:      mainErrChan,
+			ChainParams:   tapChainParams,
+			ProofUpdates:  proofArchive,
+			ErrChan:       mainErrChan,
+			MintSchedules: mintScheduleStore,
+			SupplyFetcher: supplyCommitManager,
 		}),
 		AssetCustodian: tapgarden.NewCustodian(&tapgarden.CustodianConfig{
 			ChainParams:            &tapChainParams,
@@ -758,30 +1034,47 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			ProofRetrievalDelay:    cfg.CustodianProofRetrievalDelay,
 			ProofWatcher:           reOrgWatcher,
 			IgnoreChecker:          ignoreCheckerOpt,
+			VerificationCache:      verificationCacheOpt,
 		}),
 		ChainBridge:              chainBridge,
 		AddrBook:                 addrBook,
 		AddrBookDisableSyncer:    cfg.AddrBook.DisableSyncer,
 		DefaultProofCourierAddr:  proofCourierAddr,
 		ProofArchive:             proofArchive,
+		ProofCourierStats:        proofCourierStats,
 		AssetWallet:              assetWallet,
 		CoinSelect:               coinSelect,
 		ChainPorter:              chainPorter,
+		Consolidator:             consolidator,
+		SendScheduler:            sendScheduler,
+		BalanceHistoryRecorder:   balanceHistoryRecorder,
+		BalanceHistoryStore:      balanceHistoryStore,
+		DatabaseBackup:           dbBackup,
+		StagedBackupPath:         stagedBackupPath,
 		SweepOrphanUtxos:         cfg.Wallet.SweepOrphanUtxos,
+		CoinSelectStrategy:       coinSelectStrategy,
 		FsmDaemonAdapters:        lndFsmDaemonAdapters,
 		SupplyCommitManager:      supplyCommitManager,
 		IgnoreChecker:            ignoreChecker,
+		VerificationCache:        verificationCacheOpt,
 		SupplyVerifyManager:      supplyVerifyManager,
 		UniverseArchive:          uniArchive,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
+		UniverseReplica:          universeReplica,
+		UniverseWriteAuth:        universeWriteAuth,
+		UniverseWriteQuotas:      universeWriteQuotas,
+		UniverseWriteAuthSigner:  universeIssuerSigner,
 		UniFedSyncAllAssets:      cfg.Universe.SyncAllAssets,
 		UniverseStats:            universeStats,
+		UniverseSearcher:         universeStats,
 		UniversePublicAccess:     universePublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
 		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
 		RfqManager:               rfqManager,
 		PriceOracle:              priceOracle,
+		StaticPriceOracle:        staticPriceOracle,
+		PriceOracleCache:         priceOracleCache,
 		PriceOracleSendPeerID:    cfg.Experimental.Rfq.PriceOracleSendPeerId,
 		AuxLeafSigner:            auxLeafSigner,
 		AuxFundingController:     auxFundingController,
@@ -805,12 +1098,53 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			AssetStore:   assetStore,
 			TapAddrBook:  tapdbAddrBook,
 			Multiverse:   multiverse,
-			FederationDB: federationDB,
+			FederationDB: uniFederationDB,
 		},
 		Prometheus: cfg.Prometheus,
 	}, nil
 }
 
+// restoreDatabaseBackup restores the backup archive at the given path into the
+// database.
+func restoreDatabaseBackup(dbBackup *tapdb.BackupManager,
+	backupPath string) (*tapdb.BackupHeader, error) {
+
+	backupFile, err := os.Open(backupPath)
+	if err != nil {
+		return nil, err
+	}
+	defer backupFile.Close()
+
+	return dbBackup.RestoreBackup(context.Background(), backupFile)
+}
+
+// replaceWithStagedBackup replaces the content of the database with the backup
+// archive staged at the given path. The staged archive is removed once it was
+// restored.
+func replaceWithStagedBackup(dbBackup *tapdb.BackupManager,
+	backupPath string) (*tapdb.BackupHeader, error) {
+
+	backupFile, err := os.Open(backupPath)
+	if err != nil {
+		return nil, err
+	}
+	defer backupFile.Close()
+
+	header, err := dbBackup.ReplaceWithBackup(
+		context.Background(), backupFile,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(backupPath); err != nil {
+		return nil, fmt.Errorf("unable to remove staged backup: %w",
+			err)
+	}
+
+	return header, nil
+}
+
 // CreateServerFromConfig creates a new Taproot Asset server from the given CLI
 // config.
 func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
@@ -897,3 +1231,47 @@ func ConfigureSubServer(srv *tap.Server, cfg *Config, cfgLogger btclog.Logger,
 
 	return nil
 }
+
+// newAggregatePriceOracle creates an aggregating price oracle that queries the
+// configured RPC price oracles and, if not nil, the static price oracle.
+func newAggregatePriceOracle(rfqCfg rfq.CliConfig,
+	staticOracle *rfq.StaticPriceOracle) (*rfq.AggregatePriceOracle,
+	error) {
+
+	aggregateCfg, err := rfqCfg.AggregateOracleConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range rfqCfg.AggregateOracleAddresses {
+		rpcOracle, err := rfq.NewRpcPriceOracle(addr, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create price "+
+				"oracle %s: %w", addr, err)
+		}
+
+		aggregateCfg.Sources = append(
+			aggregateCfg.Sources, rfq.AggregateOracleSource{
+				Name:   addr,
+				Oracle: rpcOracle,
+			},
+		)
+	}
+
+	if staticOracle != nil {
+		aggregateCfg.Sources = append(
+			aggregateCfg.Sources, rfq.AggregateOracleSource{
+				Name:   "static",
+				Oracle: staticOracle,
+			},
+		)
+	}
+
+	aggregateOracle, err := rfq.NewAggregatePriceOracle(aggregateCfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aggregate price "+
+			"oracle: %w", err)
+	}
+
+	return aggregateOracle, nil
+}
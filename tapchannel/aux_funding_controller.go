@@ -851,7 +851,7 @@ func (f *FundingController) fundVirtualPacket(ctx context.Context,
 	// Fund the packet. This will derive an anchor internal key for us, but
 	// we'll overwrite that later on.
 	fundedPkt, err := f.cfg.AssetWallet.FundPacket(
-		ctx, fundDesc, pktTemplate,
+		ctx, fundDesc, pktTemplate, tapfreighter.PreferMaxAmount,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fund vPacket: %w", err)
@@ -271,6 +271,22 @@ func (s *AuxInvoiceManager) handleInvoiceAccept(ctx context.Context,
 	}
 
 	htlcAssetAmount := htlc.Amounts.Val.Sum()
+
+	// If the edge node charges a forwarding fee under the quote, it
+	// deducted the fee from the assets it sent us. The invoice amount
+	// includes the fee, so we account for the assets the edge node
+	// received for this HTLC.
+	forwardFee := s.forwardFeeFromQuote(rfqID)
+	err = fn.MapOptionZ(forwardFee, func(fee rfqmsg.ForwardFee) error {
+		var err error
+		htlcAssetAmount, err = fee.GrossAmount(htlcAssetAmount)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to add forward fee of quote "+
+			"with ID %x: %w", rfqID[:], err)
+	}
+
 	totalAssetAmt := rfqmath.NewBigIntFixedPoint(htlcAssetAmount, 0)
 	resp.AmtPaid = rfqmath.UnitsToMilliSatoshi(totalAssetAmt, *assetRate)
 
@@ -290,6 +306,13 @@ func (s *AuxInvoiceManager) handleInvoiceAccept(ctx context.Context,
 	// is not yet in that list.
 	allowedMarginAssetUnits := uint64(len(req.Invoice.Htlcs) + 1)
 
+	// The forwarding fee is rounded down by the edge node, so adding it
+	// back to the received amount may come up short by another asset unit
+	// per HTLC.
+	if forwardFee.IsSome() {
+		allowedMarginAssetUnits *= 2
+	}
+
 	// Convert the allowed margin asset units to milli-satoshis.
 	marginAssetUnits := rfqmath.NewBigIntFixedPoint(
 		allowedMarginAssetUnits, 0,
@@ -409,6 +432,22 @@ func (s *AuxInvoiceManager) priceFromQuote(rfqID rfqmsg.ID) (
 	}
 }
 
+// forwardFeeFromQuote returns the forwarding fee that the edge node charges
+// under the accepted buy quote with the given RFQ ID. Only buy quotes carry a
+// fee that is deducted from the assets we receive.
+func (s *AuxInvoiceManager) forwardFeeFromQuote(
+	rfqID rfqmsg.ID) fn.Option[rfqmsg.ForwardFee] {
+
+	acceptedBuyQuotes := s.cfg.RfqManager.PeerAcceptedBuyQuotes()
+
+	buyQuote, isBuy := acceptedBuyQuotes[rfqID.Scid()]
+	if !isBuy {
+		return fn.None[rfqmsg.ForwardFee]()
+	}
+
+	return buyQuote.ForwardFee
+}
+
 // RfqPeerFromScid attempts to match the provided scid with a negotiated quote,
 // then it returns the RFQ peer's node id.
 func (s *AuxInvoiceManager) RfqPeerFromScid(scid uint64) (route.Vertex, error) {
@@ -317,15 +317,17 @@ func (s *AuxTrafficShaper) paymentBandwidth(htlc *rfqmsg.Htlc,
 		buyQuote, isBuyQuote := acceptedBuyQuotes[rfqID.Scid()]
 
 		var (
-			rate      rfqmsg.AssetRate
-			specifier asset.Specifier
-			quotePeer route.Vertex
+			rate       rfqmsg.AssetRate
+			specifier  asset.Specifier
+			quotePeer  route.Vertex
+			forwardFee fn.Option[rfqmsg.ForwardFee]
 		)
 		switch {
 		case isSellQuote:
 			quotePeer = sellQuote.Peer
 			rate = sellQuote.AssetRate
 			specifier = sellQuote.Request.AssetSpecifier
+			forwardFee = sellQuote.ForwardFee
 
 		case isBuyQuote:
 			quotePeer = buyQuote.Peer
@@ -344,8 +346,8 @@ func (s *AuxTrafficShaper) paymentBandwidth(htlc *rfqmsg.Htlc,
 		}
 
 		bandwidth, err := s.paymentBandwidthRFQ(
-			rfqID, rate, specifier, localBalance, linkBandwidth,
-			minHtlcAmt, fundingChan,
+			rfqID, rate, specifier, forwardFee, localBalance,
+			linkBandwidth, minHtlcAmt, fundingChan,
 		)
 		if err != nil {
 			return 0, err
@@ -364,8 +366,11 @@ func (s *AuxTrafficShaper) paymentBandwidth(htlc *rfqmsg.Htlc,
 }
 
 // paymentBandwidthRFQ retrieves the bandwidth for a specific channel and quote.
+// If the quote charges a forwarding fee, the fee is paid out of the local
+// balance on top of the asset units that correspond to the HTLC amount.
 func (s *AuxTrafficShaper) paymentBandwidthRFQ(rfqID rfqmsg.ID,
-	rate rfqmsg.AssetRate, specifier asset.Specifier, localBalance uint64,
+	rate rfqmsg.AssetRate, specifier asset.Specifier,
+	forwardFee fn.Option[rfqmsg.ForwardFee], localBalance uint64,
 	linkBandwidth, minHtlcAmt lnwire.MilliSatoshi,
 	fundingChan *cmsg.OpenChannel) (lnwire.MilliSatoshi, error) {
 
@@ -399,6 +404,14 @@ func (s *AuxTrafficShaper) paymentBandwidthRFQ(rfqID rfqmsg.ID,
 		}
 	}
 
+	// The HTLCs we send under the quote need to carry the forwarding fee
+	// in addition to the asset units that correspond to their amount. So
+	// only the part of the local balance that is left after paying the fee
+	// is available for the HTLC amount.
+	forwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		localBalance = fee.MaxAmountFor(localBalance)
+	})
+
 	// Calculate the local available balance in the local asset unit,
 	// expressed in milli-satoshis.
 	localBalanceFp := rfqmath.NewBigIntFixedPoint(localBalance, 0)
@@ -549,6 +562,23 @@ func (s *AuxTrafficShaper) ProduceHtlcExtraData(totalAmount lnwire.MilliSatoshi,
 	)
 	numAssetUnits := numAssetUnitsFp.ScaleTo(0).ToUint64()
 
+	// If our peer charges a forwarding fee in asset units, we need to pay
+	// it on top of the asset units that correspond to the BTC amount. A fee
+	// that would overflow the amount means we can't use the quote.
+	htlcAssetUnits := numAssetUnits
+	var feeErr error
+	quote.ForwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		htlcAssetUnits, feeErr = fee.AmountWithFee(numAssetUnits)
+	})
+	if feeErr != nil {
+		return 0, nil, fmt.Errorf("unable to add forward fee of peer "+
+			"%x: %w", peer[:], feeErr)
+	}
+	if forwardFee := htlcAssetUnits - numAssetUnits; forwardFee > 0 {
+		log.Debugf("Adding forward fee of %d asset units charged by "+
+			"peer %x", forwardFee, peer[:])
+	}
+
 	var assetId asset.ID
 
 	switch {
@@ -582,7 +612,7 @@ func (s *AuxTrafficShaper) ProduceHtlcExtraData(totalAmount lnwire.MilliSatoshi,
 		totalAmount, numAssetUnits)
 
 	htlc.Amounts.Val.Balances = []*rfqmsg.AssetBalance{
-		rfqmsg.NewAssetBalance(assetId, numAssetUnits),
+		rfqmsg.NewAssetBalance(assetId, htlcAssetUnits),
 	}
 
 	// Encode the updated HTLC TLV back into a blob and return it with the
@@ -0,0 +1,290 @@
+package balancehistory
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// AssetFlow is the sum of the asset HTLCs that were settled into and out of
+// our side of the channel.
+type AssetFlow struct {
+	// NumHtlcs is the number of settled asset HTLCs.
+	NumHtlcs uint64
+
+	// AssetsReceived is the number of asset units of the settled incoming
+	// HTLCs.
+	AssetsReceived uint64
+
+	// AssetsSent is the number of asset units of the settled outgoing
+	// HTLCs.
+	AssetsSent uint64
+
+	// ReceivedMsat is the value of the received assets at the rate of
+	// their RFQ quote. HTLCs without a known rate are not included.
+	ReceivedMsat lnwire.MilliSatoshi
+
+	// SentMsat is the value of the sent assets at the rate of their RFQ
+	// quote. HTLCs without a known rate are not included.
+	SentMsat lnwire.MilliSatoshi
+}
+
+// NetAssets returns the net number of asset units that moved to our side.
+func (f *AssetFlow) NetAssets() int64 {
+	return int64(f.AssetsReceived) - int64(f.AssetsSent)
+}
+
+// add adds a settled HTLC to the flow.
+func (f *AssetFlow) add(htlc Htlc) {
+	f.NumHtlcs++
+
+	var value lnwire.MilliSatoshi
+	htlc.Rate.WhenSome(func(rate rfqmath.BigIntFixedPoint) {
+		units := rfqmath.NewBigIntFixedPoint(htlc.AssetAmount, 0)
+		value = rfqmath.UnitsToMilliSatoshi(units, rate)
+	})
+
+	if htlc.Incoming {
+		f.AssetsReceived += htlc.AssetAmount
+		f.ReceivedMsat += value
+	} else {
+		f.AssetsSent += htlc.AssetAmount
+		f.SentMsat += value
+	}
+}
+
+// ChannelPnL is the asset denominated profit and loss of a single asset
+// channel over a period of time.
+type ChannelPnL struct {
+	AssetFlow
+
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// StartTime is the time of the first snapshot of the period.
+	StartTime time.Time
+
+	// EndTime is the time of the last snapshot of the period.
+	EndTime time.Time
+
+	// StartLocalBalance is our asset balance at the first snapshot of the
+	// period.
+	StartLocalBalance uint64
+
+	// EndLocalBalance is our asset balance at the last snapshot of the
+	// period.
+	EndLocalBalance uint64
+}
+
+// QuotePnL is the asset denominated profit and loss of all the HTLCs that were
+// sent under a single RFQ quote.
+type QuotePnL struct {
+	AssetFlow
+
+	// RfqID is the ID of the RFQ quote.
+	RfqID rfqmsg.ID
+
+	// Rate is the asset rate of the quote in units per BTC, if known.
+	Rate fn.Option[rfqmath.BigIntFixedPoint]
+}
+
+// ComputeChannelPnL aggregates the given snapshots per channel. The snapshots
+// are expected to be ordered by the time they were recorded, as returned by the
+// Store.
+func ComputeChannelPnL(snapshots []*Snapshot) []*ChannelPnL {
+	var (
+		result    []*ChannelPnL
+		byChannel = make(map[wire.OutPoint]*ChannelPnL)
+	)
+	for _, s := range snapshots {
+		pnl, ok := byChannel[s.ChanPoint]
+		if !ok {
+			pnl = &ChannelPnL{
+				ChanPoint:         s.ChanPoint,
+				StartTime:         s.Timestamp,
+				StartLocalBalance: s.LocalBalance,
+			}
+			byChannel[s.ChanPoint] = pnl
+			result = append(result, pnl)
+		}
+
+		pnl.EndTime = s.Timestamp
+		pnl.EndLocalBalance = s.LocalBalance
+
+		for _, htlc := range s.SettledHtlcs {
+			pnl.add(htlc)
+		}
+	}
+
+	return result
+}
+
+// ComputeQuotePnL aggregates the settled HTLCs of the given snapshots per RFQ
+// quote. HTLCs that weren't sent under a quote are skipped.
+func ComputeQuotePnL(snapshots []*Snapshot) []*QuotePnL {
+	var (
+		result  []*QuotePnL
+		byQuote = make(map[rfqmsg.ID]*QuotePnL)
+	)
+	for _, s := range snapshots {
+		for _, htlc := range s.SettledHtlcs {
+			htlc.RfqID.WhenSome(func(id rfqmsg.ID) {
+				pnl, ok := byQuote[id]
+				if !ok {
+					pnl = &QuotePnL{
+						RfqID: id,
+					}
+					byQuote[id] = pnl
+					result = append(result, pnl)
+				}
+
+				if pnl.Rate.IsNone() {
+					pnl.Rate = htlc.Rate
+				}
+
+				pnl.add(htlc)
+			})
+		}
+	}
+
+	return result
+}
+
+// formatRate formats an optional asset rate, an unknown rate is formatted as
+// an empty string.
+func formatRate(rate fn.Option[rfqmath.BigIntFixedPoint]) string {
+	return fn.MapOptionZ(rate, func(r rfqmath.BigIntFixedPoint) string {
+		return r.String()
+	})
+}
+
+// writeCSV writes the header and the records to w as CSV.
+func writeCSV(w io.Writer, header []string, records [][]string) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+	if err := csvWriter.WriteAll(records); err != nil {
+		return fmt.Errorf("unable to write CSV: %w", err)
+	}
+
+	return nil
+}
+
+// flowColumns returns the CSV columns of an asset flow.
+func flowColumns(f *AssetFlow) []string {
+	return []string{
+		strconv.FormatUint(f.NumHtlcs, 10),
+		strconv.FormatUint(f.AssetsReceived, 10),
+		strconv.FormatUint(f.AssetsSent, 10),
+		strconv.FormatInt(f.NetAssets(), 10),
+		strconv.FormatUint(uint64(f.ReceivedMsat), 10),
+		strconv.FormatUint(uint64(f.SentMsat), 10),
+	}
+}
+
+// flowHeader is the CSV header of the asset flow columns.
+var flowHeader = []string{
+	"num_htlcs", "assets_received", "assets_sent", "net_assets",
+	"received_msat", "sent_msat",
+}
+
+// WriteSnapshotsCSV writes the given snapshots as CSV to w. Each settled
+// HTLC is written as its own row, a snapshot without settled HTLCs is written
+// as a single row with empty HTLC columns.
+func WriteSnapshotsCSV(w io.Writer,
+	snapshots []*Snapshot) error {
+
+	header := []string{
+		"timestamp", "chan_point", "commit_height", "local_balance",
+		"remote_balance", "htlc_index", "htlc_direction",
+		"htlc_asset_amount", "rfq_id", "rate",
+	}
+
+	var records [][]string
+	for _, s := range snapshots {
+		snapshotColumns := []string{
+			s.Timestamp.UTC().Format(time.RFC3339),
+			s.ChanPoint.String(),
+			strconv.FormatUint(s.CommitHeight, 10),
+			strconv.FormatUint(s.LocalBalance, 10),
+			strconv.FormatUint(s.RemoteBalance, 10),
+		}
+
+		if len(s.SettledHtlcs) == 0 {
+			records = append(records, append(
+				snapshotColumns, "", "", "", "", "",
+			))
+			continue
+		}
+
+		for _, htlc := range s.SettledHtlcs {
+			direction := "outgoing"
+			if htlc.Incoming {
+				direction = "incoming"
+			}
+
+			rfqID := fn.MapOptionZ(
+				htlc.RfqID, func(id rfqmsg.ID) string {
+					return hex.EncodeToString(id[:])
+				},
+			)
+
+			row := append([]string{}, snapshotColumns...)
+			row = append(
+				row, strconv.FormatUint(htlc.HtlcIndex, 10),
+				direction,
+				strconv.FormatUint(htlc.AssetAmount, 10), rfqID,
+				formatRate(htlc.Rate),
+			)
+			records = append(records, row)
+		}
+	}
+
+	return writeCSV(w, header, records)
+}
+
+// WriteChannelPnLCSV writes the given per channel profit and loss as CSV to w.
+func WriteChannelPnLCSV(w io.Writer, pnls []*ChannelPnL) error {
+	header := append([]string{
+		"chan_point", "start_time", "end_time", "start_local_balance",
+		"end_local_balance",
+	}, flowHeader...)
+
+	records := make([][]string, 0, len(pnls))
+	for _, p := range pnls {
+		records = append(records, append([]string{
+			p.ChanPoint.String(),
+			p.StartTime.UTC().Format(time.RFC3339),
+			p.EndTime.UTC().Format(time.RFC3339),
+			strconv.FormatUint(p.StartLocalBalance, 10),
+			strconv.FormatUint(p.EndLocalBalance, 10),
+		}, flowColumns(&p.AssetFlow)...))
+	}
+
+	return writeCSV(w, header, records)
+}
+
+// WriteQuotePnLCSV writes the given per quote profit and loss as CSV to w.
+func WriteQuotePnLCSV(w io.Writer, pnls []*QuotePnL) error {
+	header := append([]string{"rfq_id", "rate"}, flowHeader...)
+
+	records := make([][]string, 0, len(pnls))
+	for _, p := range pnls {
+		records = append(records, append([]string{
+			hex.EncodeToString(p.RfqID[:]), formatRate(p.Rate),
+		}, flowColumns(&p.AssetFlow)...))
+	}
+
+	return writeCSV(w, header, records)
+}
@@ -0,0 +1,373 @@
+package balancehistory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	lnwl "github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// DefaultTimeout is the default timeout we use for persisting a
+	// balance snapshot.
+	DefaultTimeout = 30 * time.Second
+
+	// snapshotQueueSize is the number of balance snapshots that can be
+	// queued for persistence before new snapshots are dropped.
+	snapshotQueueSize = 1000
+)
+
+// Htlc is an asset HTLC that was settled in a commitment update and therefore
+// moved the asset balance of the channel.
+type Htlc struct {
+	// HtlcIndex is the index of the HTLC in the update log of the party
+	// that offered it.
+	HtlcIndex uint64
+
+	// Incoming is true if the HTLC was offered by our peer, which means
+	// the settled assets were added to our local balance.
+	Incoming bool
+
+	// AssetAmount is the number of asset units the HTLC carried.
+	AssetAmount uint64
+
+	// RfqID is the ID of the RFQ quote the HTLC was sent under, if any.
+	RfqID fn.Option[rfqmsg.ID]
+
+	// Rate is the asset rate in units per BTC of the RFQ quote, if it was
+	// still known when the snapshot was recorded.
+	Rate fn.Option[rfqmath.BigIntFixedPoint]
+}
+
+// Snapshot is the asset balance of an asset channel right after a commitment
+// update, as seen from our local commitment.
+type Snapshot struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// CommitHeight is the height of our local commitment the snapshot was
+	// taken at.
+	CommitHeight uint64
+
+	// LocalBalance is our asset balance in the channel, excluding pending
+	// HTLCs.
+	LocalBalance uint64
+
+	// RemoteBalance is the asset balance of our peer, excluding pending
+	// HTLCs.
+	RemoteBalance uint64
+
+	// Timestamp is the time the snapshot was taken.
+	Timestamp time.Time
+
+	// SettledHtlcs are the asset HTLCs that were settled in this update.
+	SettledHtlcs []Htlc
+}
+
+// Query is used to filter the balance snapshots that are fetched from the
+// store.
+type Query struct {
+	// ChanPoint limits the snapshots to a single channel.
+	ChanPoint fn.Option[wire.OutPoint]
+
+	// StartTime is the time from which on snapshots are returned. A zero
+	// time means no lower bound.
+	StartTime time.Time
+
+	// EndTime is the time up to which snapshots are returned. A zero time
+	// means no upper bound.
+	EndTime time.Time
+}
+
+// Store persists the balance snapshots of asset channels.
+type Store interface {
+	// InsertBalanceSnapshot persists a balance snapshot. A snapshot that
+	// was previously recorded for the same channel and commitment height
+	// is replaced.
+	InsertBalanceSnapshot(ctx context.Context,
+		snapshot *Snapshot) error
+
+	// FetchBalanceSnapshots returns the snapshots that match the query,
+	// ordered by the time they were recorded.
+	FetchBalanceSnapshots(ctx context.Context,
+		query Query) ([]*Snapshot, error)
+}
+
+// QuoteRateLookup looks up the asset rate of an accepted RFQ quote.
+type QuoteRateLookup interface {
+	// QuoteRate returns the asset rate of the accepted quote with the
+	// given ID, if the quote is known.
+	QuoteRate(id rfqmsg.ID) fn.Option[rfqmath.BigIntFixedPoint]
+}
+
+// Config houses the dependencies of the balance history recorder.
+type Config struct {
+	// Store persists the recorded snapshots.
+	Store Store
+
+	// RateLookup is used to find the asset rate of the RFQ quotes of the
+	// settled HTLCs.
+	RateLookup QuoteRateLookup
+}
+
+// Recorder records the asset balance of every asset channel after each update
+// of our local commitment, together with the HTLCs that moved it. Snapshots
+// are queued and persisted in the background, so the channel state machine
+// isn't blocked by database writes.
+type Recorder struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *Config
+
+	// snapshots is the queue of snapshots waiting to be persisted.
+	snapshots chan *Snapshot
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewRecorder creates a new balance history recorder.
+func NewRecorder(
+	cfg *Config) *Recorder {
+
+	return &Recorder{
+		cfg: cfg,
+		snapshots: make(
+			chan *Snapshot, snapshotQueueSize,
+		),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts persisting the queued balance snapshots.
+func (r *Recorder) Start() error {
+	r.startOnce.Do(func() {
+		log.Info("Starting balance history recorder")
+
+		r.Wg.Add(1)
+		go r.persistSnapshots()
+	})
+
+	return nil
+}
+
+// Stop stops the recorder. Snapshots that are still queued are dropped.
+func (r *Recorder) Stop() error {
+	r.stopOnce.Do(func() {
+		log.Info("Stopping balance history recorder")
+
+		close(r.Quit)
+		r.Wg.Wait()
+	})
+
+	return nil
+}
+
+// RecordCommitment queues a balance snapshot for the new commitment blob that
+// was created from the given input. Only updates of our local commitment are
+// recorded. This never blocks, if the queue is full the snapshot is dropped.
+func (r *Recorder) RecordCommitment(in lnwl.CommitDiffAuxInput,
+	newBlob tlv.Blob) {
+
+	if in.WhoseCommit != lntypes.Local {
+		return
+	}
+
+	newCommit, err := cmsg.DecodeCommitment(newBlob)
+	if err != nil {
+		log.Errorf("Unable to decode commitment for balance "+
+			"history: %v", err)
+		return
+	}
+
+	r.recordCommitment(in, newCommit)
+}
+
+// recordCommitment queues a balance snapshot for the given decoded new
+// commitment.
+func (r *Recorder) recordCommitment(in lnwl.CommitDiffAuxInput,
+	newCommit *cmsg.Commitment) {
+
+	snapshot, err := newSnapshot(in, newCommit, time.Now())
+	if err != nil {
+		log.Errorf("Unable to create balance snapshot: %v", err)
+		return
+	}
+
+	select {
+	case r.snapshots <- snapshot:
+	default:
+		log.Warnf("Balance history queue full, dropping snapshot of "+
+			"channel %v at height %d", snapshot.ChanPoint,
+			snapshot.CommitHeight)
+	}
+}
+
+// persistSnapshots persists the queued snapshots until the recorder is
+// stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Recorder) persistSnapshots() {
+	defer r.Wg.Done()
+
+	// We keep track of the last recorded balance of each channel, so we
+	// don't record snapshots of updates that didn't change anything, such
+	// as fee updates.
+	lastBalances := make(map[wire.OutPoint][2]uint64)
+
+	for {
+		select {
+		case snapshot := <-r.snapshots:
+			balances := [2]uint64{
+				snapshot.LocalBalance, snapshot.RemoteBalance,
+			}
+			last, ok := lastBalances[snapshot.ChanPoint]
+			if ok && last == balances &&
+				len(snapshot.SettledHtlcs) == 0 {
+
+				continue
+			}
+
+			r.addRates(snapshot)
+
+			ctx, cancel := r.WithCtxQuit()
+			err := r.cfg.Store.InsertBalanceSnapshot(ctx, snapshot)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to store balance snapshot "+
+					"of channel %v at height %d: %v",
+					snapshot.ChanPoint,
+					snapshot.CommitHeight, err)
+				continue
+			}
+
+			lastBalances[snapshot.ChanPoint] = balances
+
+		case <-r.Quit:
+			return
+		}
+	}
+}
+
+// addRates adds the asset rates of the RFQ quotes to the settled HTLCs of the
+// snapshot.
+func (r *Recorder) addRates(snapshot *Snapshot) {
+	if r.cfg.RateLookup == nil {
+		return
+	}
+
+	for idx := range snapshot.SettledHtlcs {
+		htlc := &snapshot.SettledHtlcs[idx]
+		htlc.RfqID.WhenSome(func(id rfqmsg.ID) {
+			htlc.Rate = r.cfg.RateLookup.QuoteRate(id)
+		})
+	}
+}
+
+// newSnapshot creates the balance snapshot of the given new commitment.
+// The settled HTLCs are taken from the view the commitment was created from.
+func newSnapshot(in lnwl.CommitDiffAuxInput, newCommit *cmsg.Commitment,
+	now time.Time) (*Snapshot, error) {
+
+	view := in.UnfilteredView
+	nextHeight := view.NextHeight
+
+	// Only the add entries carry the asset records, so we index them to
+	// look up the HTLCs that are settled.
+	localAdds := make(map[uint64]lnwl.AuxHtlcDescriptor)
+	remoteAdds := make(map[uint64]lnwl.AuxHtlcDescriptor)
+	for _, entry := range view.Updates.Local {
+		if entry.IsAdd() {
+			localAdds[entry.HtlcIndex] = entry
+		}
+	}
+	for _, entry := range view.Updates.Remote {
+		if entry.IsAdd() {
+			remoteAdds[entry.HtlcIndex] = entry
+		}
+	}
+
+	// A settle in our log settles an incoming HTLC from their log and vice
+	// versa.
+	var settled []Htlc
+	collect := func(entries []lnwl.AuxHtlcDescriptor,
+		parents map[uint64]lnwl.AuxHtlcDescriptor,
+		incoming bool) error {
+
+		for _, entry := range entries {
+			if entry.EntryType != lnwl.Settle {
+				continue
+			}
+
+			// Settles of previous updates are still in the view,
+			// we only want the ones of this update.
+			if entry.RemoveHeight(lntypes.Local) != nextHeight {
+				continue
+			}
+
+			parent, ok := parents[entry.ParentIndex]
+			if !ok {
+				return fmt.Errorf("unable to find htlc with "+
+					"index %d", entry.ParentIndex)
+			}
+
+			if !rfqmsg.HasAssetHTLCCustomRecords(
+				parent.CustomRecords,
+			) {
+
+				continue
+			}
+
+			assetHtlc, err := rfqmsg.HtlcFromCustomRecords(
+				parent.CustomRecords,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode asset "+
+					"htlc: %w", err)
+			}
+
+			htlc := Htlc{
+				HtlcIndex:   parent.HtlcIndex,
+				Incoming:    incoming,
+				AssetAmount: rfqmsg.Sum(assetHtlc.Balances()),
+			}
+			assetHtlc.RfqID.ValOpt().WhenSome(func(id rfqmsg.ID) {
+				htlc.RfqID = fn.Some(id)
+			})
+
+			settled = append(settled, htlc)
+		}
+
+		return nil
+	}
+
+	if err := collect(view.Updates.Local, remoteAdds, true); err != nil {
+		return nil, err
+	}
+	if err := collect(view.Updates.Remote, localAdds, false); err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		ChanPoint:     in.ChannelState.FundingOutpoint,
+		CommitHeight:  nextHeight,
+		LocalBalance:  cmsg.OutputSum(newCommit.LocalOutputs()),
+		RemoteBalance: cmsg.OutputSum(newCommit.RemoteOutputs()),
+		Timestamp:     now.UTC(),
+		SettledHtlcs:  settled,
+	}, nil
+}
@@ -0,0 +1,388 @@
+package balancehistory
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	cmsg "github.com/lightninglabs/taproot-assets/tapchannelmsg"
+	"github.com/lightningnetwork/lnd/lntypes"
+	lnwl "github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBalanceHistoryStore is a mock implementation of the Store interface.
+type mockBalanceHistoryStore struct {
+	inserted chan *Snapshot
+}
+
+func (m *mockBalanceHistoryStore) InsertBalanceSnapshot(_ context.Context,
+	snapshot *Snapshot) error {
+
+	m.inserted <- snapshot
+	return nil
+}
+
+func (m *mockBalanceHistoryStore) FetchBalanceSnapshots(_ context.Context,
+	_ Query) ([]*Snapshot, error) {
+
+	return nil, nil
+}
+
+// mockQuoteRateLookup is a mock implementation of the QuoteRateLookup
+// interface.
+type mockQuoteRateLookup struct {
+	rates map[rfqmsg.ID]rfqmath.BigIntFixedPoint
+}
+
+func (m *mockQuoteRateLookup) QuoteRate(
+	id rfqmsg.ID) fn.Option[rfqmath.BigIntFixedPoint] {
+
+	rate, ok := m.rates[id]
+	if !ok {
+		return fn.None[rfqmath.BigIntFixedPoint]()
+	}
+
+	return fn.Some(rate)
+}
+
+// newAssetHtlcAdd creates an add entry of an asset HTLC.
+func newAssetHtlcAdd(t *testing.T, idx, amt uint64,
+	rfqID fn.Option[rfqmsg.ID]) lnwl.AuxHtlcDescriptor {
+
+	htlc := rfqmsg.NewHtlc(
+		[]*rfqmsg.AssetBalance{
+			rfqmsg.NewAssetBalance(asset.ID{1}, amt),
+		}, rfqID, fn.None[[]rfqmsg.ID](),
+	)
+	records, err := htlc.ToCustomRecords()
+	require.NoError(t, err)
+
+	return lnwl.AuxHtlcDescriptor{
+		HtlcIndex:     idx,
+		Amount:        354_000,
+		EntryType:     lnwl.Add,
+		CustomRecords: records,
+	}
+}
+
+// newHtlcSettle creates a settle entry for the HTLC with the given index.
+func newHtlcSettle(parentIdx uint64) lnwl.AuxHtlcDescriptor {
+	return lnwl.AuxHtlcDescriptor{
+		ParentIndex: parentIdx,
+		EntryType:   lnwl.Settle,
+	}
+}
+
+// newBalanceTestInput creates a commitment input and new commitment with the
+// given view and balances.
+func newBalanceTestInput(t *testing.T, chanPoint wire.OutPoint,
+	whoseCommit lntypes.ChannelParty, local, remote uint64,
+	view lnwl.AuxHtlcView) (lnwl.CommitDiffAuxInput, *cmsg.Commitment) {
+
+	in := lnwl.CommitDiffAuxInput{
+		ChannelState: lnwl.AuxChanState{
+			FundingOutpoint: chanPoint,
+		},
+		UnfilteredView: view,
+		WhoseCommit:    whoseCommit,
+	}
+
+	var localOutputs, remoteOutputs []*cmsg.AssetOutput
+	if local > 0 {
+		localOutputs = append(localOutputs, cmsg.NewAssetOutput(
+			asset.ID{1}, local, proof.Proof{},
+		))
+	}
+	if remote > 0 {
+		remoteOutputs = append(remoteOutputs, cmsg.NewAssetOutput(
+			asset.ID{1}, remote, proof.Proof{},
+		))
+	}
+
+	commit := cmsg.NewCommitment(
+		localOutputs, remoteOutputs, nil, nil, lnwl.CommitAuxLeaves{},
+		false,
+	)
+
+	return in, commit
+}
+
+// TestNewBalanceSnapshot tests that the settled asset HTLCs of a view are
+// added to the balance snapshot.
+func TestNewBalanceSnapshot(t *testing.T) {
+	t.Parallel()
+
+	chanPoint := test.RandOp(t)
+	rfqID := rfqmsg.ID{7}
+
+	// We sent 30 units under a quote and received 20 units without one.
+	// Both HTLCs are settled in this update. The non-asset HTLC that is
+	// settled as well must not show up.
+	view := lnwl.AuxHtlcView{
+		Updates: lntypes.Dual[[]lnwl.AuxHtlcDescriptor]{
+			Local: []lnwl.AuxHtlcDescriptor{
+				newAssetHtlcAdd(t, 0, 30, fn.Some(rfqID)),
+				{
+					HtlcIndex: 1,
+					EntryType: lnwl.Add,
+				},
+				newHtlcSettle(5),
+			},
+			Remote: []lnwl.AuxHtlcDescriptor{
+				newAssetHtlcAdd(
+					t, 5, 20, fn.None[rfqmsg.ID](),
+				),
+				newHtlcSettle(0),
+				newHtlcSettle(1),
+			},
+		},
+	}
+
+	in, commit := newBalanceTestInput(
+		t, chanPoint, lntypes.Local, 90, 110, view,
+	)
+
+	now := time.Now()
+	snapshot, err := newSnapshot(in, commit, now)
+	require.NoError(t, err)
+
+	require.Equal(t, chanPoint, snapshot.ChanPoint)
+	require.EqualValues(t, 90, snapshot.LocalBalance)
+	require.EqualValues(t, 110, snapshot.RemoteBalance)
+	require.Equal(t, now.UTC(), snapshot.Timestamp)
+	require.Equal(t, []Htlc{
+		{
+			HtlcIndex:   5,
+			Incoming:    true,
+			AssetAmount: 20,
+		},
+		{
+			HtlcIndex:   0,
+			Incoming:    false,
+			AssetAmount: 30,
+			RfqID:       fn.Some(rfqID),
+		},
+	}, snapshot.SettledHtlcs)
+
+	// A settle of an unknown HTLC is an error.
+	view.Updates.Remote = []lnwl.AuxHtlcDescriptor{newHtlcSettle(9)}
+	in.UnfilteredView = view
+	_, err = newSnapshot(in, commit, now)
+	require.ErrorContains(t, err, "unable to find htlc")
+}
+
+// TestBalanceHistoryRecorder tests that the recorder only persists snapshots
+// of our local commitment that changed the balance, and adds the quote rates.
+func TestBalanceHistoryRecorder(t *testing.T) {
+	t.Parallel()
+
+	chanPoint := test.RandOp(t)
+	rfqID := rfqmsg.ID{7}
+	rate := rfqmath.NewBigIntFixedPoint(100_000, 0)
+
+	store := &mockBalanceHistoryStore{
+		inserted: make(chan *Snapshot, 10),
+	}
+	recorder := NewRecorder(&Config{
+		Store: store,
+		RateLookup: &mockQuoteRateLookup{
+			rates: map[rfqmsg.ID]rfqmath.BigIntFixedPoint{
+				rfqID: rate,
+			},
+		},
+	})
+	require.NoError(t, recorder.Start())
+	t.Cleanup(func() {
+		require.NoError(t, recorder.Stop())
+	})
+
+	record := func(whoseCommit lntypes.ChannelParty, local,
+		remote uint64, view lnwl.AuxHtlcView) {
+
+		in, commit := newBalanceTestInput(
+			t, chanPoint, whoseCommit, local, remote, view,
+		)
+
+		// Remote commitments are filtered before the blob is decoded.
+		if whoseCommit == lntypes.Remote {
+			recorder.RecordCommitment(in, nil)
+			return
+		}
+
+		recorder.recordCommitment(in, commit)
+	}
+
+	expectSnapshot := func() *Snapshot {
+		select {
+		case s := <-store.inserted:
+			return s
+		case <-time.After(time.Second):
+			t.Fatalf("no snapshot stored")
+			return nil
+		}
+	}
+
+	// The first snapshot of a channel is always stored.
+	record(lntypes.Local, 100, 100, lnwl.AuxHtlcView{})
+	s := expectSnapshot()
+	require.EqualValues(t, 100, s.LocalBalance)
+
+	// Remote commitments and updates that don't change the balance are
+	// ignored.
+	record(lntypes.Remote, 50, 150, lnwl.AuxHtlcView{})
+	record(lntypes.Local, 100, 100, lnwl.AuxHtlcView{})
+
+	// Settling an HTLC under a quote is recorded with the rate.
+	view := lnwl.AuxHtlcView{
+		Updates: lntypes.Dual[[]lnwl.AuxHtlcDescriptor]{
+			Local: []lnwl.AuxHtlcDescriptor{
+				newAssetHtlcAdd(t, 0, 30, fn.Some(rfqID)),
+			},
+			Remote: []lnwl.AuxHtlcDescriptor{
+				newHtlcSettle(0),
+			},
+		},
+	}
+	record(lntypes.Local, 70, 130, view)
+
+	s = expectSnapshot()
+	require.EqualValues(t, 70, s.LocalBalance)
+	require.Len(t, s.SettledHtlcs, 1)
+	require.Equal(t, fn.Some(rate), s.SettledHtlcs[0].Rate)
+
+	select {
+	case s := <-store.inserted:
+		t.Fatalf("unexpected snapshot stored: %v", s)
+	default:
+	}
+}
+
+// TestBalancePnL tests the per channel and per quote profit and loss and
+// their CSV export.
+func TestBalancePnL(t *testing.T) {
+	t.Parallel()
+
+	chanA, chanB := test.RandOp(t), test.RandOp(t)
+	quoteA, quoteB := rfqmsg.ID{1}, rfqmsg.ID{2}
+	rate := rfqmath.NewBigIntFixedPoint(100_000, 0)
+	start := time.Unix(1_700_000_000, 0)
+
+	snapshots := []*Snapshot{
+		{
+			ChanPoint:    chanA,
+			CommitHeight: 1,
+			LocalBalance: 100,
+			Timestamp:    start,
+		},
+		{
+			ChanPoint:    chanA,
+			CommitHeight: 2,
+			LocalBalance: 140,
+			Timestamp:    start.Add(time.Hour),
+			SettledHtlcs: []Htlc{
+				{
+					HtlcIndex:   0,
+					Incoming:    true,
+					AssetAmount: 50,
+					RfqID:       fn.Some(quoteA),
+					Rate:        fn.Some(rate),
+				},
+				{
+					HtlcIndex:   3,
+					AssetAmount: 10,
+					RfqID:       fn.Some(quoteB),
+				},
+			},
+		},
+		{
+			ChanPoint:    chanB,
+			CommitHeight: 7,
+			LocalBalance: 5,
+			Timestamp:    start.Add(2 * time.Hour),
+			SettledHtlcs: []Htlc{
+				{
+					HtlcIndex:   1,
+					AssetAmount: 20,
+					RfqID:       fn.Some(quoteA),
+					Rate:        fn.Some(rate),
+				},
+			},
+		},
+	}
+
+	channels := ComputeChannelPnL(snapshots)
+	require.Len(t, channels, 2)
+
+	a := channels[0]
+	require.Equal(t, chanA, a.ChanPoint)
+	require.Equal(t, start, a.StartTime)
+	require.Equal(t, start.Add(time.Hour), a.EndTime)
+	require.EqualValues(t, 100, a.StartLocalBalance)
+	require.EqualValues(t, 140, a.EndLocalBalance)
+	require.EqualValues(t, 2, a.NumHtlcs)
+	require.EqualValues(t, 50, a.AssetsReceived)
+	require.EqualValues(t, 10, a.AssetsSent)
+	require.EqualValues(t, 40, a.NetAssets())
+
+	// 50 units at 100k units per BTC are worth 50k sat. The HTLC without
+	// a rate has no value.
+	require.Equal(t, lnwire.MilliSatoshi(50_000_000), a.ReceivedMsat)
+	require.Zero(t, a.SentMsat)
+
+	require.EqualValues(t, -20, channels[1].NetAssets())
+
+	quotes := ComputeQuotePnL(snapshots)
+	require.Len(t, quotes, 2)
+	require.Equal(t, quoteA, quotes[0].RfqID)
+	require.Equal(t, fn.Some(rate), quotes[0].Rate)
+	require.EqualValues(t, 2, quotes[0].NumHtlcs)
+	require.EqualValues(t, 30, quotes[0].NetAssets())
+	require.Equal(t, lnwire.MilliSatoshi(20_000_000), quotes[0].SentMsat)
+	require.Equal(t, quoteB, quotes[1].RfqID)
+	require.True(t, quotes[1].Rate.IsNone())
+
+	readCSV := func(write func(b *bytes.Buffer) error) [][]string {
+		var b bytes.Buffer
+		require.NoError(t, write(&b))
+
+		records, err := csv.NewReader(&b).ReadAll()
+		require.NoError(t, err)
+
+		return records
+	}
+
+	// The history has a header, one row for the snapshot without HTLCs
+	// and one row per settled HTLC.
+	history := readCSV(func(b *bytes.Buffer) error {
+		return WriteSnapshotsCSV(b, snapshots)
+	})
+	require.Len(t, history, 5)
+	require.Equal(t, "", history[1][5])
+	require.Equal(t, "incoming", history[2][6])
+	require.Equal(t, "outgoing", history[3][6])
+	require.Equal(t, chanB.String(), history[4][1])
+
+	channelRows := readCSV(func(b *bytes.Buffer) error {
+		return WriteChannelPnLCSV(b, channels)
+	})
+	require.Len(t, channelRows, 3)
+	require.Equal(t, "net_assets", channelRows[0][8])
+	require.Equal(t, "40", channelRows[1][8])
+
+	quoteRows := readCSV(func(b *bytes.Buffer) error {
+		return WriteQuotePnLCSV(b, quotes)
+	})
+	require.Len(t, quoteRows, 3)
+	require.Equal(t, "", quoteRows[2][1])
+}
@@ -0,0 +1,487 @@
+package tapchannel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/rpcutils"
+	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// DefaultRebalanceAttempts is the default number of attempts to
+	// rebalance asset channels before giving up.
+	DefaultRebalanceAttempts = 3
+
+	// defaultRebalanceInvoiceExpiry is the expiry of the invoice and the
+	// quotes used for a single rebalance attempt.
+	defaultRebalanceInvoiceExpiry = 10 * time.Minute
+)
+
+// RebalanceChannelSource is the subset of the RFQ manager's functionality that
+// is needed to find the asset channels to rebalance and to route back into
+// one of them.
+type RebalanceChannelSource interface {
+	// FetchChannel returns the asset channels that match the specifier,
+	// sorted by the balance that is relevant for the given intention.
+	FetchChannel(ctx context.Context, specifier asset.Specifier,
+		peerPubKey *route.Vertex,
+		intention rfq.ChanIntention) (rfq.PeerChanMap, error)
+
+	// RfqToHopHint creates the hop hint for an accepted buy quote that
+	// routes the payment into our node through the given channel.
+	RfqToHopHint(ctx context.Context,
+		policyFetcher rfq.InboundPolicyFetcher, channelID uint64,
+		peerPubKey route.Vertex, quote *rfqrpc.PeerAcceptedBuyQuote,
+		hold bool) (*zpay32.HopHint, error)
+}
+
+// RebalanceQuoter negotiates the RFQ quotes for a circular asset payment.
+type RebalanceQuoter interface {
+	// AcquireSellQuote negotiates a quote with the given peer to sell
+	// assets for a payment of up to the given amount.
+	AcquireSellQuote(ctx context.Context, specifier asset.Specifier,
+		peer route.Vertex, paymentMaxAmt lnwire.MilliSatoshi,
+		expiry time.Time) (*rfqrpc.PeerAcceptedSellQuote, error)
+
+	// AcquireBuyQuote negotiates a quote with the given peer to buy up to
+	// the given amount of asset units.
+	AcquireBuyQuote(ctx context.Context, specifier asset.Specifier,
+		peer route.Vertex, assetMaxAmt uint64,
+		expiry time.Time) (*rfqrpc.PeerAcceptedBuyQuote, error)
+}
+
+// RebalancePaymentResult is the result of a circular payment sent by the
+// RebalancePayer.
+type RebalancePaymentResult struct {
+	// Preimage is the preimage of the paid invoice. This is only set if
+	// the payment succeeded.
+	Preimage fn.Option[lntypes.Preimage]
+
+	// FeeMsat is the total routing fee paid.
+	FeeMsat lnwire.MilliSatoshi
+
+	// FailureReason is the reason the payment failed, if it did.
+	FailureReason string
+}
+
+// RebalancePayer creates and pays the invoice of a circular payment.
+type RebalancePayer interface {
+	// CreateInvoice creates an invoice over the given amount that can only
+	// be paid through the given hop hint.
+	CreateInvoice(ctx context.Context, amt lnwire.MilliSatoshi,
+		hopHint *zpay32.HopHint, expiry time.Duration,
+		memo string) (string, lntypes.Hash, error)
+
+	// PayInvoice pays the invoice through the given outgoing channel,
+	// with the last hop being the given peer.
+	PayInvoice(ctx context.Context, payReq string, outgoingChanID uint64,
+		lastHop route.Vertex, feeLimit lnwire.MilliSatoshi,
+		firstHopRecords map[uint64][]byte) (*RebalancePaymentResult,
+		error)
+}
+
+// RebalancerConfig houses the dependencies of the Rebalancer.
+type RebalancerConfig struct {
+	// Channels is used to find the asset channels to rebalance.
+	Channels RebalanceChannelSource
+
+	// PolicyFetcher fetches the inbound policy of the channel we route
+	// the payment back into.
+	PolicyFetcher rfq.InboundPolicyFetcher
+
+	// Quoter negotiates the RFQ quotes for each attempt.
+	Quoter RebalanceQuoter
+
+	// Payer creates and pays the invoice of each attempt.
+	Payer RebalancePayer
+}
+
+// RebalanceReq is a request to shift asset liquidity from one of our asset
+// channels to another one with a circular payment.
+type RebalanceReq struct {
+	// AssetSpecifier is the asset to rebalance.
+	AssetSpecifier asset.Specifier
+
+	// OutgoingChanID is the channel ID of the channel whose local asset
+	// balance is reduced.
+	OutgoingChanID uint64
+
+	// IncomingChanID is the channel ID of the channel whose local asset
+	// balance is increased.
+	IncomingChanID uint64
+
+	// AssetAmount is the number of asset units to receive in the incoming
+	// channel.
+	AssetAmount uint64
+
+	// MaxAssetFee is the maximum number of asset units the rebalance may
+	// cost, including the spread of the quotes and the routing fees.
+	MaxAssetFee uint64
+
+	// MaxAttempts is the maximum number of attempts. If this is zero,
+	// DefaultRebalanceAttempts is used.
+	MaxAttempts uint32
+}
+
+// RebalanceAttempt is the result of a single attempt to rebalance.
+type RebalanceAttempt struct {
+	// Attempt is the 1-based number of the attempt.
+	Attempt uint32
+
+	// PaymentHash is the hash of the circular payment, if an invoice was
+	// created.
+	PaymentHash fn.Option[lntypes.Hash]
+
+	// SellQuoteID is the ID of the quote used for the outgoing channel.
+	SellQuoteID fn.Option[rfqmsg.ID]
+
+	// BuyQuoteID is the ID of the quote used for the incoming channel.
+	BuyQuoteID fn.Option[rfqmsg.ID]
+
+	// InvoiceAmt is the amount of the circular invoice.
+	InvoiceAmt lnwire.MilliSatoshi
+
+	// FeeLimit is the routing fee limit of the payment, derived from the
+	// maximum asset fee of the request.
+	FeeLimit lnwire.MilliSatoshi
+
+	// RoutingFee is the routing fee paid.
+	RoutingFee lnwire.MilliSatoshi
+
+	// AssetFee is the total cost of the attempt in asset units. This is
+	// only set if the payment succeeded.
+	AssetFee uint64
+
+	// Success is true if the circular payment succeeded.
+	Success bool
+
+	// Err is the error of a failed attempt.
+	Err error
+}
+
+// Rebalancer shifts asset liquidity between our asset channels by paying an
+// invoice to ourselves. The payment leaves through the outgoing channel, paid
+// for with assets using a sell quote, and comes back through the incoming
+// channel, where the peer converts it to assets using a buy quote.
+type Rebalancer struct {
+	cfg RebalancerConfig
+}
+
+// NewRebalancer creates a new Rebalancer.
+func NewRebalancer(cfg RebalancerConfig) *Rebalancer {
+	return &Rebalancer{
+		cfg: cfg,
+	}
+}
+
+// rebalanceChannels are the two channels of a rebalance.
+type rebalanceChannels struct {
+	outgoing rfq.TapChannel
+	incoming rfq.TapChannel
+}
+
+// findTapChannel returns the asset channel with the given ID.
+func findTapChannel(chanMap rfq.PeerChanMap,
+	chanID uint64) (rfq.TapChannel, error) {
+
+	for _, channels := range chanMap {
+		for _, c := range channels {
+			if c.ChannelInfo.ChannelID == chanID {
+				return c, nil
+			}
+		}
+	}
+
+	return rfq.TapChannel{}, fmt.Errorf("asset channel %d not found",
+		chanID)
+}
+
+// selectChannels looks up the channels of the request and makes sure they have
+// enough balance for the rebalance.
+func (r *Rebalancer) selectChannels(ctx context.Context,
+	req *RebalanceReq) (*rebalanceChannels, error) {
+
+	sendChans, err := r.cfg.Channels.FetchChannel(
+		ctx, req.AssetSpecifier, nil, rfq.SendIntention,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch outgoing channels: %w",
+			err)
+	}
+	outgoing, err := findTapChannel(sendChans, req.OutgoingChanID)
+	if err != nil {
+		return nil, fmt.Errorf("outgoing channel: %w", err)
+	}
+
+	receiveChans, err := r.cfg.Channels.FetchChannel(
+		ctx, req.AssetSpecifier, nil, rfq.ReceiveIntention,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch incoming channels: %w",
+			err)
+	}
+	incoming, err := findTapChannel(receiveChans, req.IncomingChanID)
+	if err != nil {
+		return nil, fmt.Errorf("incoming channel: %w", err)
+	}
+
+	if outgoing.AssetInfo.LocalBalance < req.AssetAmount {
+		return nil, fmt.Errorf("outgoing channel %d has local asset "+
+			"balance %d, need %d", req.OutgoingChanID,
+			outgoing.AssetInfo.LocalBalance, req.AssetAmount)
+	}
+	if incoming.AssetInfo.RemoteBalance < req.AssetAmount {
+		return nil, fmt.Errorf("incoming channel %d has remote asset "+
+			"balance %d, need %d", req.IncomingChanID,
+			incoming.AssetInfo.RemoteBalance, req.AssetAmount)
+	}
+
+	return &rebalanceChannels{
+		outgoing: outgoing,
+		incoming: incoming,
+	}, nil
+}
+
+// quoteID parses the ID of an accepted RPC quote.
+func quoteID(id []byte) (rfqmsg.ID, error) {
+	var qid rfqmsg.ID
+	if len(id) != len(qid) {
+		return qid, fmt.Errorf("quote ID has length %d, expected %d",
+			len(id), len(qid))
+	}
+
+	copy(qid[:], id)
+
+	return qid, nil
+}
+
+// rebalanceFeeLimit returns the routing fee limit of a circular payment over
+// the given invoice amount. The asset units spent on the invoice amount at the
+// sell rate, minus the asset amount received, is the spread of the quotes. The
+// rest of the maximum asset fee is available for routing fees.
+func rebalanceFeeLimit(invoiceAmt lnwire.MilliSatoshi, assetAmt,
+	maxAssetFee uint64,
+	sellRate rfqmath.BigIntFixedPoint) (lnwire.MilliSatoshi, error) {
+
+	spentUnits := rfqmath.MilliSatoshiToUnits(
+		invoiceAmt, sellRate,
+	).ScaleTo(0).ToUint64()
+
+	var spread uint64
+	if spentUnits > assetAmt {
+		spread = spentUnits - assetAmt
+	}
+
+	if spread > maxAssetFee {
+		return 0, fmt.Errorf("quote spread of %d asset units exceeds "+
+			"max asset fee of %d units", spread, maxAssetFee)
+	}
+
+	feeBudget := rfqmath.NewBigIntFixedPoint(maxAssetFee-spread, 0)
+
+	return rfqmath.UnitsToMilliSatoshi(feeBudget, sellRate), nil
+}
+
+// rebalanceAssetFee returns the total asset units a circular payment cost.
+func rebalanceAssetFee(invoiceAmt, routingFee lnwire.MilliSatoshi,
+	assetAmt uint64, sellRate rfqmath.BigIntFixedPoint) uint64 {
+
+	spentUnits := rfqmath.MilliSatoshiToUnits(
+		invoiceAmt+routingFee, sellRate,
+	).ScaleTo(0).ToUint64()
+
+	if spentUnits < assetAmt {
+		return 0
+	}
+
+	return spentUnits - assetAmt
+}
+
+// Rebalance shifts asset liquidity from the outgoing to the incoming channel
+// of the request. Each attempt negotiates new quotes. The rebalance stops after
+// the first successful attempt. The results of all attempts are returned, an
+// error is only returned if the request is invalid.
+func (r *Rebalancer) Rebalance(ctx context.Context,
+	req RebalanceReq) ([]*RebalanceAttempt, error) {
+
+	switch {
+	case req.AssetAmount == 0:
+		return nil, fmt.Errorf("asset amount must be specified")
+
+	case req.OutgoingChanID == req.IncomingChanID:
+		return nil, fmt.Errorf("outgoing and incoming channel must " +
+			"be different")
+	}
+
+	maxAttempts := req.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultRebalanceAttempts
+	}
+
+	var attempts []*RebalanceAttempt
+	for i := uint32(1); i <= maxAttempts; i++ {
+		attempt := r.attempt(ctx, &req, i)
+		attempts = append(attempts, attempt)
+
+		if attempt.Success {
+			log.Infof("Rebalanced %d asset units from channel %d "+
+				"to %d in attempt %d, asset_fee=%d",
+				req.AssetAmount, req.OutgoingChanID,
+				req.IncomingChanID, i, attempt.AssetFee)
+
+			break
+		}
+
+		log.Warnf("Rebalance attempt %d of %d failed: %v", i,
+			maxAttempts, attempt.Err)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return attempts, nil
+}
+
+// attempt executes a single rebalance attempt.
+func (r *Rebalancer) attempt(ctx context.Context, req *RebalanceReq,
+	num uint32) *RebalanceAttempt {
+
+	result := &RebalanceAttempt{
+		Attempt: num,
+	}
+	fail := func(err error) *RebalanceAttempt {
+		result.Err = err
+		return result
+	}
+
+	// The balances change with every attempt, so we look up the channels
+	// again each time.
+	chans, err := r.selectChannels(ctx, req)
+	if err != nil {
+		return fail(err)
+	}
+
+	outPeer := chans.outgoing.ChannelInfo.PubKeyBytes
+	inPeer := chans.incoming.ChannelInfo.PubKeyBytes
+	expiry := time.Now().Add(defaultRebalanceInvoiceExpiry)
+
+	// We start with the incoming side, as the buy quote determines the
+	// amount of the invoice.
+	buyQuote, err := r.cfg.Quoter.AcquireBuyQuote(
+		ctx, req.AssetSpecifier, inPeer, req.AssetAmount, expiry,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to acquire buy quote: %w", err))
+	}
+	buyQuoteID, err := quoteID(buyQuote.Id)
+	if err != nil {
+		return fail(fmt.Errorf("invalid buy quote: %w", err))
+	}
+	result.BuyQuoteID = fn.Some(buyQuoteID)
+
+	askRate, err := rpcutils.UnmarshalRfqFixedPoint(buyQuote.AskAssetRate)
+	if err != nil {
+		return fail(fmt.Errorf("invalid buy quote rate: %w", err))
+	}
+
+	result.InvoiceAmt = rfqmath.UnitsToMilliSatoshi(
+		rfqmath.NewBigIntFixedPoint(req.AssetAmount, 0), *askRate,
+	)
+
+	hopHint, err := r.cfg.Channels.RfqToHopHint(
+		ctx, r.cfg.PolicyFetcher, chans.incoming.ChannelInfo.ChannelID,
+		inPeer, buyQuote, false,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to create hop hint: %w", err))
+	}
+
+	// We don't know the sell rate yet, so we use the ask rate to estimate
+	// the routing fee budget the sell quote needs to cover.
+	maxFeeEstimate := rfqmath.UnitsToMilliSatoshi(
+		rfqmath.NewBigIntFixedPoint(req.MaxAssetFee, 0), *askRate,
+	)
+	sellQuote, err := r.cfg.Quoter.AcquireSellQuote(
+		ctx, req.AssetSpecifier, outPeer,
+		result.InvoiceAmt+maxFeeEstimate, expiry,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to acquire sell quote: %w", err))
+	}
+	sellQuoteID, err := quoteID(sellQuote.Id)
+	if err != nil {
+		return fail(fmt.Errorf("invalid sell quote: %w", err))
+	}
+	result.SellQuoteID = fn.Some(sellQuoteID)
+
+	bidRate, err := rpcutils.UnmarshalRfqFixedPoint(sellQuote.BidAssetRate)
+	if err != nil {
+		return fail(fmt.Errorf("invalid sell quote rate: %w", err))
+	}
+
+	feeLimit, err := rebalanceFeeLimit(
+		result.InvoiceAmt, req.AssetAmount, req.MaxAssetFee, *bidRate,
+	)
+	if err != nil {
+		return fail(err)
+	}
+	result.FeeLimit = min(feeLimit, maxFeeEstimate)
+
+	memo := fmt.Sprintf("asset rebalance %d -> %d", req.OutgoingChanID,
+		req.IncomingChanID)
+	payReq, payHash, err := r.cfg.Payer.CreateInvoice(
+		ctx, result.InvoiceAmt, hopHint, defaultRebalanceInvoiceExpiry,
+		memo,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to create invoice: %w", err))
+	}
+	result.PaymentHash = fn.Some(payHash)
+
+	// The sell quote is passed to lnd in the first hop records, so the
+	// traffic shaper can compute the asset bandwidth of the outgoing
+	// channel.
+	htlc := rfqmsg.NewHtlc(
+		nil, fn.None[rfqmsg.ID](),
+		fn.Some([]rfqmsg.ID{sellQuoteID}),
+	)
+	firstHopRecords, err := tlv.RecordsToMap(htlc.Records())
+	if err != nil {
+		return fail(fmt.Errorf("unable to encode HTLC records: %w",
+			err))
+	}
+
+	payment, err := r.cfg.Payer.PayInvoice(
+		ctx, payReq, req.OutgoingChanID, inPeer, result.FeeLimit,
+		firstHopRecords,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to pay invoice: %w", err))
+	}
+
+	result.RoutingFee = payment.FeeMsat
+	if payment.Preimage.IsNone() {
+		return fail(fmt.Errorf("payment failed: %s",
+			payment.FailureReason))
+	}
+
+	result.Success = true
+	result.AssetFee = rebalanceAssetFee(
+		result.InvoiceAmt, payment.FeeMsat, req.AssetAmount, *bidRate,
+	)
+
+	return result
+}
@@ -0,0 +1,309 @@
+package tapchannel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testAskRate is the asset rate of the buy quotes in units per BTC.
+	testAskRate = 100_000
+
+	// testBidRate is the asset rate of the sell quotes in units per BTC.
+	testBidRate = 101_000
+)
+
+var (
+	testOutPeer = route.Vertex{1}
+	testInPeer  = route.Vertex{2}
+)
+
+// mockRebalanceChannels is a mock implementation of the
+// RebalanceChannelSource interface.
+type mockRebalanceChannels struct {
+	channels rfq.PeerChanMap
+}
+
+func (m *mockRebalanceChannels) FetchChannel(_ context.Context,
+	_ asset.Specifier, _ *route.Vertex,
+	_ rfq.ChanIntention) (rfq.PeerChanMap, error) {
+
+	return m.channels, nil
+}
+
+func (m *mockRebalanceChannels) RfqToHopHint(_ context.Context,
+	_ rfq.InboundPolicyFetcher, channelID uint64, peerPubKey route.Vertex,
+	_ *rfqrpc.PeerAcceptedBuyQuote, _ bool) (*zpay32.HopHint, error) {
+
+	return &zpay32.HopHint{
+		ChannelID: channelID,
+	}, nil
+}
+
+// mockRebalanceQuoter is a mock implementation of the RebalanceQuoter
+// interface.
+type mockRebalanceQuoter struct {
+	sellPeers []route.Vertex
+	buyPeers  []route.Vertex
+}
+
+func testFixedPoint(rate uint64) *rfqrpc.FixedPoint {
+	return &rfqrpc.FixedPoint{
+		Coefficient: fmt.Sprintf("%d", rate),
+	}
+}
+
+func (m *mockRebalanceQuoter) AcquireSellQuote(_ context.Context,
+	_ asset.Specifier, peer route.Vertex, _ lnwire.MilliSatoshi,
+	_ time.Time) (*rfqrpc.PeerAcceptedSellQuote, error) {
+
+	m.sellPeers = append(m.sellPeers, peer)
+
+	return &rfqrpc.PeerAcceptedSellQuote{
+		Id:           bytes.Repeat([]byte{1}, 32),
+		BidAssetRate: testFixedPoint(testBidRate),
+	}, nil
+}
+
+func (m *mockRebalanceQuoter) AcquireBuyQuote(_ context.Context,
+	_ asset.Specifier, peer route.Vertex, _ uint64,
+	_ time.Time) (*rfqrpc.PeerAcceptedBuyQuote, error) {
+
+	m.buyPeers = append(m.buyPeers, peer)
+
+	return &rfqrpc.PeerAcceptedBuyQuote{
+		Id:           bytes.Repeat([]byte{2}, 32),
+		AskAssetRate: testFixedPoint(testAskRate),
+	}, nil
+}
+
+// mockRebalancePayer is a mock implementation of the RebalancePayer
+// interface that fails the first failures payments.
+type mockRebalancePayer struct {
+	failures int
+	payments int
+
+	outgoingChanID uint64
+	lastHop        route.Vertex
+	feeLimit       lnwire.MilliSatoshi
+	records        map[uint64][]byte
+}
+
+func (m *mockRebalancePayer) CreateInvoice(_ context.Context,
+	_ lnwire.MilliSatoshi, _ *zpay32.HopHint, _ time.Duration,
+	_ string) (string, lntypes.Hash, error) {
+
+	return "lnbc", lntypes.Hash{byte(m.payments)}, nil
+}
+
+func (m *mockRebalancePayer) PayInvoice(_ context.Context, _ string,
+	outgoingChanID uint64, lastHop route.Vertex,
+	feeLimit lnwire.MilliSatoshi,
+	records map[uint64][]byte) (*RebalancePaymentResult, error) {
+
+	m.payments++
+	m.outgoingChanID = outgoingChanID
+	m.lastHop = lastHop
+	m.feeLimit = feeLimit
+	m.records = records
+
+	if m.payments <= m.failures {
+		return &RebalancePaymentResult{
+			FailureReason: "no route",
+		}, nil
+	}
+
+	return &RebalancePaymentResult{
+		Preimage: fn.Some(lntypes.Preimage{1}),
+		FeeMsat:  1_000_000,
+	}, nil
+}
+
+// newTestTapChannel creates an asset channel with the given balances.
+func newTestTapChannel(chanID uint64, peer route.Vertex, local,
+	remote uint64) rfq.TapChannel {
+
+	return rfq.TapChannel{
+		ChannelInfo: lndclient.ChannelInfo{
+			ChannelID:   chanID,
+			PubKeyBytes: peer,
+		},
+		AssetInfo: rfqmsg.JsonAssetChannel{
+			LocalBalance:  local,
+			RemoteBalance: remote,
+		},
+	}
+}
+
+// TestRebalanceFeeLimit tests that the routing fee limit is derived from the
+// maximum asset fee minus the spread of the quotes.
+func TestRebalanceFeeLimit(t *testing.T) {
+	t.Parallel()
+
+	bidRate := rfqmath.NewBigIntFixedPoint(testBidRate, 0)
+
+	// Paying 0.001 BTC costs 101 units at the bid rate, so receiving 100
+	// units has a spread of one unit.
+	invoiceAmt := lnwire.MilliSatoshi(100_000_000)
+
+	feeLimit, err := rebalanceFeeLimit(invoiceAmt, 100, 5, bidRate)
+	require.NoError(t, err)
+
+	// The remaining 4 units are worth just under 4 million msat.
+	require.EqualValues(t, 3_960_396, feeLimit)
+
+	_, err = rebalanceFeeLimit(invoiceAmt, 100, 0, bidRate)
+	require.ErrorContains(t, err, "exceeds max asset fee")
+
+	fee := rebalanceAssetFee(invoiceAmt, 1_000_000, 100, bidRate)
+	require.EqualValues(t, 2, fee)
+}
+
+// TestRebalance tests that a rebalance retries failed payments and reports
+// the result of each attempt.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	channels := &mockRebalanceChannels{
+		channels: rfq.PeerChanMap{
+			testOutPeer: {
+				newTestTapChannel(1, testOutPeer, 500, 0),
+			},
+			testInPeer: {
+				newTestTapChannel(2, testInPeer, 0, 500),
+			},
+		},
+	}
+
+	newRebalancer := func(failures int) (*Rebalancer, *mockRebalanceQuoter,
+		*mockRebalancePayer) {
+
+		quoter := &mockRebalanceQuoter{}
+		payer := &mockRebalancePayer{failures: failures}
+
+		return NewRebalancer(RebalancerConfig{
+			Channels: channels,
+			Quoter:   quoter,
+			Payer:    payer,
+		}), quoter, payer
+	}
+
+	req := RebalanceReq{
+		OutgoingChanID: 1,
+		IncomingChanID: 2,
+		AssetAmount:    100,
+		MaxAssetFee:    5,
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		r, _, _ := newRebalancer(0)
+
+		_, err := r.Rebalance(context.Background(), RebalanceReq{
+			OutgoingChanID: 1,
+			IncomingChanID: 1,
+			AssetAmount:    1,
+		})
+		require.ErrorContains(t, err, "must be different")
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		r, _, payer := newRebalancer(0)
+
+		tooMuch := req
+		tooMuch.AssetAmount = 501
+		tooMuch.MaxAttempts = 1
+
+		attempts, err := r.Rebalance(context.Background(), tooMuch)
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		require.False(t, attempts[0].Success)
+		require.ErrorContains(
+			t, attempts[0].Err, "has local asset balance",
+		)
+		require.Zero(t, payer.payments)
+	})
+
+	t.Run("success after retry", func(t *testing.T) {
+		r, quoter, payer := newRebalancer(1)
+
+		attempts, err := r.Rebalance(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+
+		require.False(t, attempts[0].Success)
+		require.ErrorContains(t, attempts[0].Err, "no route")
+
+		success := attempts[1]
+		require.True(t, success.Success)
+		require.NoError(t, success.Err)
+		require.EqualValues(t, 2, success.Attempt)
+		require.EqualValues(t, 100_000_000, success.InvoiceAmt)
+		require.EqualValues(t, 3_960_396, success.FeeLimit)
+		require.EqualValues(t, 1_000_000, success.RoutingFee)
+		require.EqualValues(t, 2, success.AssetFee)
+		require.True(t, success.PaymentHash.IsSome())
+		require.True(t, success.SellQuoteID.IsSome())
+		require.True(t, success.BuyQuoteID.IsSome())
+
+		// The assets are sold to the outgoing peer and bought from the
+		// incoming peer.
+		require.Equal(
+			t, []route.Vertex{testOutPeer, testOutPeer},
+			quoter.sellPeers,
+		)
+		require.Equal(
+			t, []route.Vertex{testInPeer, testInPeer},
+			quoter.buyPeers,
+		)
+
+		require.EqualValues(t, 1, payer.outgoingChanID)
+		require.Equal(t, testInPeer, payer.lastHop)
+		require.NotEmpty(t, payer.records)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		r, _, payer := newRebalancer(10)
+
+		attempts, err := r.Rebalance(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, attempts, DefaultRebalanceAttempts)
+		require.Equal(t, DefaultRebalanceAttempts, payer.payments)
+
+		for _, a := range attempts {
+			require.False(t, a.Success)
+			require.Error(t, a.Err)
+		}
+	})
+
+	t.Run("fee too high", func(t *testing.T) {
+		r, _, payer := newRebalancer(0)
+
+		noFee := req
+		noFee.MaxAssetFee = 0
+		noFee.MaxAttempts = 1
+
+		attempts, err := r.Rebalance(context.Background(), noFee)
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		require.ErrorContains(
+			t, attempts[0].Err, "exceeds max asset fee",
+		)
+		require.Zero(t, payer.payments)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/clock"
@@ -3961,6 +3962,54 @@ func (a *AssetStore) TxHeight(ctx context.Context, txid chainhash.Hash) (uint32,
 	return uint32(dbBlockHeight), nil
 }
 
+// MarkAnchorTxUnconfirmed removes the block information of the given anchor
+// transaction, which marks all assets anchored in it as unconfirmed.
+//
+// NOTE: This is part of the tapgarden.AnchorTxStore interface.
+func (a *AssetStore) MarkAnchorTxUnconfirmed(ctx context.Context,
+	txid chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.ConfirmChainAnchorTx(ctx, AnchorTxConf{
+			Txid: txid[:],
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unconfirm anchor tx: %w", err)
+	}
+
+	a.txHeights.Delete(txid)
+
+	return nil
+}
+
+// MarkAnchorTxConfirmed sets the block information of the given anchor
+// transaction after it confirmed in a new block.
+//
+// NOTE: This is part of the tapgarden.AnchorTxStore interface.
+func (a *AssetStore) MarkAnchorTxConfirmed(ctx context.Context,
+	txid chainhash.Hash, blockHash chainhash.Hash, blockHeight,
+	txIndex uint32) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.ConfirmChainAnchorTx(ctx, AnchorTxConf{
+			Txid:        txid[:],
+			BlockHash:   blockHash[:],
+			BlockHeight: sqlInt32(blockHeight),
+			TxIndex:     sqlInt32(txIndex),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("unable to confirm anchor tx: %w", err)
+	}
+
+	a.txHeights.Delete(txid)
+
+	return nil
+}
+
 // QueryBurns queries burnt assets based on the passed filters.
 func (a *AssetStore) QueryBurns(ctx context.Context,
 	filters QueryBurnsFilters) ([]*tapfreighter.AssetBurn, error) {
@@ -4015,3 +4064,7 @@ var _ tapfreighter.CoinLister = (*AssetStore)(nil)
 // A compile-time constraint to ensure that AssetStore meets the
 // tapfreighter.ExportLog interface.
 var _ tapfreighter.ExportLog = (*AssetStore)(nil)
+
+// A compile-time constraint to ensure that AssetStore meets the
+// tapgarden.AnchorTxStore interface.
+var _ tapgarden.AnchorTxStore = (*AssetStore)(nil)
@@ -3383,3 +3383,63 @@ func TestUpsertAssetsWithSplitCommitments(t *testing.T) {
 		})
 	}
 }
+
+// TestMarkAnchorTxConfirmation tests that the confirmation status of an anchor
+// transaction can be updated after a re-org.
+func TestMarkAnchorTxConfirmation(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: test.RandBytes(34),
+		Value:    1_000,
+	})
+	txHash := anchorTx.TxHash()
+
+	var txBuf bytes.Buffer
+	require.NoError(t, anchorTx.Serialize(&txBuf))
+
+	blockHash := test.RandHash()
+	_, err := db.UpsertChainTx(ctx, ChainTxParams{
+		Txid:        txHash[:],
+		RawTx:       txBuf.Bytes(),
+		BlockHeight: sqlInt32(100),
+		BlockHash:   blockHash[:],
+		TxIndex:     sqlInt32(1),
+	})
+	require.NoError(t, err)
+
+	height, err := assetsStore.TxHeight(ctx, txHash)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, height)
+
+	// Once the transaction is evicted from the chain, it no longer has a
+	// block height.
+	require.NoError(t, assetsStore.MarkAnchorTxUnconfirmed(ctx, txHash))
+
+	_, err = assetsStore.TxHeight(ctx, txHash)
+	require.ErrorContains(t, err, "tx height not found")
+
+	dbTx, err := db.FetchChainTx(ctx, txHash[:])
+	require.NoError(t, err)
+	require.False(t, dbTx.BlockHeight.Valid)
+	require.Empty(t, dbTx.BlockHash)
+
+	// When it confirms again, the new block is stored.
+	newBlockHash := test.RandHash()
+	require.NoError(t, assetsStore.MarkAnchorTxConfirmed(
+		ctx, txHash, newBlockHash, 105, 3,
+	))
+
+	height, err = assetsStore.TxHeight(ctx, txHash)
+	require.NoError(t, err)
+	require.EqualValues(t, 105, height)
+
+	dbTx, err = db.FetchChainTx(ctx, txHash[:])
+	require.NoError(t, err)
+	require.Equal(t, newBlockHash[:], dbTx.BlockHash)
+	require.EqualValues(t, 3, dbTx.TxIndex.Int32)
+}
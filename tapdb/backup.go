@@ -0,0 +1,894 @@
+package tapdb
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+const (
+	// BackupVersion is the current version of the backup archive format.
+	BackupVersion = 1
+)
+
+var (
+	// ErrBackupChecksumMismatch is returned if the checksum stored in a
+	// backup archive doesn't match its content.
+	ErrBackupChecksumMismatch = errors.New("backup checksum mismatch")
+
+	// ErrBackupNodeMismatch is returned if a backup archive was created by
+	// a node that uses a different lnd seed than the node it is restored
+	// to.
+	ErrBackupNodeMismatch = errors.New("backup was created for a " +
+		"different node")
+
+	// ErrBackupVersionMismatch is returned if a backup archive was created
+	// with an unknown archive format or a different database version.
+	ErrBackupVersionMismatch = errors.New("backup version mismatch")
+
+	// ErrRestoreDatabaseNotEmpty is returned if a backup is restored into
+	// a database that already contains data.
+	ErrRestoreDatabaseNotEmpty = errors.New("database to restore into " +
+		"is not empty")
+
+	// backupColumnRegex is the pattern all column names of a backup
+	// archive must match, as they end up in the restore statements.
+	backupColumnRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+)
+
+// backupTables is the list of tables that are part of a backup, in an order
+// that satisfies all foreign key references between them. The rows of each
+// table are restored in the order of their primary key, which takes care of
+// tables that reference themselves.
+var backupTables = []string{
+	"assets_meta",
+	"chain_txns",
+	"genesis_points",
+	"genesis_assets",
+	"internal_keys",
+	"script_keys",
+	"addrs",
+	"managed_utxos",
+	"addr_events",
+	"addr_event_outputs",
+	"tapscript_roots",
+	"asset_groups",
+	"asset_group_witnesses",
+	"assets",
+	"asset_proofs",
+	"addr_event_proofs",
+	"asset_transfers",
+	"asset_burn_transfers",
+	"asset_minting_batches",
+	"asset_seedlings",
+	"asset_transfer_inputs",
+	"asset_transfer_outputs",
+	"asset_witnesses",
+	"tx_proof_claimed_outpoints",
+	"authmailbox_messages",
+	"channel_balance_snapshots",
+	"channel_balance_htlcs",
+	"federation_global_sync_config",
+	"mssmt_nodes",
+	"mssmt_roots",
+	"universe_roots",
+	"universe_leaves",
+	"universe_servers",
+	"federation_proof_sync_log",
+	"federation_uni_sync_config",
+	"mint_schedules",
+	"supply_commitments",
+	"mint_supply_pre_commits",
+	"multiverse_roots",
+	"multiverse_leaves",
+	"passive_assets",
+	"proof_transfer_log",
+	"rfq_accepted_quotes",
+	"rfq_collected_forward_fees",
+	"scheduled_sends",
+	"scheduled_send_recipients",
+	"supply_commit_state_machines",
+	"supply_commit_transitions",
+	"supply_pre_commits",
+	"supply_syncer_push_log",
+	"supply_update_events",
+	"tapscript_nodes",
+	"tapscript_edges",
+	"universe_events",
+	"universe_supply_roots",
+	"universe_supply_leaves",
+	"universe_write_quotas",
+	"verified_proofs",
+}
+
+// walletTables is the list of tables that hold wallet data. A backup is only
+// restored into a database that was already used by a fresh node if none of
+// these tables contain any rows.
+var walletTables = []string{
+	"internal_keys",
+	"script_keys",
+	"addrs",
+	"managed_utxos",
+	"assets",
+	"asset_transfers",
+	"asset_minting_batches",
+	"scheduled_sends",
+}
+
+// secretTables is the list of tables that hold secrets of the node itself.
+// They are never exported, so a backup archive can't be used to forge
+// credentials for the node, and they are kept as they are when a backup is
+// restored.
+var secretTables = []string{
+	"macaroons",
+}
+
+// staticTables is the list of tables that are populated with a fixed set of
+// rows by the migrations. They are not part of a backup since every database
+// of the same version already contains them.
+var staticTables = []string{
+	"proof_types",
+	"supply_commit_states",
+	"supply_commit_update_types",
+}
+
+// BackupHeader is the header of a backup archive.
+type BackupHeader struct {
+	// Version is the version of the archive format.
+	Version uint32 `json:"version"`
+
+	// DatabaseVersion is the migration version of the database the backup
+	// was created from.
+	DatabaseVersion uint `json:"database_version"`
+
+	// Backend is the type of database backend the backup was created
+	// from. This is informational only, a backup can be restored into
+	// any supported backend.
+	Backend string `json:"backend"`
+
+	// NodeKey is the hex encoded identity public key of the lnd node the
+	// backup was created for. As the key is derived from the lnd seed, it
+	// ties the backup to the seed that is required to spend the assets.
+	NodeKey string `json:"node_key"`
+
+	// CreationTime is the time the backup was created at.
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// backupValue is a single column value of a row in a backup archive. At most
+// one of the fields is set, a value without any field set is NULL.
+type backupValue struct {
+	Int   *int64     `json:"i,omitempty"`
+	Float *float64   `json:"f,omitempty"`
+	Bool  *bool      `json:"b,omitempty"`
+	Str   *string    `json:"s,omitempty"`
+	Bytes *[]byte    `json:"x,omitempty"`
+	Time  *time.Time `json:"t,omitempty"`
+}
+
+// backupRecord is a single line of a backup archive. The first record of an
+// archive is the header, followed by a table record that lists the columns of
+// each table and the row records of that table. The last record holds the
+// checksum over all previous lines.
+type backupRecord struct {
+	Header   *BackupHeader `json:"header,omitempty"`
+	Table    string        `json:"table,omitempty"`
+	Columns  []string      `json:"columns,omitempty"`
+	Row      []backupValue `json:"row,omitempty"`
+	Checksum string        `json:"checksum,omitempty"`
+}
+
+// BackupManager creates and restores backups of the complete asset database.
+// A backup is a gzip compressed archive of JSON lines that contains the rows
+// of all tables independent of the database backend, so it can be restored
+// into both SQLite and Postgres.
+type BackupManager struct {
+	db BatchedQuerier
+
+	nodeKey *btcec.PublicKey
+}
+
+// NewBackupManager creates a new BackupManager for the given database and the
+// identity key of the lnd node the database belongs to.
+func NewBackupManager(db BatchedQuerier,
+	nodeKey *btcec.PublicKey) *BackupManager {
+
+	return &BackupManager{
+		db:      db,
+		nodeKey: nodeKey,
+	}
+}
+
+// CreateBackup writes a backup archive of the database to the given writer.
+// All tables are read within a single read transaction, so the archive is a
+// consistent snapshot even if the daemon is modifying the database at the same
+// time.
+func (b *BackupManager) CreateBackup(ctx context.Context,
+	w io.Writer) (*BackupHeader, error) {
+
+	tx, err := b.db.BeginTx(ctx, ReadTxOption())
+	if err != nil {
+		return nil, fmt.Errorf("unable to start read tx: %w", err)
+	}
+
+	// The transaction is read-only, so rolling it back is all we need to
+	// do once we're done.
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	header := &BackupHeader{
+		Version:         BackupVersion,
+		DatabaseVersion: LatestMigrationVersion,
+		Backend:         backendName(b.db.Backend()),
+		NodeKey: hex.EncodeToString(
+			b.nodeKey.SerializeCompressed(),
+		),
+		CreationTime: time.Now().UTC(),
+	}
+
+	zipWriter := gzip.NewWriter(w)
+	hasher := sha256.New()
+	enc := json.NewEncoder(io.MultiWriter(zipWriter, hasher))
+
+	if err := enc.Encode(backupRecord{Header: header}); err != nil {
+		return nil, fmt.Errorf("unable to write backup header: %w", err)
+	}
+
+	for _, table := range backupTables {
+		if err := exportTable(ctx, tx, table, enc); err != nil {
+			return nil, fmt.Errorf("unable to back up table %v: %w",
+				table, err)
+		}
+	}
+
+	// The checksum record itself isn't part of the checksum, so we write
+	// it to the compressed stream directly.
+	checksum := hex.EncodeToString(hasher.Sum(nil))
+	err = json.NewEncoder(zipWriter).Encode(backupRecord{
+		Checksum: checksum,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to write backup checksum: %w",
+			err)
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("unable to finish backup: %w", err)
+	}
+
+	return header, nil
+}
+
+// RestoreBackup restores the backup archive read from the given reader into
+// the database. The database must be freshly created and not contain any data
+// yet. The backup is refused if it was created for a different lnd node or
+// database version. All rows are inserted within a single transaction that is
+// only committed once the checksum of the archive was verified.
+func (b *BackupManager) RestoreBackup(ctx context.Context,
+	r io.Reader) (*BackupHeader, error) {
+
+	return b.restore(ctx, r, ensureEmptyDatabase)
+}
+
+// ReplaceWithBackup restores the backup archive read from the given reader
+// into a database that was already used by a fresh node. This is only allowed
+// if the database doesn't contain any wallet data yet, which is checked
+// within the restore transaction. All data that is part of a backup, such as
+// the universe data a fresh node creates on startup, is replaced by the content
+// of the backup. The macaroon root keys of the node aren't part of a backup, so
+// the macaroons of the node stay valid.
+func (b *BackupManager) ReplaceWithBackup(ctx context.Context,
+	r io.Reader) (*BackupHeader, error) {
+
+	prepare := func(ctx context.Context, tx *sql.Tx) error {
+		if err := ensureNoWalletData(ctx, tx); err != nil {
+			return err
+		}
+
+		return clearBackupTables(ctx, tx)
+	}
+
+	return b.restore(ctx, r, prepare)
+}
+
+// VerifyBackup reads the backup archive from the given reader and makes sure
+// it can be restored into the database of this node, without writing to the
+// database. The backup is refused if its checksum doesn't match or if it was
+// created for a different lnd node or database version.
+func (b *BackupManager) VerifyBackup(r io.Reader) (*BackupHeader, error) {
+	reader, header, err := b.openBackup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var inTable bool
+	for {
+		record, err := reader.next()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case record.Checksum != "":
+			return header, reader.verifyChecksum(record)
+
+		case record.Table != "":
+			if !isBackupTable(record.Table) {
+				return nil, fmt.Errorf("unknown backup table "+
+					"%v", record.Table)
+			}
+			inTable = true
+
+		case len(record.Row) > 0:
+			if !inTable {
+				return nil, fmt.Errorf("backup row without " +
+					"table")
+			}
+
+		default:
+			return nil, fmt.Errorf("invalid backup record")
+		}
+	}
+}
+
+// CheckNoWalletData makes sure the database doesn't contain any wallet data
+// yet, so a backup can be restored with ReplaceWithBackup.
+func (b *BackupManager) CheckNoWalletData(ctx context.Context) error {
+	tx, err := b.db.BeginTx(ctx, ReadTxOption())
+	if err != nil {
+		return fmt.Errorf("unable to start read tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	return ensureNoWalletData(ctx, tx)
+}
+
+// restore restores the backup archive read from the given reader into the
+// database. The prepare function is called within the restore transaction
+// before any row is inserted.
+func (b *BackupManager) restore(ctx context.Context, r io.Reader,
+	prepare func(context.Context, *sql.Tx) error) (*BackupHeader, error) {
+
+	reader, header, err := b.openBackup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.db.BeginTx(ctx, WriteTxOption())
+	if err != nil {
+		return nil, fmt.Errorf("unable to start write tx: %w", err)
+	}
+
+	// Rolling back after a successful commit is a no-op, so we can always
+	// defer it to clean up in case of an error.
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := prepare(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	var insertStmt *sql.Stmt
+	defer func() {
+		if insertStmt != nil {
+			_ = insertStmt.Close()
+		}
+	}()
+
+	for {
+		record, err := reader.next()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case record.Checksum != "":
+			if err := reader.verifyChecksum(record); err != nil {
+				return nil, err
+			}
+
+			if b.db.Backend() == sqlc.BackendTypePostgres {
+				err := resetPostgresSequences(ctx, tx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if err := tx.Commit(); err != nil {
+				return nil, fmt.Errorf("unable to commit "+
+					"restored backup: %w", err)
+			}
+
+			return header, nil
+
+		case record.Table != "":
+			if insertStmt != nil {
+				_ = insertStmt.Close()
+				insertStmt = nil
+			}
+
+			insertStmt, err = prepareTableInsert(
+				ctx, tx, record.Table, record.Columns,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+		case len(record.Row) > 0:
+			if insertStmt == nil {
+				return nil, fmt.Errorf("backup row without " +
+					"table")
+			}
+
+			err := insertRow(ctx, insertStmt, record.Row)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, fmt.Errorf("invalid backup record")
+		}
+	}
+}
+
+// backupReader reads the records of a backup archive and keeps track of the
+// checksum over all records read so far.
+type backupReader struct {
+	reader *bufio.Reader
+	hasher hash.Hash
+}
+
+// next reads the next record of the archive. Every record except the checksum
+// record is added to the checksum.
+func (r *backupReader) next() (*backupRecord, error) {
+	line, err := r.reader.ReadBytes('\n')
+	switch {
+	case errors.Is(err, io.EOF):
+		return nil, fmt.Errorf("unexpected end of backup: %w",
+			io.ErrUnexpectedEOF)
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to read backup: %w", err)
+	}
+
+	var record backupRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil, fmt.Errorf("invalid backup record: %w", err)
+	}
+
+	if record.Checksum == "" {
+		_, _ = r.hasher.Write(line)
+	}
+
+	return &record, nil
+}
+
+// verifyChecksum makes sure the given checksum record matches the checksum
+// over all records read before it.
+func (r *backupReader) verifyChecksum(record *backupRecord) error {
+	checksum := hex.EncodeToString(r.hasher.Sum(nil))
+	if record.Checksum != checksum {
+		return ErrBackupChecksumMismatch
+	}
+
+	return nil
+}
+
+// openBackup opens the backup archive read from the given reader and makes
+// sure its header matches this node.
+func (b *BackupManager) openBackup(r io.Reader) (*backupReader,
+	*BackupHeader, error) {
+
+	zipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open backup: %w", err)
+	}
+
+	reader := &backupReader{
+		reader: bufio.NewReader(zipReader),
+		hasher: sha256.New(),
+	}
+
+	headerRecord, err := reader.next()
+	if err != nil {
+		return nil, nil, err
+	}
+	header := headerRecord.Header
+	if header == nil {
+		return nil, nil, fmt.Errorf("backup header missing")
+	}
+	if err := b.checkHeader(header); err != nil {
+		return nil, nil, err
+	}
+
+	return reader, header, nil
+}
+
+// checkHeader makes sure the backup with the given header can be restored
+// into the database of this node.
+func (b *BackupManager) checkHeader(header *BackupHeader) error {
+	if header.Version != BackupVersion {
+		return fmt.Errorf("%w: unknown archive version %d",
+			ErrBackupVersionMismatch, header.Version)
+	}
+
+	if header.DatabaseVersion != LatestMigrationVersion {
+		return fmt.Errorf("%w: backup was created with database "+
+			"version %d, expected %d", ErrBackupVersionMismatch,
+			header.DatabaseVersion, LatestMigrationVersion)
+	}
+
+	nodeKey := hex.EncodeToString(b.nodeKey.SerializeCompressed())
+	if header.NodeKey != nodeKey {
+		return fmt.Errorf("%w: backup node key %s, lnd node key %s",
+			ErrBackupNodeMismatch, header.NodeKey, nodeKey)
+	}
+
+	return nil
+}
+
+// exportTable writes the table record and all rows of the given table to the
+// encoder.
+func exportTable(ctx context.Context, tx *sql.Tx, table string,
+	enc *json.Encoder) error {
+
+	return readTable(ctx, tx, table, func(columns []string) error {
+		return enc.Encode(backupRecord{Table: table, Columns: columns})
+	}, func(row []backupValue) error {
+		return enc.Encode(backupRecord{Row: row})
+	})
+}
+
+// readTable reads all rows of the given table in the order of their primary
+// key. The column names are passed to the columns callback before the rows
+// are passed to the row callback one by one.
+func readTable(ctx context.Context, tx *sql.Tx, table string,
+	columnsFunc func([]string) error,
+	rowFunc func([]backupValue) error) error {
+
+	query := fmt.Sprintf("SELECT * FROM %s ORDER BY 1", table)
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	columns := fn.Map(colTypes, func(c *sql.ColumnType) string {
+		return strings.ToLower(c.Name())
+	})
+	if err := columnsFunc(columns); err != nil {
+		return err
+	}
+
+	var (
+		values    = make([]any, len(colTypes))
+		valuePtrs = make([]any, len(colTypes))
+	)
+	for idx := range values {
+		valuePtrs[idx] = &values[idx]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return err
+		}
+
+		row := make([]backupValue, len(values))
+		for idx, value := range values {
+			colType := colTypes[idx]
+			row[idx], err = newBackupValue(value, colType)
+			if err != nil {
+				return fmt.Errorf("column %v: %w",
+					columns[idx], err)
+			}
+		}
+
+		if err := rowFunc(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// newBackupValue converts a value read from the database into its backup
+// representation. Values are normalized based on the declared column type, so
+// they can be inserted into any database backend.
+func newBackupValue(value any, colType *sql.ColumnType) (backupValue, error) {
+	typeName := strings.ToUpper(colType.DatabaseTypeName())
+
+	switch v := value.(type) {
+	case nil:
+		return backupValue{}, nil
+
+	// SQLite doesn't have a native boolean type and returns boolean
+	// columns as integers.
+	case int64:
+		if typeName == "BOOLEAN" || typeName == "BOOL" {
+			b := v != 0
+			return backupValue{Bool: &b}, nil
+		}
+
+		return backupValue{Int: &v}, nil
+
+	case float64:
+		return backupValue{Float: &v}, nil
+
+	case bool:
+		return backupValue{Bool: &v}, nil
+
+	case string:
+		if typeName == "BLOB" || typeName == "BYTEA" {
+			b := []byte(v)
+			return backupValue{Bytes: &b}, nil
+		}
+
+		return backupValue{Str: &v}, nil
+
+	// The driver is allowed to re-use the byte slice for the next row,
+	// so we need to copy it.
+	case []byte:
+		b := bytes.Clone(v)
+		if b == nil {
+			b = []byte{}
+		}
+
+		return backupValue{Bytes: &b}, nil
+
+	case time.Time:
+		t := v.UTC()
+		return backupValue{Time: &t}, nil
+
+	default:
+		return backupValue{}, fmt.Errorf("unsupported value type %T",
+			value)
+	}
+}
+
+// value returns the value in the form that can be passed to the database
+// driver.
+func (v backupValue) value() any {
+	switch {
+	case v.Int != nil:
+		return *v.Int
+
+	case v.Float != nil:
+		return *v.Float
+
+	case v.Bool != nil:
+		return *v.Bool
+
+	case v.Str != nil:
+		return *v.Str
+
+	case v.Bytes != nil:
+		return *v.Bytes
+
+	case v.Time != nil:
+		return v.Time.UTC()
+
+	default:
+		return nil
+	}
+}
+
+// prepareTableInsert validates the table and column names of a table record
+// and prepares the statement to insert the rows of that table.
+func prepareTableInsert(ctx context.Context, tx *sql.Tx, table string,
+	columns []string) (*sql.Stmt, error) {
+
+	if !isBackupTable(table) {
+		return nil, fmt.Errorf("unknown table %v in backup", table)
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %v in backup has no columns",
+			table)
+	}
+
+	var (
+		quotedCols   = make([]string, len(columns))
+		placeholders = make([]string, len(columns))
+	)
+	for idx, column := range columns {
+		if !backupColumnRegex.MatchString(column) {
+			return nil, fmt.Errorf("invalid column %q of table %v "+
+				"in backup", column, table)
+		}
+
+		quotedCols[idx] = fmt.Sprintf("%q", column)
+		placeholders[idx] = fmt.Sprintf("$%d", idx+1)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table,
+		strings.Join(quotedCols, ", "),
+		strings.Join(placeholders, ", "))
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare restore of table "+
+			"%v: %w", table, err)
+	}
+
+	return stmt, nil
+}
+
+// insertRow inserts a single row with the given prepared insert statement.
+func insertRow(ctx context.Context, insertStmt *sql.Stmt,
+	row []backupValue) error {
+
+	args := make([]any, len(row))
+	for idx := range row {
+		args[idx] = row[idx].value()
+	}
+
+	if _, err := insertStmt.ExecContext(ctx, args...); err != nil {
+		return fmt.Errorf("unable to insert row: %w", err)
+	}
+
+	return nil
+}
+
+// ensureEmptyDatabase makes sure none of the tables that are part of a backup
+// contain any rows.
+func ensureEmptyDatabase(ctx context.Context, tx *sql.Tx) error {
+	for _, table := range backupTables {
+		query := fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", table)
+
+		var exists int
+		err := tx.QueryRowContext(ctx, query).Scan(&exists)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			continue
+
+		case err != nil:
+			return fmt.Errorf("unable to query table %v: %w", table,
+				err)
+
+		default:
+			return fmt.Errorf("%w: table %v contains data",
+				ErrRestoreDatabaseNotEmpty, table)
+		}
+	}
+
+	return nil
+}
+
+// ensureNoWalletData makes sure none of the tables that hold wallet data
+// contain any rows.
+func ensureNoWalletData(ctx context.Context, tx *sql.Tx) error {
+	for _, table := range walletTables {
+		query := fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", table)
+
+		var exists int
+		err := tx.QueryRowContext(ctx, query).Scan(&exists)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			continue
+
+		case err != nil:
+			return fmt.Errorf("unable to query table %v: %w", table,
+				err)
+
+		default:
+			return fmt.Errorf("%w: table %v contains wallet data",
+				ErrRestoreDatabaseNotEmpty, table)
+		}
+	}
+
+	return nil
+}
+
+// clearBackupTables deletes all rows of the tables that are part of a backup.
+// The tables are cleared in the reverse order of backupTables, so no foreign
+// key reference is violated.
+func clearBackupTables(ctx context.Context, tx *sql.Tx) error {
+	for i := len(backupTables) - 1; i >= 0; i-- {
+		table := backupTables[i]
+		query := fmt.Sprintf("DELETE FROM %s", table)
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("unable to clear table %v: %w", table,
+				err)
+		}
+	}
+
+	return nil
+}
+
+// resetPostgresSequences sets the sequences of all serial columns to the
+// highest restored value, as inserting rows with explicit IDs doesn't advance
+// them.
+func resetPostgresSequences(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT table_name, column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema()
+		  AND column_default LIKE 'nextval%'
+	`)
+	if err != nil {
+		return fmt.Errorf("unable to query serial columns: %w", err)
+	}
+
+	type serialColumn struct {
+		table  string
+		column string
+	}
+	var serialColumns []serialColumn
+	for rows.Next() {
+		var col serialColumn
+		if err := rows.Scan(&col.table, &col.column); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("unable to read serial column: %w",
+				err)
+		}
+
+		serialColumns = append(serialColumns, col)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, col := range serialColumns {
+		if !isBackupTable(col.table) {
+			continue
+		}
+
+		query := fmt.Sprintf("SELECT setval(pg_get_serial_sequence("+
+			"'%s', '%s'), MAX(%q)) FROM %s", col.table, col.column,
+			col.column, col.table)
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("unable to reset sequence of %v.%v: "+
+				"%w", col.table, col.column, err)
+		}
+	}
+
+	return nil
+}
+
+// isBackupTable returns true if the given table is part of a backup.
+func isBackupTable(table string) bool {
+	return fn.Any(backupTables, func(t string) bool {
+		return t == table
+	})
+}
+
+// backendName returns the human-readable name of the given backend type.
+func backendName(backend sqlc.BackendType) string {
+	switch backend {
+	case sqlc.BackendTypeSqlite:
+		return "sqlite"
+
+	case sqlc.BackendTypePostgres:
+		return "postgres"
+
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,377 @@
+package tapdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// backupTestStores bundles the stores that are used to populate and inspect
+// the databases in the backup tests.
+type backupTestStores struct {
+	addrBook      *TapAddressBook
+	mintSchedules *MintScheduleStore
+	sendSchedules *SendScheduleStore
+}
+
+// newBackupTestStores creates the stores used in the backup tests on top of
+// the given database.
+func newBackupTestStores(db BatchedQuerier,
+	withTx func(tx *sql.Tx) *sqlc.Queries) *backupTestStores {
+
+	addrTx := NewTransactionExecutor(db, func(tx *sql.Tx) AddrBook {
+		return withTx(tx)
+	})
+	mintTx := NewTransactionExecutor(
+		db, func(tx *sql.Tx) MintScheduleQueries {
+			return withTx(tx)
+		},
+	)
+	sendTx := NewTransactionExecutor(
+		db, func(tx *sql.Tx) ScheduledSendQueries {
+			return withTx(tx)
+		},
+	)
+
+	return &backupTestStores{
+		addrBook: NewTapAddressBook(
+			addrTx, chainParams, clock.NewDefaultClock(),
+		),
+		mintSchedules: NewMintScheduleStore(mintTx),
+		sendSchedules: NewSendScheduleStore(sendTx),
+	}
+}
+
+// TestBackupTables makes sure that every table of the database is either part
+// of a backup, a secret of the node or populated by the migrations.
+func TestBackupTables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+
+	query := `
+		SELECT name FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%'
+	`
+	if db.Backend() == sqlc.BackendTypePostgres {
+		query = `
+			SELECT table_name FROM information_schema.tables
+			WHERE table_schema = current_schema()
+			  AND table_type = 'BASE TABLE'
+		`
+	}
+
+	rows, err := db.QueryContext(ctx, query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		require.NoError(t, rows.Scan(&table))
+
+		if table == "schema_migrations" {
+			continue
+		}
+		tables = append(tables, table)
+	}
+	require.NoError(t, rows.Err())
+
+	expected := slices.Concat(backupTables, secretTables, staticTables)
+	require.ElementsMatch(t, expected, tables)
+}
+
+// TestBackupRestore tests that a backup of a database can be restored into a
+// fresh database and that invalid backups are refused.
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	nodeKey := test.RandPubKey(t)
+
+	srcDB := NewTestDB(t)
+	src := newBackupTestStores(srcDB, srcDB.WithTx)
+
+	// Populate the source database with a few addresses and schedules.
+	const numAddrs = 3
+	addrVersion := address.RandVersion()
+	proofCourierAddr := address.RandProofCourierAddrForVersion(
+		t, addrVersion,
+	)
+	addrs := make([]address.AddrWithKeyInfo, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr, assetGen, assetGroup := address.RandAddrWithVersion(
+			t, chainParams, proofCourierAddr, addrVersion,
+		)
+		addrs[i] = *addr
+
+		var writeTxOpts AddrBookTxOptions
+		err := src.addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, src.addrBook.InsertAddrs(ctx, addrs...))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	schedule := &tapgarden.MintSchedule{
+		GroupKey:       *test.RandPubKey(t),
+		AssetVersion:   asset.V1,
+		Amount:         1_000,
+		MetaType:       proof.MetaOpaque,
+		MetaTemplate:   []byte("tranche {{tranche}}"),
+		Cadence:        time.Hour,
+		MaxTotalSupply: 10_000,
+		NextMintTime:   now.Add(time.Hour),
+		State:          tapgarden.MintScheduleActive,
+		CreationTime:   now,
+	}
+	var err error
+	schedule.ID, err = src.mintSchedules.InsertMintSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	send := &tapfreighter.ScheduledSend{
+		Label: "payroll",
+		Recipients: []tapfreighter.ScheduledSendRecipient{
+			{TapAddr: "addr1", Amount: 10},
+		},
+		SkipProofCourierPingCheck: true,
+		ExecuteAtHeight:           fn.Some(uint32(1_000)),
+		State:                     tapfreighter.ScheduledSendPending,
+		CreationTime:              now,
+		UpdateTime:                now,
+	}
+	send.ID, err = src.sendSchedules.InsertScheduledSend(ctx, send)
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	header, err := NewBackupManager(srcDB, nodeKey).CreateBackup(
+		ctx, &archive,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, LatestMigrationVersion, header.DatabaseVersion)
+
+	// A backup that was created for a different node must be refused.
+	otherDB := NewTestDB(t)
+	_, err = NewBackupManager(otherDB, test.RandPubKey(t)).RestoreBackup(
+		ctx, bytes.NewReader(archive.Bytes()),
+	)
+	require.ErrorIs(t, err, ErrBackupNodeMismatch)
+
+	// A tampered backup must be refused as well, without leaving any data
+	// behind.
+	tampered := tamperBackup(t, archive.Bytes(), "payroll", "payrule")
+	_, err = NewBackupManager(otherDB, nodeKey).RestoreBackup(
+		ctx, bytes.NewReader(tampered),
+	)
+	require.ErrorIs(t, err, ErrBackupChecksumMismatch)
+
+	// Restoring the valid backup into the empty database succeeds.
+	restored, err := NewBackupManager(otherDB, nodeKey).RestoreBackup(
+		ctx, bytes.NewReader(archive.Bytes()),
+	)
+	require.NoError(t, err)
+	require.Equal(t, header.NodeKey, restored.NodeKey)
+
+	dst := newBackupTestStores(otherDB, otherDB.WithTx)
+	dbAddrs, err := dst.addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, numAddrs)
+	assertEqualAddrs(t, addrs, dbAddrs)
+
+	schedules, err := dst.mintSchedules.FetchMintSchedules(
+		ctx, fn.None[tapgarden.MintScheduleState](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.MintSchedule{schedule}, schedules)
+
+	sends, err := dst.sendSchedules.FetchScheduledSends(
+		ctx, fn.None[tapfreighter.ScheduledSendState](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.ScheduledSend{send}, sends)
+
+	// New rows must not collide with the restored ones.
+	newID, err := dst.mintSchedules.InsertMintSchedule(ctx, schedule)
+	require.NoError(t, err)
+	require.Greater(t, newID, schedule.ID)
+
+	// Restoring into a database that already contains data is refused.
+	_, err = NewBackupManager(otherDB, nodeKey).RestoreBackup(
+		ctx, bytes.NewReader(archive.Bytes()),
+	)
+	require.ErrorIs(t, err, ErrRestoreDatabaseNotEmpty)
+
+	// Replacing the data isn't allowed either, as the database already
+	// contains wallet data.
+	_, err = NewBackupManager(otherDB, nodeKey).ReplaceWithBackup(
+		ctx, bytes.NewReader(archive.Bytes()),
+	)
+	require.ErrorIs(t, err, ErrRestoreDatabaseNotEmpty)
+	require.ErrorIs(
+		t, NewBackupManager(otherDB, nodeKey).CheckNoWalletData(ctx),
+		ErrRestoreDatabaseNotEmpty,
+	)
+}
+
+// TestBackupVerifyAndReplace tests that a backup can be verified without a
+// database write and that it can replace the data of a fresh node that doesn't
+// have any wallet data yet.
+func TestBackupVerifyAndReplace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	nodeKey := test.RandPubKey(t)
+
+	srcDB := NewTestDB(t)
+	src := newBackupTestStores(srcDB, srcDB.WithTx)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	send := &tapfreighter.ScheduledSend{
+		Label: "vesting",
+		Recipients: []tapfreighter.ScheduledSendRecipient{
+			{TapAddr: "addr1", Amount: 10},
+		},
+		ExecuteAtHeight: fn.Some(uint32(1_000)),
+		State:           tapfreighter.ScheduledSendPending,
+		CreationTime:    now,
+		UpdateTime:      now,
+	}
+	var err error
+	send.ID, err = src.sendSchedules.InsertScheduledSend(ctx, send)
+	require.NoError(t, err)
+
+	insertMacaroonKey := func(db *BaseDB, id string) {
+		_, err := db.ExecContext(
+			ctx, "INSERT INTO macaroons (id, root_key) VALUES "+
+				"($1, $2)", []byte(id), test.RandBytes(32),
+		)
+		require.NoError(t, err)
+	}
+	insertMacaroonKey(srcDB.BaseDB, "src")
+
+	var archive bytes.Buffer
+	header, err := NewBackupManager(srcDB, nodeKey).CreateBackup(
+		ctx, &archive,
+	)
+	require.NoError(t, err)
+
+	// The macaroon root keys are never exported.
+	archiveTables := backupArchiveTables(t, archive.Bytes())
+	require.Contains(t, archiveTables, "scheduled_sends")
+	require.NotContains(t, archiveTables, "macaroons")
+
+	// A valid backup can be verified, a tampered one or one for a
+	// different node can't.
+	freshDB := NewTestDB(t)
+	backup := NewBackupManager(freshDB, nodeKey)
+	verified, err := backup.VerifyBackup(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, header.NodeKey, verified.NodeKey)
+
+	tampered := tamperBackup(t, archive.Bytes(), "vesting", "resting")
+	_, err = backup.VerifyBackup(bytes.NewReader(tampered))
+	require.ErrorIs(t, err, ErrBackupChecksumMismatch)
+
+	_, err = NewBackupManager(freshDB, test.RandPubKey(t)).VerifyBackup(
+		bytes.NewReader(archive.Bytes()),
+	)
+	require.ErrorIs(t, err, ErrBackupNodeMismatch)
+
+	// A fresh node already created its macaroon root key and added a
+	// universe server, so the database isn't empty anymore. It doesn't
+	// contain any wallet data though, so the backup can replace its
+	// content.
+	insertMacaroonKey(freshDB.BaseDB, "fresh")
+	_, err = freshDB.ExecContext(
+		ctx, "INSERT INTO universe_servers (server_host, "+
+			"last_sync_time) VALUES ($1, $2)", "fresh.example.com",
+		now,
+	)
+	require.NoError(t, err)
+
+	_, err = backup.RestoreBackup(ctx, bytes.NewReader(archive.Bytes()))
+	require.ErrorIs(t, err, ErrRestoreDatabaseNotEmpty)
+
+	require.NoError(t, backup.CheckNoWalletData(ctx))
+	_, err = backup.ReplaceWithBackup(ctx, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	dst := newBackupTestStores(freshDB, freshDB.WithTx)
+	sends, err := dst.sendSchedules.FetchScheduledSends(
+		ctx, fn.None[tapfreighter.ScheduledSendState](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*tapfreighter.ScheduledSend{send}, sends)
+
+	// The macaroon root key of the fresh node is kept, so its macaroons
+	// stay valid.
+	var keyID []byte
+	err = freshDB.QueryRowContext(
+		ctx, "SELECT id FROM macaroons",
+	).Scan(&keyID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("fresh"), keyID)
+}
+
+// backupArchiveTables returns the names of all tables that are contained in
+// the given backup archive.
+func backupArchiveTables(t *testing.T, archive []byte) []string {
+	zipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+
+	var (
+		tables []string
+		dec    = json.NewDecoder(zipReader)
+	)
+	for dec.More() {
+		var record backupRecord
+		require.NoError(t, dec.Decode(&record))
+
+		if record.Table != "" {
+			tables = append(tables, record.Table)
+		}
+	}
+
+	return tables
+}
+
+// tamperBackup replaces the given string in the uncompressed content of a
+// backup archive.
+func tamperBackup(t *testing.T, archive []byte, old, new string) []byte {
+	zipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(zipReader)
+	require.NoError(t, err)
+	require.Contains(t, string(content), old)
+
+	content = bytes.ReplaceAll(content, []byte(old), []byte(new))
+
+	var tampered bytes.Buffer
+	zipWriter := gzip.NewWriter(&tampered)
+	_, err = zipWriter.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+
+	return tampered.Bytes()
+}
@@ -0,0 +1,265 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+type (
+	// NewChannelBalanceSnapshot is used to insert or replace a channel
+	// balance snapshot.
+	NewChannelBalanceSnapshot = sqlc.UpsertChannelBalanceSnapshotParams
+
+	// NewChannelBalanceHtlc is used to insert a settled HTLC of a channel
+	// balance snapshot.
+	NewChannelBalanceHtlc = sqlc.InsertChannelBalanceHtlcParams
+
+	// ChannelBalanceSnapshotQuery is used to filter the channel balance
+	// snapshots.
+	ChannelBalanceSnapshotQuery = sqlc.FetchChannelBalanceSnapshotsParams
+
+	// ChannelBalanceSnapshotRow is a row in the channel balance snapshots
+	// table.
+	ChannelBalanceSnapshotRow = sqlc.ChannelBalanceSnapshot
+
+	// ChannelBalanceHtlcRow is a row in the channel balance HTLCs table.
+	ChannelBalanceHtlcRow = sqlc.ChannelBalanceHtlc
+)
+
+// BalanceHistoryQueries is the set of queries that are needed to persist the
+// balance history of asset channels.
+type BalanceHistoryQueries interface {
+	// UpsertChannelBalanceSnapshot inserts a new balance snapshot or
+	// updates the existing one of the same channel and commitment height
+	// and returns its ID.
+	UpsertChannelBalanceSnapshot(ctx context.Context,
+		arg NewChannelBalanceSnapshot) (int64, error)
+
+	// DeleteChannelBalanceHtlcs deletes the settled HTLCs of the snapshot
+	// with the given ID.
+	DeleteChannelBalanceHtlcs(ctx context.Context, snapshotID int64) error
+
+	// InsertChannelBalanceHtlc inserts a settled HTLC of a snapshot.
+	InsertChannelBalanceHtlc(ctx context.Context,
+		arg NewChannelBalanceHtlc) error
+
+	// FetchChannelBalanceSnapshots fetches the balance snapshots that
+	// match the query.
+	FetchChannelBalanceSnapshots(ctx context.Context,
+		arg ChannelBalanceSnapshotQuery) ([]ChannelBalanceSnapshotRow,
+		error)
+
+	// FetchChannelBalanceHtlcs fetches the settled HTLCs of the snapshot
+	// with the given ID.
+	FetchChannelBalanceHtlcs(ctx context.Context,
+		snapshotID int64) ([]ChannelBalanceHtlcRow, error)
+}
+
+// BatchedBalanceHistoryQueries is a version of the BalanceHistoryQueries
+// that's capable of batched database operations.
+type BatchedBalanceHistoryQueries interface {
+	BalanceHistoryQueries
+
+	BatchedTx[BalanceHistoryQueries]
+}
+
+// ChannelBalanceStore is the database backed implementation of the
+// balancehistory.Store interface.
+type ChannelBalanceStore struct {
+	db BatchedBalanceHistoryQueries
+}
+
+// NewChannelBalanceStore creates a new ChannelBalanceStore instance given an
+// open BatchedBalanceHistoryQueries.
+func NewChannelBalanceStore(
+	db BatchedBalanceHistoryQueries) *ChannelBalanceStore {
+
+	return &ChannelBalanceStore{
+		db: db,
+	}
+}
+
+// A compile-time assertion to ensure that ChannelBalanceStore implements the
+// balancehistory.Store interface.
+var _ balancehistory.Store = (*ChannelBalanceStore)(nil)
+
+// InsertBalanceSnapshot persists a balance snapshot. A snapshot that was
+// previously recorded for the same channel and commitment height is replaced.
+//
+// NOTE: This is part of the balancehistory.Store interface.
+func (s *ChannelBalanceStore) InsertBalanceSnapshot(ctx context.Context,
+	snapshot *balancehistory.Snapshot) error {
+
+	chanPoint, err := encodeOutpoint(snapshot.ChanPoint)
+	if err != nil {
+		return fmt.Errorf("unable to encode chan point: %w", err)
+	}
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q BalanceHistoryQueries) error {
+		snapshotID, err := q.UpsertChannelBalanceSnapshot(
+			ctx, NewChannelBalanceSnapshot{
+				ChanPoint:     chanPoint,
+				CommitHeight:  int64(snapshot.CommitHeight),
+				LocalBalance:  int64(snapshot.LocalBalance),
+				RemoteBalance: int64(snapshot.RemoteBalance),
+				RecordedAt:    snapshot.Timestamp.UTC(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to upsert balance snapshot: "+
+				"%w", err)
+		}
+
+		// A replaced snapshot might have had different HTLCs, so we
+		// always start from a clean slate.
+		err = q.DeleteChannelBalanceHtlcs(ctx, snapshotID)
+		if err != nil {
+			return fmt.Errorf("unable to delete balance snapshot "+
+				"htlcs: %w", err)
+		}
+
+		for _, htlc := range snapshot.SettledHtlcs {
+			params := NewChannelBalanceHtlc{
+				SnapshotID:  snapshotID,
+				HtlcIndex:   int64(htlc.HtlcIndex),
+				Incoming:    htlc.Incoming,
+				AssetAmount: int64(htlc.AssetAmount),
+			}
+			htlc.RfqID.WhenSome(func(id rfqmsg.ID) {
+				params.RfqID = fn.ByteSlice(id)
+			})
+			htlc.Rate.WhenSome(func(r rfqmath.BigIntFixedPoint) {
+				params.RateCoefficient = r.Coefficient.Bytes()
+				params.RateScale = sqlInt16(r.Scale)
+			})
+
+			err := q.InsertChannelBalanceHtlc(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to insert balance "+
+					"snapshot htlc: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchBalanceSnapshots returns the snapshots that match the query, ordered by
+// the time they were recorded.
+//
+// NOTE: This is part of the balancehistory.Store interface.
+func (s *ChannelBalanceStore) FetchBalanceSnapshots(ctx context.Context,
+	query balancehistory.Query) ([]*balancehistory.Snapshot, error) {
+
+	var filter ChannelBalanceSnapshotQuery
+	if !query.StartTime.IsZero() {
+		filter.StartTime = sql.NullTime{
+			Time:  query.StartTime.UTC(),
+			Valid: true,
+		}
+	}
+	if !query.EndTime.IsZero() {
+		filter.EndTime = sql.NullTime{
+			Time:  query.EndTime.UTC(),
+			Valid: true,
+		}
+	}
+
+	var encodeErr error
+	query.ChanPoint.WhenSome(func(op wire.OutPoint) {
+		filter.ChanPoint, encodeErr = encodeOutpoint(op)
+	})
+	if encodeErr != nil {
+		return nil, fmt.Errorf("unable to encode chan point: %w",
+			encodeErr)
+	}
+
+	var snapshots []*balancehistory.Snapshot
+	readOpt := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readOpt, func(q BalanceHistoryQueries) error {
+		rows, err := q.FetchChannelBalanceSnapshots(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("unable to fetch balance snapshots: "+
+				"%w", err)
+		}
+
+		snapshots = make([]*balancehistory.Snapshot, 0, len(rows))
+		for _, row := range rows {
+			htlcs, err := q.FetchChannelBalanceHtlcs(ctx, row.ID)
+			if err != nil {
+				return fmt.Errorf("unable to fetch htlcs of "+
+					"balance snapshot %d: %w", row.ID, err)
+			}
+
+			snapshot, err := unmarshalBalanceSnapshot(row, htlcs)
+			if err != nil {
+				return err
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return snapshots, nil
+}
+
+// unmarshalBalanceSnapshot converts a balance snapshot and its settled HTLCs
+// from their database representation.
+func unmarshalBalanceSnapshot(row ChannelBalanceSnapshotRow,
+	htlcRows []ChannelBalanceHtlcRow) (*balancehistory.Snapshot, error) {
+
+	var chanPoint wire.OutPoint
+	err := readOutPoint(bytes.NewReader(row.ChanPoint), 0, 0, &chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode chan point of "+
+			"balance snapshot %d: %w", row.ID, err)
+	}
+
+	snapshot := &balancehistory.Snapshot{
+		ChanPoint:     chanPoint,
+		CommitHeight:  uint64(row.CommitHeight),
+		LocalBalance:  uint64(row.LocalBalance),
+		RemoteBalance: uint64(row.RemoteBalance),
+		Timestamp:     row.RecordedAt.UTC(),
+	}
+
+	for _, htlcRow := range htlcRows {
+		htlc := balancehistory.Htlc{
+			HtlcIndex:   uint64(htlcRow.HtlcIndex),
+			Incoming:    htlcRow.Incoming,
+			AssetAmount: uint64(htlcRow.AssetAmount),
+		}
+
+		if len(htlcRow.RfqID) > 0 {
+			var id rfqmsg.ID
+			copy(id[:], htlcRow.RfqID)
+			htlc.RfqID = fn.Some(id)
+		}
+
+		if htlcRow.RateScale.Valid {
+			htlc.Rate = fn.Some(decodeFixedPoint(
+				htlcRow.RateCoefficient,
+				uint8(htlcRow.RateScale.Int16),
+			))
+		}
+
+		snapshot.SettledHtlcs = append(snapshot.SettledHtlcs, htlc)
+	}
+
+	return snapshot, nil
+}
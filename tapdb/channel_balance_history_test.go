@@ -0,0 +1,171 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapchannel/balancehistory"
+	"github.com/stretchr/testify/require"
+)
+
+// newChannelBalanceStore creates a new instance of ChannelBalanceStore for
+// testing.
+func newChannelBalanceStore(t *testing.T) *ChannelBalanceStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) BalanceHistoryQueries {
+		return db.WithTx(tx)
+	}
+
+	balanceTx := NewTransactionExecutor(db, txCreator)
+	return NewChannelBalanceStore(balanceTx)
+}
+
+// TestChannelBalanceStore tests that channel balance snapshots can be stored,
+// replaced and fetched with the different filters.
+func TestChannelBalanceStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newChannelBalanceStore(t)
+
+	var rfqID rfqmsg.ID
+	copy(rfqID[:], test.RandBytes(32))
+	rate := rfqmath.NewBigIntFixedPoint(100_000, 2)
+
+	chanPoint1 := test.RandOp(t)
+	chanPoint2 := test.RandOp(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	snapshot1 := &balancehistory.Snapshot{
+		ChanPoint:     chanPoint1,
+		CommitHeight:  1,
+		LocalBalance:  1000,
+		RemoteBalance: 0,
+		Timestamp:     now,
+	}
+	snapshot2 := &balancehistory.Snapshot{
+		ChanPoint:     chanPoint1,
+		CommitHeight:  2,
+		LocalBalance:  900,
+		RemoteBalance: 100,
+		Timestamp:     now.Add(time.Minute),
+		SettledHtlcs: []balancehistory.Htlc{{
+			HtlcIndex:   0,
+			AssetAmount: 100,
+			RfqID:       fn.Some(rfqID),
+			Rate:        fn.Some(rate),
+		}, {
+			HtlcIndex:   3,
+			Incoming:    true,
+			AssetAmount: 50,
+		}},
+	}
+	snapshot3 := &balancehistory.Snapshot{
+		ChanPoint:     chanPoint2,
+		CommitHeight:  1,
+		LocalBalance:  0,
+		RemoteBalance: 500,
+		Timestamp:     now.Add(2 * time.Minute),
+	}
+
+	for _, s := range []*balancehistory.Snapshot{
+		snapshot1, snapshot2, snapshot3,
+	} {
+		require.NoError(t, store.InsertBalanceSnapshot(ctx, s))
+	}
+
+	// Without a filter, all snapshots are returned in the order they were
+	// recorded.
+	snapshots, err := store.FetchBalanceSnapshots(
+		ctx, balancehistory.Query{},
+	)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assertSnapshotEqual(t, snapshot1, snapshots[0])
+	assertSnapshotEqual(t, snapshot2, snapshots[1])
+	assertSnapshotEqual(t, snapshot3, snapshots[2])
+
+	// Filtering by channel only returns the snapshots of that channel.
+	snapshots, err = store.FetchBalanceSnapshots(ctx, balancehistory.Query{
+		ChanPoint: fn.Some(chanPoint2),
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assertSnapshotEqual(t, snapshot3, snapshots[0])
+
+	// Filtering by time only returns the snapshots of that period.
+	snapshots, err = store.FetchBalanceSnapshots(ctx, balancehistory.Query{
+		StartTime: now.Add(time.Minute),
+		EndTime:   now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assertSnapshotEqual(t, snapshot2, snapshots[0])
+
+	// Recording a snapshot of the same channel and height again replaces
+	// the previous one, including its HTLCs.
+	replaced := &balancehistory.Snapshot{
+		ChanPoint:     chanPoint1,
+		CommitHeight:  2,
+		LocalBalance:  950,
+		RemoteBalance: 50,
+		Timestamp:     now.Add(time.Minute),
+		SettledHtlcs: []balancehistory.Htlc{{
+			HtlcIndex:   0,
+			AssetAmount: 50,
+		}},
+	}
+	require.NoError(t, store.InsertBalanceSnapshot(ctx, replaced))
+
+	snapshots, err = store.FetchBalanceSnapshots(ctx, balancehistory.Query{
+		ChanPoint: fn.Some(chanPoint1),
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assertSnapshotEqual(t, snapshot1, snapshots[0])
+	assertSnapshotEqual(t, replaced, snapshots[1])
+
+	// An unknown channel has no history.
+	snapshots, err = store.FetchBalanceSnapshots(ctx, balancehistory.Query{
+		ChanPoint: fn.Some(wire.OutPoint{Index: 7}),
+	})
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
+
+// assertSnapshotEqual asserts that two balance snapshots are equal.
+func assertSnapshotEqual(t *testing.T, expected,
+	actual *balancehistory.Snapshot) {
+
+	t.Helper()
+
+	require.Equal(t, expected.ChanPoint, actual.ChanPoint)
+	require.Equal(t, expected.CommitHeight, actual.CommitHeight)
+	require.Equal(t, expected.LocalBalance, actual.LocalBalance)
+	require.Equal(t, expected.RemoteBalance, actual.RemoteBalance)
+	require.True(t, expected.Timestamp.Equal(actual.Timestamp))
+	require.Len(t, actual.SettledHtlcs, len(expected.SettledHtlcs))
+
+	for idx, htlc := range expected.SettledHtlcs {
+		actualHtlc := actual.SettledHtlcs[idx]
+
+		require.Equal(t, htlc.HtlcIndex, actualHtlc.HtlcIndex)
+		require.Equal(t, htlc.Incoming, actualHtlc.Incoming)
+		require.Equal(t, htlc.AssetAmount, actualHtlc.AssetAmount)
+		require.Equal(t, htlc.RfqID, actualHtlc.RfqID)
+		require.Equal(t, htlc.Rate.IsSome(), actualHtlc.Rate.IsSome())
+
+		htlc.Rate.WhenSome(func(rate rfqmath.BigIntFixedPoint) {
+			actualRate := actualHtlc.Rate.UnwrapToPtr()
+			require.True(t, rate.Equals(*actualRate))
+		})
+	}
+}
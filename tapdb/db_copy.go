@@ -0,0 +1,268 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+)
+
+var (
+	// ErrDatabaseVersionMismatch is returned if the source and destination
+	// database of a copy aren't at the latest migration version.
+	ErrDatabaseVersionMismatch = errors.New("database version mismatch")
+
+	// ErrDatabaseCopyMismatch is returned if the content of the
+	// destination database doesn't match the source database after a
+	// copy.
+	ErrDatabaseCopyMismatch = errors.New("copied database doesn't match " +
+		"source database")
+)
+
+// DatabaseCopyStats holds the statistics of a database copy.
+type DatabaseCopyStats struct {
+	// RowCounts is the number of rows copied per table.
+	RowCounts map[string]int64
+
+	// NumTreeRoots is the number of MS-SMT roots (universe, multiverse
+	// and supply trees) that were verified to be identical in both
+	// databases.
+	NumTreeRoots int
+}
+
+// CopyDatabase copies the content of all tables from the source to the empty
+// destination database, for example to move from a SQLite to a Postgres
+// backend. Both databases must be at the latest migration version and the
+// source database must not be modified while the copy is running. All rows
+// keep their primary keys, so the references between them as well as the
+// MS-SMT node hashes are preserved. Once the copy is committed, the row counts
+// and the roots of all MS-SMT trees are compared between both databases.
+func CopyDatabase(ctx context.Context, src,
+	dst DatabaseBackend) (*DatabaseCopyStats, error) {
+
+	srcTx, err := src.BeginTx(ctx, ReadTxOption())
+	if err != nil {
+		return nil, fmt.Errorf("unable to start source read tx: %w",
+			err)
+	}
+	defer func() {
+		_ = srcTx.Rollback()
+	}()
+
+	dstTx, err := dst.BeginTx(ctx, WriteTxOption())
+	if err != nil {
+		return nil, fmt.Errorf("unable to start destination write "+
+			"tx: %w", err)
+	}
+	defer func() {
+		_ = dstTx.Rollback()
+	}()
+
+	for _, tx := range []*sql.Tx{srcTx, dstTx} {
+		version, err := databaseVersion(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if version != LatestMigrationVersion {
+			return nil, fmt.Errorf("%w: database is at version "+
+				"%d, expected %d", ErrDatabaseVersionMismatch,
+				version, LatestMigrationVersion)
+		}
+	}
+
+	if err := ensureEmptyDatabase(ctx, dstTx); err != nil {
+		return nil, err
+	}
+
+	stats := &DatabaseCopyStats{
+		RowCounts: make(map[string]int64, len(backupTables)),
+	}
+	for _, table := range backupTables {
+		numRows, err := copyTable(ctx, srcTx, dstTx, table)
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy table %v: %w",
+				table, err)
+		}
+
+		log.Debugf("Copied %d rows of table %v", numRows, table)
+		stats.RowCounts[table] = numRows
+	}
+
+	if dst.Backend() == sqlc.BackendTypePostgres {
+		if err := resetPostgresSequences(ctx, dstTx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := dstTx.Commit(); err != nil {
+		return nil, fmt.Errorf("unable to commit copied database: %w",
+			err)
+	}
+
+	stats.NumTreeRoots, err = verifyDatabaseCopy(ctx, src, dst, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// copyTable copies all rows of the given table and returns the number of
+// copied rows.
+func copyTable(ctx context.Context, srcTx, dstTx *sql.Tx,
+	table string) (int64, error) {
+
+	var (
+		insertStmt *sql.Stmt
+		numRows    int64
+	)
+	defer func() {
+		if insertStmt != nil {
+			_ = insertStmt.Close()
+		}
+	}()
+
+	err := readTable(ctx, srcTx, table, func(columns []string) error {
+		var err error
+		insertStmt, err = prepareTableInsert(ctx, dstTx, table, columns)
+		return err
+	}, func(row []backupValue) error {
+		numRows++
+		return insertRow(ctx, insertStmt, row)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numRows, nil
+}
+
+// verifyDatabaseCopy makes sure the destination database contains the same
+// number of rows as were copied from the source database and that the roots of
+// all MS-SMT trees are identical in both databases. The number of verified
+// tree roots is returned.
+func verifyDatabaseCopy(ctx context.Context, src, dst DatabaseBackend,
+	stats *DatabaseCopyStats) (int, error) {
+
+	dstTx, err := dst.BeginTx(ctx, ReadTxOption())
+	if err != nil {
+		return 0, fmt.Errorf("unable to start destination read tx: %w",
+			err)
+	}
+	defer func() {
+		_ = dstTx.Rollback()
+	}()
+
+	for _, table := range backupTables {
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s", table)
+
+		var numRows int64
+		err := dstTx.QueryRowContext(ctx, query).Scan(&numRows)
+		if err != nil {
+			return 0, fmt.Errorf("unable to count rows of table "+
+				"%v: %w", table, err)
+		}
+
+		if numRows != stats.RowCounts[table] {
+			return 0, fmt.Errorf("%w: table %v has %d rows, "+
+				"copied %d", ErrDatabaseCopyMismatch, table,
+				numRows, stats.RowCounts[table])
+		}
+	}
+
+	rows, err := dstTx.QueryContext(
+		ctx, "SELECT namespace FROM mssmt_roots ORDER BY namespace",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query tree roots: %w", err)
+	}
+
+	var namespaces []string
+	for rows.Next() {
+		var namespace string
+		if err := rows.Scan(&namespace); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("unable to read tree root: %w",
+				err)
+		}
+
+		namespaces = append(namespaces, namespace)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, err
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	// We load the roots through the tree store, which makes sure the
+	// root nodes can be resolved and their hashes and sums match.
+	for _, namespace := range namespaces {
+		srcRoot, err := treeRoot(ctx, src, namespace)
+		if err != nil {
+			return 0, err
+		}
+
+		dstRoot, err := treeRoot(ctx, dst, namespace)
+		if err != nil {
+			return 0, err
+		}
+
+		if srcRoot.NodeHash() != dstRoot.NodeHash() ||
+			srcRoot.NodeSum() != dstRoot.NodeSum() {
+
+			return 0, fmt.Errorf("%w: root of tree %v is %v, "+
+				"expected %v", ErrDatabaseCopyMismatch,
+				namespace, dstRoot.NodeHash(),
+				srcRoot.NodeHash())
+		}
+	}
+
+	return len(namespaces), nil
+}
+
+// treeRoot returns the root node of the MS-SMT tree with the given namespace.
+func treeRoot(ctx context.Context, db DatabaseBackend,
+	namespace string) (*mssmt.BranchNode, error) {
+
+	treeDB := NewTransactionExecutor(db, func(tx *sql.Tx) TreeStore {
+		return db.WithTx(tx)
+	})
+	tree := mssmt.NewCompactedTree(
+		NewTaprootAssetTreeStore(treeDB, namespace),
+	)
+
+	root, err := tree.Root(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load root of tree %v: %w",
+			namespace, err)
+	}
+
+	return root, nil
+}
+
+// databaseVersion returns the migration version of the database.
+func databaseVersion(ctx context.Context, tx *sql.Tx) (uint, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := tx.QueryRowContext(
+		ctx, "SELECT version, dirty FROM schema_migrations",
+	).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query database version: %w",
+			err)
+	}
+
+	if dirty {
+		return 0, fmt.Errorf("%w: database is in a dirty state at "+
+			"version %d", ErrDatabaseVersionMismatch, version)
+	}
+
+	return uint(version), nil
+}
@@ -0,0 +1,83 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCopyDatabase tests that the content of a SQLite database can be copied
+// into an empty database of the configured test backend.
+func TestCopyDatabase(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	srcDB := NewTestSqliteDB(t)
+	src := newBackupTestStores(srcDB, srcDB.WithTx)
+
+	// Populate the source database with a few addresses and an MS-SMT
+	// tree.
+	const numAddrs = 3
+	addrVersion := address.RandVersion()
+	proofCourierAddr := address.RandProofCourierAddrForVersion(
+		t, addrVersion,
+	)
+	addrs := make([]address.AddrWithKeyInfo, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr, assetGen, assetGroup := address.RandAddrWithVersion(
+			t, chainParams, proofCourierAddr, addrVersion,
+		)
+		addrs[i] = *addr
+
+		var writeTxOpts AddrBookTxOptions
+		err := src.addrBook.db.ExecTx(
+			ctx, &writeTxOpts,
+			insertFullAssetGen(ctx, assetGen, assetGroup),
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, src.addrBook.InsertAddrs(ctx, addrs...))
+
+	const namespace = "copy-test"
+	treeDB := NewTransactionExecutor(srcDB, func(tx *sql.Tx) TreeStore {
+		return srcDB.WithTx(tx)
+	})
+	tree := mssmt.NewCompactedTree(
+		NewTaprootAssetTreeStore(treeDB, namespace),
+	)
+	for i := 0; i < 10; i++ {
+		leaf := mssmt.NewLeafNode(
+			test.RandBytes(32), mssmt.RandLeafAmount(),
+		)
+		_, err := tree.Insert(ctx, test.RandHash(), leaf)
+		require.NoError(t, err)
+	}
+	srcRoot, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	dstDB := NewTestDB(t)
+	stats, err := CopyDatabase(ctx, srcDB, dstDB)
+	require.NoError(t, err)
+	require.EqualValues(t, numAddrs, stats.RowCounts["addrs"])
+	require.Equal(t, 1, stats.NumTreeRoots)
+
+	dst := newBackupTestStores(dstDB, dstDB.WithTx)
+	dbAddrs, err := dst.addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, numAddrs)
+	assertEqualAddrs(t, addrs, dbAddrs)
+
+	dstRoot, err := treeRoot(ctx, dstDB, namespace)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(srcRoot, dstRoot))
+
+	// Copying into a database that already contains data is refused.
+	_, err = CopyDatabase(ctx, srcDB, dstDB)
+	require.ErrorIs(t, err, ErrRestoreDatabaseNotEmpty)
+}
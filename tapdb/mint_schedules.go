@@ -0,0 +1,215 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+)
+
+type (
+	// NewMintSchedule is used to insert a new mint schedule into the
+	// database.
+	NewMintSchedule = sqlc.InsertMintScheduleParams
+
+	// MintScheduleRow is a row in the mint schedules table.
+	MintScheduleRow = sqlc.MintSchedule
+
+	// MintScheduleUpdate is used to update the progress and state of a
+	// mint schedule.
+	MintScheduleUpdate = sqlc.UpdateMintScheduleParams
+)
+
+// MintScheduleQueries is the set of queries that are needed to persist mint
+// schedules.
+type MintScheduleQueries interface {
+	// InsertMintSchedule inserts a new mint schedule and returns its ID.
+	InsertMintSchedule(ctx context.Context,
+		arg NewMintSchedule) (int64, error)
+
+	// FetchMintSchedules fetches all mint schedules, optionally filtered
+	// by their state.
+	FetchMintSchedules(ctx context.Context,
+		state sql.NullInt16) ([]MintScheduleRow, error)
+
+	// UpdateMintSchedule updates the progress and state of a mint
+	// schedule and returns the number of updated rows.
+	UpdateMintSchedule(ctx context.Context,
+		arg MintScheduleUpdate) (int64, error)
+}
+
+// BatchedMintScheduleQueries is a version of the MintScheduleQueries that's
+// capable of batched database operations.
+type BatchedMintScheduleQueries interface {
+	MintScheduleQueries
+
+	BatchedTx[MintScheduleQueries]
+}
+
+// MintScheduleStore is the database backed implementation of the
+// tapgarden.MintScheduleStore interface.
+type MintScheduleStore struct {
+	db BatchedMintScheduleQueries
+}
+
+// NewMintScheduleStore creates a new MintScheduleStore instance given an open
+// BatchedMintScheduleQueries.
+func NewMintScheduleStore(db BatchedMintScheduleQueries) *MintScheduleStore {
+	return &MintScheduleStore{
+		db: db,
+	}
+}
+
+// A compile-time assertion to ensure that MintScheduleStore implements the
+// tapgarden.MintScheduleStore interface.
+var _ tapgarden.MintScheduleStore = (*MintScheduleStore)(nil)
+
+// InsertMintSchedule persists a new mint schedule and returns its ID.
+//
+// NOTE: This is part of the tapgarden.MintScheduleStore interface.
+func (s *MintScheduleStore) InsertMintSchedule(ctx context.Context,
+	schedule *tapgarden.MintSchedule) (int64, error) {
+
+	var (
+		scheduleID int64
+		groupKey   = schedule.GroupKey.SerializeCompressed()
+	)
+	txOpt := WriteTxOption()
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q MintScheduleQueries) error {
+		var err error
+		scheduleID, err = q.InsertMintSchedule(ctx, NewMintSchedule{
+			GroupKey:         groupKey,
+			AssetVersion:     int16(schedule.AssetVersion),
+			Amount:           int64(schedule.Amount),
+			MetaType:         int16(schedule.MetaType),
+			MetaDataTemplate: schedule.MetaTemplate,
+			CadenceSeconds:   int64(schedule.Cadence.Seconds()),
+			MaxTotalSupply:   int64(schedule.MaxTotalSupply),
+			NextMintTime:     schedule.NextMintTime.UTC(),
+			NumTranches:      int32(schedule.NumTranches),
+			TotalMinted:      int64(schedule.TotalMinted),
+			State:            int16(schedule.State),
+			LastError:        sqlStr(schedule.LastError),
+			CreationTime:     schedule.CreationTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert mint schedule: %w",
+				err)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return scheduleID, nil
+}
+
+// FetchMintSchedules returns all mint schedules, optionally filtered by their
+// state.
+//
+// NOTE: This is part of the tapgarden.MintScheduleStore interface.
+func (s *MintScheduleStore) FetchMintSchedules(ctx context.Context,
+	state fn.Option[tapgarden.MintScheduleState]) (
+	[]*tapgarden.MintSchedule, error) {
+
+	var stateFilter sql.NullInt16
+	state.WhenSome(func(state tapgarden.MintScheduleState) {
+		stateFilter = sqlInt16(state)
+	})
+
+	var schedules []*tapgarden.MintSchedule
+	readOpts := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readOpts, func(q MintScheduleQueries) error {
+		rows, err := q.FetchMintSchedules(ctx, stateFilter)
+		if err != nil {
+			return fmt.Errorf("unable to fetch mint schedules: %w",
+				err)
+		}
+
+		schedules = make([]*tapgarden.MintSchedule, 0, len(rows))
+		for _, row := range rows {
+			schedule, err := unmarshalMintSchedule(row)
+			if err != nil {
+				return err
+			}
+
+			schedules = append(schedules, schedule)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return schedules, nil
+}
+
+// UpdateMintSchedule updates the progress and state of the given mint
+// schedule.
+//
+// NOTE: This is part of the tapgarden.MintScheduleStore interface.
+func (s *MintScheduleStore) UpdateMintSchedule(ctx context.Context,
+	schedule *tapgarden.MintSchedule) error {
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q MintScheduleQueries) error {
+		numRows, err := q.UpdateMintSchedule(ctx, MintScheduleUpdate{
+			NextMintTime: schedule.NextMintTime.UTC(),
+			NumTranches:  int32(schedule.NumTranches),
+			TotalMinted:  int64(schedule.TotalMinted),
+			State:        int16(schedule.State),
+			LastError:    sqlStr(schedule.LastError),
+			ID:           schedule.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update mint schedule: %w",
+				err)
+		}
+
+		if numRows == 0 {
+			return fmt.Errorf("mint schedule %d not found",
+				schedule.ID)
+		}
+
+		return nil
+	})
+}
+
+// unmarshalMintSchedule converts a mint schedule from its database
+// representation.
+func unmarshalMintSchedule(row MintScheduleRow) (*tapgarden.MintSchedule,
+	error) {
+
+	groupKey, err := btcec.ParsePubKey(row.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group key of mint schedule "+
+			"%d: %w", row.ID, err)
+	}
+
+	return &tapgarden.MintSchedule{
+		ID:             row.ID,
+		GroupKey:       *groupKey,
+		AssetVersion:   asset.Version(row.AssetVersion),
+		Amount:         uint64(row.Amount),
+		MetaType:       proof.MetaType(row.MetaType),
+		MetaTemplate:   row.MetaDataTemplate,
+		Cadence:        time.Duration(row.CadenceSeconds) * time.Second,
+		MaxTotalSupply: uint64(row.MaxTotalSupply),
+		NextMintTime:   row.NextMintTime.UTC(),
+		NumTranches:    uint32(row.NumTranches),
+		TotalMinted:    uint64(row.TotalMinted),
+		State:          tapgarden.MintScheduleState(row.State),
+		LastError:      row.LastError.String,
+		CreationTime:   row.CreationTime.UTC(),
+	}, nil
+}
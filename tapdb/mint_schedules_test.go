@@ -0,0 +1,96 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/stretchr/testify/require"
+)
+
+// newMintScheduleStore creates a new instance of MintScheduleStore for
+// testing.
+func newMintScheduleStore(t *testing.T) *MintScheduleStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) MintScheduleQueries {
+		return db.WithTx(tx)
+	}
+
+	scheduleTx := NewTransactionExecutor(db, txCreator)
+	return NewMintScheduleStore(scheduleTx)
+}
+
+// TestMintScheduleStore tests that mint schedules can be stored, fetched and
+// updated.
+func TestMintScheduleStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newMintScheduleStore(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	schedule := &tapgarden.MintSchedule{
+		GroupKey:       *test.RandPubKey(t),
+		AssetVersion:   asset.V1,
+		Amount:         1_000,
+		MetaType:       proof.MetaJson,
+		MetaTemplate:   []byte(`{"tranche": {{tranche}}}`),
+		Cadence:        24 * time.Hour,
+		MaxTotalSupply: 10_000,
+		NextMintTime:   now.Add(time.Hour),
+		State:          tapgarden.MintScheduleActive,
+		CreationTime:   now,
+	}
+
+	var err error
+	schedule.ID, err = store.InsertMintSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	schedules, err := store.FetchMintSchedules(
+		ctx, fn.None[tapgarden.MintScheduleState](),
+	)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, schedule, schedules[0])
+
+	// Record a minted tranche and a failed attempt.
+	schedule.NumTranches = 1
+	schedule.TotalMinted = 1_000
+	schedule.NextMintTime = now.Add(25 * time.Hour)
+	schedule.LastError = "insufficient funds"
+	require.NoError(t, store.UpdateMintSchedule(ctx, schedule))
+
+	active, err := store.FetchMintSchedules(
+		ctx, fn.Some(tapgarden.MintScheduleActive),
+	)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, schedule, active[0])
+
+	// Once cancelled, the schedule is no longer active.
+	schedule.State = tapgarden.MintScheduleCancelled
+	require.NoError(t, store.UpdateMintSchedule(ctx, schedule))
+
+	active, err = store.FetchMintSchedules(
+		ctx, fn.Some(tapgarden.MintScheduleActive),
+	)
+	require.NoError(t, err)
+	require.Empty(t, active)
+
+	cancelled, err := store.FetchMintSchedules(
+		ctx, fn.Some(tapgarden.MintScheduleCancelled),
+	)
+	require.NoError(t, err)
+	require.Len(t, cancelled, 1)
+
+	// Updating an unknown schedule fails.
+	schedule.ID++
+	require.Error(t, store.UpdateMintSchedule(ctx, schedule))
+}
@@ -383,6 +383,29 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	return leafKeys, nil
 }
 
+// UniverseSubtrees returns the subtree roots of the given universe at the given
+// depth for each of the given paths, in the same order as the paths.
+func (b *MultiverseStore) UniverseSubtrees(ctx context.Context,
+	q universe.UniverseSubtreesQuery) ([]universe.UniverseSubtree, error) {
+
+	var subtrees []universe.UniverseSubtree
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		var err error
+		subtrees, err = universeSubtrees(
+			ctx, db, q.Id.String(), q.Depth, q.Paths,
+		)
+
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return subtrees, nil
+}
+
 // RootNodes returns the complete set of known base universe root nodes for the
 // set of base universes tracked in the multiverse.
 func (b *MultiverseStore) RootNodes(ctx context.Context,
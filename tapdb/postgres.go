@@ -39,6 +39,12 @@ var (
 		"INTEGER PRIMARY KEY": "BIGSERIAL PRIMARY KEY",
 		"TIMESTAMP":           "TIMESTAMP WITHOUT TIME ZONE",
 		"UNHEX":               "DECODE",
+
+		// Postgres can only use an index for prefix LIKE queries if
+		// the index uses the text_pattern_ops operator class, unless
+		// the database uses the C collation.
+		"ON genesis_assets (lower(asset_tag));": "ON genesis_assets " +
+			"(lower(asset_tag) text_pattern_ops);",
 	}
 )
 
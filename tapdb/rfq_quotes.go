@@ -0,0 +1,524 @@
+package tapdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+type (
+	// NewRfqAcceptedQuote is used to insert a new accepted quote into the
+	// database.
+	NewRfqAcceptedQuote = sqlc.InsertRfqAcceptedQuoteParams
+
+	// RfqAcceptedQuote is a row in the accepted quotes table.
+	RfqAcceptedQuote = sqlc.RfqAcceptedQuote
+
+	// NewRfqCollectedForwardFee is used to add a collected forwarding fee
+	// to the database.
+	NewRfqCollectedForwardFee = sqlc.AddRfqCollectedForwardFeeParams
+
+	// RfqCollectedForwardFee is a row in the collected forwarding fees
+	// table.
+	RfqCollectedForwardFee = sqlc.RfqCollectedForwardFee
+)
+
+// RfqStore is the set of queries that are needed to persist accepted RFQ
+// quotes.
+type RfqStore interface {
+	// InsertRfqAcceptedQuote inserts a new accepted quote. If the quote
+	// already exists, this is a no-op.
+	InsertRfqAcceptedQuote(ctx context.Context,
+		arg NewRfqAcceptedQuote) error
+
+	// FetchUnexpiredRfqAcceptedQuotes fetches all accepted quotes that
+	// expire after the given unix timestamp.
+	FetchUnexpiredRfqAcceptedQuotes(ctx context.Context,
+		now int64) ([]RfqAcceptedQuote, error)
+
+	// DeleteExpiredRfqAcceptedQuotes deletes all accepted quotes that
+	// expired at or before the given unix timestamp.
+	DeleteExpiredRfqAcceptedQuotes(ctx context.Context,
+		now int64) (int64, error)
+
+	// AddRfqCollectedForwardFee adds the fee of a settled HTLC to the
+	// forwarding fees collected for an asset.
+	AddRfqCollectedForwardFee(ctx context.Context,
+		arg NewRfqCollectedForwardFee) error
+
+	// FetchRfqCollectedForwardFees fetches the forwarding fees collected
+	// per asset.
+	FetchRfqCollectedForwardFees(
+		ctx context.Context) ([]RfqCollectedForwardFee, error)
+}
+
+// BatchedRfqStore is a version of the RfqStore that's capable of batched
+// database operations.
+type BatchedRfqStore interface {
+	RfqStore
+
+	BatchedTx[RfqStore]
+}
+
+// RfqQuoteStore is the database backed implementation of the rfq.QuoteStore
+// interface.
+type RfqQuoteStore struct {
+	db BatchedRfqStore
+}
+
+// NewRfqQuoteStore creates a new RfqQuoteStore instance given an open
+// BatchedRfqStore.
+func NewRfqQuoteStore(db BatchedRfqStore) *RfqQuoteStore {
+	return &RfqQuoteStore{
+		db: db,
+	}
+}
+
+// A compile-time assertion to ensure that RfqQuoteStore implements the
+// rfq.QuoteStore interface.
+var _ rfq.QuoteStore = (*RfqQuoteStore)(nil)
+
+// quoteRequest holds the request related fields of an accepted quote that are
+// common to buy and sell quotes.
+type quoteRequest struct {
+	version        rfqmsg.WireMsgDataVersion
+	specifier      asset.Specifier
+	maxAmount      uint64
+	rateHint       fn.Option[rfqmsg.AssetRate]
+	oracleMetadata string
+}
+
+// acceptedQuote holds the accept related fields of an accepted quote that are
+// common to buy and sell quotes.
+type acceptedQuote struct {
+	version    rfqmsg.WireMsgDataVersion
+	rate       rfqmsg.AssetRate
+	forwardFee fn.Option[rfqmsg.ForwardFee]
+}
+
+// newAcceptedQuoteParams creates the insert parameters for an accepted quote.
+func newAcceptedQuoteParams(id rfqmsg.ID, peer route.Vertex, isBuy,
+	local bool, req quoteRequest,
+	accept acceptedQuote) NewRfqAcceptedQuote {
+
+	rate := accept.rate
+	params := NewRfqAcceptedQuote{
+		QuoteID:             id[:],
+		Peer:                peer[:],
+		IsBuy:               isBuy,
+		LocalAccept:         local,
+		RequestVersion:      int16(req.version),
+		AcceptVersion:       int16(accept.version),
+		MaxAmount:           int64(req.maxAmount),
+		PriceOracleMetadata: sqlStr(req.oracleMetadata),
+		RateCoefficient:     rate.Rate.Coefficient.Bytes(),
+		RateScale:           int16(rate.Rate.Scale),
+		Expiry:              rate.Expiry.Unix(),
+	}
+
+	req.specifier.WhenId(func(id asset.ID) {
+		params.AssetID = fn.CopySlice(id[:])
+	})
+	req.specifier.WhenGroupPubKey(func(groupKey btcec.PublicKey) {
+		params.GroupKey = groupKey.SerializeCompressed()
+	})
+
+	req.rateHint.WhenSome(func(hint rfqmsg.AssetRate) {
+		params.RateHintCoefficient = hint.Rate.Coefficient.Bytes()
+		params.RateHintScale = sqlInt16(hint.Rate.Scale)
+		params.RateHintExpiry = sqlInt64(hint.Expiry.Unix())
+	})
+
+	accept.forwardFee.WhenSome(func(fee rfqmsg.ForwardFee) {
+		params.ForwardFeeBase = sqlInt64(fee.BaseFee)
+		params.ForwardFeeRatePpm = sqlInt64(fee.FeeRatePpm)
+	})
+
+	return params
+}
+
+// StoreBuyAccept persists an accepted buy quote. The local flag indicates
+// whether the quote was accepted by our node (true) or by a peer (false).
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (s *RfqQuoteStore) StoreBuyAccept(ctx context.Context,
+	accept rfqmsg.BuyAccept, local bool) error {
+
+	params := newAcceptedQuoteParams(
+		accept.ID, accept.Peer, true, local, quoteRequest{
+			version:        accept.Request.Version,
+			specifier:      accept.Request.AssetSpecifier,
+			maxAmount:      accept.Request.AssetMaxAmt,
+			rateHint:       accept.Request.AssetRateHint,
+			oracleMetadata: accept.Request.PriceOracleMetadata,
+		}, acceptedQuote{
+			version:    accept.Version,
+			rate:       accept.AssetRate,
+			forwardFee: accept.ForwardFee,
+		},
+	)
+
+	return s.insertQuote(ctx, params)
+}
+
+// StoreSellAccept persists an accepted sell quote. The local flag indicates
+// whether the quote was accepted by our node (true) or by a peer (false).
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (s *RfqQuoteStore) StoreSellAccept(ctx context.Context,
+	accept rfqmsg.SellAccept, local bool) error {
+
+	params := newAcceptedQuoteParams(
+		accept.ID, accept.Peer, false, local, quoteRequest{
+			version:        accept.Request.Version,
+			specifier:      accept.Request.AssetSpecifier,
+			maxAmount:      uint64(accept.Request.PaymentMaxAmt),
+			rateHint:       accept.Request.AssetRateHint,
+			oracleMetadata: accept.Request.PriceOracleMetadata,
+		}, acceptedQuote{
+			version:    accept.Version,
+			rate:       accept.AssetRate,
+			forwardFee: accept.ForwardFee,
+		},
+	)
+
+	return s.insertQuote(ctx, params)
+}
+
+// insertQuote inserts the given accepted quote into the database.
+func (s *RfqQuoteStore) insertQuote(ctx context.Context,
+	params NewRfqAcceptedQuote) error {
+
+	txOpt := WriteTxOption()
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q RfqStore) error {
+		return q.InsertRfqAcceptedQuote(ctx, params)
+	})
+	if dbErr != nil {
+		return fmt.Errorf("error storing accepted quote %x: %w",
+			params.QuoteID, dbErr)
+	}
+
+	return nil
+}
+
+// FetchAcceptedQuotes returns all accepted quotes that have not yet expired at
+// the given time.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (s *RfqQuoteStore) FetchAcceptedQuotes(ctx context.Context,
+	now time.Time) (*rfq.AcceptedQuotes, error) {
+
+	var (
+		txOpt  = ReadTxOption()
+		quotes rfq.AcceptedQuotes
+	)
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q RfqStore) error {
+		// Reset the result in case the transaction is retried.
+		quotes = rfq.AcceptedQuotes{}
+
+		rows, err := q.FetchUnexpiredRfqAcceptedQuotes(ctx, now.Unix())
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			err := addAcceptedQuote(&quotes, row)
+			if err != nil {
+				return fmt.Errorf("unable to decode quote "+
+					"%x: %w", row.QuoteID, err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("error fetching accepted quotes: %w",
+			dbErr)
+	}
+
+	return &quotes, nil
+}
+
+// PruneExpiredQuotes deletes all accepted quotes that have expired at the
+// given time and returns the number of deleted quotes.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (s *RfqQuoteStore) PruneExpiredQuotes(ctx context.Context,
+	now time.Time) (int64, error) {
+
+	var (
+		txOpt     = WriteTxOption()
+		numPruned int64
+	)
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q RfqStore) error {
+		var err error
+		numPruned, err = q.DeleteExpiredRfqAcceptedQuotes(
+			ctx, now.Unix(),
+		)
+		return err
+	})
+	if dbErr != nil {
+		return 0, fmt.Errorf("error pruning expired quotes: %w", dbErr)
+	}
+
+	return numPruned, nil
+}
+
+// AddCollectedForwardFee adds the fee in asset units that was collected for a
+// settled HTLC to the fees collected for the given asset.
+//
+// NOTE: This is part of the rfq.ForwardFeeStore interface.
+func (s *RfqQuoteStore) AddCollectedForwardFee(ctx context.Context,
+	specifier asset.Specifier, fee uint64) error {
+
+	specifierBytes, err := encodeFeeSpecifier(specifier)
+	if err != nil {
+		return err
+	}
+
+	params := NewRfqCollectedForwardFee{
+		AssetSpecifier: specifierBytes,
+		Fee:            int64(fee),
+	}
+
+	txOpt := WriteTxOption()
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q RfqStore) error {
+		return q.AddRfqCollectedForwardFee(ctx, params)
+	})
+	if dbErr != nil {
+		return fmt.Errorf("error adding collected forward fee: %w",
+			dbErr)
+	}
+
+	return nil
+}
+
+// FetchCollectedForwardFees returns the forwarding fees collected per asset.
+//
+// NOTE: This is part of the rfq.ForwardFeeStore interface.
+func (s *RfqQuoteStore) FetchCollectedForwardFees(
+	ctx context.Context) ([]rfq.CollectedForwardFees, error) {
+
+	var (
+		txOpt = ReadTxOption()
+		fees  []rfq.CollectedForwardFees
+	)
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q RfqStore) error {
+		// Reset the result in case the transaction is retried.
+		fees = nil
+
+		rows, err := q.FetchRfqCollectedForwardFees(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			specifier, err := decodeFeeSpecifier(row.AssetSpecifier)
+			if err != nil {
+				return err
+			}
+
+			fees = append(fees, rfq.CollectedForwardFees{
+				AssetSpecifier: specifier,
+				NumHtlcs:       uint64(row.NumHtlcs),
+				TotalFees:      uint64(row.TotalFees),
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("error fetching collected forward "+
+			"fees: %w", dbErr)
+	}
+
+	return fees, nil
+}
+
+// encodeFeeSpecifier encodes the asset specifier of collected forwarding fees
+// as the asset ID followed by the compressed group key, leaving out whichever
+// of the two isn't set.
+func encodeFeeSpecifier(specifier asset.Specifier) ([]byte, error) {
+	assetID, groupKey := specifier.AsBytes()
+	if len(assetID) == 0 && len(groupKey) == 0 {
+		return nil, fmt.Errorf("asset specifier has neither asset ID " +
+			"nor group key")
+	}
+
+	return append(fn.CopySlice(assetID), groupKey...), nil
+}
+
+// decodeFeeSpecifier decodes the asset specifier of collected forwarding fees
+// that was encoded with encodeFeeSpecifier.
+func decodeFeeSpecifier(specifierBytes []byte) (asset.Specifier, error) {
+	var (
+		assetID  *asset.ID
+		groupKey *btcec.PublicKey
+	)
+	switch len(specifierBytes) {
+	case sha256.Size, sha256.Size + btcec.PubKeyBytesLenCompressed:
+		var id asset.ID
+		copy(id[:], specifierBytes)
+		assetID = &id
+
+		specifierBytes = specifierBytes[sha256.Size:]
+
+	case btcec.PubKeyBytesLenCompressed:
+		// Only the group key is set, which we parse below.
+
+	default:
+		return asset.Specifier{}, fmt.Errorf("invalid asset "+
+			"specifier length: %d", len(specifierBytes))
+	}
+
+	if len(specifierBytes) > 0 {
+		var err error
+		groupKey, err = btcec.ParsePubKey(specifierBytes)
+		if err != nil {
+			return asset.Specifier{}, fmt.Errorf("unable to parse "+
+				"group key: %w", err)
+		}
+	}
+
+	return asset.NewSpecifier(assetID, groupKey, nil, true)
+}
+
+// addAcceptedQuote decodes the given database row and adds the resulting
+// quote to the matching category of the accepted quotes.
+func addAcceptedQuote(quotes *rfq.AcceptedQuotes, row RfqAcceptedQuote) error {
+	var id rfqmsg.ID
+	if len(row.QuoteID) != len(id) {
+		return fmt.Errorf("invalid quote ID length: %d",
+			len(row.QuoteID))
+	}
+	copy(id[:], row.QuoteID)
+
+	peer, err := route.NewVertexFromBytes(row.Peer)
+	if err != nil {
+		return fmt.Errorf("unable to parse peer: %w", err)
+	}
+
+	var (
+		assetID  *asset.ID
+		groupKey *btcec.PublicKey
+	)
+	if len(row.AssetID) > 0 {
+		var id asset.ID
+		copy(id[:], row.AssetID)
+		assetID = &id
+	}
+	if len(row.GroupKey) > 0 {
+		groupKey, err = btcec.ParsePubKey(row.GroupKey)
+		if err != nil {
+			return fmt.Errorf("unable to parse group key: %w", err)
+		}
+	}
+
+	specifier, err := asset.NewSpecifier(assetID, groupKey, nil, true)
+	if err != nil {
+		return fmt.Errorf("unable to create asset specifier: %w", err)
+	}
+
+	var rateHint fn.Option[rfqmsg.AssetRate]
+	if row.RateHintScale.Valid && row.RateHintExpiry.Valid {
+		rateHint = fn.Some(rfqmsg.NewAssetRate(
+			decodeFixedPoint(
+				row.RateHintCoefficient,
+				extractSqlInt16[uint8](row.RateHintScale),
+			),
+			time.Unix(row.RateHintExpiry.Int64, 0).UTC(),
+		))
+	}
+
+	assetRate := rfqmsg.NewAssetRate(
+		decodeFixedPoint(row.RateCoefficient, uint8(row.RateScale)),
+		time.Unix(row.Expiry, 0).UTC(),
+	)
+
+	var forwardFee fn.Option[rfqmsg.ForwardFee]
+	if row.ForwardFeeBase.Valid && row.ForwardFeeRatePpm.Valid {
+		forwardFee = fn.Some(rfqmsg.ForwardFee{
+			BaseFee:    uint64(row.ForwardFeeBase.Int64),
+			FeeRatePpm: uint64(row.ForwardFeeRatePpm.Int64),
+		})
+	}
+
+	oracleMetadata := extractNullString(row.PriceOracleMetadata)
+	requestVersion := rfqmsg.WireMsgDataVersion(row.RequestVersion)
+	acceptVersion := rfqmsg.WireMsgDataVersion(row.AcceptVersion)
+
+	if row.IsBuy {
+		accept := rfqmsg.NewBuyAcceptFromRequest(rfqmsg.BuyRequest{
+			Peer:                peer,
+			Version:             requestVersion,
+			ID:                  id,
+			AssetSpecifier:      specifier,
+			AssetMaxAmt:         uint64(row.MaxAmount),
+			AssetRateHint:       rateHint,
+			PriceOracleMetadata: oracleMetadata,
+		}, assetRate)
+		accept.Version = acceptVersion
+		accept.ForwardFee = forwardFee
+
+		if row.LocalAccept {
+			quotes.LocalBuy = append(quotes.LocalBuy, *accept)
+		} else {
+			quotes.PeerBuy = append(quotes.PeerBuy, *accept)
+		}
+
+		return nil
+	}
+
+	accept := rfqmsg.NewSellAcceptFromRequest(rfqmsg.SellRequest{
+		Peer:                peer,
+		Version:             requestVersion,
+		ID:                  id,
+		AssetSpecifier:      specifier,
+		PaymentMaxAmt:       lnwire.MilliSatoshi(row.MaxAmount),
+		AssetRateHint:       rateHint,
+		PriceOracleMetadata: oracleMetadata,
+	}, assetRate)
+	accept.Version = acceptVersion
+	accept.ForwardFee = forwardFee
+
+	if row.LocalAccept {
+		quotes.LocalSell = append(quotes.LocalSell, *accept)
+	} else {
+		quotes.PeerSell = append(quotes.PeerSell, *accept)
+	}
+
+	return nil
+}
+
+// decodeFixedPoint creates a fixed-point number from the big-endian encoded
+// coefficient and the scale.
+func decodeFixedPoint(coefficient []byte,
+	scale uint8) rfqmath.BigIntFixedPoint {
+
+	return rfqmath.BigIntFixedPoint{
+		Coefficient: rfqmath.BigInt{}.FromBytes(coefficient),
+		Scale:       scale,
+	}
+}
+
+// extractNullString returns the string value of the given NullString or an
+// empty string if it is NULL.
+func extractNullString(s sql.NullString) string {
+	if !s.Valid {
+		return ""
+	}
+
+	return s.String
+}
@@ -0,0 +1,201 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmath"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// newRfqQuoteStore creates a new instance of RfqQuoteStore for testing.
+func newRfqQuoteStore(t *testing.T) *RfqQuoteStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) RfqStore {
+		return db.WithTx(tx)
+	}
+
+	rfqTx := NewTransactionExecutor(db, txCreator)
+	return NewRfqQuoteStore(rfqTx)
+}
+
+// randPeer returns a random peer public key.
+func randPeer(t *testing.T) route.Vertex {
+	return route.NewVertex(test.RandPubKey(t))
+}
+
+// randBuyAccept creates a random buy accept that expires at the given time.
+func randBuyAccept(t *testing.T, expiry time.Time) rfqmsg.BuyAccept {
+	peer := randPeer(t)
+	specifier := asset.NewSpecifierFromId(asset.RandID(t))
+
+	// The rate hint is only set on some of the requests, so we cover both
+	// the NULL and the non-NULL case.
+	var rateHint fn.Option[rfqmsg.AssetRate]
+	if test.RandBool() {
+		rateHint = fn.Some(rfqmsg.NewAssetRate(
+			rfqmath.NewBigIntFixedPoint(
+				uint64(test.RandInt[uint32]()), 3,
+			), expiry,
+		))
+	}
+
+	req, err := rfqmsg.NewBuyRequest(
+		peer, specifier, uint64(test.RandInt[uint32]()), rateHint,
+		"metadata",
+	)
+	require.NoError(t, err)
+
+	rate := rfqmsg.NewAssetRate(
+		rfqmath.NewBigIntFixedPoint(test.RandInt[uint64](), 9), expiry,
+	)
+
+	return *rfqmsg.NewBuyAcceptFromRequest(*req, rate)
+}
+
+// randSellAccept creates a random sell accept that expires at the given time.
+func randSellAccept(t *testing.T, expiry time.Time) rfqmsg.SellAccept {
+	peer := randPeer(t)
+	specifier := asset.NewSpecifierFromGroupKey(*test.RandPubKey(t))
+
+	req, err := rfqmsg.NewSellRequest(
+		peer, specifier, lnwire.MilliSatoshi(test.RandInt[uint32]()),
+		fn.None[rfqmsg.AssetRate](), "",
+	)
+	require.NoError(t, err)
+
+	rate := rfqmsg.NewAssetRate(
+		rfqmath.NewBigIntFixedPoint(test.RandInt[uint64](), 9), expiry,
+	)
+
+	return *rfqmsg.NewSellAcceptFromRequest(*req, rate)
+}
+
+// TestRfqQuoteStore tests that accepted quotes can be stored, fetched and
+// pruned.
+func TestRfqQuoteStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newRfqQuoteStore(t)
+
+	// We use whole seconds, as that's the resolution of the expiry stored
+	// in the database.
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	expiry := now.Add(time.Hour)
+
+	peerBuy := randBuyAccept(t, expiry)
+	localBuy := randBuyAccept(t, expiry)
+	peerSell := randSellAccept(t, expiry)
+	localSell := randSellAccept(t, expiry)
+
+	// The forwarding fee is only set on the quotes we accepted, so we
+	// cover both the NULL and the non-NULL case.
+	localBuy.ForwardFee = fn.Some(rfqmsg.ForwardFee{
+		BaseFee:    uint64(test.RandInt[uint32]()),
+		FeeRatePpm: 1_000,
+	})
+	localSell.ForwardFee = fn.Some(rfqmsg.ForwardFee{
+		BaseFee:    5,
+		FeeRatePpm: uint64(test.RandInt[uint16]()),
+	})
+
+	require.NoError(t, store.StoreBuyAccept(ctx, peerBuy, false))
+	require.NoError(t, store.StoreBuyAccept(ctx, localBuy, true))
+	require.NoError(t, store.StoreSellAccept(ctx, peerSell, false))
+	require.NoError(t, store.StoreSellAccept(ctx, localSell, true))
+
+	// Storing the same quote twice should be a no-op.
+	require.NoError(t, store.StoreBuyAccept(ctx, peerBuy, false))
+
+	// We also add an expired quote, which should never be returned.
+	expiredBuy := randBuyAccept(t, now.Add(-time.Minute))
+	require.NoError(t, store.StoreBuyAccept(ctx, expiredBuy, true))
+
+	quotes, err := store.FetchAcceptedQuotes(ctx, now)
+	require.NoError(t, err)
+
+	require.Equal(t, []rfqmsg.BuyAccept{peerBuy}, quotes.PeerBuy)
+	require.Equal(t, []rfqmsg.BuyAccept{localBuy}, quotes.LocalBuy)
+	require.Equal(t, []rfqmsg.SellAccept{peerSell}, quotes.PeerSell)
+	require.Equal(t, []rfqmsg.SellAccept{localSell}, quotes.LocalSell)
+
+	// Pruning should only remove the expired quote.
+	numPruned, err := store.PruneExpiredQuotes(ctx, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numPruned)
+
+	// Once all quotes have expired, nothing should be returned and all
+	// remaining quotes should be pruned.
+	later := expiry.Add(time.Second)
+	quotes, err = store.FetchAcceptedQuotes(ctx, later)
+	require.NoError(t, err)
+	require.Empty(t, quotes.PeerBuy)
+	require.Empty(t, quotes.LocalBuy)
+	require.Empty(t, quotes.PeerSell)
+	require.Empty(t, quotes.LocalSell)
+
+	numPruned, err = store.PruneExpiredQuotes(ctx, later)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, numPruned)
+}
+
+// TestRfqCollectedForwardFees tests that the forwarding fees collected for
+// settled HTLCs are summed up per asset and survive a restart.
+func TestRfqCollectedForwardFees(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newRfqQuoteStore(t)
+
+	groupKey := test.RandPubKey(t)
+	idSpecifier := asset.NewSpecifierFromId(asset.RandID(t))
+	groupSpecifier := asset.NewSpecifierFromGroupKey(*groupKey)
+	bothSpecifier := asset.NewSpecifierOptionalGroupPubKey(
+		asset.RandID(t), groupKey,
+	)
+
+	// Nothing was collected yet.
+	fees, err := store.FetchCollectedForwardFees(ctx)
+	require.NoError(t, err)
+	require.Empty(t, fees)
+
+	require.NoError(t, store.AddCollectedForwardFee(ctx, idSpecifier, 10))
+	require.NoError(t, store.AddCollectedForwardFee(ctx, idSpecifier, 15))
+	require.NoError(t, store.AddCollectedForwardFee(
+		ctx, groupSpecifier, 7,
+	))
+	require.NoError(t, store.AddCollectedForwardFee(
+		ctx, bothSpecifier, 3,
+	))
+
+	// A specifier without an asset ID or group key can't be stored.
+	err = store.AddCollectedForwardFee(ctx, asset.Specifier{}, 1)
+	require.Error(t, err)
+
+	fees, err = store.FetchCollectedForwardFees(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []rfq.CollectedForwardFees{{
+		AssetSpecifier: idSpecifier,
+		NumHtlcs:       2,
+		TotalFees:      25,
+	}, {
+		AssetSpecifier: groupSpecifier,
+		NumHtlcs:       1,
+		TotalFees:      7,
+	}, {
+		AssetSpecifier: bothSpecifier,
+		NumHtlcs:       1,
+		TotalFees:      3,
+	}}, fees)
+}
@@ -0,0 +1,338 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+type (
+	// NewScheduledSend is used to insert a new scheduled send into the
+	// database.
+	NewScheduledSend = sqlc.InsertScheduledSendParams
+
+	// NewScheduledSendRecipient is used to insert a new recipient of a
+	// scheduled send into the database.
+	NewScheduledSendRecipient = sqlc.InsertScheduledSendRecipientParams
+
+	// ScheduledSendRow is a row in the scheduled sends table.
+	ScheduledSendRow = sqlc.ScheduledSend
+
+	// ScheduledSendRecipientRow is a row in the scheduled send recipients
+	// table.
+	ScheduledSendRecipientRow = sqlc.ScheduledSendRecipient
+
+	// ScheduledSendStateUpdate is used to update the state of a scheduled
+	// send.
+	ScheduledSendStateUpdate = sqlc.UpdateScheduledSendStateParams
+
+	// ScheduledSendRetry is used to record a failed execution attempt of
+	// a scheduled send that is retried later.
+	ScheduledSendRetry = sqlc.RetryScheduledSendParams
+)
+
+// ScheduledSendQueries is the set of queries that are needed to persist
+// scheduled sends.
+type ScheduledSendQueries interface {
+	// InsertScheduledSend inserts a new scheduled send and returns its
+	// ID.
+	InsertScheduledSend(ctx context.Context,
+		arg NewScheduledSend) (int64, error)
+
+	// InsertScheduledSendRecipient inserts a new recipient of a scheduled
+	// send.
+	InsertScheduledSendRecipient(ctx context.Context,
+		arg NewScheduledSendRecipient) error
+
+	// FetchScheduledSends fetches all scheduled sends, optionally filtered
+	// by their state.
+	FetchScheduledSends(ctx context.Context,
+		state sql.NullInt16) ([]ScheduledSendRow, error)
+
+	// FetchScheduledSendRecipients fetches the recipients of the scheduled
+	// send with the given ID.
+	FetchScheduledSendRecipients(ctx context.Context,
+		scheduledSendID int64) ([]ScheduledSendRecipientRow, error)
+
+	// UpdateScheduledSendState updates the state of a scheduled send if
+	// it is in the given old state and returns the number of updated
+	// rows.
+	UpdateScheduledSendState(ctx context.Context,
+		arg ScheduledSendStateUpdate) (int64, error)
+
+	// RetryScheduledSend updates the state of a scheduled send if it is in
+	// the given old state, increments its number of execution attempts
+	// and returns the number of updated rows.
+	RetryScheduledSend(ctx context.Context,
+		arg ScheduledSendRetry) (int64, error)
+}
+
+// BatchedScheduledSendQueries is a version of the ScheduledSendQueries that's
+// capable of batched database operations.
+type BatchedScheduledSendQueries interface {
+	ScheduledSendQueries
+
+	BatchedTx[ScheduledSendQueries]
+}
+
+// SendScheduleStore is the database backed implementation of the
+// tapfreighter.ScheduledSendStore interface.
+type SendScheduleStore struct {
+	db BatchedScheduledSendQueries
+}
+
+// NewSendScheduleStore creates a new SendScheduleStore instance given an open
+// BatchedScheduledSendQueries.
+func NewSendScheduleStore(db BatchedScheduledSendQueries) *SendScheduleStore {
+	return &SendScheduleStore{
+		db: db,
+	}
+}
+
+// A compile-time assertion to ensure that SendScheduleStore implements the
+// tapfreighter.ScheduledSendStore interface.
+var _ tapfreighter.ScheduledSendStore = (*SendScheduleStore)(nil)
+
+// InsertScheduledSend persists a new scheduled send together with its
+// recipients and returns its ID.
+//
+// NOTE: This is part of the tapfreighter.ScheduledSendStore interface.
+func (s *SendScheduleStore) InsertScheduledSend(ctx context.Context,
+	send *tapfreighter.ScheduledSend) (int64, error) {
+
+	var executeAtHeight sql.NullInt32
+	send.ExecuteAtHeight.WhenSome(func(height uint32) {
+		executeAtHeight = sqlInt32(height)
+	})
+
+	var executeAtTime sql.NullTime
+	send.ExecuteAtTime.WhenSome(func(t time.Time) {
+		executeAtTime = sql.NullTime{Time: t.UTC(), Valid: true}
+	})
+
+	var (
+		sendID      int64
+		skipPingChk = send.SkipProofCourierPingCheck
+	)
+	txOpt := WriteTxOption()
+	dbErr := s.db.ExecTx(ctx, txOpt, func(q ScheduledSendQueries) error {
+		var err error
+		sendID, err = q.InsertScheduledSend(ctx, NewScheduledSend{
+			Label:                     send.Label,
+			FeeRate:                   int64(send.FeeRate),
+			SkipProofCourierPingCheck: skipPingChk,
+			ExecuteAtHeight:           executeAtHeight,
+			ExecuteAtTime:             executeAtTime,
+			State:                     int16(send.State),
+			CreationTime:              send.CreationTime.UTC(),
+			UpdateTime:                send.UpdateTime.UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert scheduled send: %w",
+				err)
+		}
+
+		for _, recipient := range send.Recipients {
+			amount := int64(recipient.Amount)
+			err := q.InsertScheduledSendRecipient(
+				ctx, NewScheduledSendRecipient{
+					ScheduledSendID: sendID,
+					TapAddr:         recipient.TapAddr,
+					Amount:          amount,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert scheduled "+
+					"send recipient: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return sendID, nil
+}
+
+// FetchScheduledSends returns all scheduled sends, optionally filtered by their
+// state.
+//
+// NOTE: This is part of the tapfreighter.ScheduledSendStore interface.
+func (s *SendScheduleStore) FetchScheduledSends(ctx context.Context,
+	state fn.Option[tapfreighter.ScheduledSendState]) (
+	[]*tapfreighter.ScheduledSend, error) {
+
+	var stateFilter sql.NullInt16
+	state.WhenSome(func(state tapfreighter.ScheduledSendState) {
+		stateFilter = sqlInt16(state)
+	})
+
+	var sends []*tapfreighter.ScheduledSend
+	readOpts := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readOpts, func(q ScheduledSendQueries) error {
+		rows, err := q.FetchScheduledSends(ctx, stateFilter)
+		if err != nil {
+			return fmt.Errorf("unable to fetch scheduled sends: %w",
+				err)
+		}
+
+		sends = make([]*tapfreighter.ScheduledSend, 0, len(rows))
+		for _, row := range rows {
+			recipients, err := q.FetchScheduledSendRecipients(
+				ctx, row.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch recipients "+
+					"of scheduled send %d: %w", row.ID, err)
+			}
+
+			send, err := unmarshalScheduledSend(row, recipients)
+			if err != nil {
+				return err
+			}
+
+			sends = append(sends, send)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return sends, nil
+}
+
+// UpdateScheduledSendState moves the scheduled send with the given ID from the
+// old to the new state. If the send isn't in the old state,
+// tapfreighter.ErrScheduledSendNotPending is returned.
+//
+// NOTE: This is part of the tapfreighter.ScheduledSendStore interface.
+func (s *SendScheduleStore) UpdateScheduledSendState(ctx context.Context,
+	id int64, oldState, newState tapfreighter.ScheduledSendState,
+	anchorTxHash fn.Option[chainhash.Hash], failureReason string) error {
+
+	var anchorTxid []byte
+	anchorTxHash.WhenSome(func(hash chainhash.Hash) {
+		anchorTxid = fn.ByteSlice(hash)
+	})
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q ScheduledSendQueries) error {
+		numRows, err := q.UpdateScheduledSendState(
+			ctx, ScheduledSendStateUpdate{
+				NewState:      int16(newState),
+				AnchorTxid:    anchorTxid,
+				FailureReason: sqlStr(failureReason),
+				UpdateTime:    time.Now().UTC(),
+				ID:            id,
+				OldState:      int16(oldState),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update scheduled send: %w",
+				err)
+		}
+
+		if numRows == 0 {
+			return fmt.Errorf("%w: scheduled send %d not %v",
+				tapfreighter.ErrScheduledSendNotPending, id,
+				oldState)
+		}
+
+		return nil
+	})
+}
+
+// RetryScheduledSend moves the executing scheduled send with the given ID back
+// to the pending state and records the failed execution attempt together with
+// its failure reason. If the send isn't executing,
+// tapfreighter.ErrScheduledSendNotPending is returned.
+//
+// NOTE: This is part of the tapfreighter.ScheduledSendStore interface.
+func (s *SendScheduleStore) RetryScheduledSend(ctx context.Context, id int64,
+	failureReason string) error {
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q ScheduledSendQueries) error {
+		numRows, err := q.RetryScheduledSend(ctx, ScheduledSendRetry{
+			NewState:      int16(tapfreighter.ScheduledSendPending),
+			FailureReason: sqlStr(failureReason),
+			UpdateTime:    time.Now().UTC(),
+			ID:            id,
+			OldState:      int16(tapfreighter.ScheduledSendExecuting),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update scheduled send: %w",
+				err)
+		}
+
+		if numRows == 0 {
+			return fmt.Errorf("%w: scheduled send %d not %v",
+				tapfreighter.ErrScheduledSendNotPending, id,
+				tapfreighter.ScheduledSendExecuting)
+		}
+
+		return nil
+	})
+}
+
+// unmarshalScheduledSend converts a scheduled send and its recipients from
+// their database representation.
+func unmarshalScheduledSend(row ScheduledSendRow,
+	recipientRows []ScheduledSendRecipientRow) (*tapfreighter.ScheduledSend,
+	error) {
+
+	send := &tapfreighter.ScheduledSend{
+		ID:                        row.ID,
+		Label:                     row.Label,
+		FeeRate:                   chainfee.SatPerKWeight(row.FeeRate),
+		SkipProofCourierPingCheck: row.SkipProofCourierPingCheck,
+		FailureReason:             row.FailureReason.String,
+		NumAttempts:               uint32(row.NumAttempts),
+		CreationTime:              row.CreationTime.UTC(),
+		UpdateTime:                row.UpdateTime.UTC(),
+	}
+	send.State = tapfreighter.ScheduledSendState(row.State)
+
+	if row.ExecuteAtHeight.Valid {
+		send.ExecuteAtHeight = fn.Some(
+			uint32(row.ExecuteAtHeight.Int32),
+		)
+	}
+	if row.ExecuteAtTime.Valid {
+		send.ExecuteAtTime = fn.Some(row.ExecuteAtTime.Time.UTC())
+	}
+
+	if len(row.AnchorTxid) > 0 {
+		anchorTxHash, err := chainhash.NewHash(row.AnchorTxid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor txid of "+
+				"scheduled send %d: %w", row.ID, err)
+		}
+		send.AnchorTxHash = fn.Some(*anchorTxHash)
+	}
+
+	send.Recipients = make(
+		[]tapfreighter.ScheduledSendRecipient, 0, len(recipientRows),
+	)
+	for _, recipient := range recipientRows {
+		send.Recipients = append(
+			send.Recipients, tapfreighter.ScheduledSendRecipient{
+				TapAddr: recipient.TapAddr,
+				Amount:  uint64(recipient.Amount),
+			},
+		)
+	}
+
+	return send, nil
+}
@@ -0,0 +1,172 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// newSendScheduleStore creates a new instance of SendScheduleStore for
+// testing.
+func newSendScheduleStore(t *testing.T) *SendScheduleStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) ScheduledSendQueries {
+		return db.WithTx(tx)
+	}
+
+	sendTx := NewTransactionExecutor(db, txCreator)
+	return NewSendScheduleStore(sendTx)
+}
+
+// TestScheduledSendStore tests that scheduled sends can be stored, fetched and
+// moved between states.
+func TestScheduledSendStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newSendScheduleStore(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	heightSend := &tapfreighter.ScheduledSend{
+		Label: "payroll",
+		Recipients: []tapfreighter.ScheduledSendRecipient{
+			{TapAddr: "addr1"},
+			{TapAddr: "addr2", Amount: 1234},
+		},
+		FeeRate:                   chainfee.SatPerKWeight(2500),
+		SkipProofCourierPingCheck: true,
+		ExecuteAtHeight:           fn.Some(uint32(800_000)),
+		State:                     tapfreighter.ScheduledSendPending,
+		CreationTime:              now,
+		UpdateTime:                now,
+	}
+	timeSend := &tapfreighter.ScheduledSend{
+		Recipients: []tapfreighter.ScheduledSendRecipient{
+			{TapAddr: "addr3"},
+		},
+		ExecuteAtTime: fn.Some(now.Add(time.Hour)),
+		State:         tapfreighter.ScheduledSendPending,
+		CreationTime:  now,
+		UpdateTime:    now,
+	}
+
+	var err error
+	heightSend.ID, err = store.InsertScheduledSend(ctx, heightSend)
+	require.NoError(t, err)
+	timeSend.ID, err = store.InsertScheduledSend(ctx, timeSend)
+	require.NoError(t, err)
+
+	sends, err := store.FetchScheduledSends(
+		ctx, fn.None[tapfreighter.ScheduledSendState](),
+	)
+	require.NoError(t, err)
+	require.Len(t, sends, 2)
+
+	require.Equal(t, heightSend, sends[0])
+	require.Equal(t, timeSend.Recipients, sends[1].Recipients)
+	require.Equal(t, timeSend.ExecuteAtTime, sends[1].ExecuteAtTime)
+	require.True(t, sends[1].ExecuteAtHeight.IsNone())
+
+	// Cancelling the first send moves it out of the pending state, so it
+	// can't be executed anymore.
+	err = store.UpdateScheduledSendState(
+		ctx, heightSend.ID, tapfreighter.ScheduledSendPending,
+		tapfreighter.ScheduledSendCancelled, fn.None[chainhash.Hash](),
+		"",
+	)
+	require.NoError(t, err)
+
+	err = store.UpdateScheduledSendState(
+		ctx, heightSend.ID, tapfreighter.ScheduledSendPending,
+		tapfreighter.ScheduledSendExecuting, fn.None[chainhash.Hash](),
+		"",
+	)
+	require.ErrorIs(t, err, tapfreighter.ErrScheduledSendNotPending)
+
+	// Execute the second send and make sure the anchor transaction hash
+	// is stored.
+	anchorTxHash := chainhash.Hash(test.RandBytes(32))
+	err = store.UpdateScheduledSendState(
+		ctx, timeSend.ID, tapfreighter.ScheduledSendPending,
+		tapfreighter.ScheduledSendExecuting, fn.None[chainhash.Hash](),
+		"",
+	)
+	require.NoError(t, err)
+	err = store.UpdateScheduledSendState(
+		ctx, timeSend.ID, tapfreighter.ScheduledSendExecuting,
+		tapfreighter.ScheduledSendExecuted, fn.Some(anchorTxHash), "",
+	)
+	require.NoError(t, err)
+
+	pending, err := store.FetchScheduledSends(
+		ctx, fn.Some(tapfreighter.ScheduledSendPending),
+	)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	executed, err := store.FetchScheduledSends(
+		ctx, fn.Some(tapfreighter.ScheduledSendExecuted),
+	)
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	require.Equal(t, timeSend.ID, executed[0].ID)
+	require.Equal(t, fn.Some(anchorTxHash), executed[0].AnchorTxHash)
+
+	cancelled, err := store.FetchScheduledSends(
+		ctx, fn.Some(tapfreighter.ScheduledSendCancelled),
+	)
+	require.NoError(t, err)
+	require.Len(t, cancelled, 1)
+	require.Equal(t, heightSend.ID, cancelled[0].ID)
+
+	// A failed execution attempt that is retried moves the send back to
+	// the pending state and is counted.
+	retrySend := &tapfreighter.ScheduledSend{
+		Recipients: []tapfreighter.ScheduledSendRecipient{
+			{TapAddr: "addr4"},
+		},
+		ExecuteAtHeight: fn.Some(uint32(800_000)),
+		State:           tapfreighter.ScheduledSendPending,
+		CreationTime:    now,
+		UpdateTime:      now,
+	}
+	retrySend.ID, err = store.InsertScheduledSend(ctx, retrySend)
+	require.NoError(t, err)
+
+	err = store.RetryScheduledSend(ctx, retrySend.ID, "not executing")
+	require.ErrorIs(t, err, tapfreighter.ErrScheduledSendNotPending)
+
+	for i := 1; i <= 2; i++ {
+		err = store.UpdateScheduledSendState(
+			ctx, retrySend.ID, tapfreighter.ScheduledSendPending,
+			tapfreighter.ScheduledSendExecuting,
+			fn.None[chainhash.Hash](), "",
+		)
+		require.NoError(t, err)
+
+		err = store.RetryScheduledSend(
+			ctx, retrySend.ID, "insufficient balance",
+		)
+		require.NoError(t, err)
+
+		pending, err = store.FetchScheduledSends(
+			ctx, fn.Some(tapfreighter.ScheduledSendPending),
+		)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, retrySend.ID, pending[0].ID)
+		require.EqualValues(t, i, pending[0].NumAttempts)
+		require.Equal(
+			t, "insufficient balance", pending[0].FailureReason,
+		)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: channel_balance_history.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const DeleteChannelBalanceHtlcs = `-- name: DeleteChannelBalanceHtlcs :exec
+DELETE FROM channel_balance_htlcs
+WHERE snapshot_id = $1
+`
+
+func (q *Queries) DeleteChannelBalanceHtlcs(ctx context.Context, snapshotID int64) error {
+	_, err := q.db.ExecContext(ctx, DeleteChannelBalanceHtlcs, snapshotID)
+	return err
+}
+
+const FetchChannelBalanceHtlcs = `-- name: FetchChannelBalanceHtlcs :many
+SELECT id, snapshot_id, htlc_index, incoming, asset_amount, rfq_id, rate_coefficient, rate_scale
+FROM channel_balance_htlcs
+WHERE snapshot_id = $1
+ORDER BY id
+`
+
+func (q *Queries) FetchChannelBalanceHtlcs(ctx context.Context, snapshotID int64) ([]ChannelBalanceHtlc, error) {
+	rows, err := q.db.QueryContext(ctx, FetchChannelBalanceHtlcs, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelBalanceHtlc
+	for rows.Next() {
+		var i ChannelBalanceHtlc
+		if err := rows.Scan(
+			&i.ID,
+			&i.SnapshotID,
+			&i.HtlcIndex,
+			&i.Incoming,
+			&i.AssetAmount,
+			&i.RfqID,
+			&i.RateCoefficient,
+			&i.RateScale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const FetchChannelBalanceSnapshots = `-- name: FetchChannelBalanceSnapshots :many
+SELECT id, chan_point, commit_height, local_balance, remote_balance, recorded_at
+FROM channel_balance_snapshots
+WHERE (chan_point = $1 OR
+      $1 IS NULL)
+    AND (recorded_at >= $2 OR
+      $2 IS NULL)
+    AND (recorded_at <= $3 OR
+      $3 IS NULL)
+ORDER BY recorded_at, id
+`
+
+type FetchChannelBalanceSnapshotsParams struct {
+	ChanPoint []byte
+	StartTime sql.NullTime
+	EndTime   sql.NullTime
+}
+
+func (q *Queries) FetchChannelBalanceSnapshots(ctx context.Context, arg FetchChannelBalanceSnapshotsParams) ([]ChannelBalanceSnapshot, error) {
+	rows, err := q.db.QueryContext(ctx, FetchChannelBalanceSnapshots, arg.ChanPoint, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelBalanceSnapshot
+	for rows.Next() {
+		var i ChannelBalanceSnapshot
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChanPoint,
+			&i.CommitHeight,
+			&i.LocalBalance,
+			&i.RemoteBalance,
+			&i.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const InsertChannelBalanceHtlc = `-- name: InsertChannelBalanceHtlc :exec
+INSERT INTO channel_balance_htlcs (
+    snapshot_id, htlc_index, incoming, asset_amount, rfq_id,
+    rate_coefficient, rate_scale
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type InsertChannelBalanceHtlcParams struct {
+	SnapshotID      int64
+	HtlcIndex       int64
+	Incoming        bool
+	AssetAmount     int64
+	RfqID           []byte
+	RateCoefficient []byte
+	RateScale       sql.NullInt16
+}
+
+func (q *Queries) InsertChannelBalanceHtlc(ctx context.Context, arg InsertChannelBalanceHtlcParams) error {
+	_, err := q.db.ExecContext(ctx, InsertChannelBalanceHtlc,
+		arg.SnapshotID,
+		arg.HtlcIndex,
+		arg.Incoming,
+		arg.AssetAmount,
+		arg.RfqID,
+		arg.RateCoefficient,
+		arg.RateScale,
+	)
+	return err
+}
+
+const UpsertChannelBalanceSnapshot = `-- name: UpsertChannelBalanceSnapshot :one
+INSERT INTO channel_balance_snapshots (
+    chan_point, commit_height, local_balance, remote_balance, recorded_at
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (chan_point, commit_height)
+    DO UPDATE SET local_balance = EXCLUDED.local_balance,
+        remote_balance = EXCLUDED.remote_balance,
+        recorded_at = EXCLUDED.recorded_at
+RETURNING id
+`
+
+type UpsertChannelBalanceSnapshotParams struct {
+	ChanPoint     []byte
+	CommitHeight  int64
+	LocalBalance  int64
+	RemoteBalance int64
+	RecordedAt    time.Time
+}
+
+func (q *Queries) UpsertChannelBalanceSnapshot(ctx context.Context, arg UpsertChannelBalanceSnapshotParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, UpsertChannelBalanceSnapshot,
+		arg.ChanPoint,
+		arg.CommitHeight,
+		arg.LocalBalance,
+		arg.RemoteBalance,
+		arg.RecordedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
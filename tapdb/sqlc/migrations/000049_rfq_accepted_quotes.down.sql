@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS rfq_accepted_quotes_expiry_idx;
+DROP INDEX IF EXISTS rfq_accepted_quotes_quote_id_idx;
+DROP TABLE IF EXISTS rfq_accepted_quotes;
@@ -0,0 +1,67 @@
+-- rfq_accepted_quotes stores RFQ quotes that were accepted either by our node
+-- (in response to a peer's request) or by a peer (in response to one of our
+-- requests). Quotes are persisted so that in-flight asset invoices and
+-- forwards survive a restart of the daemon.
+CREATE TABLE IF NOT EXISTS rfq_accepted_quotes (
+    id INTEGER PRIMARY KEY,
+
+    -- The ID of the quote request that was accepted. The SCID alias of the
+    -- quote is derived from this ID.
+    quote_id BLOB NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The public key of the peer we negotiated the quote with.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- Indicates whether the quote is a buy quote (true) or a sell quote
+    -- (false), from the point of view of the requesting node.
+    is_buy BOOLEAN NOT NULL,
+
+    -- Indicates whether our node accepted the quote (true) or our peer did
+    -- (false). Quotes accepted by our node back the HTLC policies of the
+    -- order handler.
+    local_accept BOOLEAN NOT NULL,
+
+    -- The version of the request message data.
+    request_version SMALLINT NOT NULL,
+
+    -- The version of the accept message data.
+    accept_version SMALLINT NOT NULL,
+
+    -- The ID of the asset the quote was requested for. Either the asset ID
+    -- or the group key must be set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The group key of the asset the quote was requested for.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The maximum amount of the request. For buy quotes this is the maximum
+    -- asset amount, for sell quotes it is the maximum payment amount in
+    -- milli-satoshi.
+    max_amount BIGINT NOT NULL,
+
+    -- The optional asset rate hint sent with the request, expressed as a
+    -- fixed-point coefficient (big-endian unsigned integer) and scale,
+    -- together with its expiry as a unix timestamp.
+    rate_hint_coefficient BLOB,
+    rate_hint_scale SMALLINT,
+    rate_hint_expiry BIGINT,
+
+    -- The optional price oracle metadata sent with the request.
+    price_oracle_metadata TEXT,
+
+    -- The accepted asset rate, expressed as a fixed-point coefficient
+    -- (big-endian unsigned integer) and scale.
+    rate_coefficient BLOB NOT NULL,
+    rate_scale SMALLINT NOT NULL,
+
+    -- The unix timestamp in seconds at which the accepted quote expires.
+    expiry BIGINT NOT NULL
+);
+
+-- A quote can only be accepted once per side.
+CREATE UNIQUE INDEX IF NOT EXISTS rfq_accepted_quotes_quote_id_idx
+    ON rfq_accepted_quotes (quote_id, local_accept);
+
+-- Expired quotes are fetched and pruned by their expiry.
+CREATE INDEX IF NOT EXISTS rfq_accepted_quotes_expiry_idx
+    ON rfq_accepted_quotes (expiry);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS scheduled_send_recipients_send_id_idx;
+DROP TABLE IF EXISTS scheduled_send_recipients;
+DROP INDEX IF EXISTS scheduled_sends_state_idx;
+DROP TABLE IF EXISTS scheduled_sends;
@@ -0,0 +1,63 @@
+-- scheduled_sends stores asset sends that are only funded, signed and
+-- broadcast once a given block height or wall-clock time is reached.
+CREATE TABLE IF NOT EXISTS scheduled_sends (
+    id INTEGER PRIMARY KEY,
+
+    -- The label of the transfer that is created when the send is executed.
+    label TEXT NOT NULL,
+
+    -- The fee rate in sat/kw to use for the anchor transaction. If zero, the
+    -- fee rate is estimated when the send is executed.
+    fee_rate BIGINT NOT NULL,
+
+    -- Indicates whether the proof courier ping check should be skipped when
+    -- the send is executed.
+    skip_proof_courier_ping_check BOOLEAN NOT NULL,
+
+    -- The block height at or after which the send is executed. At least one
+    -- of execute_at_height and execute_at_time must be set. If both are set,
+    -- the send is executed once both conditions are met.
+    execute_at_height INTEGER,
+
+    -- The wall-clock time at or after which the send is executed.
+    execute_at_time TIMESTAMP,
+
+    -- The state of the scheduled send: 0 = pending, 1 = executing,
+    -- 2 = executed, 3 = failed, 4 = cancelled.
+    state SMALLINT NOT NULL,
+
+    -- The ID of the anchor transaction of the transfer, once executed.
+    anchor_txid BLOB CHECK(length(anchor_txid) = 32),
+
+    -- The reason the send failed, if it did.
+    failure_reason TEXT,
+
+    -- The time the send was scheduled.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- The time the state of the send was last updated.
+    update_time TIMESTAMP NOT NULL
+);
+
+-- Pending sends are fetched by their state.
+CREATE INDEX IF NOT EXISTS scheduled_sends_state_idx
+    ON scheduled_sends (state);
+
+-- scheduled_send_recipients stores the addresses a scheduled send pays to.
+CREATE TABLE IF NOT EXISTS scheduled_send_recipients (
+    id INTEGER PRIMARY KEY,
+
+    -- The scheduled send this recipient belongs to.
+    scheduled_send_id BIGINT NOT NULL REFERENCES scheduled_sends(id)
+        ON DELETE CASCADE,
+
+    -- The encoded Taproot Asset address of the recipient.
+    tap_addr TEXT NOT NULL,
+
+    -- The amount to send to addresses that don't specify an amount. Zero if
+    -- the amount of the address is used.
+    amount BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS scheduled_send_recipients_send_id_idx
+    ON scheduled_send_recipients (scheduled_send_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS mint_schedules_state_idx;
+DROP TABLE IF EXISTS mint_schedules;
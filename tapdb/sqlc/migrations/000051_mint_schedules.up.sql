@@ -0,0 +1,51 @@
+-- mint_schedules stores recurring issuance schedules that mint a new tranche
+-- of an existing asset group at a fixed cadence, until the maximum total
+-- supply of the group is reached.
+CREATE TABLE IF NOT EXISTS mint_schedules (
+    id INTEGER PRIMARY KEY,
+
+    -- The tweaked group key of the asset group to mint new tranches of.
+    group_key BLOB NOT NULL CHECK(length(group_key) = 33),
+
+    -- The asset version of the minted tranches.
+    asset_version SMALLINT NOT NULL,
+
+    -- The amount to mint in each tranche.
+    amount BIGINT NOT NULL,
+
+    -- The type of the metadata of each tranche.
+    meta_type SMALLINT NOT NULL,
+
+    -- The metadata template of each tranche. The placeholder {{tranche}} is
+    -- replaced with the number of the tranche.
+    meta_data_template BLOB,
+
+    -- The interval between two tranches in seconds.
+    cadence_seconds BIGINT NOT NULL,
+
+    -- The maximum total supply of the asset group. No tranche is minted that
+    -- would increase the issued supply above this amount.
+    max_total_supply BIGINT NOT NULL,
+
+    -- The time at or after which the next tranche is minted.
+    next_mint_time TIMESTAMP NOT NULL,
+
+    -- The number of tranches minted by this schedule.
+    num_tranches INTEGER NOT NULL,
+
+    -- The total amount minted by this schedule.
+    total_minted BIGINT NOT NULL,
+
+    -- The state of the schedule: 0 = active, 1 = completed, 2 = cancelled.
+    state SMALLINT NOT NULL,
+
+    -- The error of the last failed mint attempt, if any.
+    last_error TEXT,
+
+    -- The time the schedule was created.
+    creation_time TIMESTAMP NOT NULL
+);
+
+-- Active schedules are fetched by their state.
+CREATE INDEX IF NOT EXISTS mint_schedules_state_idx
+    ON mint_schedules (state);
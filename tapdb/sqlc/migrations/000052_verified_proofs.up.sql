@@ -0,0 +1,23 @@
+-- verified_proofs caches the proofs that were already fully verified. A proof
+-- is identified by its chained hash within its proof file, which commits to
+-- the proof and all its ancestors. A proof file therefore only needs to be
+-- verified from the deepest cached proof onwards.
+CREATE TABLE IF NOT EXISTS verified_proofs (
+    -- The chained hash of the proof, SHA256(prev_hash || proof).
+    proof_hash BLOB NOT NULL CHECK(length(proof_hash) = 32),
+
+    -- The hash of the block the proof is anchored in.
+    block_hash BLOB NOT NULL CHECK(length(block_hash) = 32),
+
+    -- The height of the block the proof is anchored in.
+    block_height INTEGER NOT NULL,
+
+    -- The time the proof was verified.
+    verified_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (proof_hash, block_hash)
+);
+
+-- Entries are invalidated by the height of their anchor block on re-orgs.
+CREATE INDEX IF NOT EXISTS verified_proofs_block_height_idx
+    ON verified_proofs (block_height);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS universe_leaves_asset_genesis_id_idx;
+DROP INDEX IF EXISTS universe_leaves_script_key_idx;
+DROP INDEX IF EXISTS chain_txns_block_height_idx;
+DROP INDEX IF EXISTS assets_meta_type_idx;
+DROP INDEX IF EXISTS genesis_assets_asset_tag_idx;
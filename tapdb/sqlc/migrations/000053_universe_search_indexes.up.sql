@@ -0,0 +1,17 @@
+-- These indexes speed up searching the assets and leaves known to the
+-- universe by their name, meta type, issuance height, script key and the
+-- universe leaves of a given asset.
+CREATE INDEX IF NOT EXISTS genesis_assets_asset_tag_idx
+    ON genesis_assets (asset_tag);
+
+CREATE INDEX IF NOT EXISTS assets_meta_type_idx
+    ON assets_meta (meta_data_type);
+
+CREATE INDEX IF NOT EXISTS chain_txns_block_height_idx
+    ON chain_txns (block_height);
+
+CREATE INDEX IF NOT EXISTS universe_leaves_script_key_idx
+    ON universe_leaves (script_key_bytes);
+
+CREATE INDEX IF NOT EXISTS universe_leaves_asset_genesis_id_idx
+    ON universe_leaves (asset_genesis_id);
@@ -0,0 +1,22 @@
+-- universe_write_quotas is the write allowlist of the universe server. Each
+-- entry allows an issuer to insert proofs up to its proof-count and byte
+-- limits. Issuers are identified by their group key for grouped assets, or by
+-- the script key of the asset for non-grouped assets.
+CREATE TABLE IF NOT EXISTS universe_write_quotas (
+    -- The compressed public key the issuer authenticates inserts with.
+    issuer_key BLOB PRIMARY KEY CHECK(length(issuer_key) = 33),
+
+    -- The maximum number of proofs the issuer may insert. Zero means
+    -- unlimited.
+    max_proofs BIGINT NOT NULL,
+
+    -- The maximum number of raw proof bytes the issuer may insert. Zero
+    -- means unlimited.
+    max_bytes BIGINT NOT NULL,
+
+    -- The number of proofs the issuer inserted so far.
+    used_proofs BIGINT NOT NULL DEFAULT 0,
+
+    -- The number of raw proof bytes the issuer inserted so far.
+    used_bytes BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS channel_balance_htlcs_snapshot_id_idx;
+DROP TABLE IF EXISTS channel_balance_htlcs;
+DROP INDEX IF EXISTS channel_balance_snapshots_recorded_at_idx;
+DROP INDEX IF EXISTS channel_balance_snapshots_commit_idx;
+DROP TABLE IF EXISTS channel_balance_snapshots;
@@ -0,0 +1,61 @@
+-- channel_balance_snapshots stores the asset balance of our asset channels
+-- after each update of our local commitment. The snapshots form the balance
+-- history of a channel that is used for accounting.
+CREATE TABLE IF NOT EXISTS channel_balance_snapshots (
+    id INTEGER PRIMARY KEY,
+
+    -- The funding outpoint of the channel, encoded in Bitcoin wire format.
+    chan_point BLOB NOT NULL,
+
+    -- The height of our local commitment the snapshot was taken at.
+    commit_height BIGINT NOT NULL,
+
+    -- Our asset balance in the channel, excluding pending HTLCs.
+    local_balance BIGINT NOT NULL,
+
+    -- The asset balance of our peer, excluding pending HTLCs.
+    remote_balance BIGINT NOT NULL,
+
+    -- The time the snapshot was recorded.
+    recorded_at TIMESTAMP NOT NULL
+);
+
+-- There is only a single snapshot per commitment of a channel.
+CREATE UNIQUE INDEX IF NOT EXISTS channel_balance_snapshots_commit_idx
+    ON channel_balance_snapshots (chan_point, commit_height);
+
+-- Snapshots are queried by time range.
+CREATE INDEX IF NOT EXISTS channel_balance_snapshots_recorded_at_idx
+    ON channel_balance_snapshots (recorded_at);
+
+-- channel_balance_htlcs stores the asset HTLCs that were settled in the
+-- commitment update of a balance snapshot.
+CREATE TABLE IF NOT EXISTS channel_balance_htlcs (
+    id INTEGER PRIMARY KEY,
+
+    -- The snapshot of the commitment update the HTLC was settled in.
+    snapshot_id BIGINT NOT NULL REFERENCES channel_balance_snapshots(id)
+        ON DELETE CASCADE,
+
+    -- The index of the HTLC in the update log of the party that offered it.
+    htlc_index BIGINT NOT NULL,
+
+    -- Indicates whether the HTLC was offered by our peer (true) or by us
+    -- (false).
+    incoming BOOLEAN NOT NULL,
+
+    -- The number of asset units the HTLC carried.
+    asset_amount BIGINT NOT NULL,
+
+    -- The ID of the RFQ quote the HTLC was sent under, if any.
+    rfq_id BLOB CHECK(length(rfq_id) = 32),
+
+    -- The asset rate of the RFQ quote, expressed as a fixed-point
+    -- coefficient (big-endian unsigned integer) and scale, if it was known
+    -- when the snapshot was recorded.
+    rate_coefficient BLOB,
+    rate_scale SMALLINT
+);
+
+CREATE INDEX IF NOT EXISTS channel_balance_htlcs_snapshot_id_idx
+    ON channel_balance_htlcs (snapshot_id);
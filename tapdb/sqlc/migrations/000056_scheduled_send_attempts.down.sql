@@ -0,0 +1 @@
+ALTER TABLE scheduled_sends DROP COLUMN num_attempts;
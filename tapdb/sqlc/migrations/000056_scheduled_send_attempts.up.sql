@@ -0,0 +1,4 @@
+-- The number of times the execution of a scheduled send failed with an error
+-- that allowed it to be retried on the next trigger.
+ALTER TABLE scheduled_sends
+    ADD COLUMN num_attempts INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS genesis_assets_asset_tag_lower_idx;
+
+CREATE INDEX IF NOT EXISTS genesis_assets_asset_tag_idx
+    ON genesis_assets (asset_tag);
@@ -0,0 +1,9 @@
+-- Asset names are searched case insensitively by their lower cased prefix, so
+-- the index on the plain asset name is replaced with one on the lower cased
+-- name. Postgres uses the text_pattern_ops operator class for this index, so
+-- it can be used for prefix LIKE queries independent of the collation of the
+-- database.
+DROP INDEX IF EXISTS genesis_assets_asset_tag_idx;
+
+CREATE INDEX IF NOT EXISTS genesis_assets_asset_tag_lower_idx
+    ON genesis_assets (lower(asset_tag));
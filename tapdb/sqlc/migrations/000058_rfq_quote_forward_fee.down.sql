@@ -0,0 +1,3 @@
+ALTER TABLE rfq_accepted_quotes DROP COLUMN forward_fee_rate_ppm;
+
+ALTER TABLE rfq_accepted_quotes DROP COLUMN forward_fee_base;
@@ -0,0 +1,7 @@
+-- The optional forwarding fee in asset units that was advertised in the accept
+-- message of the quote. The fee consists of a base fee per HTLC and a
+-- proportional fee rate in parts per million of the asset amount. Both are
+-- NULL if no fee was advertised.
+ALTER TABLE rfq_accepted_quotes ADD COLUMN forward_fee_base BIGINT;
+
+ALTER TABLE rfq_accepted_quotes ADD COLUMN forward_fee_rate_ppm BIGINT;
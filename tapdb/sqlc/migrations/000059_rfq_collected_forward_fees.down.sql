@@ -0,0 +1 @@
+DROP TABLE IF EXISTS rfq_collected_forward_fees;
@@ -0,0 +1,19 @@
+-- rfq_collected_forward_fees stores the forwarding fees in asset units that
+-- our node collected for settled asset HTLCs, summed up per asset. The totals
+-- are persisted so they survive a restart of the daemon.
+CREATE TABLE IF NOT EXISTS rfq_collected_forward_fees (
+    id INTEGER PRIMARY KEY,
+
+    -- The asset specifier of the quotes the fees were collected under. This
+    -- is the 32-byte asset ID, the 33-byte compressed group key, or the asset
+    -- ID followed by the group key if the specifier has both.
+    asset_specifier BLOB NOT NULL UNIQUE CHECK(
+        length(asset_specifier) IN (32, 33, 65)
+    ),
+
+    -- The number of settled HTLCs a fee was collected for.
+    num_htlcs BIGINT NOT NULL,
+
+    -- The sum of the collected fees in asset units.
+    total_fees BIGINT NOT NULL
+);
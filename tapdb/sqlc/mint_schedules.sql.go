@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: mint_schedules.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const FetchMintSchedules = `-- name: FetchMintSchedules :many
+SELECT id, group_key, asset_version, amount, meta_type, meta_data_template, cadence_seconds, max_total_supply, next_mint_time, num_tranches, total_minted, state, last_error, creation_time
+FROM mint_schedules
+WHERE (state = $1 OR $1 IS NULL)
+ORDER BY id
+`
+
+func (q *Queries) FetchMintSchedules(ctx context.Context, state sql.NullInt16) ([]MintSchedule, error) {
+	rows, err := q.db.QueryContext(ctx, FetchMintSchedules, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MintSchedule
+	for rows.Next() {
+		var i MintSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.GroupKey,
+			&i.AssetVersion,
+			&i.Amount,
+			&i.MetaType,
+			&i.MetaDataTemplate,
+			&i.CadenceSeconds,
+			&i.MaxTotalSupply,
+			&i.NextMintTime,
+			&i.NumTranches,
+			&i.TotalMinted,
+			&i.State,
+			&i.LastError,
+			&i.CreationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const InsertMintSchedule = `-- name: InsertMintSchedule :one
+INSERT INTO mint_schedules (
+    group_key, asset_version, amount, meta_type, meta_data_template,
+    cadence_seconds, max_total_supply, next_mint_time, num_tranches,
+    total_minted, state, last_error, creation_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+)
+RETURNING id
+`
+
+type InsertMintScheduleParams struct {
+	GroupKey         []byte
+	AssetVersion     int16
+	Amount           int64
+	MetaType         int16
+	MetaDataTemplate []byte
+	CadenceSeconds   int64
+	MaxTotalSupply   int64
+	NextMintTime     time.Time
+	NumTranches      int32
+	TotalMinted      int64
+	State            int16
+	LastError        sql.NullString
+	CreationTime     time.Time
+}
+
+func (q *Queries) InsertMintSchedule(ctx context.Context, arg InsertMintScheduleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, InsertMintSchedule,
+		arg.GroupKey,
+		arg.AssetVersion,
+		arg.Amount,
+		arg.MetaType,
+		arg.MetaDataTemplate,
+		arg.CadenceSeconds,
+		arg.MaxTotalSupply,
+		arg.NextMintTime,
+		arg.NumTranches,
+		arg.TotalMinted,
+		arg.State,
+		arg.LastError,
+		arg.CreationTime,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const UpdateMintSchedule = `-- name: UpdateMintSchedule :execrows
+UPDATE mint_schedules
+SET next_mint_time = $1,
+    num_tranches = $2,
+    total_minted = $3,
+    state = $4,
+    last_error = $5
+WHERE id = $6
+`
+
+type UpdateMintScheduleParams struct {
+	NextMintTime time.Time
+	NumTranches  int32
+	TotalMinted  int64
+	State        int16
+	LastError    sql.NullString
+	ID           int64
+}
+
+func (q *Queries) UpdateMintSchedule(ctx context.Context, arg UpdateMintScheduleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, UpdateMintSchedule,
+		arg.NextMintTime,
+		arg.NumTranches,
+		arg.TotalMinted,
+		arg.State,
+		arg.LastError,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -210,6 +210,26 @@ type ChainTxn struct {
 	TxIndex     sql.NullInt32
 }
 
+type ChannelBalanceHtlc struct {
+	ID              int64
+	SnapshotID      int64
+	HtlcIndex       int64
+	Incoming        bool
+	AssetAmount     int64
+	RfqID           []byte
+	RateCoefficient []byte
+	RateScale       sql.NullInt16
+}
+
+type ChannelBalanceSnapshot struct {
+	ID            int64
+	ChanPoint     []byte
+	CommitHeight  int64
+	LocalBalance  int64
+	RemoteBalance int64
+	RecordedAt    time.Time
+}
+
 type FederationGlobalSyncConfig struct {
 	ProofType       string
 	AllowSyncInsert bool
@@ -305,6 +325,23 @@ type ManagedUtxo struct {
 	SweptTxnID       sql.NullInt64
 }
 
+type MintSchedule struct {
+	ID               int64
+	GroupKey         []byte
+	AssetVersion     int16
+	Amount           int64
+	MetaType         int16
+	MetaDataTemplate []byte
+	CadenceSeconds   int64
+	MaxTotalSupply   int64
+	NextMintTime     time.Time
+	NumTranches      int32
+	TotalMinted      int64
+	State            int16
+	LastError        sql.NullString
+	CreationTime     time.Time
+}
+
 type MintSupplyPreCommit struct {
 	ID                   int64
 	BatchID              int32
@@ -366,6 +403,57 @@ type ProofType struct {
 	ProofType string
 }
 
+type RfqAcceptedQuote struct {
+	ID                  int64
+	QuoteID             []byte
+	Peer                []byte
+	IsBuy               bool
+	LocalAccept         bool
+	RequestVersion      int16
+	AcceptVersion       int16
+	AssetID             []byte
+	GroupKey            []byte
+	MaxAmount           int64
+	RateHintCoefficient []byte
+	RateHintScale       sql.NullInt16
+	RateHintExpiry      sql.NullInt64
+	PriceOracleMetadata sql.NullString
+	RateCoefficient     []byte
+	RateScale           int16
+	Expiry              int64
+	ForwardFeeBase      sql.NullInt64
+	ForwardFeeRatePpm   sql.NullInt64
+}
+
+type RfqCollectedForwardFee struct {
+	ID             int64
+	AssetSpecifier []byte
+	NumHtlcs       int64
+	TotalFees      int64
+}
+
+type ScheduledSend struct {
+	ID                        int64
+	Label                     string
+	FeeRate                   int64
+	SkipProofCourierPingCheck bool
+	ExecuteAtHeight           sql.NullInt32
+	ExecuteAtTime             sql.NullTime
+	State                     int16
+	AnchorTxid                []byte
+	FailureReason             sql.NullString
+	CreationTime              time.Time
+	UpdateTime                time.Time
+	NumAttempts               int32
+}
+
+type ScheduledSendRecipient struct {
+	ID              int64
+	ScheduledSendID int64
+	TapAddr         string
+	Amount          int64
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int64
 	InternalKeyID    int64
@@ -524,3 +612,18 @@ type UniverseSupplyRoot struct {
 	NamespaceRoot string
 	GroupKey      []byte
 }
+
+type UniverseWriteQuota struct {
+	IssuerKey  []byte
+	MaxProofs  int64
+	MaxBytes   int64
+	UsedProofs int64
+	UsedBytes  int64
+}
+
+type VerifiedProof struct {
+	ProofHash   []byte
+	BlockHash   []byte
+	BlockHeight int32
+	VerifiedAt  time.Time
+}
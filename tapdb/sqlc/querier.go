@@ -11,6 +11,7 @@ import (
 )
 
 type Querier interface {
+	AddRfqCollectedForwardFee(ctx context.Context, arg AddRfqCollectedForwardFeeParams) error
 	AllAssets(ctx context.Context) ([]Asset, error)
 	AllInternalKeys(ctx context.Context) ([]InternalKey, error)
 	AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow, error)
@@ -25,9 +26,12 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) (int64, error)
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	ConsumeUniverseWriteQuota(ctx context.Context, arg ConsumeUniverseWriteQuotaParams) (int64, error)
 	CountAuthMailboxMessages(ctx context.Context) (int64, error)
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
+	DeleteChannelBalanceHtlcs(ctx context.Context, snapshotID int64) error
+	DeleteExpiredRfqAcceptedQuotes(ctx context.Context, now int64) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 	DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFederationProofSyncLogParams) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
@@ -47,6 +51,8 @@ type Querier interface {
 	DeleteUniverseSupplyLeaf(ctx context.Context, arg DeleteUniverseSupplyLeafParams) error
 	DeleteUniverseSupplyLeaves(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseSupplyRoot(ctx context.Context, namespaceRoot string) error
+	DeleteUniverseWriteQuota(ctx context.Context, issuerKey []byte) (int64, error)
+	DeleteVerifiedProofsFromHeight(ctx context.Context, blockHeight int32) (int64, error)
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrEventByAddrKeyAndOutpoint(ctx context.Context, arg FetchAddrEventByAddrKeyAndOutpointParams) (FetchAddrEventByAddrKeyAndOutpointRow, error)
 	FetchAddrEventOutputs(ctx context.Context, addrEventID int64) ([]FetchAddrEventOutputsRow, error)
@@ -74,6 +80,8 @@ type Querier interface {
 	FetchAuthMailboxMessageByOutpoint(ctx context.Context, claimedOutpoint []byte) (FetchAuthMailboxMessageByOutpointRow, error)
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChainTxByID(ctx context.Context, txnID int64) (FetchChainTxByIDRow, error)
+	FetchChannelBalanceHtlcs(ctx context.Context, snapshotID int64) ([]ChannelBalanceHtlc, error)
+	FetchChannelBalanceSnapshots(ctx context.Context, arg FetchChannelBalanceSnapshotsParams) ([]ChannelBalanceSnapshot, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
 	FetchGenesisByAssetID(ctx context.Context, assetID []byte) (GenesisInfoView, error)
@@ -90,13 +98,17 @@ type Querier interface {
 	FetchInternalKeyLocator(ctx context.Context, rawKey []byte) (FetchInternalKeyLocatorRow, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
+	FetchMintSchedules(ctx context.Context, state sql.NullInt16) ([]MintSchedule, error)
 	// Fetch records from the supply_pre_commits table with optional
 	// filtering.
 	FetchMintSupplyPreCommits(ctx context.Context, arg FetchMintSupplyPreCommitsParams) ([]FetchMintSupplyPreCommitsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
 	FetchMultiverseRoot(ctx context.Context, namespaceRoot string) (FetchMultiverseRootRow, error)
+	FetchRfqCollectedForwardFees(ctx context.Context) ([]RfqCollectedForwardFee, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
+	FetchScheduledSendRecipients(ctx context.Context, scheduledSendID int64) ([]ScheduledSendRecipient, error)
+	FetchScheduledSends(ctx context.Context, state sql.NullInt16) ([]ScheduledSend, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
 	FetchSeedlingByID(ctx context.Context, seedlingID int64) (AssetSeedling, error)
@@ -110,9 +122,12 @@ type Querier interface {
 	FetchTapscriptTree(ctx context.Context, rootHash []byte) ([]FetchTapscriptTreeRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
+	FetchUnexpiredRfqAcceptedQuotes(ctx context.Context, now int64) ([]RfqAcceptedQuote, error)
+	FetchUniverseKeyByNodeKey(ctx context.Context, arg FetchUniverseKeyByNodeKeyParams) (FetchUniverseKeyByNodeKeyRow, error)
 	FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
 	FetchUniverseSupplyRoot(ctx context.Context, namespaceRoot string) (FetchUniverseSupplyRootRow, error)
+	FetchUniverseWriteQuota(ctx context.Context, issuerKey []byte) (UniverseWriteQuota, error)
 	FetchUnknownTypeScriptKeys(ctx context.Context) ([]FetchUnknownTypeScriptKeysRow, error)
 	// Fetch unspent supply pre-commitment outputs. Each pre-commitment output
 	// comes from a mint anchor transaction and relates to an asset issuance
@@ -123,6 +138,7 @@ type Querier interface {
 	// where a peer node acted as the issuer. Rows in this table do not relate to an
 	// issuance where the local node acted as the issuer.
 	FetchUnspentSupplyPreCommits(ctx context.Context, groupKey []byte) ([]FetchUnspentSupplyPreCommitsRow, error)
+	FetchVerifiedProofs(ctx context.Context, proofHashes [][]byte) ([]VerifiedProof, error)
 	FinalizeSupplyCommitTransition(ctx context.Context, transitionID int64) error
 	FreezePendingTransition(ctx context.Context, groupKey []byte) error
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
@@ -137,12 +153,17 @@ type Querier interface {
 	InsertAuthMailboxMessage(ctx context.Context, arg InsertAuthMailboxMessageParams) (int64, error)
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
 	InsertBurn(ctx context.Context, arg InsertBurnParams) (int64, error)
+	InsertChannelBalanceHtlc(ctx context.Context, arg InsertChannelBalanceHtlcParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
+	InsertMintSchedule(ctx context.Context, arg InsertMintScheduleParams) (int64, error)
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertRfqAcceptedQuote(ctx context.Context, arg InsertRfqAcceptedQuoteParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertScheduledSend(ctx context.Context, arg InsertScheduledSendParams) (int64, error)
+	InsertScheduledSendRecipient(ctx context.Context, arg InsertScheduledSendRecipientParams) error
 	InsertSupplyCommitTransition(ctx context.Context, arg InsertSupplyCommitTransitionParams) (int64, error)
 	InsertSupplyCommitment(ctx context.Context, arg InsertSupplyCommitmentParams) (int64, error)
 	// Inserts a new push log entry to track a successful supply commitment
@@ -152,7 +173,9 @@ type Querier interface {
 	InsertSupplyUpdateEvent(ctx context.Context, arg InsertSupplyUpdateEventParams) error
 	InsertTxProof(ctx context.Context, arg InsertTxProofParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
+	InsertVerifiedProof(ctx context.Context, arg InsertVerifiedProofParams) error
 	LinkDanglingSupplyUpdateEvents(ctx context.Context, arg LinkDanglingSupplyUpdateEventsParams) error
+	ListUniverseWriteQuotas(ctx context.Context) ([]UniverseWriteQuota, error)
 	LogProofTransferAttempt(ctx context.Context, arg LogProofTransferAttemptParams) error
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
 	MarkManagedUTXOAsSwept(ctx context.Context, arg MarkManagedUTXOAsSweptParams) error
@@ -218,13 +241,18 @@ type Querier interface {
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
 	QueryUniverseSupplyLeaves(ctx context.Context, arg QueryUniverseSupplyLeavesParams) ([]QueryUniverseSupplyLeavesRow, error)
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	RetryScheduledSend(ctx context.Context, arg RetryScheduledSendParams) (int64, error)
+	SearchUniverseAssets(ctx context.Context, arg SearchUniverseAssetsParams) ([]SearchUniverseAssetsRow, error)
+	SearchUniverseLeaves(ctx context.Context, arg SearchUniverseLeavesParams) ([]SearchUniverseLeavesRow, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
 	SetTransferOutputProofDeliveryStatus(ctx context.Context, arg SetTransferOutputProofDeliveryStatusParams) error
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error)
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateMintSchedule(ctx context.Context, arg UpdateMintScheduleParams) (int64, error)
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateScheduledSendState(ctx context.Context, arg UpdateScheduledSendStateParams) (int64, error)
 	UpdateSupplyCommitTransitionCommitment(ctx context.Context, arg UpdateSupplyCommitTransitionCommitmentParams) error
 	UpdateSupplyCommitmentChainDetails(ctx context.Context, arg UpdateSupplyCommitmentChainDetailsParams) error
 	UpdateSupplyCommitmentRoot(ctx context.Context, arg UpdateSupplyCommitmentRootParams) error
@@ -240,6 +268,7 @@ type Querier interface {
 	UpsertAssetProofByID(ctx context.Context, arg UpsertAssetProofByIDParams) error
 	UpsertAssetWitness(ctx context.Context, arg UpsertAssetWitnessParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int64, error)
+	UpsertChannelBalanceSnapshot(ctx context.Context, arg UpsertChannelBalanceSnapshotParams) (int64, error)
 	UpsertFederationGlobalSyncConfig(ctx context.Context, arg UpsertFederationGlobalSyncConfigParams) error
 	UpsertFederationProofSyncLog(ctx context.Context, arg UpsertFederationProofSyncLogParams) (int64, error)
 	UpsertFederationUniSyncConfig(ctx context.Context, arg UpsertFederationUniSyncConfigParams) error
@@ -271,6 +300,7 @@ type Querier interface {
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error)
 	UpsertUniverseSupplyLeaf(ctx context.Context, arg UpsertUniverseSupplyLeafParams) (int64, error)
 	UpsertUniverseSupplyRoot(ctx context.Context, arg UpsertUniverseSupplyRootParams) (int64, error)
+	UpsertUniverseWriteQuota(ctx context.Context, arg UpsertUniverseWriteQuotaParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,40 @@
+-- name: UpsertChannelBalanceSnapshot :one
+INSERT INTO channel_balance_snapshots (
+    chan_point, commit_height, local_balance, remote_balance, recorded_at
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (chan_point, commit_height)
+    DO UPDATE SET local_balance = EXCLUDED.local_balance,
+        remote_balance = EXCLUDED.remote_balance,
+        recorded_at = EXCLUDED.recorded_at
+RETURNING id;
+
+-- name: DeleteChannelBalanceHtlcs :exec
+DELETE FROM channel_balance_htlcs
+WHERE snapshot_id = $1;
+
+-- name: InsertChannelBalanceHtlc :exec
+INSERT INTO channel_balance_htlcs (
+    snapshot_id, htlc_index, incoming, asset_amount, rfq_id,
+    rate_coefficient, rate_scale
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: FetchChannelBalanceSnapshots :many
+SELECT *
+FROM channel_balance_snapshots
+WHERE (chan_point = sqlc.narg('chan_point') OR
+      sqlc.narg('chan_point') IS NULL)
+    AND (recorded_at >= sqlc.narg('start_time') OR
+      sqlc.narg('start_time') IS NULL)
+    AND (recorded_at <= sqlc.narg('end_time') OR
+      sqlc.narg('end_time') IS NULL)
+ORDER BY recorded_at, id;
+
+-- name: FetchChannelBalanceHtlcs :many
+SELECT *
+FROM channel_balance_htlcs
+WHERE snapshot_id = $1
+ORDER BY id;
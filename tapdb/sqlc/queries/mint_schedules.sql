@@ -0,0 +1,24 @@
+-- name: InsertMintSchedule :one
+INSERT INTO mint_schedules (
+    group_key, asset_version, amount, meta_type, meta_data_template,
+    cadence_seconds, max_total_supply, next_mint_time, num_tranches,
+    total_minted, state, last_error, creation_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+)
+RETURNING id;
+
+-- name: FetchMintSchedules :many
+SELECT *
+FROM mint_schedules
+WHERE (state = sqlc.narg('state') OR sqlc.narg('state') IS NULL)
+ORDER BY id;
+
+-- name: UpdateMintSchedule :execrows
+UPDATE mint_schedules
+SET next_mint_time = @next_mint_time,
+    num_tranches = @num_tranches,
+    total_minted = @total_minted,
+    state = @state,
+    last_error = @last_error
+WHERE id = @id;
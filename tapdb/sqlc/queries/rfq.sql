@@ -0,0 +1,40 @@
+-- name: InsertRfqAcceptedQuote :exec
+INSERT INTO rfq_accepted_quotes (
+    quote_id, peer, is_buy, local_accept, request_version, accept_version,
+    asset_id, group_key, max_amount, rate_hint_coefficient, rate_hint_scale,
+    rate_hint_expiry, price_oracle_metadata, rate_coefficient, rate_scale,
+    expiry, forward_fee_base, forward_fee_rate_ppm
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+    $17, $18
+)
+ON CONFLICT (quote_id, local_accept)
+    -- This is a NOP, quote_id and local_accept are the unique fields that
+    -- caused the conflict.
+    DO UPDATE SET quote_id = EXCLUDED.quote_id;
+
+-- name: FetchUnexpiredRfqAcceptedQuotes :many
+SELECT *
+FROM rfq_accepted_quotes
+WHERE expiry > @now
+ORDER BY id;
+
+-- name: DeleteExpiredRfqAcceptedQuotes :execrows
+DELETE FROM rfq_accepted_quotes
+WHERE expiry <= @now;
+
+-- name: AddRfqCollectedForwardFee :exec
+INSERT INTO rfq_collected_forward_fees (
+    asset_specifier, num_htlcs, total_fees
+) VALUES (
+    @asset_specifier, 1, @fee
+)
+ON CONFLICT (asset_specifier)
+    DO UPDATE SET
+        num_htlcs = rfq_collected_forward_fees.num_htlcs + 1,
+        total_fees = rfq_collected_forward_fees.total_fees + EXCLUDED.total_fees;
+
+-- name: FetchRfqCollectedForwardFees :many
+SELECT *
+FROM rfq_collected_forward_fees
+ORDER BY id;
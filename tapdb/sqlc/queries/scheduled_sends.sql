@@ -0,0 +1,43 @@
+-- name: InsertScheduledSend :one
+INSERT INTO scheduled_sends (
+    label, fee_rate, skip_proof_courier_ping_check, execute_at_height,
+    execute_at_time, state, creation_time, update_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id;
+
+-- name: InsertScheduledSendRecipient :exec
+INSERT INTO scheduled_send_recipients (
+    scheduled_send_id, tap_addr, amount
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: FetchScheduledSends :many
+SELECT *
+FROM scheduled_sends
+WHERE (state = sqlc.narg('state') OR sqlc.narg('state') IS NULL)
+ORDER BY id;
+
+-- name: FetchScheduledSendRecipients :many
+SELECT *
+FROM scheduled_send_recipients
+WHERE scheduled_send_id = $1
+ORDER BY id;
+
+-- name: UpdateScheduledSendState :execrows
+UPDATE scheduled_sends
+SET state = @new_state,
+    anchor_txid = @anchor_txid,
+    failure_reason = @failure_reason,
+    update_time = @update_time
+WHERE id = @id AND state = @old_state;
+
+-- name: RetryScheduledSend :execrows
+UPDATE scheduled_sends
+SET state = @new_state,
+    num_attempts = num_attempts + 1,
+    failure_reason = @failure_reason,
+    update_time = @update_time
+WHERE id = @id AND state = @old_state;
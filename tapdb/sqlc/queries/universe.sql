@@ -79,6 +79,12 @@ ORDER BY
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseKeyByNodeKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves AS leaves
+WHERE leaves.leaf_node_namespace = @namespace
+      AND leaves.leaf_node_key = @leaf_node_key;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -312,6 +318,69 @@ ORDER BY
              asset_info.supply END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: SearchUniverseAssets :many
+SELECT gen.gen_asset_id, gen.asset_id, gen.asset_tag AS asset_name,
+       gen.asset_type, gen.block_height AS genesis_height,
+       gen.prev_out AS genesis_prev_out, gen.output_index AS anchor_index,
+       gen.anchor_txid, meta.meta_data_type AS meta_type,
+       meta.meta_decimal_display AS decimal_display,
+       group_info.tweaked_group_key AS group_key
+FROM genesis_info_view gen
+-- We use a LEFT JOIN here as not every asset has a meta reveal.
+LEFT JOIN assets_meta meta
+    ON gen.meta_hash = meta.meta_data_hash
+-- We use a LEFT JOIN here as not every asset has a group key.
+LEFT JOIN key_group_info_view group_info
+    ON gen.gen_asset_id = group_info.gen_asset_id
+WHERE gen.gen_asset_id > @cursor AND
+      -- Only assets with an issuance proof in the universe are returned.
+      EXISTS (
+          SELECT 1
+          FROM universe_leaves leaves
+          JOIN universe_roots roots
+              ON leaves.universe_root_id = roots.id
+          WHERE leaves.asset_genesis_id = gen.gen_asset_id AND
+                roots.proof_type = 'issuance'
+      ) AND
+      -- Both sides are lower cased, as LIKE is case insensitive in SQLite
+      -- but case sensitive in Postgres.
+      (lower(gen.asset_tag) LIKE (lower(CAST(sqlc.narg('name_prefix') AS TEXT)) || '%') ESCAPE '\' OR
+       sqlc.narg('name_prefix') IS NULL) AND
+      (gen.asset_type = sqlc.narg('asset_type') OR
+       sqlc.narg('asset_type') IS NULL) AND
+      (meta.meta_data_type = sqlc.narg('meta_type') OR
+       sqlc.narg('meta_type') IS NULL) AND
+      (COALESCE(meta.meta_decimal_display, 0) = sqlc.narg('decimal_display') OR
+       sqlc.narg('decimal_display') IS NULL) AND
+      (gen.block_height >= sqlc.narg('min_height') OR
+       sqlc.narg('min_height') IS NULL) AND
+      (gen.block_height <= sqlc.narg('max_height') OR
+       sqlc.narg('max_height') IS NULL) AND
+      (group_info.tweaked_group_key = sqlc.narg('group_key') OR
+       sqlc.narg('group_key') IS NULL) AND
+      ((group_info.tweaked_group_key IS NOT NULL) = sqlc.narg('grouped') OR
+       sqlc.narg('grouped') IS NULL)
+ORDER BY gen.gen_asset_id
+LIMIT @num_limit;
+
+-- name: SearchUniverseLeaves :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       gen.asset_id, roots.group_key, roots.proof_type
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE leaves.id > @cursor AND
+      (leaves.minting_point = sqlc.narg('minting_point') OR
+       sqlc.narg('minting_point') IS NULL) AND
+      (leaves.script_key_bytes = sqlc.narg('script_key_bytes') OR
+       sqlc.narg('script_key_bytes') IS NULL) AND
+      (roots.proof_type = sqlc.narg('proof_type') OR
+       sqlc.narg('proof_type') IS NULL)
+ORDER BY leaves.id
+LIMIT @num_limit;
+
 -- name: QueryAssetStatsPerDaySqlite :many
 SELECT
     cast(strftime('%Y-%m-%d', datetime(event_timestamp, 'unixepoch')) as text) AS day,
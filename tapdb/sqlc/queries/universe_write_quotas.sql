@@ -0,0 +1,32 @@
+-- name: UpsertUniverseWriteQuota :exec
+INSERT INTO universe_write_quotas (
+    issuer_key, max_proofs, max_bytes
+) VALUES (
+    @issuer_key, @max_proofs, @max_bytes
+)
+ON CONFLICT (issuer_key)
+    -- Only the limits are updated, the usage of an existing quota is kept.
+    DO UPDATE SET max_proofs = EXCLUDED.max_proofs,
+        max_bytes = EXCLUDED.max_bytes;
+
+-- name: FetchUniverseWriteQuota :one
+SELECT *
+FROM universe_write_quotas
+WHERE issuer_key = @issuer_key;
+
+-- name: ListUniverseWriteQuotas :many
+SELECT *
+FROM universe_write_quotas
+ORDER BY issuer_key;
+
+-- name: DeleteUniverseWriteQuota :execrows
+DELETE FROM universe_write_quotas
+WHERE issuer_key = @issuer_key;
+
+-- name: ConsumeUniverseWriteQuota :execrows
+UPDATE universe_write_quotas
+SET used_proofs = used_proofs + 1,
+    used_bytes = used_bytes + @num_bytes
+WHERE issuer_key = @issuer_key
+    AND (max_proofs = 0 OR used_proofs + 1 <= max_proofs)
+    AND (max_bytes = 0 OR used_bytes + @num_bytes <= max_bytes);
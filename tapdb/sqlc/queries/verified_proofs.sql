@@ -0,0 +1,16 @@
+-- name: InsertVerifiedProof :exec
+INSERT INTO verified_proofs (
+    proof_hash, block_hash, block_height, verified_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (proof_hash, block_hash) DO NOTHING;
+
+-- name: FetchVerifiedProofs :many
+SELECT *
+FROM verified_proofs
+WHERE proof_hash IN (sqlc.slice('proof_hashes')/*SLICE:proof_hashes*/);
+
+-- name: DeleteVerifiedProofsFromHeight :execrows
+DELETE FROM verified_proofs
+WHERE block_height >= $1;
@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: scheduled_sends.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const FetchScheduledSendRecipients = `-- name: FetchScheduledSendRecipients :many
+SELECT id, scheduled_send_id, tap_addr, amount
+FROM scheduled_send_recipients
+WHERE scheduled_send_id = $1
+ORDER BY id
+`
+
+func (q *Queries) FetchScheduledSendRecipients(ctx context.Context, scheduledSendID int64) ([]ScheduledSendRecipient, error) {
+	rows, err := q.db.QueryContext(ctx, FetchScheduledSendRecipients, scheduledSendID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduledSendRecipient
+	for rows.Next() {
+		var i ScheduledSendRecipient
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduledSendID,
+			&i.TapAddr,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const FetchScheduledSends = `-- name: FetchScheduledSends :many
+SELECT id, label, fee_rate, skip_proof_courier_ping_check, execute_at_height, execute_at_time, state, anchor_txid, failure_reason, creation_time, update_time, num_attempts
+FROM scheduled_sends
+WHERE (state = $1 OR $1 IS NULL)
+ORDER BY id
+`
+
+func (q *Queries) FetchScheduledSends(ctx context.Context, state sql.NullInt16) ([]ScheduledSend, error) {
+	rows, err := q.db.QueryContext(ctx, FetchScheduledSends, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduledSend
+	for rows.Next() {
+		var i ScheduledSend
+		if err := rows.Scan(
+			&i.ID,
+			&i.Label,
+			&i.FeeRate,
+			&i.SkipProofCourierPingCheck,
+			&i.ExecuteAtHeight,
+			&i.ExecuteAtTime,
+			&i.State,
+			&i.AnchorTxid,
+			&i.FailureReason,
+			&i.CreationTime,
+			&i.UpdateTime,
+			&i.NumAttempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const InsertScheduledSend = `-- name: InsertScheduledSend :one
+INSERT INTO scheduled_sends (
+    label, fee_rate, skip_proof_courier_ping_check, execute_at_height,
+    execute_at_time, state, creation_time, update_time
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id
+`
+
+type InsertScheduledSendParams struct {
+	Label                     string
+	FeeRate                   int64
+	SkipProofCourierPingCheck bool
+	ExecuteAtHeight           sql.NullInt32
+	ExecuteAtTime             sql.NullTime
+	State                     int16
+	CreationTime              time.Time
+	UpdateTime                time.Time
+}
+
+func (q *Queries) InsertScheduledSend(ctx context.Context, arg InsertScheduledSendParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, InsertScheduledSend,
+		arg.Label,
+		arg.FeeRate,
+		arg.SkipProofCourierPingCheck,
+		arg.ExecuteAtHeight,
+		arg.ExecuteAtTime,
+		arg.State,
+		arg.CreationTime,
+		arg.UpdateTime,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const InsertScheduledSendRecipient = `-- name: InsertScheduledSendRecipient :exec
+INSERT INTO scheduled_send_recipients (
+    scheduled_send_id, tap_addr, amount
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertScheduledSendRecipientParams struct {
+	ScheduledSendID int64
+	TapAddr         string
+	Amount          int64
+}
+
+func (q *Queries) InsertScheduledSendRecipient(ctx context.Context, arg InsertScheduledSendRecipientParams) error {
+	_, err := q.db.ExecContext(ctx, InsertScheduledSendRecipient, arg.ScheduledSendID, arg.TapAddr, arg.Amount)
+	return err
+}
+
+const RetryScheduledSend = `-- name: RetryScheduledSend :execrows
+UPDATE scheduled_sends
+SET state = $1,
+    num_attempts = num_attempts + 1,
+    failure_reason = $2,
+    update_time = $3
+WHERE id = $4 AND state = $5
+`
+
+type RetryScheduledSendParams struct {
+	NewState      int16
+	FailureReason sql.NullString
+	UpdateTime    time.Time
+	ID            int64
+	OldState      int16
+}
+
+func (q *Queries) RetryScheduledSend(ctx context.Context, arg RetryScheduledSendParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, RetryScheduledSend,
+		arg.NewState,
+		arg.FailureReason,
+		arg.UpdateTime,
+		arg.ID,
+		arg.OldState,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const UpdateScheduledSendState = `-- name: UpdateScheduledSendState :execrows
+UPDATE scheduled_sends
+SET state = $1,
+    anchor_txid = $2,
+    failure_reason = $3,
+    update_time = $4
+WHERE id = $5 AND state = $6
+`
+
+type UpdateScheduledSendStateParams struct {
+	NewState      int16
+	AnchorTxid    []byte
+	FailureReason sql.NullString
+	UpdateTime    time.Time
+	ID            int64
+	OldState      int16
+}
+
+func (q *Queries) UpdateScheduledSendState(ctx context.Context, arg UpdateScheduledSendStateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, UpdateScheduledSendState,
+		arg.NewState,
+		arg.AnchorTxid,
+		arg.FailureReason,
+		arg.UpdateTime,
+		arg.ID,
+		arg.OldState,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -385,6 +385,9 @@ CREATE TABLE assets_meta (
     CHECK(LENGTH(meta_canonical_universes) <= 4096), meta_delegation_key BLOB
     CHECK(LENGTH(meta_delegation_key) <= 33));
 
+CREATE INDEX assets_meta_type_idx
+    ON assets_meta (meta_data_type);
+
 CREATE TABLE authmailbox_messages (
     -- The primary key of the message, which is an auto-incrementing integer.
     id INTEGER PRIMARY KEY,
@@ -432,6 +435,64 @@ CREATE TABLE chain_txns (
     tx_index INTEGER
 );
 
+CREATE INDEX chain_txns_block_height_idx
+    ON chain_txns (block_height);
+
+CREATE TABLE channel_balance_htlcs (
+    id INTEGER PRIMARY KEY,
+
+    -- The snapshot of the commitment update the HTLC was settled in.
+    snapshot_id BIGINT NOT NULL REFERENCES channel_balance_snapshots(id)
+        ON DELETE CASCADE,
+
+    -- The index of the HTLC in the update log of the party that offered it.
+    htlc_index BIGINT NOT NULL,
+
+    -- Indicates whether the HTLC was offered by our peer (true) or by us
+    -- (false).
+    incoming BOOLEAN NOT NULL,
+
+    -- The number of asset units the HTLC carried.
+    asset_amount BIGINT NOT NULL,
+
+    -- The ID of the RFQ quote the HTLC was sent under, if any.
+    rfq_id BLOB CHECK(length(rfq_id) = 32),
+
+    -- The asset rate of the RFQ quote, expressed as a fixed-point
+    -- coefficient (big-endian unsigned integer) and scale, if it was known
+    -- when the snapshot was recorded.
+    rate_coefficient BLOB,
+    rate_scale SMALLINT
+);
+
+CREATE INDEX channel_balance_htlcs_snapshot_id_idx
+    ON channel_balance_htlcs (snapshot_id);
+
+CREATE TABLE channel_balance_snapshots (
+    id INTEGER PRIMARY KEY,
+
+    -- The funding outpoint of the channel, encoded in Bitcoin wire format.
+    chan_point BLOB NOT NULL,
+
+    -- The height of our local commitment the snapshot was taken at.
+    commit_height BIGINT NOT NULL,
+
+    -- Our asset balance in the channel, excluding pending HTLCs.
+    local_balance BIGINT NOT NULL,
+
+    -- The asset balance of our peer, excluding pending HTLCs.
+    remote_balance BIGINT NOT NULL,
+
+    -- The time the snapshot was recorded.
+    recorded_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX channel_balance_snapshots_commit_idx
+    ON channel_balance_snapshots (chan_point, commit_height);
+
+CREATE INDEX channel_balance_snapshots_recorded_at_idx
+    ON channel_balance_snapshots (recorded_at);
+
 CREATE INDEX creation_time_idx ON addr_events(creation_time);
 
 CREATE TABLE federation_global_sync_config (
@@ -520,6 +581,9 @@ CREATE TABLE genesis_assets (
     genesis_point_id BIGINT NOT NULL REFERENCES genesis_points(genesis_id)
 );
 
+CREATE INDEX genesis_assets_asset_tag_lower_idx
+    ON genesis_assets (lower(asset_tag));
+
 CREATE VIEW genesis_info_view AS
     SELECT
         gen_asset_id, asset_id, asset_tag, assets_meta.meta_data_hash meta_hash,
@@ -631,6 +695,54 @@ CREATE INDEX mint_anchor_uni_commitments_outpoint_idx
 CREATE UNIQUE INDEX mint_anchor_uni_commitments_unique
     ON mint_supply_pre_commits (batch_id, tx_output_index);
 
+CREATE TABLE mint_schedules (
+    id INTEGER PRIMARY KEY,
+
+    -- The tweaked group key of the asset group to mint new tranches of.
+    group_key BLOB NOT NULL CHECK(length(group_key) = 33),
+
+    -- The asset version of the minted tranches.
+    asset_version SMALLINT NOT NULL,
+
+    -- The amount to mint in each tranche.
+    amount BIGINT NOT NULL,
+
+    -- The type of the metadata of each tranche.
+    meta_type SMALLINT NOT NULL,
+
+    -- The metadata template of each tranche. The placeholder {{tranche}} is
+    -- replaced with the number of the tranche.
+    meta_data_template BLOB,
+
+    -- The interval between two tranches in seconds.
+    cadence_seconds BIGINT NOT NULL,
+
+    -- The maximum total supply of the asset group. No tranche is minted that
+    -- would increase the issued supply above this amount.
+    max_total_supply BIGINT NOT NULL,
+
+    -- The time at or after which the next tranche is minted.
+    next_mint_time TIMESTAMP NOT NULL,
+
+    -- The number of tranches minted by this schedule.
+    num_tranches INTEGER NOT NULL,
+
+    -- The total amount minted by this schedule.
+    total_minted BIGINT NOT NULL,
+
+    -- The state of the schedule: 0 = active, 1 = completed, 2 = cancelled.
+    state SMALLINT NOT NULL,
+
+    -- The error of the last failed mint attempt, if any.
+    last_error TEXT,
+
+    -- The time the schedule was created.
+    creation_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX mint_schedules_state_idx
+    ON mint_schedules (state);
+
 CREATE TABLE mint_supply_pre_commits (
     id INTEGER PRIMARY KEY,
 
@@ -782,6 +894,145 @@ CREATE TABLE proof_types (
     proof_type TEXT PRIMARY KEY
 );
 
+CREATE TABLE rfq_accepted_quotes (
+    id INTEGER PRIMARY KEY,
+
+    -- The ID of the quote request that was accepted. The SCID alias of the
+    -- quote is derived from this ID.
+    quote_id BLOB NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The public key of the peer we negotiated the quote with.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- Indicates whether the quote is a buy quote (true) or a sell quote
+    -- (false), from the point of view of the requesting node.
+    is_buy BOOLEAN NOT NULL,
+
+    -- Indicates whether our node accepted the quote (true) or our peer did
+    -- (false). Quotes accepted by our node back the HTLC policies of the
+    -- order handler.
+    local_accept BOOLEAN NOT NULL,
+
+    -- The version of the request message data.
+    request_version SMALLINT NOT NULL,
+
+    -- The version of the accept message data.
+    accept_version SMALLINT NOT NULL,
+
+    -- The ID of the asset the quote was requested for. Either the asset ID
+    -- or the group key must be set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The group key of the asset the quote was requested for.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The maximum amount of the request. For buy quotes this is the maximum
+    -- asset amount, for sell quotes it is the maximum payment amount in
+    -- milli-satoshi.
+    max_amount BIGINT NOT NULL,
+
+    -- The optional asset rate hint sent with the request, expressed as a
+    -- fixed-point coefficient (big-endian unsigned integer) and scale,
+    -- together with its expiry as a unix timestamp.
+    rate_hint_coefficient BLOB,
+    rate_hint_scale SMALLINT,
+    rate_hint_expiry BIGINT,
+
+    -- The optional price oracle metadata sent with the request.
+    price_oracle_metadata TEXT,
+
+    -- The accepted asset rate, expressed as a fixed-point coefficient
+    -- (big-endian unsigned integer) and scale.
+    rate_coefficient BLOB NOT NULL,
+    rate_scale SMALLINT NOT NULL,
+
+    -- The unix timestamp in seconds at which the accepted quote expires.
+    expiry BIGINT NOT NULL
+, forward_fee_base BIGINT, forward_fee_rate_ppm BIGINT);
+
+CREATE INDEX rfq_accepted_quotes_expiry_idx
+    ON rfq_accepted_quotes (expiry);
+
+CREATE UNIQUE INDEX rfq_accepted_quotes_quote_id_idx
+    ON rfq_accepted_quotes (quote_id, local_accept);
+
+CREATE TABLE rfq_collected_forward_fees (
+    id INTEGER PRIMARY KEY,
+
+    -- The asset specifier of the quotes the fees were collected under. This
+    -- is the 32-byte asset ID, the 33-byte compressed group key, or the asset
+    -- ID followed by the group key if the specifier has both.
+    asset_specifier BLOB NOT NULL UNIQUE CHECK(
+        length(asset_specifier) IN (32, 33, 65)
+    ),
+
+    -- The number of settled HTLCs a fee was collected for.
+    num_htlcs BIGINT NOT NULL,
+
+    -- The sum of the collected fees in asset units.
+    total_fees BIGINT NOT NULL
+);
+
+CREATE TABLE scheduled_send_recipients (
+    id INTEGER PRIMARY KEY,
+
+    -- The scheduled send this recipient belongs to.
+    scheduled_send_id BIGINT NOT NULL REFERENCES scheduled_sends(id)
+        ON DELETE CASCADE,
+
+    -- The encoded Taproot Asset address of the recipient.
+    tap_addr TEXT NOT NULL,
+
+    -- The amount to send to addresses that don't specify an amount. Zero if
+    -- the amount of the address is used.
+    amount BIGINT NOT NULL
+);
+
+CREATE INDEX scheduled_send_recipients_send_id_idx
+    ON scheduled_send_recipients (scheduled_send_id);
+
+CREATE TABLE scheduled_sends (
+    id INTEGER PRIMARY KEY,
+
+    -- The label of the transfer that is created when the send is executed.
+    label TEXT NOT NULL,
+
+    -- The fee rate in sat/kw to use for the anchor transaction. If zero, the
+    -- fee rate is estimated when the send is executed.
+    fee_rate BIGINT NOT NULL,
+
+    -- Indicates whether the proof courier ping check should be skipped when
+    -- the send is executed.
+    skip_proof_courier_ping_check BOOLEAN NOT NULL,
+
+    -- The block height at or after which the send is executed. At least one
+    -- of execute_at_height and execute_at_time must be set. If both are set,
+    -- the send is executed once both conditions are met.
+    execute_at_height INTEGER,
+
+    -- The wall-clock time at or after which the send is executed.
+    execute_at_time TIMESTAMP,
+
+    -- The state of the scheduled send: 0 = pending, 1 = executing,
+    -- 2 = executed, 3 = failed, 4 = cancelled.
+    state SMALLINT NOT NULL,
+
+    -- The ID of the anchor transaction of the transfer, once executed.
+    anchor_txid BLOB CHECK(length(anchor_txid) = 32),
+
+    -- The reason the send failed, if it did.
+    failure_reason TEXT,
+
+    -- The time the send was scheduled.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- The time the state of the send was last updated.
+    update_time TIMESTAMP NOT NULL
+, num_attempts INTEGER NOT NULL DEFAULT 0);
+
+CREATE INDEX scheduled_sends_state_idx
+    ON scheduled_sends (state);
+
 CREATE TABLE script_keys (
     script_key_id INTEGER PRIMARY KEY,
 
@@ -1079,10 +1330,16 @@ CREATE TABLE "universe_leaves" (
     leaf_node_namespace VARCHAR NOT NULL
 , block_height INTEGER);
 
+CREATE INDEX universe_leaves_asset_genesis_id_idx
+    ON universe_leaves (asset_genesis_id);
+
 CREATE INDEX universe_leaves_key_idx ON universe_leaves(leaf_node_key);
 
 CREATE INDEX universe_leaves_namespace ON universe_leaves(leaf_node_namespace);
 
+CREATE INDEX universe_leaves_script_key_idx
+    ON universe_leaves (script_key_bytes);
+
 CREATE UNIQUE INDEX universe_leaves_unique_minting_script_namespace ON "universe_leaves"(minting_point, script_key_bytes, leaf_node_namespace);
 
 CREATE TABLE universe_roots (
@@ -1198,3 +1455,41 @@ CREATE TABLE universe_supply_roots (
 
 CREATE INDEX universe_supply_roots_group_key_idx ON universe_supply_roots(group_key);
 
+CREATE TABLE universe_write_quotas (
+    -- The compressed public key the issuer authenticates inserts with.
+    issuer_key BLOB PRIMARY KEY CHECK(length(issuer_key) = 33),
+
+    -- The maximum number of proofs the issuer may insert. Zero means
+    -- unlimited.
+    max_proofs BIGINT NOT NULL,
+
+    -- The maximum number of raw proof bytes the issuer may insert. Zero
+    -- means unlimited.
+    max_bytes BIGINT NOT NULL,
+
+    -- The number of proofs the issuer inserted so far.
+    used_proofs BIGINT NOT NULL DEFAULT 0,
+
+    -- The number of raw proof bytes the issuer inserted so far.
+    used_bytes BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE verified_proofs (
+    -- The chained hash of the proof, SHA256(prev_hash || proof).
+    proof_hash BLOB NOT NULL CHECK(length(proof_hash) = 32),
+
+    -- The hash of the block the proof is anchored in.
+    block_hash BLOB NOT NULL CHECK(length(block_hash) = 32),
+
+    -- The height of the block the proof is anchored in.
+    block_height INTEGER NOT NULL,
+
+    -- The time the proof was verified.
+    verified_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (proof_hash, block_hash)
+);
+
+CREATE INDEX verified_proofs_block_height_idx
+    ON verified_proofs (block_height);
+
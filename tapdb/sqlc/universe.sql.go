@@ -138,6 +138,30 @@ func (q *Queries) FetchMultiverseRoot(ctx context.Context, namespaceRoot string)
 	return i, err
 }
 
+const FetchUniverseKeyByNodeKey = `-- name: FetchUniverseKeyByNodeKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves AS leaves
+WHERE leaves.leaf_node_namespace = $1
+      AND leaves.leaf_node_key = $2
+`
+
+type FetchUniverseKeyByNodeKeyParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+type FetchUniverseKeyByNodeKeyRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseKeyByNodeKey(ctx context.Context, arg FetchUniverseKeyByNodeKeyParams) (FetchUniverseKeyByNodeKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, FetchUniverseKeyByNodeKey, arg.Namespace, arg.LeafNodeKey)
+	var i FetchUniverseKeyByNodeKeyRow
+	err := row.Scan(&i.MintingPoint, &i.ScriptKeyBytes)
+	return i, err
+}
+
 const FetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves AS leaves
@@ -1010,6 +1034,197 @@ func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow
 	return i, err
 }
 
+const SearchUniverseAssets = `-- name: SearchUniverseAssets :many
+SELECT gen.gen_asset_id, gen.asset_id, gen.asset_tag AS asset_name,
+       gen.asset_type, gen.block_height AS genesis_height,
+       gen.prev_out AS genesis_prev_out, gen.output_index AS anchor_index,
+       gen.anchor_txid, meta.meta_data_type AS meta_type,
+       meta.meta_decimal_display AS decimal_display,
+       group_info.tweaked_group_key AS group_key
+FROM genesis_info_view gen
+-- We use a LEFT JOIN here as not every asset has a meta reveal.
+LEFT JOIN assets_meta meta
+    ON gen.meta_hash = meta.meta_data_hash
+-- We use a LEFT JOIN here as not every asset has a group key.
+LEFT JOIN key_group_info_view group_info
+    ON gen.gen_asset_id = group_info.gen_asset_id
+WHERE gen.gen_asset_id > $1 AND
+      -- Only assets with an issuance proof in the universe are returned.
+      EXISTS (
+          SELECT 1
+          FROM universe_leaves leaves
+          JOIN universe_roots roots
+              ON leaves.universe_root_id = roots.id
+          WHERE leaves.asset_genesis_id = gen.gen_asset_id AND
+                roots.proof_type = 'issuance'
+      ) AND
+      -- Both sides are lower cased, as LIKE is case insensitive in SQLite
+      -- but case sensitive in Postgres.
+      (lower(gen.asset_tag) LIKE (lower(CAST($2 AS TEXT)) || '%') ESCAPE '\' OR
+       $2 IS NULL) AND
+      (gen.asset_type = $3 OR
+       $3 IS NULL) AND
+      (meta.meta_data_type = $4 OR
+       $4 IS NULL) AND
+      (COALESCE(meta.meta_decimal_display, 0) = $5 OR
+       $5 IS NULL) AND
+      (gen.block_height >= $6 OR
+       $6 IS NULL) AND
+      (gen.block_height <= $7 OR
+       $7 IS NULL) AND
+      (group_info.tweaked_group_key = $8 OR
+       $8 IS NULL) AND
+      ((group_info.tweaked_group_key IS NOT NULL) = $9 OR
+       $9 IS NULL)
+ORDER BY gen.gen_asset_id
+LIMIT $10
+`
+
+type SearchUniverseAssetsParams struct {
+	Cursor         int64
+	NamePrefix     sql.NullString
+	AssetType      sql.NullInt16
+	MetaType       sql.NullInt16
+	DecimalDisplay sql.NullInt32
+	MinHeight      sql.NullInt32
+	MaxHeight      sql.NullInt32
+	GroupKey       []byte
+	Grouped        sql.NullBool
+	NumLimit       int32
+}
+
+type SearchUniverseAssetsRow struct {
+	GenAssetID     int64
+	AssetID        []byte
+	AssetName      string
+	AssetType      int16
+	GenesisHeight  sql.NullInt32
+	GenesisPrevOut []byte
+	AnchorIndex    int32
+	AnchorTxid     []byte
+	MetaType       sql.NullInt16
+	DecimalDisplay sql.NullInt32
+	GroupKey       []byte
+}
+
+func (q *Queries) SearchUniverseAssets(ctx context.Context, arg SearchUniverseAssetsParams) ([]SearchUniverseAssetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, SearchUniverseAssets,
+		arg.Cursor,
+		arg.NamePrefix,
+		arg.AssetType,
+		arg.MetaType,
+		arg.DecimalDisplay,
+		arg.MinHeight,
+		arg.MaxHeight,
+		arg.GroupKey,
+		arg.Grouped,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUniverseAssetsRow
+	for rows.Next() {
+		var i SearchUniverseAssetsRow
+		if err := rows.Scan(
+			&i.GenAssetID,
+			&i.AssetID,
+			&i.AssetName,
+			&i.AssetType,
+			&i.GenesisHeight,
+			&i.GenesisPrevOut,
+			&i.AnchorIndex,
+			&i.AnchorTxid,
+			&i.MetaType,
+			&i.DecimalDisplay,
+			&i.GroupKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const SearchUniverseLeaves = `-- name: SearchUniverseLeaves :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       gen.asset_id, roots.group_key, roots.proof_type
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE leaves.id > $1 AND
+      (leaves.minting_point = $2 OR
+       $2 IS NULL) AND
+      (leaves.script_key_bytes = $3 OR
+       $3 IS NULL) AND
+      (roots.proof_type = $4 OR
+       $4 IS NULL)
+ORDER BY leaves.id
+LIMIT $5
+`
+
+type SearchUniverseLeavesParams struct {
+	Cursor         int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	ProofType      sql.NullString
+	NumLimit       int32
+}
+
+type SearchUniverseLeavesRow struct {
+	ID             int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      sql.NullString
+}
+
+func (q *Queries) SearchUniverseLeaves(ctx context.Context, arg SearchUniverseLeavesParams) ([]SearchUniverseLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, SearchUniverseLeaves,
+		arg.Cursor,
+		arg.MintingPoint,
+		arg.ScriptKeyBytes,
+		arg.ProofType,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUniverseLeavesRow
+	for rows.Next() {
+		var i SearchUniverseLeavesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const UniverseLeaves = `-- name: UniverseLeaves :many
 SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace, block_height FROM universe_leaves
 `
@@ -0,0 +1,122 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: universe_write_quotas.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const ConsumeUniverseWriteQuota = `-- name: ConsumeUniverseWriteQuota :execrows
+UPDATE universe_write_quotas
+SET used_proofs = used_proofs + 1,
+    used_bytes = used_bytes + $1
+WHERE issuer_key = $2
+    AND (max_proofs = 0 OR used_proofs + 1 <= max_proofs)
+    AND (max_bytes = 0 OR used_bytes + $1 <= max_bytes)
+`
+
+type ConsumeUniverseWriteQuotaParams struct {
+	NumBytes  int64
+	IssuerKey []byte
+}
+
+func (q *Queries) ConsumeUniverseWriteQuota(ctx context.Context, arg ConsumeUniverseWriteQuotaParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, ConsumeUniverseWriteQuota, arg.NumBytes, arg.IssuerKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const DeleteUniverseWriteQuota = `-- name: DeleteUniverseWriteQuota :execrows
+DELETE FROM universe_write_quotas
+WHERE issuer_key = $1
+`
+
+func (q *Queries) DeleteUniverseWriteQuota(ctx context.Context, issuerKey []byte) (int64, error) {
+	result, err := q.db.ExecContext(ctx, DeleteUniverseWriteQuota, issuerKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const FetchUniverseWriteQuota = `-- name: FetchUniverseWriteQuota :one
+SELECT issuer_key, max_proofs, max_bytes, used_proofs, used_bytes
+FROM universe_write_quotas
+WHERE issuer_key = $1
+`
+
+func (q *Queries) FetchUniverseWriteQuota(ctx context.Context, issuerKey []byte) (UniverseWriteQuota, error) {
+	row := q.db.QueryRowContext(ctx, FetchUniverseWriteQuota, issuerKey)
+	var i UniverseWriteQuota
+	err := row.Scan(
+		&i.IssuerKey,
+		&i.MaxProofs,
+		&i.MaxBytes,
+		&i.UsedProofs,
+		&i.UsedBytes,
+	)
+	return i, err
+}
+
+const ListUniverseWriteQuotas = `-- name: ListUniverseWriteQuotas :many
+SELECT issuer_key, max_proofs, max_bytes, used_proofs, used_bytes
+FROM universe_write_quotas
+ORDER BY issuer_key
+`
+
+func (q *Queries) ListUniverseWriteQuotas(ctx context.Context) ([]UniverseWriteQuota, error) {
+	rows, err := q.db.QueryContext(ctx, ListUniverseWriteQuotas)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseWriteQuota
+	for rows.Next() {
+		var i UniverseWriteQuota
+		if err := rows.Scan(
+			&i.IssuerKey,
+			&i.MaxProofs,
+			&i.MaxBytes,
+			&i.UsedProofs,
+			&i.UsedBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const UpsertUniverseWriteQuota = `-- name: UpsertUniverseWriteQuota :exec
+INSERT INTO universe_write_quotas (
+    issuer_key, max_proofs, max_bytes
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (issuer_key)
+    -- Only the limits are updated, the usage of an existing quota is kept.
+    DO UPDATE SET max_proofs = EXCLUDED.max_proofs,
+        max_bytes = EXCLUDED.max_bytes
+`
+
+type UpsertUniverseWriteQuotaParams struct {
+	IssuerKey []byte
+	MaxProofs int64
+	MaxBytes  int64
+}
+
+func (q *Queries) UpsertUniverseWriteQuota(ctx context.Context, arg UpsertUniverseWriteQuotaParams) error {
+	_, err := q.db.ExecContext(ctx, UpsertUniverseWriteQuota, arg.IssuerKey, arg.MaxProofs, arg.MaxBytes)
+	return err
+}
@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: verified_proofs.sql
+
+package sqlc
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const DeleteVerifiedProofsFromHeight = `-- name: DeleteVerifiedProofsFromHeight :execrows
+DELETE FROM verified_proofs
+WHERE block_height >= $1
+`
+
+func (q *Queries) DeleteVerifiedProofsFromHeight(ctx context.Context, blockHeight int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, DeleteVerifiedProofsFromHeight, blockHeight)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const FetchVerifiedProofs = `-- name: FetchVerifiedProofs :many
+SELECT proof_hash, block_hash, block_height, verified_at
+FROM verified_proofs
+WHERE proof_hash IN (/*SLICE:proof_hashes*/?)
+`
+
+func (q *Queries) FetchVerifiedProofs(ctx context.Context, proofHashes [][]byte) ([]VerifiedProof, error) {
+	query := FetchVerifiedProofs
+	var queryParams []interface{}
+	if len(proofHashes) > 0 {
+		for _, v := range proofHashes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:proof_hashes*/?", makeQueryParams(len(queryParams), len(proofHashes)), 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:proof_hashes*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VerifiedProof
+	for rows.Next() {
+		var i VerifiedProof
+		if err := rows.Scan(
+			&i.ProofHash,
+			&i.BlockHash,
+			&i.BlockHeight,
+			&i.VerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const InsertVerifiedProof = `-- name: InsertVerifiedProof :exec
+INSERT INTO verified_proofs (
+    proof_hash, block_hash, block_height, verified_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (proof_hash, block_hash) DO NOTHING
+`
+
+type InsertVerifiedProofParams struct {
+	ProofHash   []byte
+	BlockHash   []byte
+	BlockHeight int32
+	VerifiedAt  time.Time
+}
+
+func (q *Queries) InsertVerifiedProof(ctx context.Context, arg InsertVerifiedProofParams) error {
+	_, err := q.db.ExecContext(ctx, InsertVerifiedProof,
+		arg.ProofHash,
+		arg.BlockHash,
+		arg.BlockHeight,
+		arg.VerifiedAt,
+	)
+	return err
+}
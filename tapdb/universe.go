@@ -45,6 +45,10 @@ type (
 	// currently stored for a given namespace.
 	UniverseLeafKeysQuery = sqlc.FetchUniverseKeysParams
 
+	// UniverseNodeKeyQuery is used to query for the leaf key of the
+	// universe leaf that is stored at a given MS-SMT key.
+	UniverseNodeKeyQuery = sqlc.FetchUniverseKeyByNodeKeyParams
+
 	// UpsertMultiverseRoot is used to upsert a multiverse root.
 	UpsertMultiverseRoot = sqlc.UpsertMultiverseRootParams
 
@@ -112,6 +116,12 @@ type BaseUniverseStore interface {
 	FetchUniverseKeys(ctx context.Context,
 		arg UniverseLeafKeysQuery) ([]UniverseKeys, error)
 
+	// FetchUniverseKeyByNodeKey fetches the leaf key of the universe leaf
+	// that is stored at the given MS-SMT key.
+	FetchUniverseKeyByNodeKey(ctx context.Context,
+		arg UniverseNodeKeyQuery) (sqlc.FetchUniverseKeyByNodeKeyRow,
+		error)
+
 	// UpsertMultiverseRoot upserts a multiverse root in the database.
 	UpsertMultiverseRoot(ctx context.Context,
 		arg UpsertMultiverseRoot) (int64, error)
@@ -1205,27 +1215,14 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 
 	var leafKeys []universe.LeafKey
 	err = fn.ForEachErr(universeKeys, func(key UniverseKeys) error {
-		scriptKeyPub, err := schnorr.ParsePubKey(
-			key.ScriptKeyBytes,
+		leafKey, err := parseUniverseKey(
+			key.MintingPoint, key.ScriptKeyBytes,
 		)
 		if err != nil {
 			return err
 		}
-		scriptKey := asset.NewScriptKey(scriptKeyPub)
 
-		var genPoint wire.OutPoint
-		err = readOutPoint(
-			bytes.NewReader(key.MintingPoint), 0, 0,
-			&genPoint,
-		)
-		if err != nil {
-			return err
-		}
-
-		leafKeys = append(leafKeys, universe.BaseLeafKey{
-			OutPoint:  genPoint,
-			ScriptKey: &scriptKey,
-		})
+		leafKeys = append(leafKeys, leafKey)
 
 		return nil
 	})
@@ -1236,6 +1233,76 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 	return leafKeys, nil
 }
 
+// parseUniverseKey parses the minting point and script key of a universe leaf
+// as stored in the database into a universe leaf key.
+func parseUniverseKey(mintingPoint,
+	scriptKeyBytes []byte) (universe.BaseLeafKey, error) {
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return universe.BaseLeafKey{}, err
+	}
+	scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	var genPoint wire.OutPoint
+	err = readOutPoint(bytes.NewReader(mintingPoint), 0, 0, &genPoint)
+	if err != nil {
+		return universe.BaseLeafKey{}, err
+	}
+
+	return universe.BaseLeafKey{
+		OutPoint:  genPoint,
+		ScriptKey: &scriptKey,
+	}, nil
+}
+
+// universeSubtrees returns the subtree roots of the universe tree with the
+// given namespace at the given depth for each of the given paths. Subtrees
+// that only contain a single leaf are returned together with the universe
+// leaf key of that leaf.
+func universeSubtrees(ctx context.Context, dbTx BaseUniverseStore,
+	namespace string, depth int,
+	paths [][32]byte) ([]universe.UniverseSubtree, error) {
+
+	tree := mssmt.NewCompactedTree(newTreeStoreWrapperTx(dbTx, namespace))
+	nodes, err := tree.SubtreeNodes(ctx, depth, paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	subtrees := make([]universe.UniverseSubtree, 0, len(nodes))
+	for _, node := range nodes {
+		subtree := universe.UniverseSubtree{
+			SubtreeNode: node,
+		}
+
+		if node.LeafKey != nil {
+			dbKey, err := dbTx.FetchUniverseKeyByNodeKey(
+				ctx, UniverseNodeKeyQuery{
+					Namespace:   namespace,
+					LeafNodeKey: node.LeafKey[:],
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch leaf "+
+					"key for node key %x: %w",
+					node.LeafKey[:], err)
+			}
+
+			subtree.Key, err = parseUniverseKey(
+				dbKey.MintingPoint, dbKey.ScriptKeyBytes,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		subtrees = append(subtrees, subtree)
+	}
+
+	return subtrees, nil
+}
+
 // FetchKeys retrieves all keys from the universe tree.
 func (b *BaseUniverseTree) FetchKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/lightninglabs/neutrino/cache/lru"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -56,6 +58,20 @@ type (
 	// AssetStatsPerDayQueryPg is the query used to fetch the asset stats
 	// for a given day (for Postgres).
 	AssetStatsPerDayQueryPg = sqlc.QueryAssetStatsPerDayPostgresParams
+
+	// AssetSearchQuery is the query used to search the assets known to the
+	// universe.
+	AssetSearchQuery = sqlc.SearchUniverseAssetsParams
+
+	// AssetSearchRow is a single asset returned by an asset search.
+	AssetSearchRow = sqlc.SearchUniverseAssetsRow
+
+	// LeafSearchQuery is the query used to search the leaves of all
+	// universes.
+	LeafSearchQuery = sqlc.SearchUniverseLeavesParams
+
+	// LeafSearchRow is a single leaf returned by a leaf search.
+	LeafSearchRow = sqlc.SearchUniverseLeavesRow
 )
 
 // UniverseStatsStore is an interface that defines the methods required to
@@ -84,6 +100,16 @@ type UniverseStatsStore interface {
 	// grouped by day in a Postgres specific format.
 	QueryAssetStatsPerDayPostgres(ctx context.Context,
 		q AssetStatsPerDayQueryPg) ([]AssetStatsPerDayPg, error)
+
+	// SearchUniverseAssets returns the assets known to the universe that
+	// match the given filters.
+	SearchUniverseAssets(ctx context.Context,
+		q AssetSearchQuery) ([]AssetSearchRow, error)
+
+	// SearchUniverseLeaves returns the leaves of all universes that match
+	// the given filters.
+	SearchUniverseLeaves(ctx context.Context,
+		q LeafSearchQuery) ([]LeafSearchRow, error)
 }
 
 // UniverseStatsOptions defines the set of txn options for the universe stats.
@@ -937,4 +963,213 @@ func (u *UniverseStats) QuerySyncStats(ctx context.Context,
 	return resp, nil
 }
 
+// escapeLikePattern escapes the wildcard characters of a SQL LIKE pattern,
+// so the given string is matched literally.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`, `%`, `\%`, `_`, `\_`,
+	).Replace(s)
+}
+
+// SearchAssets returns a page of the assets known to the universe that match
+// the given query.
+func (u *UniverseStats) SearchAssets(ctx context.Context,
+	q universe.AssetSearchQuery) (*universe.AssetSearchResult, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	limit := universe.SearchLimit(q.Limit)
+	query := AssetSearchQuery{
+		Cursor: int64(q.Cursor),
+		NamePrefix: fn.MapOptionZ(
+			q.NamePrefix, func(prefix string) sql.NullString {
+				return sqlStr(escapeLikePattern(prefix))
+			},
+		),
+		AssetType: fn.MapOptionZ(
+			q.AssetType, func(t asset.Type) sql.NullInt16 {
+				return sqlInt16(t)
+			},
+		),
+		MetaType: fn.MapOptionZ(
+			q.MetaType, func(t proof.MetaType) sql.NullInt16 {
+				return sqlInt16(t)
+			},
+		),
+		DecimalDisplay: sqlOptInt32(q.DecimalDisplay),
+		MinHeight:      sqlOptInt32(q.MinGenesisHeight),
+		MaxHeight:      sqlOptInt32(q.MaxGenesisHeight),
+		Grouped: fn.MapOptionZ(q.Grouped, func(b bool) sql.NullBool {
+			return sqlBool(b)
+		}),
+		NumLimit: int32(limit),
+	}
+	if q.GroupKey != nil {
+		query.GroupKey = q.GroupKey.SerializeCompressed()
+	}
+
+	var rows []AssetSearchRow
+	readTx := NewUniverseStatsReadTx()
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseStatsStore) error {
+		var err error
+		rows, err = db.SearchUniverseAssets(ctx, query)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to search assets: %w", err)
+	}
+
+	result := &universe.AssetSearchResult{
+		Assets: make([]universe.AssetSearchEntry, 0, len(rows)),
+	}
+	for _, row := range rows {
+		entry := universe.AssetSearchEntry{
+			AssetID:       fn.ToArray[asset.ID](row.AssetID),
+			AssetName:     row.AssetName,
+			AssetType:     asset.Type(row.AssetType),
+			GenesisHeight: uint32(row.GenesisHeight.Int32),
+			DecimalDisplay: uint32(
+				row.DecimalDisplay.Int32,
+			),
+		}
+
+		if row.MetaType.Valid {
+			entry.MetaType = fn.Some(
+				proof.MetaType(row.MetaType.Int16),
+			)
+		}
+
+		if len(row.GroupKey) > 0 {
+			entry.GroupKey, err = btcec.ParsePubKey(row.GroupKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = readOutPoint(
+			bytes.NewReader(row.GenesisPrevOut), 0, 0,
+			&entry.GenesisPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read outpoint: %w",
+				err)
+		}
+
+		// The anchor transaction is only known once the asset was
+		// confirmed.
+		if len(row.AnchorTxid) > 0 {
+			hash, err := chainhash.NewHash(row.AnchorTxid)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.AnchorPoint = wire.OutPoint{
+				Hash:  *hash,
+				Index: uint32(row.AnchorIndex),
+			}
+		}
+
+		result.Assets = append(result.Assets, entry)
+	}
+
+	// If the page is full, there might be more results after the last
+	// asset of this page.
+	if len(rows) == limit {
+		result.NextCursor = uint64(rows[len(rows)-1].GenAssetID)
+	}
+
+	return result, nil
+}
+
+// SearchLeaves returns a page of the leaves across all universes that match
+// the given query.
+func (u *UniverseStats) SearchLeaves(ctx context.Context,
+	q universe.LeafSearchQuery) (*universe.LeafSearchResult, error) {
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	limit := universe.SearchLimit(q.Limit)
+	query := LeafSearchQuery{
+		Cursor:   int64(q.Cursor),
+		NumLimit: int32(limit),
+	}
+	if q.ScriptKey != nil {
+		query.ScriptKeyBytes = schnorr.SerializePubKey(q.ScriptKey)
+	}
+	if q.OutPoint != nil {
+		outPoint, err := encodeOutpoint(*q.OutPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		query.MintingPoint = outPoint
+	}
+	if q.ProofType != universe.ProofTypeUnspecified {
+		query.ProofType = sqlStr(q.ProofType.String())
+	}
+
+	var rows []LeafSearchRow
+	readTx := NewUniverseStatsReadTx()
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseStatsStore) error {
+		var err error
+		rows, err = db.SearchUniverseLeaves(ctx, query)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to search leaves: %w", err)
+	}
+
+	result := &universe.LeafSearchResult{
+		Leaves: make([]universe.LeafSearchEntry, 0, len(rows)),
+	}
+	for _, row := range rows {
+		entry := universe.LeafSearchEntry{
+			AssetID: fn.ToArray[asset.ID](row.AssetID),
+		}
+
+		entry.ID.ProofType, err = universe.ParseStrProofType(
+			row.ProofType.String,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Grouped assets are part of the universe of their group,
+		// all other assets have a universe of their own.
+		if len(row.GroupKey) > 0 {
+			entry.ID.GroupKey, err = schnorr.ParsePubKey(
+				row.GroupKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			entry.ID.AssetID = entry.AssetID
+		}
+
+		entry.Key, err = parseUniverseKey(
+			row.MintingPoint, row.ScriptKeyBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Leaves = append(result.Leaves, entry)
+	}
+
+	// If the page is full, there might be more results after the last
+	// leaf of this page.
+	if len(rows) == limit {
+		result.NextCursor = uint64(rows[len(rows)-1].ID)
+	}
+
+	return result, nil
+}
+
 var _ universe.Telemetry = (*UniverseStats)(nil)
+
+var _ universe.Searcher = (*UniverseStats)(nil)
@@ -10,9 +10,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -664,3 +667,248 @@ func TestUniverseQuerySyncFilters(t *testing.T) {
 		})
 	}
 }
+
+// TestUniverseSearch tests that the assets and leaves known to the universe can
+// be searched with the supported filters and paginated with a cursor.
+func TestUniverseSearch(t *testing.T) {
+	t.Parallel()
+
+	db := NewTestDB(t)
+
+	testClock := clock.NewTestClock(time.Now())
+	statsDB, _ := newUniverseStatsWithDB(db.BaseDB, testClock)
+
+	ctx := context.Background()
+
+	// insertAsset inserts an issuance leaf for a new asset with the given
+	// name, type, meta reveal and group key.
+	insertAsset := func(name string, assetType asset.Type,
+		meta *proof.MetaReveal,
+		groupKey *btcec.PublicKey) (asset.ID, universe.LeafKey) {
+
+		assetGen := asset.RandGenesis(t, assetType)
+		assetGen.Tag = name
+		assetGen.MetaHash = [32]byte{}
+		if meta != nil {
+			assetGen.MetaHash = meta.MetaHash()
+		}
+
+		id := universe.Identifier{
+			AssetID:   assetGen.ID(),
+			GroupKey:  groupKey,
+			ProofType: universe.ProofTypeIssuance,
+		}
+		assetUniverse, _ := newTestUniverseWithDb(db.BaseDB, id)
+
+		leafKey := randLeafKey(t)
+		leaf := randMintingLeaf(t, assetGen, groupKey)
+		_, err := assetUniverse.UpsertProofLeaf(
+			ctx, leafKey, &leaf, meta,
+		)
+		require.NoError(t, err)
+
+		return assetGen.ID(), leafKey
+	}
+
+	jsonMeta := &proof.MetaReveal{
+		Type:           proof.MetaJson,
+		Data:           []byte(`{"name":"alpha"}`),
+		DecimalDisplay: fn.Some[uint32](2),
+	}
+	opaqueMeta := &proof.MetaReveal{
+		Type: proof.MetaOpaque,
+		Data: test.RandBytes(32),
+	}
+	groupKey := test.RandPubKey(t)
+
+	alpha1ID, alpha1Key := insertAsset(
+		"alpha-1", asset.Normal, jsonMeta, nil,
+	)
+	alpha2ID, _ := insertAsset(
+		"alpha-2", asset.Collectible, opaqueMeta, nil,
+	)
+	underscoreID, _ := insertAsset("al_x", asset.Normal, nil, nil)
+	betaID, _ := insertAsset("beta", asset.Normal, nil, groupKey)
+
+	searchIDs := func(q universe.AssetSearchQuery) []asset.ID {
+		result, err := statsDB.SearchAssets(ctx, q)
+		require.NoError(t, err)
+
+		ids := make([]asset.ID, 0, len(result.Assets))
+		for _, entry := range result.Assets {
+			ids = append(ids, entry.AssetID)
+		}
+
+		return ids
+	}
+
+	testCases := []struct {
+		name     string
+		query    universe.AssetSearchQuery
+		expected []asset.ID
+	}{
+		{
+			name: "all",
+			expected: []asset.ID{
+				alpha1ID, alpha2ID, underscoreID, betaID,
+			},
+		},
+		{
+			name: "name prefix",
+			query: universe.AssetSearchQuery{
+				NamePrefix: fn.Some("alpha"),
+			},
+			expected: []asset.ID{alpha1ID, alpha2ID},
+		},
+		{
+			name: "name prefix case insensitive",
+			query: universe.AssetSearchQuery{
+				NamePrefix: fn.Some("ALPHA"),
+			},
+			expected: []asset.ID{alpha1ID, alpha2ID},
+		},
+		{
+			name: "name prefix with wildcard",
+			query: universe.AssetSearchQuery{
+				NamePrefix: fn.Some("al_"),
+			},
+			expected: []asset.ID{underscoreID},
+		},
+		{
+			name: "asset type",
+			query: universe.AssetSearchQuery{
+				AssetType: fn.Some(asset.Collectible),
+			},
+			expected: []asset.ID{alpha2ID},
+		},
+		{
+			name: "meta type",
+			query: universe.AssetSearchQuery{
+				MetaType: fn.Some(proof.MetaJson),
+			},
+			expected: []asset.ID{alpha1ID},
+		},
+		{
+			name: "decimal display",
+			query: universe.AssetSearchQuery{
+				DecimalDisplay: fn.Some[uint32](2),
+			},
+			expected: []asset.ID{alpha1ID},
+		},
+		{
+			name: "grouped",
+			query: universe.AssetSearchQuery{
+				Grouped: fn.Some(true),
+			},
+			expected: []asset.ID{betaID},
+		},
+		{
+			name: "ungrouped",
+			query: universe.AssetSearchQuery{
+				Grouped: fn.Some(false),
+			},
+			expected: []asset.ID{alpha1ID, alpha2ID, underscoreID},
+		},
+		{
+			name: "group key",
+			query: universe.AssetSearchQuery{
+				GroupKey: groupKey,
+			},
+			expected: []asset.ID{betaID},
+		},
+		{
+			name: "combined filters",
+			query: universe.AssetSearchQuery{
+				NamePrefix: fn.Some("alpha"),
+				AssetType:  fn.Some(asset.Normal),
+			},
+			expected: []asset.ID{alpha1ID},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ids := searchIDs(tc.query)
+			require.ElementsMatch(t, tc.expected, ids)
+		})
+	}
+
+	// The search result contains the details of the asset.
+	result, err := statsDB.SearchAssets(ctx, universe.AssetSearchQuery{
+		NamePrefix: fn.Some("alpha-1"),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Assets, 1)
+	require.Equal(t, "alpha-1", result.Assets[0].AssetName)
+	require.Equal(t, asset.Normal, result.Assets[0].AssetType)
+	require.Equal(t, fn.Some(proof.MetaJson), result.Assets[0].MetaType)
+	require.EqualValues(t, 2, result.Assets[0].DecimalDisplay)
+	require.Zero(t, result.NextCursor)
+
+	// We can page through all assets with the cursor of each page.
+	var (
+		pagedIDs []asset.ID
+		cursor   uint64
+	)
+	for {
+		result, err := statsDB.SearchAssets(
+			ctx, universe.AssetSearchQuery{
+				Cursor: cursor,
+				Limit:  3,
+			},
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.Assets), 3)
+
+		for _, entry := range result.Assets {
+			pagedIDs = append(pagedIDs, entry.AssetID)
+		}
+
+		if result.NextCursor == 0 {
+			break
+		}
+		cursor = result.NextCursor
+	}
+	require.ElementsMatch(
+		t, []asset.ID{alpha1ID, alpha2ID, underscoreID, betaID},
+		pagedIDs,
+	)
+
+	// Leaves can be searched by their script key and outpoint across all
+	// universes.
+	outPoint := alpha1Key.LeafOutPoint()
+	leafQueries := []universe.LeafSearchQuery{
+		{
+			ScriptKey: alpha1Key.LeafScriptKey().PubKey,
+		},
+		{
+			OutPoint: &outPoint,
+		},
+		{
+			OutPoint:  &outPoint,
+			ProofType: universe.ProofTypeIssuance,
+		},
+	}
+	for _, q := range leafQueries {
+		leafResult, err := statsDB.SearchLeaves(ctx, q)
+		require.NoError(t, err)
+		require.Len(t, leafResult.Leaves, 1)
+
+		leaf := leafResult.Leaves[0]
+		require.Equal(t, alpha1ID, leaf.AssetID)
+		require.Equal(t, alpha1ID, leaf.ID.AssetID)
+		require.Equal(t, universe.ProofTypeIssuance, leaf.ID.ProofType)
+		require.Equal(
+			t, alpha1Key.UniverseKey(), leaf.Key.UniverseKey(),
+		)
+	}
+
+	leafResult, err := statsDB.SearchLeaves(ctx, universe.LeafSearchQuery{
+		OutPoint:  &outPoint,
+		ProofType: universe.ProofTypeTransfer,
+	})
+	require.NoError(t, err)
+	require.Empty(t, leafResult.Leaves)
+
+	_, err = statsDB.SearchLeaves(ctx, universe.LeafSearchQuery{})
+	require.ErrorContains(t, err, "must be specified")
+}
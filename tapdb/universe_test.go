@@ -1113,6 +1113,85 @@ func TestMultiverseRootSum(t *testing.T) {
 	}
 }
 
+// TestMultiverseUniverseSubtrees tests that the subtrees of a universe tree
+// commit to the universe root and that the leaf key of a single leaf subtree
+// can be resolved.
+func TestMultiverseUniverseSubtrees(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	const numLeaves = 5
+	leafKeys := make([]universe.LeafKey, 0, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		targetKey := randLeafKey(t)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, targetKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		leafKeys = append(leafKeys, targetKey)
+	}
+
+	// The subtree at depth zero is the root of the universe tree.
+	root, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	subtrees, err := multiverse.UniverseSubtrees(
+		ctx, universe.UniverseSubtreesQuery{
+			Id:    id,
+			Paths: [][32]byte{{}},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, subtrees, 1)
+	require.Equal(t, root.Node.NodeHash(), subtrees[0].NodeHash)
+	require.Equal(t, root.Node.NodeSum(), subtrees[0].Sum)
+	require.Nil(t, subtrees[0].Key)
+
+	// With only a few random keys, each key is the only one below its
+	// path at depth 32, so we should get the leaf key back.
+	const depth = 32
+	paths := fn.Map(leafKeys, func(key universe.LeafKey) [32]byte {
+		return mssmt.SubtreePath(key.UniverseKey(), depth)
+	})
+	subtrees, err = multiverse.UniverseSubtrees(
+		ctx, universe.UniverseSubtreesQuery{
+			Id:    id,
+			Depth: depth,
+			Paths: paths,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, subtrees, numLeaves)
+
+	for idx, subtree := range subtrees {
+		require.NotNil(t, subtree.Key)
+		nodeKey := leafKeys[idx].UniverseKey()
+		require.Equal(t, nodeKey, subtree.Key.UniverseKey())
+		require.Equal(t, nodeKey, *subtree.LeafKey)
+	}
+
+	// A subtree in an unknown universe is empty.
+	subtrees, err = multiverse.UniverseSubtrees(
+		ctx, universe.UniverseSubtreesQuery{
+			Id:    randUniverseID(t, false),
+			Depth: depth,
+			Paths: paths[:1],
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, subtrees[0].IsEmpty())
+	require.Nil(t, subtrees[0].Key)
+}
+
 // TestShouldInsertPreCommit tests the shouldInsertPreCommit function with
 // various combinations of proof types, asset groups, and meta reveals.
 func TestShouldInsertPreCommit(t *testing.T) {
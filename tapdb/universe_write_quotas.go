@@ -0,0 +1,253 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+type (
+	// NewUniverseWriteQuota is used to insert or update the write quota
+	// of an issuer.
+	NewUniverseWriteQuota = sqlc.UpsertUniverseWriteQuotaParams
+
+	// UniverseWriteQuotaRow is a row in the universe write quotas table.
+	UniverseWriteQuotaRow = sqlc.UniverseWriteQuota
+
+	// ConsumeWriteQuotaParams is used to account a proof insert against
+	// the write quota of an issuer.
+	ConsumeWriteQuotaParams = sqlc.ConsumeUniverseWriteQuotaParams
+)
+
+// UniverseWriteQuotaQueries is the set of queries that are needed to persist
+// the write quotas of the universe server.
+type UniverseWriteQuotaQueries interface {
+	// UpsertUniverseWriteQuota inserts a new write quota or updates the
+	// limits of an existing one.
+	UpsertUniverseWriteQuota(ctx context.Context,
+		arg NewUniverseWriteQuota) error
+
+	// FetchUniverseWriteQuota fetches the write quota of the given issuer.
+	FetchUniverseWriteQuota(ctx context.Context,
+		issuerKey []byte) (UniverseWriteQuotaRow, error)
+
+	// ListUniverseWriteQuotas fetches all write quotas.
+	ListUniverseWriteQuotas(
+		ctx context.Context) ([]UniverseWriteQuotaRow, error)
+
+	// DeleteUniverseWriteQuota deletes the write quota of the given
+	// issuer and returns the number of deleted rows.
+	DeleteUniverseWriteQuota(ctx context.Context,
+		issuerKey []byte) (int64, error)
+
+	// ConsumeUniverseWriteQuota increments the usage of the write quota
+	// of the given issuer if the quota allows it, and returns the number
+	// of updated rows.
+	ConsumeUniverseWriteQuota(ctx context.Context,
+		arg ConsumeWriteQuotaParams) (int64, error)
+}
+
+// BatchedUniverseWriteQuotaQueries is a version of the
+// UniverseWriteQuotaQueries that's capable of batched database operations.
+type BatchedUniverseWriteQuotaQueries interface {
+	UniverseWriteQuotaQueries
+
+	BatchedTx[UniverseWriteQuotaQueries]
+}
+
+// UniverseWriteQuotaStore is the database backed implementation of the
+// universe.WriteQuotaStore interface.
+type UniverseWriteQuotaStore struct {
+	db BatchedUniverseWriteQuotaQueries
+}
+
+// NewUniverseWriteQuotaStore creates a new UniverseWriteQuotaStore instance
+// given an open BatchedUniverseWriteQuotaQueries.
+func NewUniverseWriteQuotaStore(
+	db BatchedUniverseWriteQuotaQueries) *UniverseWriteQuotaStore {
+
+	return &UniverseWriteQuotaStore{
+		db: db,
+	}
+}
+
+// A compile-time assertion to ensure that UniverseWriteQuotaStore implements
+// the universe.WriteQuotaStore interface.
+var _ universe.WriteQuotaStore = (*UniverseWriteQuotaStore)(nil)
+
+// UpsertWriteQuota adds the issuer of the given quota to the write allowlist,
+// or updates the limits of an existing quota.
+//
+// NOTE: This is part of the universe.WriteQuotaStore interface.
+func (s *UniverseWriteQuotaStore) UpsertWriteQuota(ctx context.Context,
+	quota universe.WriteQuota) error {
+
+	if quota.IssuerKey == nil {
+		return fmt.Errorf("issuer key must be specified")
+	}
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q UniverseWriteQuotaQueries) error {
+		err := q.UpsertUniverseWriteQuota(ctx, NewUniverseWriteQuota{
+			IssuerKey: quota.IssuerKey.SerializeCompressed(),
+			MaxProofs: int64(quota.MaxProofs),
+			MaxBytes:  int64(quota.MaxBytes),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to upsert write quota: %w",
+				err)
+		}
+
+		return nil
+	})
+}
+
+// FetchWriteQuota returns the write quota of the given issuer.
+//
+// NOTE: This is part of the universe.WriteQuotaStore interface.
+func (s *UniverseWriteQuotaStore) FetchWriteQuota(ctx context.Context,
+	issuerKey *btcec.PublicKey) (*universe.WriteQuota, error) {
+
+	var quota *universe.WriteQuota
+	readTx := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readTx, func(q UniverseWriteQuotaQueries) error {
+		row, err := q.FetchUniverseWriteQuota(
+			ctx, issuerKey.SerializeCompressed(),
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoWriteQuota
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch write quota: %w",
+				err)
+		}
+
+		quota, err = parseWriteQuota(row)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return quota, nil
+}
+
+// ListWriteQuotas returns the write quotas of all issuers on the allowlist.
+//
+// NOTE: This is part of the universe.WriteQuotaStore interface.
+func (s *UniverseWriteQuotaStore) ListWriteQuotas(
+	ctx context.Context) ([]universe.WriteQuota, error) {
+
+	var quotas []universe.WriteQuota
+	readTx := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readTx, func(q UniverseWriteQuotaQueries) error {
+		rows, err := q.ListUniverseWriteQuotas(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to list write quotas: %w",
+				err)
+		}
+
+		quotas = make([]universe.WriteQuota, 0, len(rows))
+		for _, row := range rows {
+			quota, err := parseWriteQuota(row)
+			if err != nil {
+				return err
+			}
+
+			quotas = append(quotas, *quota)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return quotas, nil
+}
+
+// DeleteWriteQuota removes the given issuer from the write allowlist.
+//
+// NOTE: This is part of the universe.WriteQuotaStore interface.
+func (s *UniverseWriteQuotaStore) DeleteWriteQuota(ctx context.Context,
+	issuerKey *btcec.PublicKey) error {
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q UniverseWriteQuotaQueries) error {
+		numRows, err := q.DeleteUniverseWriteQuota(
+			ctx, issuerKey.SerializeCompressed(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete write quota: %w",
+				err)
+		}
+		if numRows == 0 {
+			return universe.ErrNoWriteQuota
+		}
+
+		return nil
+	})
+}
+
+// ConsumeWriteQuota atomically accounts a single proof of the given size
+// against the quota of the given issuer.
+//
+// NOTE: This is part of the universe.WriteQuotaStore interface.
+func (s *UniverseWriteQuotaStore) ConsumeWriteQuota(ctx context.Context,
+	issuerKey *btcec.PublicKey, numBytes uint64) error {
+
+	issuerKeyBytes := issuerKey.SerializeCompressed()
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q UniverseWriteQuotaQueries) error {
+		numRows, err := q.ConsumeUniverseWriteQuota(
+			ctx, ConsumeWriteQuotaParams{
+				NumBytes:  int64(numBytes),
+				IssuerKey: issuerKeyBytes,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to consume write quota: %w",
+				err)
+		}
+		if numRows != 0 {
+			return nil
+		}
+
+		// No row was updated, either because the issuer isn't on the
+		// allowlist or because the proof would exceed its quota.
+		_, err = q.FetchUniverseWriteQuota(ctx, issuerKeyBytes)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoWriteQuota
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch write quota: %w",
+				err)
+		}
+
+		return universe.ErrWriteQuotaExceeded
+	})
+}
+
+// parseWriteQuota parses a write quota from the given database row.
+func parseWriteQuota(row UniverseWriteQuotaRow) (*universe.WriteQuota, error) {
+	issuerKey, err := btcec.ParsePubKey(row.IssuerKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse issuer key: %w", err)
+	}
+
+	return &universe.WriteQuota{
+		IssuerKey:  issuerKey,
+		MaxProofs:  uint64(row.MaxProofs),
+		MaxBytes:   uint64(row.MaxBytes),
+		UsedProofs: uint64(row.UsedProofs),
+		UsedBytes:  uint64(row.UsedBytes),
+	}, nil
+}
@@ -0,0 +1,116 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+// newUniverseWriteQuotaStore creates a new instance of UniverseWriteQuotaStore
+// for testing.
+func newUniverseWriteQuotaStore(t *testing.T) *UniverseWriteQuotaStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) UniverseWriteQuotaQueries {
+		return db.WithTx(tx)
+	}
+
+	quotaTx := NewTransactionExecutor(db, txCreator)
+	return NewUniverseWriteQuotaStore(quotaTx)
+}
+
+// TestUniverseWriteQuotaStore tests that write quotas can be managed and are
+// enforced when consumed.
+func TestUniverseWriteQuotaStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newUniverseWriteQuotaStore(t)
+
+	issuer1 := test.RandPubKey(t)
+	issuer2 := test.RandPubKey(t)
+
+	// An issuer that isn't on the allowlist has no quota.
+	_, err := store.FetchWriteQuota(ctx, issuer1)
+	require.ErrorIs(t, err, universe.ErrNoWriteQuota)
+	err = store.ConsumeWriteQuota(ctx, issuer1, 100)
+	require.ErrorIs(t, err, universe.ErrNoWriteQuota)
+	err = store.DeleteWriteQuota(ctx, issuer1)
+	require.ErrorIs(t, err, universe.ErrNoWriteQuota)
+
+	// The first issuer may insert two proofs of at most 1000 bytes in
+	// total, the second one has an unlimited quota.
+	require.NoError(t, store.UpsertWriteQuota(ctx, universe.WriteQuota{
+		IssuerKey: issuer1,
+		MaxProofs: 2,
+		MaxBytes:  1000,
+	}))
+	require.NoError(t, store.UpsertWriteQuota(ctx, universe.WriteQuota{
+		IssuerKey: issuer2,
+	}))
+
+	require.NoError(t, store.ConsumeWriteQuota(ctx, issuer1, 600))
+
+	// A proof that exceeds the byte limit is rejected without changing
+	// the usage.
+	err = store.ConsumeWriteQuota(ctx, issuer1, 500)
+	require.ErrorIs(t, err, universe.ErrWriteQuotaExceeded)
+
+	require.NoError(t, store.ConsumeWriteQuota(ctx, issuer1, 400))
+
+	// The proof count limit is reached now.
+	err = store.ConsumeWriteQuota(ctx, issuer1, 0)
+	require.ErrorIs(t, err, universe.ErrWriteQuotaExceeded)
+
+	quota, err := store.FetchWriteQuota(ctx, issuer1)
+	require.NoError(t, err)
+	require.True(t, issuer1.IsEqual(quota.IssuerKey))
+	require.EqualValues(t, 2, quota.UsedProofs)
+	require.EqualValues(t, 1000, quota.UsedBytes)
+	require.False(t, quota.Allows(0))
+
+	// Raising the limits keeps the usage, so the issuer may insert
+	// another proof.
+	require.NoError(t, store.UpsertWriteQuota(ctx, universe.WriteQuota{
+		IssuerKey: issuer1,
+		MaxProofs: 3,
+		MaxBytes:  2000,
+	}))
+	require.NoError(t, store.ConsumeWriteQuota(ctx, issuer1, 1000))
+
+	quota, err = store.FetchWriteQuota(ctx, issuer1)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, quota.UsedProofs)
+	require.EqualValues(t, 2000, quota.UsedBytes)
+
+	// An unlimited quota only tracks the usage.
+	for range 5 {
+		require.NoError(t, store.ConsumeWriteQuota(ctx, issuer2, 1<<20))
+	}
+
+	quotas, err := store.ListWriteQuotas(ctx)
+	require.NoError(t, err)
+	require.Len(t, quotas, 2)
+
+	for _, quota := range quotas {
+		if !quota.IssuerKey.IsEqual(issuer2) {
+			continue
+		}
+
+		require.EqualValues(t, 5, quota.UsedProofs)
+		require.EqualValues(t, 5<<20, quota.UsedBytes)
+	}
+
+	// Deleting a quota removes the issuer from the allowlist.
+	require.NoError(t, store.DeleteWriteQuota(ctx, issuer1))
+	err = store.ConsumeWriteQuota(ctx, issuer1, 1)
+	require.ErrorIs(t, err, universe.ErrNoWriteQuota)
+
+	quotas, err = store.ListWriteQuotas(ctx)
+	require.NoError(t, err)
+	require.Len(t, quotas, 1)
+}
@@ -0,0 +1,172 @@
+package tapdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewVerifiedProof is used to insert a new entry into the proof
+	// verification cache.
+	NewVerifiedProof = sqlc.InsertVerifiedProofParams
+
+	// VerifiedProofRow is a row in the verified proofs table.
+	VerifiedProofRow = sqlc.VerifiedProof
+)
+
+// VerifiedProofQueries is the set of queries that are needed to maintain the
+// proof verification cache.
+type VerifiedProofQueries interface {
+	// InsertVerifiedProof inserts a new verified proof, ignoring entries
+	// that already exist.
+	InsertVerifiedProof(ctx context.Context, arg NewVerifiedProof) error
+
+	// FetchVerifiedProofs fetches the verified proofs with the given
+	// chained proof hashes.
+	FetchVerifiedProofs(ctx context.Context,
+		proofHashes [][]byte) ([]VerifiedProofRow, error)
+
+	// DeleteVerifiedProofsFromHeight deletes all verified proofs that are
+	// anchored at or above the given block height and returns the number
+	// of deleted rows.
+	DeleteVerifiedProofsFromHeight(ctx context.Context,
+		blockHeight int32) (int64, error)
+}
+
+// BatchedVerifiedProofQueries is a version of the VerifiedProofQueries that's
+// capable of batched database operations.
+type BatchedVerifiedProofQueries interface {
+	VerifiedProofQueries
+
+	BatchedTx[VerifiedProofQueries]
+}
+
+// VerifiedProofStore is the database backed implementation of the
+// proof.VerificationCache interface.
+type VerifiedProofStore struct {
+	db BatchedVerifiedProofQueries
+
+	clock clock.Clock
+}
+
+// NewVerifiedProofStore creates a new VerifiedProofStore instance given an
+// open BatchedVerifiedProofQueries.
+func NewVerifiedProofStore(db BatchedVerifiedProofQueries,
+	clock clock.Clock) *VerifiedProofStore {
+
+	return &VerifiedProofStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// A compile-time assertion to ensure that VerifiedProofStore implements the
+// proof.VerificationCache interface.
+var _ proof.VerificationCache = (*VerifiedProofStore)(nil)
+
+// FetchVerified returns the cache entries of the given chained proof hashes.
+// Hashes that aren't known to the cache are omitted from the returned map.
+//
+// NOTE: This is part of the proof.VerificationCache interface.
+func (s *VerifiedProofStore) FetchVerified(ctx context.Context,
+	proofHashes ...[sha256.Size]byte) (
+	map[[sha256.Size]byte]proof.VerifiedProof, error) {
+
+	entries := make(map[[sha256.Size]byte]proof.VerifiedProof)
+	if len(proofHashes) == 0 {
+		return entries, nil
+	}
+
+	hashes := fn.Map(proofHashes, fn.ByteSlice[[sha256.Size]byte])
+
+	readOpts := ReadTxOption()
+	dbErr := s.db.ExecTx(ctx, readOpts, func(q VerifiedProofQueries) error {
+		rows, err := q.FetchVerifiedProofs(ctx, hashes)
+		if err != nil {
+			return fmt.Errorf("unable to fetch verified proofs: %w",
+				err)
+		}
+
+		for _, row := range rows {
+			var proofHash [sha256.Size]byte
+			copy(proofHash[:], row.ProofHash)
+
+			blockHash, err := chainhash.NewHash(row.BlockHash)
+			if err != nil {
+				return fmt.Errorf("invalid block hash: %w",
+					err)
+			}
+
+			entries[proofHash] = proof.VerifiedProof{
+				ProofHash:   proofHash,
+				BlockHash:   *blockHash,
+				BlockHeight: uint32(row.BlockHeight),
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return entries, nil
+}
+
+// AddVerified adds the given proofs to the cache.
+//
+// NOTE: This is part of the proof.VerificationCache interface.
+func (s *VerifiedProofStore) AddVerified(ctx context.Context,
+	proofs ...proof.VerifiedProof) error {
+
+	verifiedAt := s.clock.Now().UTC()
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q VerifiedProofQueries) error {
+		for _, p := range proofs {
+			err := q.InsertVerifiedProof(ctx, NewVerifiedProof{
+				ProofHash:   fn.ByteSlice(p.ProofHash),
+				BlockHash:   fn.ByteSlice(p.BlockHash),
+				BlockHeight: int32(p.BlockHeight),
+				VerifiedAt:  verifiedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert verified "+
+					"proof: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// InvalidateFromHeight removes all entries of proofs that are anchored in a
+// block at or above the given height.
+//
+// NOTE: This is part of the proof.VerificationCache interface.
+func (s *VerifiedProofStore) InvalidateFromHeight(ctx context.Context,
+	height uint32) error {
+
+	txOpt := WriteTxOption()
+	return s.db.ExecTx(ctx, txOpt, func(q VerifiedProofQueries) error {
+		numDeleted, err := q.DeleteVerifiedProofsFromHeight(
+			ctx, int32(height),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete verified proofs: "+
+				"%w", err)
+		}
+
+		log.Debugf("Invalidated %d verified proofs anchored at or "+
+			"above height %d", numDeleted, height)
+
+		return nil
+	})
+}
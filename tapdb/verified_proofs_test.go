@@ -0,0 +1,83 @@
+package tapdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// newVerifiedProofStore creates a new instance of VerifiedProofStore for
+// testing.
+func newVerifiedProofStore(t *testing.T) *VerifiedProofStore {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) VerifiedProofQueries {
+		return db.WithTx(tx)
+	}
+
+	cacheTx := NewTransactionExecutor(db, txCreator)
+	return NewVerifiedProofStore(cacheTx, clock.NewDefaultClock())
+}
+
+// randVerifiedProof returns a random verified proof anchored at the given
+// height.
+func randVerifiedProof(height uint32) proof.VerifiedProof {
+	return proof.VerifiedProof{
+		ProofHash:   test.RandHash(),
+		BlockHash:   test.RandHash(),
+		BlockHeight: height,
+	}
+}
+
+// TestVerifiedProofStore tests that verified proofs can be added to, looked
+// up in and invalidated from the proof verification cache.
+func TestVerifiedProofStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newVerifiedProofStore(t)
+
+	proof1 := randVerifiedProof(100)
+	proof2 := randVerifiedProof(200)
+	proof3 := randVerifiedProof(300)
+	unknownHash := test.RandHash()
+
+	// An empty cache doesn't know any proofs.
+	entries, err := store.FetchVerified(ctx, proof1.ProofHash)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, store.AddVerified(ctx, proof1, proof2, proof3))
+
+	// Adding the same proof twice is not an error.
+	require.NoError(t, store.AddVerified(ctx, proof2))
+
+	entries, err = store.FetchVerified(
+		ctx, proof1.ProofHash, proof2.ProofHash, proof3.ProofHash,
+		unknownHash,
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[[sha256.Size]byte]proof.VerifiedProof{
+		proof1.ProofHash: proof1,
+		proof2.ProofHash: proof2,
+		proof3.ProofHash: proof3,
+	}, entries)
+
+	// A re-org at height 200 invalidates all proofs anchored at or above
+	// that height.
+	require.NoError(t, store.InvalidateFromHeight(ctx, 200))
+
+	entries, err = store.FetchVerified(
+		ctx, proof1.ProofHash, proof2.ProofHash, proof3.ProofHash,
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[[sha256.Size]byte]proof.VerifiedProof{
+		proof1.ProofHash: proof1,
+	}, entries)
+}
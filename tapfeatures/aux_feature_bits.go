@@ -18,6 +18,14 @@ const (
 	// STXOOptional is a feature bit that declares the STXO proofs as an
 	// optional feature.
 	STXOOptional lnwire.FeatureBit = 3
+
+	// ForwardFeesRequired is a feature bit that declares asset forwarding
+	// fees in RFQ quotes as a required feature.
+	ForwardFeesRequired lnwire.FeatureBit = 4
+
+	// ForwardFeesOptional is a feature bit that declares asset forwarding
+	// fees in RFQ quotes as an optional feature.
+	ForwardFeesOptional lnwire.FeatureBit = 5
 )
 
 // featureNames keeps track of the string description of known features.
@@ -26,6 +34,9 @@ var featureNames = map[lnwire.FeatureBit]string{
 	NoOpHTLCsOptional: "noop-htlcs",
 	STXORequired:      "stxo-proofs",
 	STXOOptional:      "stxo-proofs",
+
+	ForwardFeesRequired: "asset-forward-fees",
+	ForwardFeesOptional: "asset-forward-fees",
 }
 
 // ourFeatures returns a slice containing all of the locally supported features.
@@ -35,6 +46,7 @@ func ourFeatures() []lnwire.FeatureBit {
 	return []lnwire.FeatureBit{
 		NoOpHTLCsOptional,
 		STXOOptional,
+		ForwardFeesOptional,
 	}
 }
 
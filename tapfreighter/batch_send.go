@@ -0,0 +1,154 @@
+package tapfreighter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// DefaultMaxBatchRecipients is the default maximum number of
+	// recipients that are paid in a single anchor transaction of a batch
+	// send.
+	DefaultMaxBatchRecipients = 100
+
+	// MaxBatchRecipients is the maximum number of recipients that can be
+	// paid in a single anchor transaction of a batch send. Each recipient
+	// creates its own P2TR anchor output of 172 weight units, so this
+	// keeps the anchor transaction well below the standard transaction
+	// weight limit of 400k weight units, leaving enough room for the
+	// inputs.
+	MaxBatchRecipients = 1_000
+
+	// BatchIDSize is the size of a batch ID in bytes.
+	BatchIDSize = 8
+)
+
+// NewBatchID returns a new random batch ID. The batch ID is part of the label
+// of every chunk of a batch send, so the transfers of a batch can't be
+// confused with the transfers of another batch that uses the same label.
+func NewBatchID() (string, error) {
+	var batchID [BatchIDSize]byte
+	if _, err := rand.Read(batchID[:]); err != nil {
+		return "", fmt.Errorf("unable to create batch ID: %w", err)
+	}
+
+	return hex.EncodeToString(batchID[:]), nil
+}
+
+// ValidateBatchID makes sure the given batch ID is a hex encoded string of
+// BatchIDSize bytes.
+func ValidateBatchID(batchID string) error {
+	decoded, err := hex.DecodeString(batchID)
+	if err != nil || len(decoded) != BatchIDSize {
+		return fmt.Errorf("batch ID must be %d hex encoded bytes",
+			BatchIDSize)
+	}
+
+	return nil
+}
+
+// BatchChunkLabel returns the label of the transfer of the given chunk of a
+// batch send.
+func BatchChunkLabel(label, batchID string, number int) string {
+	return fmt.Sprintf("%s-%s-%d", label, batchID, number)
+}
+
+// BatchRecipient is a single recipient of a batch send.
+type BatchRecipient struct {
+	// TapAddr is the encoded address of the recipient.
+	TapAddr string
+
+	// Addr is the decoded address of the recipient.
+	Addr *address.Tap
+
+	// Amount is the amount to send to the recipient. This is only set for
+	// V2 addresses that don't specify an amount themselves.
+	Amount uint64
+}
+
+// SendAmount returns the amount of asset units the recipient receives.
+func (r *BatchRecipient) SendAmount() uint64 {
+	if r.Amount > 0 {
+		return r.Amount
+	}
+
+	return r.Addr.Amount
+}
+
+// assetSpecifier returns the specifier of the asset the recipient receives.
+// All recipients of a single transfer must receive the same asset ID and
+// group key.
+func (r *BatchRecipient) assetSpecifier() asset.Specifier {
+	return asset.NewSpecifierOptionalGroupPubKey(
+		r.Addr.AssetID, r.Addr.GroupKey,
+	)
+}
+
+// BatchChunk is a set of recipients of a batch send that is paid in a single
+// anchor transaction.
+type BatchChunk struct {
+	// Number is the number of the chunk within the batch, starting at 1.
+	Number int
+
+	// Label is the label of the chunk's transfer.
+	Label string
+
+	// Recipients are the recipients of the chunk.
+	Recipients []*BatchRecipient
+}
+
+// ChunkBatchRecipients groups the given recipients by asset ID and group key
+// and splits them into chunks of at most maxRecipients recipients. The chunks
+// are labeled '<label>-<batch ID>-<chunk number>', starting with chunk number
+// 1.
+func ChunkBatchRecipients(recipients []*BatchRecipient, maxRecipients int,
+	label, batchID string) ([]*BatchChunk, error) {
+
+	if err := ValidateBatchID(batchID); err != nil {
+		return nil, err
+	}
+
+	if maxRecipients < 1 || maxRecipients > MaxBatchRecipients {
+		return nil, fmt.Errorf("max recipients must be between 1 and "+
+			"%d", MaxBatchRecipients)
+	}
+
+	var (
+		specifiers []asset.Specifier
+		byAsset    = make(map[asset.Specifier][]*BatchRecipient)
+	)
+	for _, recipient := range recipients {
+		spec := recipient.assetSpecifier()
+		if _, ok := byAsset[spec]; !ok {
+			specifiers = append(specifiers, spec)
+		}
+
+		byAsset[spec] = append(byAsset[spec], recipient)
+	}
+
+	var chunks []*BatchChunk
+	for _, spec := range specifiers {
+		assetRecipients := byAsset[spec]
+		for len(assetRecipients) > 0 {
+			numRecipients := min(
+				len(assetRecipients), maxRecipients,
+			)
+
+			number := len(chunks) + 1
+			chunks = append(chunks, &BatchChunk{
+				Number: number,
+				Label: BatchChunkLabel(
+					label, batchID, number,
+				),
+				Recipients: assetRecipients[:numRecipients],
+			})
+			assetRecipients = assetRecipients[numRecipients:]
+		}
+	}
+
+	return chunks, nil
+}
@@ -0,0 +1,128 @@
+package tapfreighter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChunkBatchRecipients tests that batch send recipients are grouped by
+// asset and split into labeled chunks.
+func TestChunkBatchRecipients(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assetA    = asset.RandID(t)
+		assetB    = asset.RandID(t)
+		groupKey  = test.RandPubKey(t)
+		assetInGr = asset.RandID(t)
+	)
+
+	var recipients []*BatchRecipient
+	for i := 0; i < 5; i++ {
+		recipients = append(recipients, &BatchRecipient{
+			TapAddr: fmt.Sprintf("a%d", i),
+			Addr: &address.Tap{
+				AssetID: assetA,
+			},
+		}, &BatchRecipient{
+			TapAddr: fmt.Sprintf("b%d", i),
+			Addr: &address.Tap{
+				AssetID: assetB,
+			},
+		})
+	}
+
+	// Addresses of the same group are only sent together if they also
+	// specify the same asset ID.
+	for i := 0; i < 2; i++ {
+		recipients = append(recipients, &BatchRecipient{
+			TapAddr: fmt.Sprintf("g%d", i),
+			Addr: &address.Tap{
+				AssetID:  assetInGr,
+				GroupKey: groupKey,
+			},
+		})
+	}
+	recipients = append(recipients, &BatchRecipient{
+		TapAddr: "h0",
+		Addr: &address.Tap{
+			AssetID:  asset.RandID(t),
+			GroupKey: groupKey,
+		},
+	})
+
+	const batchID = "0011223344556677"
+	chunks, err := ChunkBatchRecipients(recipients, 2, "payroll", batchID)
+	require.NoError(t, err)
+
+	var (
+		labels     []string
+		chunkAddrs [][]string
+	)
+	for idx, chunk := range chunks {
+		require.Equal(t, idx+1, chunk.Number)
+
+		var addrs []string
+		for _, recipient := range chunk.Recipients {
+			addrs = append(addrs, recipient.TapAddr)
+		}
+
+		labels = append(labels, chunk.Label)
+		chunkAddrs = append(chunkAddrs, addrs)
+	}
+
+	require.Equal(t, []string{
+		"payroll-0011223344556677-1", "payroll-0011223344556677-2",
+		"payroll-0011223344556677-3", "payroll-0011223344556677-4",
+		"payroll-0011223344556677-5", "payroll-0011223344556677-6",
+		"payroll-0011223344556677-7", "payroll-0011223344556677-8",
+	}, labels)
+	require.Equal(t, [][]string{
+		{"a0", "a1"}, {"a2", "a3"}, {"a4"},
+		{"b0", "b1"}, {"b2", "b3"}, {"b4"},
+		{"g0", "g1"}, {"h0"},
+	}, chunkAddrs)
+
+	_, err = ChunkBatchRecipients(recipients, 0, "payroll", batchID)
+	require.ErrorContains(t, err, "max recipients")
+
+	_, err = ChunkBatchRecipients(
+		recipients, MaxBatchRecipients+1, "payroll", batchID,
+	)
+	require.ErrorContains(t, err, "max recipients")
+
+	_, err = ChunkBatchRecipients(recipients, 2, "payroll", "")
+	require.ErrorContains(t, err, "batch ID")
+
+	_, err = ChunkBatchRecipients(recipients, 2, "payroll", "zz")
+	require.ErrorContains(t, err, "batch ID")
+
+	// A new batch ID is always valid.
+	newID, err := NewBatchID()
+	require.NoError(t, err)
+	require.NoError(t, ValidateBatchID(newID))
+}
+
+// TestBatchRecipientSendAmount tests that the amount of a V2 address without
+// an amount is taken from the recipient.
+func TestBatchRecipientSendAmount(t *testing.T) {
+	t.Parallel()
+
+	withAmount := &BatchRecipient{
+		Addr: &address.Tap{
+			Amount: 10,
+		},
+	}
+	require.EqualValues(t, 10, withAmount.SendAmount())
+
+	withoutAmount := &BatchRecipient{
+		Addr:   &address.Tap{},
+		Amount: 20,
+	}
+	require.EqualValues(t, 20, withoutAmount.SendAmount())
+}
@@ -148,6 +148,9 @@ type ChainPorter struct {
 	// subscriberMtx guards the subscribers map.
 	subscriberMtx sync.Mutex
 
+	// metrics keeps track of the parcels processed by the porter.
+	metrics *porterMetrics
+
 	*fn.ContextGuard
 }
 
@@ -161,6 +164,7 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		cfg:             cfg,
 		outboundParcels: make(chan Parcel),
 		subscribers:     subscribers,
+		metrics:         newPorterMetrics(),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -360,10 +364,45 @@ func (p *ChainPorter) mainEventLoop() {
 	}
 }
 
+// ParcelStateError is the error the ChainPorter returns for a parcel that
+// failed to advance past one of the states of the send state machine.
+type ParcelStateError struct {
+	// State is the state the parcel failed to advance past.
+	State SendState
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message of the underlying error.
+func (e *ParcelStateError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ParcelStateError) Unwrap() error {
+	return e.Err
+}
+
+// TransferLogged returns true if the transfer of the parcel was already
+// written to disk when the error occurred. Such a transfer is resumed by the
+// porter on the next start, so the send must not be attempted again.
+func (e *ParcelStateError) TransferLogged() bool {
+	return e.State >= SendStateBroadcast
+}
+
 // advanceState advances the state machine.
 //
 // NOTE: This method MUST be called as a goroutine.
 func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
+	// Keep track of the state the parcel is in, so the number of parcels
+	// per state can be reported.
+	trackedState := pkg.SendState
+	p.metrics.enterState(trackedState)
+	defer func() {
+		p.metrics.leaveState(trackedState)
+	}()
+
 	// Continue state transitions whilst state complete has not yet
 	// been reached.
 	for pkg.SendState <= SendStateComplete {
@@ -382,9 +421,13 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 		stateToExecute := pkg.SendState
 		updatedPkg, err := p.stateStep(*pkg)
 		if err != nil {
-			kit.errChan <- err
+			kit.errChan <- &ParcelStateError{
+				State: stateToExecute,
+				Err:   err,
+			}
 			log.Errorf("Error evaluating state (%v): %v",
 				pkg.SendState, err)
+			p.metrics.parcelDone(false)
 
 			p.publishSubscriberEvent(newAssetSendErrorEvent(
 				err, stateToExecute, *pkg,
@@ -405,11 +448,18 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 			log.Infof("ChainPorter completed state machine for "+
 				"parcel (anchor_txid=%v)",
 				updatedPkg.OutboundPkg.AnchorTx.TxHash())
+			p.metrics.parcelDone(true)
 
 			return
 		}
 
 		pkg = updatedPkg
+
+		if pkg.SendState != trackedState {
+			p.metrics.leaveState(trackedState)
+			trackedState = pkg.SendState
+			p.metrics.enterState(trackedState)
+		}
 	}
 }
 
@@ -448,6 +498,12 @@ func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
 		)
 		pkg.OutboundPkg.AnchorTxBlockHeight = confEvent.BlockHeight
 
+		// We only know the time of the broadcast if it happened since
+		// the last restart.
+		if !pkg.BroadcastTime.IsZero() {
+			p.metrics.confirmed(time.Since(pkg.BroadcastTime))
+		}
+
 		pkg.SendState = SendStateStorePostAnchorTxConf
 
 	case err := <-errChan:
@@ -566,6 +622,7 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		map[OutputIdentifier]*proof.AnnotatedProof,
 		len(parcel.Outputs),
 	)
+	outputProofs := make([]*proof.Proof, 0, len(parcel.Outputs))
 	for idx := range parcel.Outputs {
 		out := parcel.Outputs[idx]
 
@@ -604,6 +661,7 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 				"output %d: %w", idx, err)
 		}
 		sendPkg.FinalProofs[outKey] = outputProof
+		outputProofs = append(outputProofs, parsedSuffix)
 
 		vCtx := proof.VerifierCtx{
 			HeaderVerifier: headerVerifier,
@@ -700,10 +758,56 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		}
 	}
 
+	// We also watch the transfer as a whole, so we can notify the send
+	// event subscribers if its anchor transaction is evicted from the
+	// chain by a re-org and once it confirms again. The proofs themselves
+	// are updated by the watch registrations above.
+	if len(outputProofs) > 0 {
+		onUpdate, onEviction := p.reOrgCallbacks(*sendPkg)
+		err := p.cfg.ProofWatcher.WatchProofs(
+			outputProofs, onUpdate, onEviction,
+		)
+		if err != nil {
+			return fmt.Errorf("error watching transfer: %w", err)
+		}
+	}
+
 	sendPkg.SendState = SendStateTransferProofs
 	return nil
 }
 
+// reOrgCallbacks returns the proof update callback and the eviction callback
+// watch option that notify the send event subscribers about a re-org that
+// affected the anchor transaction of the given transfer.
+func (p *ChainPorter) reOrgCallbacks(
+	pkg sendPackage) (proof.UpdateCallback, proof.WatchOption) {
+
+	onUpdate := func([]*proof.Proof) error {
+		log.Infof("Anchor TX of transfer %v confirmed again after "+
+			"re-org", pkg.OutboundPkg.AnchorTx.TxHash())
+
+		p.publishSubscriberEvent(newReOrgSendEvent(
+			SendStateAnchorTxReConfirmed, SendStateComplete, pkg,
+		))
+
+		return nil
+	}
+
+	onEviction := func([]*proof.Proof) error {
+		log.Warnf("Anchor TX of transfer %v was evicted from the "+
+			"chain by a re-org", pkg.OutboundPkg.AnchorTx.TxHash())
+
+		p.publishSubscriberEvent(newReOrgSendEvent(
+			SendStateAnchorTxEvicted, SendStateAnchorTxReConfirmed,
+			pkg,
+		))
+
+		return nil
+	}
+
+	return onUpdate, proof.WithEvictionCallback(onEviction)
+}
+
 // sendBurnSupplyCommitEvents sends supply commitment events for all burned
 // assets to track them in the supply commitment state machine.
 func (p *ChainPorter) sendBurnSupplyCommitEvents(ctx context.Context,
@@ -1487,7 +1591,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		wallet := p.cfg.AssetWallet
 		fundSendRes, err := wallet.FundAddressSend(
 			ctx, fn.Some(asset.ScriptKeyBip86), nil,
-			addrParcel.destAddrs...,
+			addrParcel.coinSelectStrategy, addrParcel.destAddrs...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fund address send: "+
@@ -1864,6 +1968,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 
 		// Set send state to the next state to evaluate.
+		currentPkg.BroadcastTime = time.Now()
 		currentPkg.SendState = SendStateWaitTxConf
 		return &currentPkg, nil
 
@@ -2021,6 +2126,11 @@ func logPacket(vPkt *tappsbt.VPacket, action string) {
 		receiverScriptKey.SerializeCompressed())
 }
 
+// Stats returns a snapshot of the chain porter's metrics.
+func (p *ChainPorter) Stats() PorterStats {
+	return p.metrics.snapshot()
+}
+
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
 // be notified of any new events that are broadcast.
 //
@@ -2186,6 +2296,17 @@ func newAssetSendEvent(executedState SendState,
 	return newSendEvent
 }
 
+// newReOrgSendEvent creates a new AssetSendEvent for a completed transfer whose
+// anchor transaction was affected by a re-org.
+func newReOrgSendEvent(state, nextState SendState,
+	pkg sendPackage) *AssetSendEvent {
+
+	newSendEvent := newAssetSendEvent(state, pkg)
+	newSendEvent.NextSendState = nextState
+
+	return newSendEvent
+}
+
 // newAssetSendErrorEvent creates a new AssetSendEvent with an error.
 func newAssetSendErrorEvent(err error, executedState SendState,
 	pkg sendPackage) *AssetSendEvent {
@@ -12,6 +12,13 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 )
 
+const (
+	// maxBranchAndBoundTries is the maximum number of branches the
+	// BranchAndBound coin selection strategy explores before settling on
+	// the best subset found so far.
+	maxBranchAndBoundTries = 100_000
+)
+
 // NewCoinSelect creates a new CoinSelect.
 func NewCoinSelect(coinLister CoinLister) *CoinSelect {
 	return &CoinSelect{
@@ -45,6 +52,48 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 	s.coinLock.Lock()
 	defer s.coinLock.Unlock()
 
+	compatibleCommitments, err := s.listCompatibleCoins(
+		ctx, constraints, maxVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedCoins, err := s.selectForAmount(
+		constraints.MinAmt, compatibleCommitments, strategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to select coins: %w", err)
+	}
+
+	// We now need to lock/lease/reserve those selected coins so
+	// that they can't be used by other processes.
+	expiry := time.Now().Add(defaultCoinLeaseDuration)
+	coinOutPoints := fn.Map(
+		selectedCoins, func(c *AnchoredCommitment) wire.OutPoint {
+			return c.AnchorPoint
+		},
+	)
+	err = s.coinLister.LeaseCoins(
+		ctx, defaultWalletLeaseIdentifier, expiry, coinOutPoints...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lease coin: %w", err)
+	}
+
+	return selectedCoins, nil
+}
+
+// listCompatibleCoins returns all coins that satisfy the given constraints
+// (except for the minimum amount) and that are anchored in a commitment with
+// at most the given version. Expired leases are cleaned up first.
+//
+// NOTE: The caller must hold the coin lock.
+func (s *CoinSelect) listCompatibleCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxVersion commitment.TapCommitmentVersion) ([]*AnchoredCommitment,
+	error) {
+
 	// Before we select any coins, let's do some cleanup of expired leases.
 	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
 		return nil, fmt.Errorf("unable to delete expired leases: %w",
@@ -89,15 +138,46 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 			"version %v", ErrMatchingAssetsNotFound, maxVersion)
 	}
 
-	selectedCoins, err := s.selectForAmount(
-		constraints.MinAmt, compatibleCommitments, strategy,
+	return compatibleCommitments, nil
+}
+
+// SelectConsolidationCoins returns up to maxInputs of the smallest not yet
+// leased coins that satisfy the given constraints (except for the minimum
+// amount). At least two coins are required for a consolidation, otherwise
+// ErrNothingToConsolidate is returned. Unless dryRun is set, the coins
+// returned are leased for the default lease duration.
+func (s *CoinSelect) SelectConsolidationCoins(ctx context.Context,
+	constraints CommitmentConstraints, maxInputs int,
+	maxVersion commitment.TapCommitmentVersion,
+	dryRun bool) ([]*AnchoredCommitment, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	compatibleCommitments, err := s.listCompatibleCoins(
+		ctx, constraints, maxVersion,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to select coins: %w", err)
+		return nil, err
+	}
+
+	// We want to sweep up the smallest coins first, as those are the ones
+	// that are the most expensive to spend individually.
+	sortByAmount(compatibleCommitments, true)
+	selectedCoins := compatibleCommitments
+	if maxInputs > 0 && len(selectedCoins) > maxInputs {
+		selectedCoins = selectedCoins[:maxInputs]
+	}
+
+	if len(selectedCoins) < 2 {
+		return nil, fmt.Errorf("%w: found %d eligible coin(s)",
+			ErrNothingToConsolidate, len(selectedCoins))
+	}
+
+	if dryRun {
+		return selectedCoins, nil
 	}
 
-	// We now need to lock/lease/reserve those selected coins so
-	// that they can't be used by other processes.
 	expiry := time.Now().Add(defaultCoinLeaseDuration)
 	coinOutPoints := fn.Map(
 		selectedCoins, func(c *AnchoredCommitment) wire.OutPoint {
@@ -133,42 +213,37 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
 	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
-
 	switch strategy {
 	case PreferMaxAmount:
 		// Sort eligible commitments from the largest amount to
 		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
-		)
+		sortByAmount(eligibleCommitments, false)
 
 		// Select the first subset of eligible commitments which
 		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
-
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += anchoredCommitment.Asset.Amount
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
-			}
-		}
+		selectedCommitments = selectFirstSubset(
+			minTotalAmount, eligibleCommitments,
+		)
+
+	case PreferMinAmount:
+		// Sort eligible commitments from the smallest amount to
+		// largest.
+		sortByAmount(eligibleCommitments, true)
+
+		selectedCommitments = selectFirstSubset(
+			minTotalAmount, eligibleCommitments,
+		)
+
+	case BranchAndBound:
+		selectedCommitments = selectBranchAndBound(
+			minTotalAmount, eligibleCommitments,
+		)
+
+	case PrivacyAware:
+		selectedCommitments = selectPrivacyAware(
+			minTotalAmount, eligibleCommitments,
+		)
 
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
@@ -177,6 +252,7 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 
 	// Having examined all the eligible commitments, return an error if the
 	// minimal funding amount was not reached.
+	amountSum := sumAmounts(selectedCommitments)
 	if amountSum < minTotalAmount {
 		return nil, fmt.Errorf("%w: insufficient amount available, "+
 			"have %d, want %d", ErrMatchingAssetsNotFound,
@@ -185,6 +261,215 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	return selectedCommitments, nil
 }
 
+// sortByAmount sorts the given commitments by their asset amount. Commitments
+// with the same amount are ordered by their anchor outpoint to make the
+// selection deterministic.
+func sortByAmount(commitments []*AnchoredCommitment, ascending bool) {
+	sort.SliceStable(commitments, func(i, j int) bool {
+		a, b := commitments[i], commitments[j]
+		if a.Asset.Amount != b.Asset.Amount {
+			if ascending {
+				return a.Asset.Amount < b.Asset.Amount
+			}
+
+			return a.Asset.Amount > b.Asset.Amount
+		}
+
+		return a.AnchorPoint.String() < b.AnchorPoint.String()
+	})
+}
+
+// sumAmounts returns the total asset amount of the given commitments.
+func sumAmounts(commitments []*AnchoredCommitment) uint64 {
+	var sum uint64
+	for _, c := range commitments {
+		sum += c.Asset.Amount
+	}
+
+	return sum
+}
+
+// selectFirstSubset selects the commitments in the given order until they
+// cumulatively sum to at least the minimum required amount. If that amount is
+// never reached, all commitments are returned.
+func selectFirstSubset(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	var (
+		selected  []*AnchoredCommitment
+		amountSum uint64
+	)
+	for _, anchoredCommitment := range commitments {
+		selected = append(selected, anchoredCommitment)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			// At this point a target min amount was specified and
+			// has been reached.
+			break
+		}
+	}
+
+	return selected
+}
+
+// selectBranchAndBound performs a depth-first search over the inclusion and
+// exclusion branches of the commitments (sorted by descending amount) to find
+// the subset that reaches the target amount with the least excess. The search
+// is bounded by maxBranchAndBoundTries. If no subset reaches the target, the
+// result of the PreferMaxAmount strategy is returned, which in that case
+// contains all commitments and will be rejected by the caller.
+func selectBranchAndBound(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	sortByAmount(commitments, false)
+
+	// remaining[i] is the sum of all amounts from index i onward, which
+	// allows us to prune branches that can never reach the target.
+	remaining := make([]uint64, len(commitments)+1)
+	for i := len(commitments) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + commitments[i].Asset.Amount
+	}
+
+	var (
+		tries       int
+		bestExcess  uint64
+		bestSubset  []int
+		foundSubset bool
+		current     []int
+	)
+
+	var search func(idx int, sum uint64) bool
+	search = func(idx int, sum uint64) bool {
+		tries++
+		if tries > maxBranchAndBoundTries {
+			return true
+		}
+
+		if sum >= minTotalAmount {
+			excess := sum - minTotalAmount
+			isBetter := !foundSubset || excess < bestExcess ||
+				(excess == bestExcess &&
+					len(current) < len(bestSubset))
+
+			if isBetter {
+				foundSubset = true
+				bestExcess = excess
+				bestSubset = append([]int(nil), current...)
+			}
+
+			// Adding more commitments would only increase the
+			// excess, so there's no need to go deeper. An exact
+			// match can't be improved upon, so we stop entirely.
+			return excess == 0
+		}
+
+		// Prune this branch if the remaining commitments can't reach
+		// the target amount.
+		if idx >= len(commitments) ||
+			sum+remaining[idx] < minTotalAmount {
+
+			return false
+		}
+
+		// Explore the inclusion branch first, then the omission branch.
+		current = append(current, idx)
+		done := search(idx+1, sum+commitments[idx].Asset.Amount)
+		current = current[:len(current)-1]
+		if done {
+			return true
+		}
+
+		return search(idx+1, sum)
+	}
+	search(0, 0)
+
+	if !foundSubset {
+		return selectFirstSubset(minTotalAmount, commitments)
+	}
+
+	return fn.Map(bestSubset, func(idx int) *AnchoredCommitment {
+		return commitments[idx]
+	})
+}
+
+// selectPrivacyAware selects commitments while minimizing the number of
+// distinct anchor outpoints that are spent. Commitments sharing an anchor
+// outpoint are always spent together, as the anchor output needs to be spent
+// in its entirety anyway.
+func selectPrivacyAware(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	type anchorGroup struct {
+		anchorPoint wire.OutPoint
+		commitments []*AnchoredCommitment
+		amount      uint64
+	}
+
+	// Group the commitments by their anchor outpoint, keeping the order
+	// of the groups deterministic.
+	sortByAmount(commitments, false)
+	var (
+		groups     []*anchorGroup
+		groupIndex = make(map[wire.OutPoint]*anchorGroup)
+	)
+	for _, c := range commitments {
+		group, ok := groupIndex[c.AnchorPoint]
+		if !ok {
+			group = &anchorGroup{
+				anchorPoint: c.AnchorPoint,
+			}
+			groupIndex[c.AnchorPoint] = group
+			groups = append(groups, group)
+		}
+
+		group.commitments = append(group.commitments, c)
+		group.amount += c.Asset.Amount
+	}
+
+	// Order the groups from the largest to the smallest total amount.
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.amount != b.amount {
+			return a.amount > b.amount
+		}
+
+		return a.anchorPoint.String() < b.anchorPoint.String()
+	})
+
+	// If a single anchor output can cover the full amount, we pick the
+	// smallest one that does, which is the last sufficient group.
+	var singleGroup *anchorGroup
+	for _, group := range groups {
+		if group.amount < minTotalAmount {
+			break
+		}
+
+		singleGroup = group
+	}
+	if singleGroup != nil {
+		return singleGroup.commitments
+	}
+
+	// Otherwise, we select whole anchor outputs from the largest to the
+	// smallest, which results in the fewest number of anchor outputs
+	// being linked together.
+	var (
+		selected  []*AnchoredCommitment
+		amountSum uint64
+	)
+	for _, group := range groups {
+		selected = append(selected, group.commitments...)
+		amountSum += group.amount
+		if amountSum >= minTotalAmount {
+			break
+		}
+	}
+
+	return selected
+}
+
 // SelectOrphanCoins fetches all managed UTXOs that contain only
 // zero-value assets (tombstones and burns). The selected UTXOs are
 // leased for the default lease duration.
@@ -133,6 +133,36 @@ func TestCoinSelector(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// testCommitment creates an anchored commitment with the given amount that is
+// anchored at the output with the given index.
+func testCommitment(amount uint64, anchorIndex uint32) *AnchoredCommitment {
+	return &AnchoredCommitment{
+		AnchorPoint: wire.OutPoint{
+			Index: anchorIndex,
+		},
+		Asset: &asset.Asset{
+			Amount: amount,
+		},
+	}
+}
+
+// TestCoinSelectStrategyString tests that all coin selection strategies can
+// be parsed from their string representation.
+func TestCoinSelectStrategyString(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range []MultiCommitmentSelectStrategy{
+		PreferMaxAmount, PreferMinAmount, BranchAndBound, PrivacyAware,
+	} {
+		parsed, err := ParseCoinSelectStrategy(strategy.String())
+		require.NoError(t, err)
+		require.Equal(t, strategy, parsed)
+	}
+
+	_, err := ParseCoinSelectStrategy("unknown")
+	require.ErrorContains(t, err, "unknown coin selection strategy")
+}
+
 // TestCoinSelection tests that the coin selection logic behaves as expected.
 func TestCoinSelection(t *testing.T) {
 	t.Parallel()
@@ -228,6 +258,126 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+		// Test that when the PreferMinAmount strategy is employed the
+		// smallest commitments are selected first.
+		{
+			name:           "prefer min amount",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(2000, 0),
+				testCommitment(490, 1),
+				testCommitment(510, 2),
+				testCommitment(10, 3),
+			},
+			strategy: PreferMinAmount,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(10, 3),
+				testCommitment(490, 1),
+				testCommitment(510, 2),
+			},
+		},
+
+		// Test that the BranchAndBound strategy finds an exact match
+		// even if neither the largest nor the smallest commitments
+		// would result in one.
+		{
+			name:           "branch and bound exact match",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(2000, 0),
+				testCommitment(700, 1),
+				testCommitment(600, 2),
+				testCommitment(400, 3),
+				testCommitment(300, 4),
+			},
+			strategy: BranchAndBound,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(700, 1),
+				testCommitment(300, 4),
+			},
+		},
+
+		// Test that the BranchAndBound strategy prefers fewer inputs
+		// if there are multiple exact matches.
+		{
+			name:           "branch and bound fewest inputs",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(500, 0),
+				testCommitment(250, 1),
+				testCommitment(250, 2),
+				testCommitment(1000, 3),
+			},
+			strategy: BranchAndBound,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(1000, 3),
+			},
+		},
+
+		// Test that the BranchAndBound strategy selects the subset
+		// with the least excess if there is no exact match.
+		{
+			name:           "branch and bound least excess",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(1500, 0),
+				testCommitment(800, 1),
+				testCommitment(250, 2),
+			},
+			strategy: BranchAndBound,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(800, 1),
+				testCommitment(250, 2),
+			},
+		},
+		{
+			name:           "branch and bound not enough assets",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(500, 0),
+				testCommitment(400, 1),
+			},
+			strategy:    BranchAndBound,
+			expectedErr: ErrMatchingAssetsNotFound.Error(),
+		},
+
+		// Test that the PrivacyAware strategy selects the smallest
+		// single anchor output that covers the amount, including all
+		// commitments of that anchor output.
+		{
+			name:           "privacy aware single anchor",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(5000, 0),
+				testCommitment(600, 1),
+				testCommitment(500, 1),
+				testCommitment(900, 2),
+			},
+			strategy: PrivacyAware,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(600, 1),
+				testCommitment(500, 1),
+			},
+		},
+
+		// Test that the PrivacyAware strategy selects the fewest
+		// anchor outputs if no single one covers the amount.
+		{
+			name:           "privacy aware multiple anchors",
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				testCommitment(300, 0),
+				testCommitment(400, 1),
+				testCommitment(200, 2),
+				testCommitment(500, 2),
+			},
+			strategy: PrivacyAware,
+			expectedCommitments: []*AnchoredCommitment{
+				testCommitment(500, 2),
+				testCommitment(200, 2),
+				testCommitment(400, 1),
+			},
+		},
 		{
 			name:           "not enough assets",
 			minTotalAmount: 1000,
@@ -0,0 +1,367 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultConsolidationMaxInputs is the default maximum number of asset
+	// inputs that are merged in a single consolidation transaction.
+	DefaultConsolidationMaxInputs = 100
+)
+
+// ConsolidationRequest describes a request to merge many small outputs of an
+// asset into a single output.
+type ConsolidationRequest struct {
+	// AssetSpecifier is the asset ID or group key of the asset to
+	// consolidate. If a group key is given, the outputs of all tranches of
+	// the group are consolidated, resulting in one output per asset ID.
+	AssetSpecifier asset.Specifier
+
+	// MaxInputs is the maximum number of asset inputs that are merged in a
+	// single anchor transaction. If zero, DefaultConsolidationMaxInputs is
+	// used.
+	MaxInputs int
+
+	// MaxTransactions is the maximum number of anchor transactions that
+	// are created to consolidate the asset. If zero, a single transaction
+	// is created.
+	MaxTransactions int
+
+	// DryRun indicates that no transaction should be created. Instead, the
+	// expected number of inputs and outputs and the expected chain fee are
+	// reported.
+	DryRun bool
+
+	// Label is an optional label for the consolidation transfers.
+	Label string
+}
+
+// ConsolidationResult is the result of a consolidation or a consolidation dry
+// run.
+type ConsolidationResult struct {
+	// NumTransactions is the number of anchor transactions that were (or
+	// would be) created.
+	NumTransactions int
+
+	// NumInputs is the total number of asset inputs that were (or would
+	// be) merged.
+	NumInputs int
+
+	// NumAnchorInputs is the total number of distinct BTC anchor outputs
+	// that were (or would be) spent.
+	NumAnchorInputs int
+
+	// NumOutputs is the total number of asset outputs that were (or would
+	// be) created. This is one output per asset ID and transaction.
+	NumOutputs int
+
+	// TotalAmount is the total amount of asset units that were (or would
+	// be) consolidated.
+	TotalAmount uint64
+
+	// FeeRate is the fee rate used to estimate the chain fees.
+	FeeRate chainfee.SatPerKWeight
+
+	// ChainFees is the total chain fee of all anchor transactions. For a
+	// dry run, this is an estimate.
+	ChainFees btcutil.Amount
+
+	// Transfers are the outbound parcels of the consolidation
+	// transactions. This is empty for a dry run.
+	Transfers []*OutboundParcel
+}
+
+// ConsolidatorConfig is the main config for the Consolidator.
+type ConsolidatorConfig struct {
+	// CoinSelector is used to select the coins to consolidate.
+	CoinSelector CoinSelector
+
+	// AssetWallet is used to fund and sign the consolidation packets.
+	AssetWallet Wallet
+
+	// ChainPorter is used to anchor and broadcast the consolidation
+	// transfers.
+	ChainPorter Porter
+
+	// ChainBridge is used to estimate the chain fee rate.
+	ChainBridge ChainBridge
+
+	// Wallet is used to query the minimum relay fee rate.
+	Wallet WalletAnchor
+}
+
+// Consolidator merges many small outputs of the same asset into a single
+// output by spending them to the local node.
+type Consolidator struct {
+	cfg *ConsolidatorConfig
+}
+
+// NewConsolidator creates a new Consolidator from the given config.
+func NewConsolidator(cfg *ConsolidatorConfig) *Consolidator {
+	return &Consolidator{
+		cfg: cfg,
+	}
+}
+
+// Consolidate merges the smallest outputs of the requested asset into a
+// single output per asset ID, creating up to the requested number of anchor
+// transactions. If the request is a dry run, only the expected result is
+// computed. If creating a transaction other than the first one fails, the
+// result of the transactions that were already broadcast is returned together
+// with the error.
+func (c *Consolidator) Consolidate(ctx context.Context,
+	req *ConsolidationRequest) (*ConsolidationResult, error) {
+
+	if !req.AssetSpecifier.IsSome() {
+		return nil, fmt.Errorf("asset ID or group key must be set")
+	}
+
+	maxInputs := req.MaxInputs
+	if maxInputs == 0 {
+		maxInputs = DefaultConsolidationMaxInputs
+	}
+	if maxInputs < 2 {
+		return nil, fmt.Errorf("max inputs must be at least 2")
+	}
+
+	maxTransactions := req.MaxTransactions
+	if maxTransactions == 0 {
+		maxTransactions = 1
+	}
+	if maxTransactions < 0 {
+		return nil, fmt.Errorf("max transactions must not be negative")
+	}
+
+	feeRate, err := c.feeRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		return c.dryRun(ctx, req, maxInputs, maxTransactions, feeRate)
+	}
+
+	result := &ConsolidationResult{
+		FeeRate: feeRate,
+	}
+	for i := 0; i < maxTransactions; i++ {
+		parcel, err := c.consolidateOnce(ctx, req, maxInputs)
+
+		// If there is nothing left to consolidate after the first
+		// transaction, we're done.
+		if errors.Is(err, ErrNothingToConsolidate) && i > 0 {
+			break
+		}
+
+		// The transfers of the previous iterations were already
+		// broadcast, so we return them together with the error.
+		if err != nil && i > 0 {
+			log.Errorf("Consolidation of asset %s stopped after "+
+				"%d transaction(s): %v", &req.AssetSpecifier,
+				result.NumTransactions, err)
+
+			return result, err
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Transfers = append(result.Transfers, parcel)
+		result.NumTransactions++
+
+		anchorPoints := make(map[wire.OutPoint]struct{})
+		for _, in := range parcel.Inputs {
+			result.NumInputs++
+			result.TotalAmount += in.Amount
+			anchorPoints[in.PrevID.OutPoint] = struct{}{}
+		}
+		result.NumAnchorInputs += len(anchorPoints)
+
+		// Passive assets are re-anchored into the same output, so we
+		// only count the outputs that carry the consolidated asset.
+		for _, out := range parcel.Outputs {
+			if out.Amount > 0 {
+				result.NumOutputs++
+			}
+		}
+		result.ChainFees += btcutil.Amount(parcel.ChainFees)
+	}
+
+	log.Infof("Consolidated %d inputs of asset %s into %d outputs in %d "+
+		"transaction(s)", result.NumInputs, &req.AssetSpecifier,
+		result.NumOutputs, result.NumTransactions)
+
+	return result, nil
+}
+
+// consolidateOnce funds, signs and ships a single consolidation transfer.
+func (c *Consolidator) consolidateOnce(ctx context.Context,
+	req *ConsolidationRequest, maxInputs int) (*OutboundParcel, error) {
+
+	funded, err := c.cfg.AssetWallet.FundConsolidation(
+		ctx, req.AssetSpecifier, maxInputs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund consolidation: %w", err)
+	}
+
+	// Now that the coins are leased, we need to make sure to release them
+	// again if anything goes wrong before the porter takes over.
+	releaseCoins := func() {
+		var outpoints []wire.OutPoint
+		for prevID := range funded.InputCommitments {
+			outpoints = append(outpoints, prevID.OutPoint)
+		}
+		for _, z := range funded.ZeroValueInputs {
+			outpoints = append(outpoints, z.OutPoint)
+		}
+
+		err := c.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+		if err != nil {
+			log.Errorf("Unable to release coins: %v", err)
+		}
+	}
+
+	for _, vPkt := range funded.VPackets {
+		_, err := c.cfg.AssetWallet.SignVirtualPacket(ctx, vPkt)
+		if err != nil {
+			releaseCoins()
+			return nil, fmt.Errorf("unable to sign consolidation "+
+				"packet: %w", err)
+		}
+	}
+
+	parcel, err := c.cfg.ChainPorter.RequestShipment(NewPreSignedParcel(
+		funded.VPackets, funded.InputCommitments,
+		funded.ZeroValueInputs, req.Label,
+	))
+	if err != nil {
+		releaseCoins()
+		return nil, fmt.Errorf("unable to ship consolidation: %w", err)
+	}
+
+	return parcel, nil
+}
+
+// dryRun computes the expected result of a consolidation without leasing any
+// coins or creating any transactions.
+func (c *Consolidator) dryRun(ctx context.Context, req *ConsolidationRequest,
+	maxInputs, maxTransactions int,
+	feeRate chainfee.SatPerKWeight) (*ConsolidationResult, error) {
+
+	constraints := CommitmentConstraints{
+		AssetSpecifier:    req.AssetSpecifier,
+		ScriptKeyType:     fn.Some(asset.ScriptKeyBip86),
+		DistinctSpecifier: true,
+	}
+	coins, err := c.cfg.CoinSelector.SelectConsolidationCoins(
+		ctx, constraints, maxInputs*maxTransactions,
+		commitment.TapCommitmentV2, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConsolidationResult{
+		FeeRate: feeRate,
+	}
+	for start := 0; start < len(coins); start += maxInputs {
+		end := min(start+maxInputs, len(coins))
+
+		// A batch with a single coin can't be consolidated any further,
+		// which can only happen for the last batch.
+		batch := coins[start:end]
+		if len(batch) < 2 {
+			break
+		}
+
+		estimate := estimateConsolidation(batch, feeRate)
+		result.NumTransactions++
+		result.NumInputs += len(batch)
+		result.NumAnchorInputs += estimate.numAnchorInputs
+		result.NumOutputs += estimate.numOutputs
+		result.TotalAmount += estimate.totalAmount
+		result.ChainFees += estimate.fee
+	}
+
+	return result, nil
+}
+
+// feeRate returns the fee rate the chain porter would use for a pre-signed
+// parcel, which is the estimated fee rate for the default confirmation target
+// but at least the minimum relay fee rate.
+func (c *Consolidator) feeRate(
+	ctx context.Context) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := c.cfg.ChainBridge.EstimateFee(
+		ctx, tapsend.SendConfTarget,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	minRelayFee, err := c.cfg.Wallet.MinRelayFee(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to obtain min relay fee: %w", err)
+	}
+
+	return max(feeRate, minRelayFee), nil
+}
+
+// consolidationEstimate is the estimated outcome of consolidating a single
+// batch of coins in one anchor transaction.
+type consolidationEstimate struct {
+	numAnchorInputs int
+	numOutputs      int
+	totalAmount     uint64
+	fee             btcutil.Amount
+}
+
+// estimateConsolidation estimates the outcome of consolidating the given coins
+// in a single anchor transaction. The anchor transaction spends every distinct
+// anchor output once and creates one asset anchor output and one BTC change
+// output.
+func estimateConsolidation(coins []*AnchoredCommitment,
+	feeRate chainfee.SatPerKWeight) consolidationEstimate {
+
+	var (
+		estimate     consolidationEstimate
+		anchorPoints = make(map[wire.OutPoint]struct{})
+		assetIDs     = make(map[asset.ID]struct{})
+		inputScripts [][]byte
+	)
+	for _, coin := range coins {
+		estimate.totalAmount += coin.Asset.Amount
+		assetIDs[coin.Asset.ID()] = struct{}{}
+
+		if _, ok := anchorPoints[coin.AnchorPoint]; ok {
+			continue
+		}
+		anchorPoints[coin.AnchorPoint] = struct{}{}
+
+		// All asset anchor outputs are P2TR outputs, so the dummy
+		// script is sufficient for the estimation.
+		inputScripts = append(inputScripts, tapsend.GenesisDummyScript)
+	}
+
+	outputs := []*wire.TxOut{
+		tapsend.CreateDummyOutput(), tapsend.CreateDummyOutput(),
+	}
+	_, estimate.fee = tapscript.EstimateFee(inputScripts, outputs, feeRate)
+	estimate.numAnchorInputs = len(anchorPoints)
+	estimate.numOutputs = len(assetIDs)
+
+	return estimate
+}
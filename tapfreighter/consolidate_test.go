@@ -0,0 +1,158 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/tapsend"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// consolidationCoin creates an anchored commitment of the given asset with the
+// given amount that is anchored at the output with the given index.
+func consolidationCoin(t *testing.T, genesis asset.Genesis, amount uint64,
+	anchorIndex uint32) *AnchoredCommitment {
+
+	a := asset.RandAssetWithValues(
+		t, genesis, nil, asset.RandScriptKey(t),
+	)
+	a.Amount = amount
+
+	return &AnchoredCommitment{
+		AnchorPoint: wire.OutPoint{
+			Index: anchorIndex,
+		},
+		Commitment: &commitment.TapCommitment{
+			Version: commitment.TapCommitmentV2,
+		},
+		Asset: a,
+	}
+}
+
+// TestSelectConsolidationCoins tests that the smallest coins are selected for
+// a consolidation and that they are only leased if it's not a dry run.
+func TestSelectConsolidationCoins(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		genesis = asset.RandGenesis(t, asset.Normal)
+		coins   = []*AnchoredCommitment{
+			consolidationCoin(t, genesis, 500, 0),
+			consolidationCoin(t, genesis, 10, 1),
+			consolidationCoin(t, genesis, 300, 2),
+			consolidationCoin(t, genesis, 20, 3),
+		}
+		constraints = CommitmentConstraints{
+			AssetSpecifier: asset.NewSpecifierFromId(genesis.ID()),
+		}
+	)
+
+	// A dry run should select the smallest coins but not lease them.
+	coinLister := newMockCoinLister(coins)
+	coinSelect := NewCoinSelect(coinLister)
+	selected, err := coinSelect.SelectConsolidationCoins(
+		ctx, constraints, 3, commitment.TapCommitmentV2, true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20, 300}, fn.Map(
+		selected, func(c *AnchoredCommitment) uint64 {
+			return c.Asset.Amount
+		},
+	))
+	require.Len(t, coinLister.leaseSignals, 0)
+
+	// Drain the signals of the first call.
+	<-coinLister.deleteSignals
+	<-coinLister.listSignals
+
+	// Without a dry run, all coins should be selected and leased.
+	selected, err = coinSelect.SelectConsolidationCoins(
+		ctx, constraints, 0, commitment.TapCommitmentV2, false,
+	)
+	require.NoError(t, err)
+	require.Len(t, selected, 4)
+	require.Len(t, coinLister.leaseSignals, 1)
+
+	// A single coin can't be consolidated.
+	coinLister = newMockCoinLister(coins[:1])
+	coinSelect = NewCoinSelect(coinLister)
+	_, err = coinSelect.SelectConsolidationCoins(
+		ctx, constraints, 0, commitment.TapCommitmentV2, false,
+	)
+	require.ErrorIs(t, err, ErrNothingToConsolidate)
+	require.Len(t, coinLister.leaseSignals, 0)
+}
+
+// TestConsolidationDryRun tests that a consolidation dry run reports the
+// expected number of transactions, inputs and outputs and the expected chain
+// fee.
+func TestConsolidationDryRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		genesis1 = asset.RandGenesis(t, asset.Normal)
+		genesis2 = asset.RandGenesis(t, asset.Normal)
+		groupKey = test.RandPubKey(t)
+		feeRate  = chainfee.SatPerKWeight(2_500)
+	)
+
+	// We create five coins of two different tranches of a group. Two of
+	// the coins share an anchor output.
+	coins := []*AnchoredCommitment{
+		consolidationCoin(t, genesis1, 10, 0),
+		consolidationCoin(t, genesis2, 20, 0),
+		consolidationCoin(t, genesis1, 30, 1),
+		consolidationCoin(t, genesis2, 40, 2),
+		consolidationCoin(t, genesis1, 50, 3),
+	}
+
+	consolidator := NewConsolidator(&ConsolidatorConfig{
+		CoinSelector: NewCoinSelect(newMockCoinLister(coins)),
+	})
+	req := &ConsolidationRequest{
+		AssetSpecifier: asset.NewSpecifierFromGroupKey(*groupKey),
+		DryRun:         true,
+	}
+
+	// With two inputs per transaction and at most three transactions, the
+	// last coin is left over on its own and isn't consolidated.
+	result, err := consolidator.dryRun(ctx, req, 2, 3, feeRate)
+	require.NoError(t, err)
+
+	// Only one anchor input (index 0) is shared by two coins.
+	_, fee1 := tapscript.EstimateFee(
+		[][]byte{tapsend.GenesisDummyScript},
+		[]*wire.TxOut{
+			tapsend.CreateDummyOutput(),
+			tapsend.CreateDummyOutput(),
+		}, feeRate,
+	)
+	_, fee2 := tapscript.EstimateFee(
+		[][]byte{
+			tapsend.GenesisDummyScript, tapsend.GenesisDummyScript,
+		},
+		[]*wire.TxOut{
+			tapsend.CreateDummyOutput(),
+			tapsend.CreateDummyOutput(),
+		}, feeRate,
+	)
+
+	require.Equal(t, &ConsolidationResult{
+		NumTransactions: 2,
+		NumInputs:       4,
+		NumAnchorInputs: 3,
+		NumOutputs:      4,
+		TotalAmount:     100,
+		FeeRate:         feeRate,
+		ChainFees:       fee1 + fee2,
+	}, result)
+}
@@ -142,6 +142,11 @@ var (
 	ErrMatchingAssetsNotFound = fmt.Errorf("failed to find coin(s) that " +
 		"satisfy given constraints; if previous transfers are un-" +
 		"confirmed, wait for them to confirm before trying again")
+
+	// ErrNothingToConsolidate is returned when there are fewer than two
+	// coins of an asset that could be merged into a single output.
+	ErrNothingToConsolidate = fmt.Errorf("not enough coins to " +
+		"consolidate")
 )
 
 // CoinLister attracts over the coin selection process needed to be
@@ -183,8 +188,65 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments over time at the cost of using more inputs.
+	PreferMinAmount
+
+	// BranchAndBound is a strategy which searches for the subset of
+	// commitments that sums up to the target amount with the least amount
+	// of excess, ideally resulting in a transfer without a change output.
+	// Among subsets with the same excess, the one with the fewest inputs is
+	// preferred. If the search is exhausted before an exact match is found,
+	// the best subset found so far is used.
+	BranchAndBound
+
+	// PrivacyAware is a strategy which attempts to minimize the number of
+	// distinct anchor outputs that are spent, to avoid linking multiple
+	// on-chain outputs to the same owner. If a single anchor output can
+	// cover the target amount, the smallest such output is selected.
+	// Otherwise, anchor outputs are selected in order of descending amount.
+	PrivacyAware
 )
 
+// String returns a human-readable name of the coin selection strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "largest-first"
+
+	case PreferMinAmount:
+		return "smallest-first"
+
+	case BranchAndBound:
+		return "branch-and-bound"
+
+	case PrivacyAware:
+		return "privacy-aware"
+
+	default:
+		return fmt.Sprintf("<unknown strategy %d>", s)
+	}
+}
+
+// ParseCoinSelectStrategy parses the human-readable name of a coin selection
+// strategy as returned by MultiCommitmentSelectStrategy.String.
+func ParseCoinSelectStrategy(name string) (MultiCommitmentSelectStrategy,
+	error) {
+
+	for _, strategy := range []MultiCommitmentSelectStrategy{
+		PreferMaxAmount, PreferMinAmount, BranchAndBound, PrivacyAware,
+	} {
+		if strategy.String() == name {
+			return strategy, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown coin selection strategy: %v", name)
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -196,6 +258,15 @@ type CoinSelector interface {
 		maxVersion commitment.TapCommitmentVersion,
 	) ([]*AnchoredCommitment, error)
 
+	// SelectConsolidationCoins returns up to maxInputs of the smallest not
+	// yet leased coins that satisfy the given constraints (except for the
+	// minimum amount). Unless dryRun is set, the coins returned are leased
+	// for the default lease duration.
+	SelectConsolidationCoins(ctx context.Context,
+		constraints CommitmentConstraints, maxInputs int,
+		maxVersion commitment.TapCommitmentVersion,
+		dryRun bool) ([]*AnchoredCommitment, error)
+
 	// ReleaseCoins releases/unlocks coins that were previously leased and
 	// makes them available for coin selection again.
 	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
@@ -701,6 +772,9 @@ type Porter interface {
 		anchorTxHash fn.Option[chainhash.Hash], pending bool,
 	) ([]*OutboundParcel, error)
 
+	// Stats returns a snapshot of the porter's metrics.
+	Stats() PorterStats
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
@@ -72,6 +72,18 @@ const (
 	// SendStateComplete is the state which is reached once entire asset
 	// transfer process is complete.
 	SendStateComplete
+
+	// SendStateAnchorTxEvicted is not part of the state machine. It is only
+	// used in send events to signal that the anchor transaction of a
+	// completed transfer was evicted from the chain by a re-org and was
+	// broadcast again.
+	SendStateAnchorTxEvicted
+
+	// SendStateAnchorTxReConfirmed is not part of the state machine. It is
+	// only used in send events to signal that the anchor transaction of a
+	// completed transfer confirmed in a new block after a re-org and that
+	// the transfer proofs were updated accordingly.
+	SendStateAnchorTxReConfirmed
 )
 
 // String returns a human-readable version of SendState.
@@ -110,6 +122,12 @@ func (s SendState) String() string {
 	case SendStateComplete:
 		return "SendStateComplete"
 
+	case SendStateAnchorTxEvicted:
+		return "SendStateAnchorTxEvicted"
+
+	case SendStateAnchorTxReConfirmed:
+		return "SendStateAnchorTxReConfirmed"
+
 	default:
 		return fmt.Sprintf("<unknown_state(%d)>", s)
 	}
@@ -160,6 +178,10 @@ type AddressParcel struct {
 	// testing purposes or to force transfer attempts even if the
 	// proof courier is not immediately reachable.
 	skipProofCourierPingCheck bool
+
+	// coinSelectStrategy is the strategy used to select the asset coins
+	// that fund the transfer.
+	coinSelectStrategy MultiCommitmentSelectStrategy
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -169,6 +191,7 @@ var _ Parcel = (*AddressParcel)(nil)
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight, label string,
 	skipProofCourierPingCheck bool,
+	coinSelectStrategy MultiCommitmentSelectStrategy,
 	destAddrs ...*address.Tap) *AddressParcel {
 
 	return &AddressParcel{
@@ -180,6 +203,7 @@ func NewAddressParcel(feeRate *chainfee.SatPerKWeight, label string,
 		transferFeeRate:           feeRate,
 		label:                     label,
 		skipProofCourierPingCheck: skipProofCourierPingCheck,
+		coinSelectStrategy:        coinSelectStrategy,
 	}
 }
 
@@ -531,6 +555,11 @@ type sendPackage struct {
 	// confirmation data.
 	TransferTxConfEvent *chainntnfs.TxConfirmation
 
+	// BroadcastTime is the time the anchor transaction was broadcast. It
+	// is zero if the broadcast happened before the last restart or was
+	// skipped.
+	BroadcastTime time.Time
+
 	// Label is a user provided short label for this transfer.
 	Label string
 
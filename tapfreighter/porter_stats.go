@@ -0,0 +1,105 @@
+package tapfreighter
+
+import (
+	"sync"
+	"time"
+)
+
+// PorterStats is a snapshot of the chain porter's metrics.
+type PorterStats struct {
+	// ParcelsByState is the number of parcels that are currently being
+	// processed by the chain porter, keyed by their send state.
+	ParcelsByState map[SendState]int
+
+	// ParcelsCompleted is the number of parcels that reached the final
+	// send state.
+	ParcelsCompleted uint64
+
+	// ParcelsFailed is the number of parcels for which the state machine
+	// returned an error.
+	ParcelsFailed uint64
+
+	// NumConfirmations is the number of broadcast anchor transactions that
+	// confirmed on chain.
+	NumConfirmations uint64
+
+	// ConfirmationTime is the sum of the durations between the broadcast
+	// of an anchor transaction and its confirmation. Together with
+	// NumConfirmations it can be used to compute the average confirmation
+	// time.
+	ConfirmationTime time.Duration
+}
+
+// porterMetrics keeps track of the chain porter's metrics.
+type porterMetrics struct {
+	mtx sync.Mutex
+
+	stats PorterStats
+}
+
+// newPorterMetrics creates a new, empty set of chain porter metrics.
+func newPorterMetrics() *porterMetrics {
+	return &porterMetrics{
+		stats: PorterStats{
+			ParcelsByState: make(map[SendState]int),
+		},
+	}
+}
+
+// enterState records that a parcel entered the given send state.
+func (m *porterMetrics) enterState(state SendState) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.stats.ParcelsByState[state]++
+}
+
+// leaveState records that a parcel left the given send state.
+func (m *porterMetrics) leaveState(state SendState) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.stats.ParcelsByState[state]--
+	if m.stats.ParcelsByState[state] <= 0 {
+		delete(m.stats.ParcelsByState, state)
+	}
+}
+
+// parcelDone records that the state machine of a parcel finished, either
+// successfully or with an error.
+func (m *porterMetrics) parcelDone(success bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if success {
+		m.stats.ParcelsCompleted++
+	} else {
+		m.stats.ParcelsFailed++
+	}
+}
+
+// confirmed records that an anchor transaction confirmed on chain after the
+// given duration since its broadcast.
+func (m *porterMetrics) confirmed(sinceBroadcast time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.stats.NumConfirmations++
+	m.stats.ConfirmationTime += sinceBroadcast
+}
+
+// snapshot returns a copy of the chain porter's metrics.
+func (m *porterMetrics) snapshot() PorterStats {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	stats := m.stats
+	stats.ParcelsByState = make(
+		map[SendState]int, len(m.stats.ParcelsByState),
+	)
+	for state, num := range m.stats.ParcelsByState {
+		stats.ParcelsByState[state] = num
+	}
+
+	return stats
+}
@@ -0,0 +1,46 @@
+package tapfreighter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPorterMetrics tests that the chain porter metrics keep track of the
+// parcels per state and the confirmation times.
+func TestPorterMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := newPorterMetrics()
+
+	// Two parcels enter the state machine, one of which advances to the
+	// confirmation wait state.
+	metrics.enterState(SendStateVirtualCommitmentSelect)
+	metrics.enterState(SendStateVirtualCommitmentSelect)
+	metrics.leaveState(SendStateVirtualCommitmentSelect)
+	metrics.enterState(SendStateWaitTxConf)
+
+	stats := metrics.snapshot()
+	require.Equal(t, map[SendState]int{
+		SendStateVirtualCommitmentSelect: 1,
+		SendStateWaitTxConf:              1,
+	}, stats.ParcelsByState)
+
+	// The snapshot must not be affected by later updates.
+	metrics.leaveState(SendStateVirtualCommitmentSelect)
+	metrics.parcelDone(false)
+	require.Len(t, stats.ParcelsByState, 2)
+
+	metrics.confirmed(10 * time.Minute)
+	metrics.confirmed(20 * time.Minute)
+	metrics.leaveState(SendStateWaitTxConf)
+	metrics.parcelDone(true)
+
+	stats = metrics.snapshot()
+	require.Empty(t, stats.ParcelsByState)
+	require.EqualValues(t, 1, stats.ParcelsCompleted)
+	require.EqualValues(t, 1, stats.ParcelsFailed)
+	require.EqualValues(t, 2, stats.NumConfirmations)
+	require.Equal(t, 30*time.Minute, stats.ConfirmationTime)
+}
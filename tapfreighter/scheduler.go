@@ -0,0 +1,649 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DefaultSchedulerPollInterval is the default interval at which the
+	// send scheduler checks for sends that became due by time, in
+	// addition to checking on every new block.
+	DefaultSchedulerPollInterval = time.Minute
+
+	// DefaultScheduledSendMaxAttempts is the default number of times the
+	// execution of a scheduled send is attempted before it is marked as
+	// failed.
+	DefaultScheduledSendMaxAttempts = 10
+)
+
+// ScheduledSendState is the state of a scheduled send.
+type ScheduledSendState uint8
+
+const (
+	// ScheduledSendPending is the state of a send that waits for its
+	// execution conditions to be met.
+	ScheduledSendPending ScheduledSendState = 0
+
+	// ScheduledSendExecuting is the state of a send that was handed to the
+	// chain porter but didn't yet complete.
+	ScheduledSendExecuting ScheduledSendState = 1
+
+	// ScheduledSendExecuted is the state of a send whose anchor
+	// transaction was broadcast.
+	ScheduledSendExecuted ScheduledSendState = 2
+
+	// ScheduledSendFailed is the state of a send that couldn't be
+	// executed.
+	ScheduledSendFailed ScheduledSendState = 3
+
+	// ScheduledSendCancelled is the state of a send that was cancelled
+	// before it was executed.
+	ScheduledSendCancelled ScheduledSendState = 4
+)
+
+// String returns a human-readable version of the scheduled send state.
+func (s ScheduledSendState) String() string {
+	switch s {
+	case ScheduledSendPending:
+		return "pending"
+
+	case ScheduledSendExecuting:
+		return "executing"
+
+	case ScheduledSendExecuted:
+		return "executed"
+
+	case ScheduledSendFailed:
+		return "failed"
+
+	case ScheduledSendCancelled:
+		return "cancelled"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// ScheduledSendRecipient is a single recipient of a scheduled send.
+type ScheduledSendRecipient struct {
+	// TapAddr is the encoded address of the recipient.
+	TapAddr string
+
+	// Amount is the amount to send to an address that doesn't specify an
+	// amount. If zero, the amount of the address is sent.
+	Amount uint64
+}
+
+// ScheduledSend is an asset send that is executed once a block height or a
+// point in time is reached. The asset coins are only selected when the send
+// is executed.
+type ScheduledSend struct {
+	// ID is the database ID of the scheduled send.
+	ID int64
+
+	// Label is an optional label of the resulting transfer.
+	Label string
+
+	// Recipients are the recipients of the send. All recipients must
+	// receive the same asset ID or assets of the same group.
+	Recipients []ScheduledSendRecipient
+
+	// FeeRate is the fee rate of the anchor transaction. If zero, the fee
+	// rate is estimated at execution time.
+	FeeRate chainfee.SatPerKWeight
+
+	// SkipProofCourierPingCheck indicates whether the proof courier ping
+	// check should be skipped.
+	SkipProofCourierPingCheck bool
+
+	// ExecuteAtHeight is the block height at or after which the send is
+	// executed.
+	ExecuteAtHeight fn.Option[uint32]
+
+	// ExecuteAtTime is the point in time at or after which the send is
+	// executed.
+	ExecuteAtTime fn.Option[time.Time]
+
+	// State is the current state of the send.
+	State ScheduledSendState
+
+	// AnchorTxHash is the hash of the anchor transaction of an executed
+	// send.
+	AnchorTxHash fn.Option[chainhash.Hash]
+
+	// FailureReason is the reason a send failed. For a pending send that
+	// is retried, this is the reason the last attempt failed.
+	FailureReason string
+
+	// NumAttempts is the number of execution attempts that failed with an
+	// error that allowed the send to be retried later.
+	NumAttempts uint32
+
+	// CreationTime is the time the send was scheduled.
+	CreationTime time.Time
+
+	// UpdateTime is the time the state of the send last changed.
+	UpdateTime time.Time
+}
+
+// isDue returns true if all execution conditions of the send are met at the
+// given block height and time.
+func (s *ScheduledSend) isDue(height uint32, now time.Time) bool {
+	heightReached := s.ExecuteAtHeight.UnwrapOr(0) <= height
+	timeReached := !s.ExecuteAtTime.UnwrapOr(time.Time{}).After(now)
+
+	return heightReached && timeReached
+}
+
+// ErrScheduledSendNotPending is returned when the state of a scheduled send
+// can't be updated because it's not in the expected state.
+var ErrScheduledSendNotPending = errors.New("scheduled send not in " +
+	"expected state")
+
+// ScheduledSendStore is used to persist scheduled sends.
+type ScheduledSendStore interface {
+	// InsertScheduledSend persists a new scheduled send together with its
+	// recipients and returns its ID.
+	InsertScheduledSend(context.Context, *ScheduledSend) (int64, error)
+
+	// FetchScheduledSends returns all scheduled sends, optionally
+	// filtered by their state.
+	FetchScheduledSends(context.Context,
+		fn.Option[ScheduledSendState]) ([]*ScheduledSend, error)
+
+	// UpdateScheduledSendState moves the scheduled send with the given ID
+	// from the old to the new state. If the send isn't in the old state,
+	// ErrScheduledSendNotPending is returned.
+	UpdateScheduledSendState(ctx context.Context, id int64,
+		oldState, newState ScheduledSendState,
+		anchorTxHash fn.Option[chainhash.Hash],
+		failureReason string) error
+
+	// RetryScheduledSend moves the executing scheduled send with the given
+	// ID back to the pending state and records the failed execution
+	// attempt together with its failure reason. If the send isn't
+	// executing, ErrScheduledSendNotPending is returned.
+	RetryScheduledSend(ctx context.Context, id int64,
+		failureReason string) error
+}
+
+// SendSchedulerConfig is the main config for the SendScheduler.
+type SendSchedulerConfig struct {
+	// Store is used to persist the scheduled sends.
+	Store ScheduledSendStore
+
+	// ChainBridge is used to be notified of new blocks.
+	ChainBridge tapgarden.ChainBridge
+
+	// ChainPorter is used to execute the scheduled sends.
+	ChainPorter Porter
+
+	// ChainParams are the chain parameters used to decode addresses.
+	ChainParams *address.ChainParams
+
+	// CoinSelectStrategy is the strategy used to select the asset coins
+	// when a send is executed.
+	CoinSelectStrategy MultiCommitmentSelectStrategy
+
+	// Clock is used to determine whether time-locked sends are due.
+	Clock clock.Clock
+
+	// PollInterval is the interval at which sends that became due by
+	// time are executed. If zero, DefaultSchedulerPollInterval is used.
+	PollInterval time.Duration
+
+	// MaxAttempts is the number of times the execution of a send is
+	// attempted before it is marked as failed. If zero,
+	// DefaultScheduledSendMaxAttempts is used.
+	MaxAttempts uint32
+
+	// ErrChan is the main error channel the scheduler will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// SendScheduler persists asset sends that should only be executed at a future
+// block height or point in time and hands them to the chain porter once they
+// are due.
+type SendScheduler struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *SendSchedulerConfig
+
+	// wakeUp is used to signal the main event loop that a new send was
+	// scheduled.
+	wakeUp chan struct{}
+
+	// ContextGuard provides a wait group and main quit channel that can
+	// be used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewSendScheduler creates a new SendScheduler from the given config.
+func NewSendScheduler(cfg *SendSchedulerConfig) *SendScheduler {
+	return &SendScheduler{
+		cfg:    cfg,
+		wakeUp: make(chan struct{}, 1),
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: tapgarden.DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the SendScheduler.
+func (s *SendScheduler) Start() error {
+	var startErr error
+	s.startOnce.Do(func() {
+		log.Infof("Starting SendScheduler")
+
+		ctx, cancel := s.WithCtxQuit()
+		defer cancel()
+
+		// A send that is still executing was interrupted by a
+		// shutdown. We can't know whether its anchor transaction was
+		// broadcast, so we don't retry it and mark it as failed
+		// instead.
+		err := s.failInterruptedSends(ctx)
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		s.Wg.Add(1)
+		go s.mainEventLoop()
+	})
+
+	return startErr
+}
+
+// Stop signals the SendScheduler to stop.
+func (s *SendScheduler) Stop() error {
+	s.stopOnce.Do(func() {
+		log.Infof("Stopping SendScheduler")
+
+		close(s.Quit)
+		s.Wg.Wait()
+	})
+
+	return nil
+}
+
+// failInterruptedSends marks all sends that are still executing as failed.
+func (s *SendScheduler) failInterruptedSends(ctx context.Context) error {
+	executing, err := s.cfg.Store.FetchScheduledSends(
+		ctx, fn.Some(ScheduledSendExecuting),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch executing scheduled "+
+			"sends: %w", err)
+	}
+
+	for _, send := range executing {
+		log.Warnf("Scheduled send %d was interrupted, marking as "+
+			"failed", send.ID)
+
+		err := s.cfg.Store.UpdateScheduledSendState(
+			ctx, send.ID, ScheduledSendExecuting,
+			ScheduledSendFailed, fn.None[chainhash.Hash](),
+			fmt.Sprintf("interrupted by shutdown, check the "+
+				"transfers with label '%s'", sendLabel(send)),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update scheduled send "+
+				"%d: %w", send.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// mainEventLoop executes due sends on every new block, periodically and
+// whenever a new send is scheduled.
+func (s *SendScheduler) mainEventLoop() {
+	defer s.Wg.Done()
+
+	ctx, cancel := s.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	newBlockChan, blockErrChan, err :=
+		s.cfg.ChainBridge.RegisterBlockEpochNtfn(ctx)
+	if err != nil {
+		s.reportErr(fmt.Errorf("unable to register for block epoch "+
+			"notifications: %w", err))
+		return
+	}
+
+	height, err := s.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		s.reportErr(fmt.Errorf("unable to fetch current height: %w",
+			err))
+		return
+	}
+
+	pollInterval := s.cfg.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultSchedulerPollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.executeDueSends(ctx, height)
+
+		select {
+		case newHeight := <-newBlockChan:
+			height = uint32(newHeight)
+
+		case err := <-blockErrChan:
+			s.reportErr(fmt.Errorf("unable to receive new block "+
+				"notifications: %w", err))
+			return
+
+		case <-ticker.C:
+
+		case <-s.wakeUp:
+
+		case <-s.Quit:
+			return
+		}
+	}
+}
+
+// reportErr reports a critical error to the main server, unless the scheduler
+// is shutting down.
+func (s *SendScheduler) reportErr(err error) {
+	select {
+	case <-s.Quit:
+	case s.cfg.ErrChan <- err:
+	}
+}
+
+// executeDueSends starts the execution of all pending sends that are due at
+// the given block height. Each send is executed in its own goroutine, so a slow
+// send doesn't hold up the others.
+func (s *SendScheduler) executeDueSends(ctx context.Context, height uint32) {
+	pending, err := s.cfg.Store.FetchScheduledSends(
+		ctx, fn.Some(ScheduledSendPending),
+	)
+	if err != nil {
+		log.Errorf("Unable to fetch pending scheduled sends: %v", err)
+		return
+	}
+
+	for _, send := range pending {
+		if !send.isDue(height, s.cfg.Clock.Now()) {
+			continue
+		}
+
+		// We first move the send to the executing state, which makes
+		// sure it wasn't cancelled in the meantime and that it isn't
+		// picked up again while it is executing.
+		err := s.cfg.Store.UpdateScheduledSendState(
+			ctx, send.ID, ScheduledSendPending,
+			ScheduledSendExecuting, fn.None[chainhash.Hash](), "",
+		)
+		if err != nil {
+			log.Errorf("Unable to execute scheduled send %d: %v",
+				send.ID, err)
+			continue
+		}
+
+		s.Wg.Add(1)
+		go func() {
+			defer s.Wg.Done()
+
+			if err := s.executeSend(ctx, send); err != nil {
+				log.Errorf("Unable to execute scheduled send "+
+					"%d: %v", send.ID, err)
+			}
+		}()
+
+		select {
+		case <-s.Quit:
+			return
+		default:
+		}
+	}
+}
+
+// executeSend hands a due send that was moved to the executing state to the
+// chain porter. The asset coins of the send are only selected now, so the send
+// uses the coins available at execution time.
+func (s *SendScheduler) executeSend(ctx context.Context,
+	send *ScheduledSend) error {
+
+	log.Infof("Executing scheduled send %d with %d recipient(s) "+
+		"(attempt %d)", send.ID, len(send.Recipients),
+		send.NumAttempts+1)
+
+	// The addresses were validated when the send was scheduled, so this
+	// can't be fixed by trying again.
+	tapAddrs, err := decodeScheduledRecipients(
+		send.Recipients, s.cfg.ChainParams,
+	)
+	if err != nil {
+		return s.failSend(ctx, send, err.Error())
+	}
+
+	var feeRate *chainfee.SatPerKWeight
+	if send.FeeRate > 0 {
+		feeRate = &send.FeeRate
+	}
+
+	resp, err := s.cfg.ChainPorter.RequestShipment(NewAddressParcel(
+		feeRate, sendLabel(send), send.SkipProofCourierPingCheck,
+		s.cfg.CoinSelectStrategy, tapAddrs...,
+	))
+
+	// If we're shutting down, the porter might have aborted the send. We
+	// leave the send in the executing state, so it is marked as
+	// interrupted on the next start.
+	select {
+	case <-s.Quit:
+		if err != nil {
+			return nil
+		}
+	default:
+	}
+
+	maxAttempts := s.cfg.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultScheduledSendMaxAttempts
+	}
+
+	var stateErr *ParcelStateError
+	switch {
+	// If the transfer was already written to disk, the porter resumes it
+	// on the next start. Trying again would pay the recipients twice.
+	case errors.As(err, &stateErr) && stateErr.TransferLogged():
+		return s.failSend(ctx, send, fmt.Sprintf("%v, check the "+
+			"transfers with label '%s'", err, sendLabel(send)))
+
+	case err != nil && send.NumAttempts+1 >= maxAttempts:
+		return s.failSend(ctx, send, fmt.Sprintf("giving up after %d "+
+			"attempts: %v", send.NumAttempts+1, err))
+
+	// Most errors before the transfer is written to disk are transient,
+	// for example if the coins are leased by another send, the balance
+	// is too low at the moment or the fee estimation failed. We try
+	// again on the next block or poll interval.
+	case err != nil:
+		log.Warnf("Scheduled send %d failed, trying again later: %v",
+			send.ID, err)
+
+		return s.cfg.Store.RetryScheduledSend(ctx, send.ID, err.Error())
+	}
+
+	anchorTxHash := resp.AnchorTx.TxHash()
+	log.Infof("Scheduled send %d executed in anchor transaction %v",
+		send.ID, anchorTxHash)
+
+	return s.cfg.Store.UpdateScheduledSendState(
+		ctx, send.ID, ScheduledSendExecuting, ScheduledSendExecuted,
+		fn.Some(anchorTxHash), "",
+	)
+}
+
+// failSend marks the executing send as failed for the given reason.
+func (s *SendScheduler) failSend(ctx context.Context, send *ScheduledSend,
+	reason string) error {
+
+	log.Errorf("Scheduled send %d failed: %v", send.ID, reason)
+
+	return s.cfg.Store.UpdateScheduledSendState(
+		ctx, send.ID, ScheduledSendExecuting, ScheduledSendFailed,
+		fn.None[chainhash.Hash](), reason,
+	)
+}
+
+// sendLabel returns the label of the transfer of a scheduled send.
+func sendLabel(send *ScheduledSend) string {
+	if send.Label != "" {
+		return send.Label
+	}
+
+	return fmt.Sprintf("scheduled-send-%d", send.ID)
+}
+
+// decodeScheduledRecipients decodes the addresses of the given recipients and
+// applies the amount of recipients whose address doesn't specify one. All
+// addresses must be for the same asset ID or group.
+func decodeScheduledRecipients(recipients []ScheduledSendRecipient,
+	chainParams *address.ChainParams) ([]*address.Tap, error) {
+
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("at least one recipient must be " +
+			"specified")
+	}
+
+	tapAddrs := make([]*address.Tap, len(recipients))
+	for idx, recipient := range recipients {
+		tapAddr, err := address.DecodeAddress(
+			recipient.TapAddr, chainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode addr %d: %w",
+				idx, err)
+		}
+
+		switch {
+		case recipient.Amount > 0 && tapAddr.Amount > 0 &&
+			tapAddr.Amount != recipient.Amount:
+
+			return nil, fmt.Errorf("addr '%s' has a non-zero "+
+				"amount defined, cannot override amount",
+				recipient.TapAddr)
+
+		case recipient.Amount > 0:
+			tapAddr.Amount = recipient.Amount
+
+		case tapAddr.Amount == 0:
+			return nil, fmt.Errorf("addr '%s' has no amount "+
+				"defined, the amount must be set",
+				recipient.TapAddr)
+		}
+		tapAddrs[idx] = tapAddr
+
+		firstSpec := asset.NewSpecifierOptionalGroupPubKey(
+			tapAddrs[0].AssetID, tapAddrs[0].GroupKey,
+		)
+		if idx > 0 {
+			spec := asset.NewSpecifierOptionalGroupPubKey(
+				tapAddr.AssetID, tapAddr.GroupKey,
+			)
+			if spec != firstSpec {
+				return nil, fmt.Errorf("all addrs must be of "+
+					"the same asset ID or group key %v",
+					firstSpec)
+			}
+		}
+	}
+
+	return tapAddrs, nil
+}
+
+// ScheduleSend validates and persists a new scheduled send. The send is
+// executed once all of its execution conditions are met.
+func (s *SendScheduler) ScheduleSend(ctx context.Context,
+	send *ScheduledSend) (*ScheduledSend, error) {
+
+	if send.ExecuteAtHeight.IsNone() && send.ExecuteAtTime.IsNone() {
+		return nil, fmt.Errorf("either an execution height or an " +
+			"execution time must be specified")
+	}
+
+	// We validate the addresses now, so the send doesn't fail much later
+	// because of a typo.
+	_, err := decodeScheduledRecipients(
+		send.Recipients, s.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.cfg.Clock.Now().UTC()
+	newSend := *send
+	newSend.State = ScheduledSendPending
+	newSend.AnchorTxHash = fn.None[chainhash.Hash]()
+	newSend.FailureReason = ""
+	newSend.CreationTime = now
+	newSend.UpdateTime = now
+
+	newSend.ID, err = s.cfg.Store.InsertScheduledSend(ctx, &newSend)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store scheduled send: %w",
+			err)
+	}
+
+	log.Infof("Scheduled send %d with %d recipient(s)", newSend.ID,
+		len(newSend.Recipients))
+
+	// Wake up the main event loop, in case the send is already due.
+	select {
+	case s.wakeUp <- struct{}{}:
+	default:
+	}
+
+	return &newSend, nil
+}
+
+// ListScheduledSends returns all scheduled sends, optionally filtered by their
+// state.
+func (s *SendScheduler) ListScheduledSends(ctx context.Context,
+	state fn.Option[ScheduledSendState]) ([]*ScheduledSend, error) {
+
+	return s.cfg.Store.FetchScheduledSends(ctx, state)
+}
+
+// CancelScheduledSend cancels the pending scheduled send with the given ID.
+// Sends that are already executing or completed can't be cancelled.
+func (s *SendScheduler) CancelScheduledSend(ctx context.Context,
+	id int64) error {
+
+	err := s.cfg.Store.UpdateScheduledSendState(
+		ctx, id, ScheduledSendPending, ScheduledSendCancelled,
+		fn.None[chainhash.Hash](), "",
+	)
+	if err != nil {
+		return fmt.Errorf("unable to cancel scheduled send %d: %w",
+			id, err)
+	}
+
+	log.Infof("Cancelled scheduled send %d", id)
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduledSendIsDue tests that a scheduled send is only due once all of
+// its execution conditions are met.
+func TestScheduledSendIsDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		name   string
+		height fn.Option[uint32]
+		time   fn.Option[time.Time]
+		isDue  bool
+	}{{
+		name:   "height not reached",
+		height: fn.Some(uint32(101)),
+		isDue:  false,
+	}, {
+		name:   "height reached",
+		height: fn.Some(uint32(100)),
+		isDue:  true,
+	}, {
+		name:  "time not reached",
+		time:  fn.Some(now.Add(time.Second)),
+		isDue: false,
+	}, {
+		name:  "time reached",
+		time:  fn.Some(now),
+		isDue: true,
+	}, {
+		name:   "height reached, time not reached",
+		height: fn.Some(uint32(99)),
+		time:   fn.Some(now.Add(time.Minute)),
+		isDue:  false,
+	}, {
+		name:   "height and time reached",
+		height: fn.Some(uint32(99)),
+		time:   fn.Some(now.Add(-time.Minute)),
+		isDue:  true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			send := &ScheduledSend{
+				ExecuteAtHeight: tc.height,
+				ExecuteAtTime:   tc.time,
+			}
+			require.Equal(t, tc.isDue, send.isDue(100, now))
+		})
+	}
+}
+
+// mockScheduledSendStore is an in-memory implementation of the
+// ScheduledSendStore interface.
+type mockScheduledSendStore struct {
+	sends map[int64]*ScheduledSend
+}
+
+func (m *mockScheduledSendStore) InsertScheduledSend(_ context.Context,
+	send *ScheduledSend) (int64, error) {
+
+	id := int64(len(m.sends) + 1)
+	newSend := *send
+	newSend.ID = id
+	m.sends[id] = &newSend
+
+	return id, nil
+}
+
+func (m *mockScheduledSendStore) FetchScheduledSends(_ context.Context,
+	state fn.Option[ScheduledSendState]) ([]*ScheduledSend, error) {
+
+	var sends []*ScheduledSend
+	for _, send := range m.sends {
+		if state.UnwrapOr(send.State) != send.State {
+			continue
+		}
+
+		sendCopy := *send
+		sends = append(sends, &sendCopy)
+	}
+
+	return sends, nil
+}
+
+func (m *mockScheduledSendStore) UpdateScheduledSendState(_ context.Context,
+	id int64, oldState, newState ScheduledSendState,
+	anchorTxHash fn.Option[chainhash.Hash], failureReason string) error {
+
+	send, ok := m.sends[id]
+	if !ok || send.State != oldState {
+		return ErrScheduledSendNotPending
+	}
+
+	send.State = newState
+	send.AnchorTxHash = anchorTxHash
+	send.FailureReason = failureReason
+
+	return nil
+}
+
+func (m *mockScheduledSendStore) RetryScheduledSend(_ context.Context,
+	id int64, failureReason string) error {
+
+	send, ok := m.sends[id]
+	if !ok || send.State != ScheduledSendExecuting {
+		return ErrScheduledSendNotPending
+	}
+
+	send.State = ScheduledSendPending
+	send.NumAttempts++
+	send.FailureReason = failureReason
+
+	return nil
+}
+
+// failingPorter is a Porter that fails every shipment with the given error.
+type failingPorter struct {
+	Porter
+
+	err error
+}
+
+func (p *failingPorter) RequestShipment(Parcel) (*OutboundParcel, error) {
+	return nil, p.err
+}
+
+// TestSendSchedulerRetry tests that a scheduled send is retried after
+// transient errors and only marked as failed after the maximum number of
+// attempts or if its transfer was already written to disk.
+func TestSendSchedulerRetry(t *testing.T) {
+	t.Parallel()
+
+	chainParams := &address.RegressionNetTap
+	tapAddr, _, _ := address.RandAddr(
+		t, chainParams, address.RandProofCourierAddr(t),
+	)
+	addrStr, err := tapAddr.EncodeAddress()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		addr        string
+		numAttempts uint32
+		err         error
+		state       ScheduledSendState
+		reason      string
+	}{{
+		name:   "transient error",
+		addr:   addrStr,
+		err:    errors.New("insufficient balance"),
+		state:  ScheduledSendPending,
+		reason: "insufficient balance",
+	}, {
+		name:        "last attempt",
+		addr:        addrStr,
+		numAttempts: 2,
+		err:         errors.New("insufficient balance"),
+		state:       ScheduledSendFailed,
+		reason:      "giving up after 3 attempts",
+	}, {
+		name: "transfer logged",
+		addr: addrStr,
+		err: &ParcelStateError{
+			State: SendStateBroadcast,
+			Err:   errors.New("unable to broadcast"),
+		},
+		state:  ScheduledSendFailed,
+		reason: "check the transfers with label",
+	}, {
+		name:   "invalid address",
+		addr:   "invalid",
+		state:  ScheduledSendFailed,
+		reason: "unable to decode addr",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := &mockScheduledSendStore{
+				sends: make(map[int64]*ScheduledSend),
+			}
+			scheduler := NewSendScheduler(&SendSchedulerConfig{
+				Store: store,
+				ChainPorter: &failingPorter{
+					err: tc.err,
+				},
+				ChainParams: chainParams,
+				MaxAttempts: 3,
+			})
+
+			id, err := store.InsertScheduledSend(
+				ctx, &ScheduledSend{
+					Recipients: []ScheduledSendRecipient{{
+						TapAddr: tc.addr,
+					}},
+					State:       ScheduledSendExecuting,
+					NumAttempts: tc.numAttempts,
+				},
+			)
+			require.NoError(t, err)
+
+			err = scheduler.executeSend(ctx, store.sends[id])
+			require.NoError(t, err)
+
+			send := store.sends[id]
+			require.Equal(t, tc.state, send.State)
+			require.Contains(t, send.FailureReason, tc.reason)
+		})
+	}
+}
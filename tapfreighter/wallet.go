@@ -65,23 +65,31 @@ type Wallet interface {
 	// spend in order to pay the given address. It also returns supporting
 	// data which assists in processing the virtual transaction: passive
 	// asset re-anchors and the Taproot Asset level commitment of the
-	// selected assets.
+	// selected assets. The given strategy is used to select the assets.
 	FundAddressSend(ctx context.Context,
 		scriptKeyType fn.Option[asset.ScriptKeyType],
-		prevIDs []asset.PrevID,
+		prevIDs []asset.PrevID, strategy MultiCommitmentSelectStrategy,
 		receiverAddrs ...*address.Tap) (*FundedVPacket, error)
 
 	// FundPacket funds a virtual transaction, selecting assets to spend
 	// in order to pay the given recipient. The selected input is then added
-	// to the given virtual transaction.
+	// to the given virtual transaction. The given strategy is used to
+	// select the assets.
 	FundPacket(ctx context.Context, fundDesc *tapsend.FundingDescriptor,
-		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
+		vPkt *tappsbt.VPacket,
+		strategy MultiCommitmentSelectStrategy) (*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
 	// units of the given asset.
 	FundBurn(ctx context.Context,
 		fundDesc *tapsend.FundingDescriptor) (*FundedVPacket, error)
 
+	// FundConsolidation funds a virtual transaction that merges up to
+	// maxInputs of the smallest coins of the given asset into a single new
+	// output (per asset ID) that is owned by the local node.
+	FundConsolidation(ctx context.Context, specifier asset.Specifier,
+		maxInputs int) (*FundedVPacket, error)
+
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
 	SignVirtualPacket(ctx context.Context, vPkt *tappsbt.VPacket,
@@ -265,11 +273,13 @@ type FundedVPacket struct {
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
 // order to pay the given address. It also returns supporting data which assists
 // in processing the virtual transaction: passive asset re-anchors and the
-// Taproot Asset level commitment of the selected assets.
+// Taproot Asset level commitment of the selected assets. The given strategy is
+// used to select the assets.
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	scriptKeyType fn.Option[asset.ScriptKeyType], prevIDs []asset.PrevID,
+	strategy MultiCommitmentSelectStrategy,
 	receiverAddrs ...*address.Tap) (*FundedVPacket, error) {
 
 	// We start by creating a new virtual transaction that will be used to
@@ -293,7 +303,7 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	}
 
 	fundDesc.ScriptKeyType = scriptKeyType
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt, strategy)
 	if err != nil {
 		return nil, fmt.Errorf("error funding packet: %w", err)
 	}
@@ -614,10 +624,10 @@ func createPassivePacket(passiveAsset *asset.Asset,
 
 // FundPacket funds a virtual transaction, selecting assets to spend in order to
 // pay the given recipient. The selected input is then added to the given
-// virtual transaction.
+// virtual transaction. The given strategy is used to select the assets.
 func (f *AssetWallet) FundPacket(ctx context.Context,
-	fundDesc *tapsend.FundingDescriptor,
-	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
+	fundDesc *tapsend.FundingDescriptor, vPkt *tappsbt.VPacket,
+	strategy MultiCommitmentSelectStrategy) (*FundedVPacket, error) {
 
 	// The input and address networks must match.
 	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
@@ -662,7 +672,7 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 	}
 
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount, *anchorVersion,
+		ctx, constraints, strategy, *anchorVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -870,6 +880,150 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	return fundedPkt, nil
 }
 
+// FundConsolidation funds a virtual transaction that merges up to maxInputs of
+// the smallest coins of the given asset into a single new output (per asset ID)
+// that is owned by the local node.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundConsolidation(ctx context.Context,
+	specifier asset.Specifier, maxInputs int) (*FundedVPacket, error) {
+
+	constraints := CommitmentConstraints{
+		AssetSpecifier:    specifier,
+		ScriptKeyType:     fn.Some(asset.ScriptKeyBip86),
+		DistinctSpecifier: true,
+	}
+	selectedCommitments, err := f.cfg.CoinSelector.SelectConsolidationCoins(
+		ctx, constraints, maxInputs, commitment.TapCommitmentV2, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var zeroValueInputs []*ZeroValueInput
+
+	// If we return with an error, we want to release all the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if !success {
+			outpoints := fn.Map(
+				selectedCommitments,
+				func(c *AnchoredCommitment) wire.OutPoint {
+					return c.AnchorPoint
+				},
+			)
+
+			// Also release any zero-value UTXOs we may have leased.
+			zeroValueOutpoints := fn.Map(
+				zeroValueInputs,
+				func(z *ZeroValueInput) wire.OutPoint {
+					return z.OutPoint
+				},
+			)
+			outpoints = append(outpoints, zeroValueOutpoints...)
+
+			err := f.cfg.CoinSelector.ReleaseCoins(
+				ctx, outpoints...,
+			)
+			if err != nil {
+				log.Errorf("Unable to release coins: %v", err)
+			}
+		}
+	}()
+
+	if f.cfg.SweepOrphanUtxos {
+		zeroValueInputs, err = f.cfg.CoinSelector.SelectOrphanCoins(
+			ctx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to select zero-value "+
+				"UTXOs: %w", err)
+		}
+	}
+
+	// All selected coins go to a single output, so we need the total
+	// amount and the highest asset version of the inputs.
+	var (
+		totalAmount uint64
+		maxVersion  = asset.V0
+	)
+	for _, c := range selectedCommitments {
+		totalAmount += c.Asset.Amount
+		if c.Asset.Version > maxVersion {
+			maxVersion = c.Asset.Version
+		}
+	}
+
+	// The consolidated output goes to a fresh script key that we need to
+	// store, so we recognize it when the transfer confirms.
+	scriptKeyDesc, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+	scriptKey := asset.NewScriptKeyBip86(scriptKeyDesc)
+	err = f.cfg.AddrBook.InsertScriptKey(ctx, scriptKey, scriptKey.Type)
+	if err != nil {
+		return nil, fmt.Errorf("cannot insert script key: %w", err)
+	}
+
+	newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Since we're spending all inputs fully to ourselves, this is an
+	// interactive full-value send that doesn't produce any change.
+	vPkt := &tappsbt.VPacket{
+		Outputs: []*tappsbt.VOutput{{
+			Amount:            totalAmount,
+			Type:              tappsbt.TypeSimple,
+			Interactive:       true,
+			AnchorOutputIndex: 0,
+			AssetVersion:      maxVersion,
+			ScriptKey:         scriptKey,
+		}},
+		ChainParams: f.cfg.ChainParams,
+		Version:     tappsbt.V1,
+	}
+	vPkt.Outputs[0].SetAnchorInternalKey(
+		newInternalKey, f.cfg.ChainParams.HDCoinType,
+	)
+
+	// If the coins were selected by asset ID but belong to a group, the
+	// funding descriptor needs the group key to identify the correct TAP
+	// commitment of the inputs.
+	fundSpecifier := specifier
+	firstAsset := selectedCommitments[0].Asset
+	if !specifier.HasGroupPubKey() && firstAsset.GroupKey != nil {
+		fundSpecifier = asset.NewSpecifierOptionalGroupKey(
+			firstAsset.ID(), firstAsset.GroupKey,
+		)
+	}
+
+	fundDesc := &tapsend.FundingDescriptor{
+		AssetSpecifier:    fundSpecifier,
+		Amount:            totalAmount,
+		DistinctSpecifier: true,
+	}
+	fundedPkt, err := createFundedPacketWithInputs(
+		ctx, f.cfg.AssetProofs, f.cfg.KeyRing, f.cfg.AddrBook, fundDesc,
+		vPkt, selectedCommitments, zeroValueInputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedPkt, nil
+}
+
 // SignVirtualPacketOptions is a set of functional options that allow callers to
 // further modify the virtual packet signing process.
 type SignVirtualPacketOptions struct {
@@ -1742,5 +1742,6 @@ func (b *BatchCaretaker) verifierCtx(ctx context.Context) proof.VerifierCtx {
 		GroupAnchorVerifier: groupAnchorVerifier,
 		ChainLookupGen:      b.cfg.ChainBridge,
 		IgnoreChecker:       b.cfg.IgnoreChecker,
+		VerificationCache:   b.cfg.VerificationCache,
 	}
 }
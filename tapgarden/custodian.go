@@ -157,6 +157,10 @@ type CustodianConfig struct {
 	// a proof should be ignored.
 	IgnoreChecker lfn.Option[proof.IgnoreChecker]
 
+	// VerificationCache is an optional cache of already verified proofs
+	// that is used to speed up the verification of proof files.
+	VerificationCache lfn.Option[proof.VerificationCache]
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -1401,9 +1405,8 @@ func (c *Custodian) setReceiveCompleted(event *address.Event,
 	// file to the re-org watcher and replace the updated proof in the local
 	// proof archive if a re-org happens. The sender will do the same, so no
 	// re-send of the proof is necessary.
-	err := c.cfg.ProofWatcher.MaybeWatch(
-		proofFile, c.cfg.ProofWatcher.DefaultUpdateCallback(),
-	)
+	onUpdate, onEviction := c.reOrgCallbacks(event)
+	err := c.cfg.ProofWatcher.MaybeWatch(proofFile, onUpdate, onEviction)
 	if err != nil {
 		return fmt.Errorf("error watching received proof: %w", err)
 	}
@@ -1463,6 +1466,53 @@ func (c *Custodian) setReceiveCompleted(event *address.Event,
 	return nil
 }
 
+// reOrgCallbacks returns the proof update callback and the eviction callback
+// watch option for the proofs of a received asset. Besides updating the proofs
+// in the local proof archive, they notify the receive event subscribers if the
+// anchor transaction of the given event is affected by a re-org.
+func (c *Custodian) reOrgCallbacks(
+	event *address.Event) (proof.UpdateCallback, proof.WatchOption) {
+
+	// The received proof file can contain multiple proofs that aren't
+	// buried sufficiently yet. We only notify about the proof of the
+	// receive itself.
+	isReceiveProof := func(proofs []*proof.Proof) bool {
+		return len(proofs) > 0 &&
+			proofs[0].AnchorTx.TxHash() == event.Outpoint.Hash
+	}
+
+	updateProofs := c.cfg.ProofWatcher.DefaultUpdateCallback()
+	onUpdate := func(proofs []*proof.Proof) error {
+		if err := updateProofs(proofs); err != nil {
+			return err
+		}
+
+		if isReceiveProof(proofs) {
+			c.publishSubscriberStatusEvent(NewAssetReceiveEvent(
+				*event.Addr.Tap, event.Outpoint,
+				proofs[0].BlockHeight, address.StatusCompleted,
+			))
+		}
+
+		return nil
+	}
+
+	// If the anchor transaction is evicted from the chain, the receive
+	// goes back to being unconfirmed until the transaction confirms again.
+	onEviction := func(proofs []*proof.Proof) error {
+		if isReceiveProof(proofs) {
+			c.publishSubscriberStatusEvent(NewAssetReceiveEvent(
+				*event.Addr.Tap, event.Outpoint, 0,
+				address.StatusTransactionDetected,
+			))
+		}
+
+		return nil
+	}
+
+	return onUpdate, proof.WithEvictionCallback(onEviction)
+}
+
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
 // be notified of any new status update events.
 func (c *Custodian) RegisterSubscriber(receiver *fn.EventReceiver[fn.Event],
@@ -1610,10 +1660,11 @@ func (c *Custodian) verifierCtx(ctx context.Context) proof.VerifierCtx {
 	merkleVerifier := proof.DefaultMerkleVerifier
 
 	return proof.VerifierCtx{
-		HeaderVerifier: headerVerifier,
-		MerkleVerifier: merkleVerifier,
-		GroupVerifier:  c.cfg.GroupVerifier,
-		ChainLookupGen: c.cfg.ChainBridge,
-		IgnoreChecker:  c.cfg.IgnoreChecker,
+		HeaderVerifier:    headerVerifier,
+		MerkleVerifier:    merkleVerifier,
+		GroupVerifier:     c.cfg.GroupVerifier,
+		ChainLookupGen:    c.cfg.ChainBridge,
+		IgnoreChecker:     c.cfg.IgnoreChecker,
+		VerificationCache: c.cfg.VerificationCache,
 	}
 }
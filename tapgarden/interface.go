@@ -27,6 +27,11 @@ const (
 	// IssuanceTxLabel defines the label assigned to an on-chain transaction
 	// that represents a tapd asset issuance.
 	IssuanceTxLabel = "tapd-asset-issuance"
+
+	// ReOrgRebroadcastTxLabel defines the label assigned to an anchor
+	// transaction that is broadcast again after it was evicted from the
+	// chain by a re-org.
+	ReOrgRebroadcastTxLabel = "tapd-reorg-rebroadcast"
 )
 
 // FundBatchResp is the response returned from the FundBatch method.
@@ -70,6 +75,17 @@ type Planter interface {
 	// current batch, if one exists.
 	CancelBatch() (*btcec.PublicKey, error)
 
+	// AddMintSchedule adds a new recurring mint schedule that mints new
+	// tranches of an existing asset group.
+	AddMintSchedule(schedule *MintSchedule) (*MintSchedule, error)
+
+	// ListMintSchedules returns all mint schedules.
+	ListMintSchedules() ([]*MintSchedule, error)
+
+	// CancelMintSchedule cancels the active mint schedule with the given
+	// ID.
+	CancelMintSchedule(id int64) error
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
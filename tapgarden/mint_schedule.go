@@ -0,0 +1,570 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// DefaultMintSchedulePollInterval is the default interval at which the
+	// planter checks for mint schedules that are due.
+	DefaultMintSchedulePollInterval = time.Minute
+
+	// MinMintScheduleCadence is the minimum interval between two tranches
+	// of a mint schedule. Each tranche is minted in its own anchor
+	// transaction, so minting more often than once per block isn't
+	// useful.
+	MinMintScheduleCadence = 10 * time.Minute
+
+	// MintScheduleTranchePlaceholder is the placeholder in the metadata
+	// template of a mint schedule that is replaced with the number of the
+	// tranche, starting at 1.
+	MintScheduleTranchePlaceholder = "{{tranche}}"
+)
+
+// MintScheduleState is the state of a mint schedule.
+type MintScheduleState uint8
+
+const (
+	// MintScheduleActive is the state of a schedule that mints new
+	// tranches.
+	MintScheduleActive MintScheduleState = 0
+
+	// MintScheduleCompleted is the state of a schedule that reached the
+	// maximum total supply of its asset group.
+	MintScheduleCompleted MintScheduleState = 1
+
+	// MintScheduleCancelled is the state of a schedule that was cancelled.
+	MintScheduleCancelled MintScheduleState = 2
+)
+
+// String returns a human-readable version of the mint schedule state.
+func (s MintScheduleState) String() string {
+	switch s {
+	case MintScheduleActive:
+		return "active"
+
+	case MintScheduleCompleted:
+		return "completed"
+
+	case MintScheduleCancelled:
+		return "cancelled"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// MintSchedule is a recurring issuance schedule that mints a new tranche of an
+// existing asset group at a fixed cadence, until the maximum total supply of
+// the group is reached.
+type MintSchedule struct {
+	// ID is the database ID of the schedule.
+	ID int64
+
+	// GroupKey is the tweaked group key of the asset group. The group key
+	// must be controlled by this node.
+	GroupKey btcec.PublicKey
+
+	// AssetVersion is the asset version of the minted tranches.
+	AssetVersion asset.Version
+
+	// Amount is the amount minted in each tranche. The last tranche may be
+	// smaller, so the maximum total supply isn't exceeded.
+	Amount uint64
+
+	// MetaType is the type of the metadata of each tranche.
+	MetaType proof.MetaType
+
+	// MetaTemplate is the metadata template of each tranche. Any
+	// occurrence of MintScheduleTranchePlaceholder is replaced with the
+	// number of the tranche.
+	MetaTemplate []byte
+
+	// Cadence is the interval between two tranches.
+	Cadence time.Duration
+
+	// MaxTotalSupply is the maximum issued supply of the asset group. No
+	// tranche is minted that would increase the issued supply of the
+	// group above this amount.
+	MaxTotalSupply uint64
+
+	// NextMintTime is the time at or after which the next tranche is
+	// minted.
+	NextMintTime time.Time
+
+	// NumTranches is the number of tranches minted by the schedule.
+	NumTranches uint32
+
+	// TotalMinted is the total amount minted by the schedule.
+	TotalMinted uint64
+
+	// State is the state of the schedule.
+	State MintScheduleState
+
+	// LastError is the error of the last failed mint attempt. It is
+	// cleared once a tranche is minted successfully.
+	LastError string
+
+	// CreationTime is the time the schedule was created.
+	CreationTime time.Time
+}
+
+// isDue returns true if the schedule is active and its next tranche is due at
+// the given time.
+func (m *MintSchedule) isDue(now time.Time) bool {
+	return m.State == MintScheduleActive && !m.NextMintTime.After(now)
+}
+
+// nextMintTime returns the time of the tranche after the current one. If the
+// planter was offline for longer than the cadence, missed tranches aren't
+// caught up on, instead the next tranche is scheduled one cadence from now.
+func (m *MintSchedule) nextMintTime(now time.Time) time.Time {
+	next := m.NextMintTime.Add(m.Cadence)
+	if next.Before(now) {
+		next = now.Add(m.Cadence)
+	}
+
+	return next
+}
+
+// trancheAmount returns the amount of the next tranche, given the currently
+// issued supply of the asset group. Zero is returned if the maximum total
+// supply is reached.
+func (m *MintSchedule) trancheAmount(issuedSupply uint64) uint64 {
+	if issuedSupply >= m.MaxTotalSupply {
+		return 0
+	}
+
+	return min(m.Amount, m.MaxTotalSupply-issuedSupply)
+}
+
+// trancheMeta renders the metadata of the given tranche from the metadata
+// template. The decimal display of the group anchor is carried over, as all
+// assets of a group must use the same decimal display.
+func (m *MintSchedule) trancheMeta(tranche uint32,
+	anchorMeta *proof.MetaReveal) (*proof.MetaReveal, error) {
+
+	meta := &proof.MetaReveal{
+		Type: m.MetaType,
+		Data: bytes.ReplaceAll(
+			m.MetaTemplate, []byte(MintScheduleTranchePlaceholder),
+			[]byte(strconv.FormatUint(uint64(tranche), 10)),
+		),
+	}
+
+	_, decDisplay, _ := anchorMeta.GetDecDisplay()
+	if decDisplay > 0 {
+		if err := meta.SetDecDisplay(decDisplay); err != nil {
+			return nil, fmt.Errorf("unable to set decimal "+
+				"display: %w", err)
+		}
+	}
+
+	return meta, nil
+}
+
+// MintScheduleStore is used to persist mint schedules.
+type MintScheduleStore interface {
+	// InsertMintSchedule persists a new mint schedule and returns its ID.
+	InsertMintSchedule(context.Context, *MintSchedule) (int64, error)
+
+	// FetchMintSchedules returns all mint schedules, optionally filtered
+	// by their state.
+	FetchMintSchedules(context.Context,
+		fn.Option[MintScheduleState]) ([]*MintSchedule, error)
+
+	// UpdateMintSchedule updates the progress and state of the given mint
+	// schedule.
+	UpdateMintSchedule(context.Context, *MintSchedule) error
+}
+
+// GroupSupplyFetcher is used to look up the issued supply of an asset group.
+type GroupSupplyFetcher interface {
+	// FetchIssuedSupply returns the total amount of assets minted for the
+	// given asset group, as committed to in the universe supply
+	// commitment of the group.
+	FetchIssuedSupply(ctx context.Context,
+		groupKey btcec.PublicKey) (uint64, error)
+}
+
+// AddMintSchedule validates and persists a new mint schedule. The first
+// tranche is minted at the schedule's next mint time, or as soon as possible
+// if none is set.
+func (c *ChainPlanter) AddMintSchedule(
+	schedule *MintSchedule) (*MintSchedule, error) {
+
+	req := newStateParamReq[*MintSchedule](
+		reqTypeAddMintSchedule, schedule,
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// ListMintSchedules returns all mint schedules.
+func (c *ChainPlanter) ListMintSchedules() ([]*MintSchedule, error) {
+	req := newStateReq[[]*MintSchedule](reqTypeListMintSchedules)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// CancelMintSchedule cancels the active mint schedule with the given ID.
+// Tranches that were already minted aren't affected.
+func (c *ChainPlanter) CancelMintSchedule(id int64) error {
+	req := newStateParamReq[*MintSchedule](reqTypeCancelMintSchedule, id)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.err
+}
+
+// handleMintScheduleReq handles a request to add, list or cancel mint
+// schedules. This must only be called from the gardener goroutine.
+func (c *ChainPlanter) handleMintScheduleReq(req stateRequest) {
+	if c.cfg.MintSchedules == nil {
+		req.Error(fmt.Errorf("mint schedules not supported"))
+		return
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	switch req.Type() {
+	case reqTypeAddMintSchedule:
+		schedule, err := typedParam[*MintSchedule](req)
+		if err != nil {
+			req.Error(fmt.Errorf("bad mint schedule: %w", err))
+			return
+		}
+
+		newSchedule, err := c.addMintSchedule(ctx, *schedule)
+		if err != nil {
+			req.Error(err)
+			return
+		}
+
+		req.Resolve(newSchedule)
+
+	case reqTypeListMintSchedules:
+		schedules, err := c.cfg.MintSchedules.FetchMintSchedules(
+			ctx, fn.None[MintScheduleState](),
+		)
+		if err != nil {
+			req.Error(fmt.Errorf("unable to fetch mint "+
+				"schedules: %w", err))
+			return
+		}
+
+		req.Resolve(schedules)
+
+	case reqTypeCancelMintSchedule:
+		id, err := typedParam[int64](req)
+		if err != nil {
+			req.Error(fmt.Errorf("bad mint schedule ID: %w", err))
+			return
+		}
+
+		schedule, err := c.cancelMintSchedule(ctx, *id)
+		if err != nil {
+			req.Error(err)
+			return
+		}
+
+		req.Resolve(schedule)
+	}
+}
+
+// addMintSchedule validates and persists a new mint schedule.
+func (c *ChainPlanter) addMintSchedule(ctx context.Context,
+	schedule *MintSchedule) (*MintSchedule, error) {
+
+	switch {
+	case schedule.Amount == 0:
+		return nil, ErrInvalidAssetAmt
+
+	case schedule.Cadence < MinMintScheduleCadence:
+		return nil, fmt.Errorf("cadence must be at least %v",
+			MinMintScheduleCadence)
+
+	case schedule.MaxTotalSupply < schedule.Amount:
+		return nil, fmt.Errorf("max total supply must be at least " +
+			"the tranche amount")
+	}
+
+	// We can only mint new tranches of a group we can sign for.
+	groupKeyBytes := schedule.GroupKey.SerializeCompressed()
+	group, err := c.cfg.Log.FetchGroupByGroupKey(ctx, &schedule.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("group key %x not found: %w",
+			groupKeyBytes, err)
+	}
+	if !group.GroupKey.IsLocal() {
+		return nil, fmt.Errorf("can't sign with group key %x",
+			groupKeyBytes)
+	}
+
+	if group.Genesis.Type == asset.Collectible && schedule.Amount != 1 {
+		return nil, fmt.Errorf("collectible tranches must have an " +
+			"amount of 1")
+	}
+
+	// Make sure the metadata of the first tranche is valid, so we don't
+	// only find out when the first tranche is minted.
+	anchorMeta, err := c.cfg.Log.FetchAssetMeta(ctx, group.Genesis.ID())
+	if err != nil {
+		return nil, fmt.Errorf("group anchor meta of %x not found: %w",
+			groupKeyBytes, err)
+	}
+	meta, err := schedule.trancheMeta(1, anchorMeta)
+	if err != nil {
+		return nil, err
+	}
+	if err := meta.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid metadata template: %w", err)
+	}
+
+	now := time.Now().UTC()
+	newSchedule := *schedule
+	newSchedule.State = MintScheduleActive
+	newSchedule.NumTranches = 0
+	newSchedule.TotalMinted = 0
+	newSchedule.LastError = ""
+	newSchedule.CreationTime = now
+	if newSchedule.NextMintTime.IsZero() {
+		newSchedule.NextMintTime = now
+	}
+
+	newSchedule.ID, err = c.cfg.MintSchedules.InsertMintSchedule(
+		ctx, &newSchedule,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store mint schedule: %w",
+			err)
+	}
+
+	log.Infof("Added mint schedule %d for group %x: %d units every %v, "+
+		"max total supply %d", newSchedule.ID, groupKeyBytes,
+		newSchedule.Amount, newSchedule.Cadence,
+		newSchedule.MaxTotalSupply)
+
+	return &newSchedule, nil
+}
+
+// cancelMintSchedule cancels the active mint schedule with the given ID.
+func (c *ChainPlanter) cancelMintSchedule(ctx context.Context,
+	id int64) (*MintSchedule, error) {
+
+	schedules, err := c.cfg.MintSchedules.FetchMintSchedules(
+		ctx, fn.Some(MintScheduleActive),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch mint schedules: %w",
+			err)
+	}
+
+	for _, schedule := range schedules {
+		if schedule.ID != id {
+			continue
+		}
+
+		schedule.State = MintScheduleCancelled
+		err := c.cfg.MintSchedules.UpdateMintSchedule(ctx, schedule)
+		if err != nil {
+			return nil, fmt.Errorf("unable to cancel mint "+
+				"schedule %d: %w", id, err)
+		}
+
+		log.Infof("Cancelled mint schedule %d", id)
+
+		return schedule, nil
+	}
+
+	return nil, fmt.Errorf("no active mint schedule with ID %d", id)
+}
+
+// executeMintSchedules mints a new tranche for each active mint schedule that
+// is due. This must only be called from the gardener goroutine.
+func (c *ChainPlanter) executeMintSchedules() error {
+	// Each tranche is minted in its own batch. If there is a pending
+	// batch, we don't want to mint the seedlings of the user along with
+	// the tranche, so we wait until the batch is finalized or cancelled.
+	if c.pendingBatch != nil {
+		log.Debugf("Pending batch exists, deferring mint schedules")
+		return nil
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	schedules, err := c.cfg.MintSchedules.FetchMintSchedules(
+		ctx, fn.Some(MintScheduleActive),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch mint schedules: %w", err)
+	}
+
+	for _, schedule := range schedules {
+		now := time.Now()
+		if !schedule.isDue(now) {
+			continue
+		}
+
+		err := c.mintTranche(ctx, schedule, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mintTranche mints the next tranche of the given mint schedule and updates
+// the schedule's progress. A failure to mint the tranche is recorded in the
+// schedule, which then retries with the next tranche. Only errors that should
+// stop the planter are returned.
+func (c *ChainPlanter) mintTranche(ctx context.Context, schedule *MintSchedule,
+	now time.Time) error {
+
+	issuedSupply, err := c.issuedSupply(ctx, schedule)
+	if err != nil {
+		log.Warnf("Unable to fetch issued supply of mint schedule "+
+			"%d: %v", schedule.ID, err)
+		return nil
+	}
+
+	amount := schedule.trancheAmount(issuedSupply)
+	if amount == 0 {
+		log.Infof("Mint schedule %d reached max total supply %d",
+			schedule.ID, schedule.MaxTotalSupply)
+
+		schedule.State = MintScheduleCompleted
+		return c.cfg.MintSchedules.UpdateMintSchedule(ctx, schedule)
+	}
+
+	tranche := schedule.NumTranches + 1
+	log.Infof("Minting tranche %d of mint schedule %d with %d units",
+		tranche, schedule.ID, amount)
+
+	seedling, err := c.trancheSeedling(ctx, schedule, tranche, amount)
+	if err == nil {
+		err = c.prepAssetSeedling(ctx, seedling)
+	}
+	if err == nil {
+		_, err = c.finalizeAndBroadcast(FinalizeParams{})
+	}
+	switch {
+	case errors.Is(err, errPlanterShuttingDown):
+		return err
+
+	case err != nil:
+		log.Errorf("Unable to mint tranche %d of mint schedule %d: %v",
+			tranche, schedule.ID, err)
+
+		// Don't leave a batch with the tranche behind that would
+		// block the next tranches.
+		if c.pendingBatch != nil {
+			cancelErr := c.cancelMintingBatch(
+				ctx, c.pendingBatch.BatchKey.PubKey,
+			)
+			if cancelErr != nil {
+				log.Warnf("Unable to cancel batch of mint "+
+					"schedule %d: %v", schedule.ID,
+					cancelErr)
+			}
+			c.pendingBatch = nil
+		}
+
+		schedule.LastError = err.Error()
+		schedule.NextMintTime = schedule.nextMintTime(now)
+
+		return c.cfg.MintSchedules.UpdateMintSchedule(ctx, schedule)
+	}
+
+	schedule.NumTranches = tranche
+	schedule.TotalMinted += amount
+	schedule.LastError = ""
+	schedule.NextMintTime = schedule.nextMintTime(now)
+	if issuedSupply+amount >= schedule.MaxTotalSupply {
+		schedule.State = MintScheduleCompleted
+	}
+
+	return c.cfg.MintSchedules.UpdateMintSchedule(ctx, schedule)
+}
+
+// issuedSupply returns the issued supply of the asset group of the given mint
+// schedule. The supply commitment of the group lags behind recent mints, so
+// the amount minted by the schedule itself is used as a lower bound.
+func (c *ChainPlanter) issuedSupply(ctx context.Context,
+	schedule *MintSchedule) (uint64, error) {
+
+	if c.cfg.SupplyFetcher == nil {
+		return schedule.TotalMinted, nil
+	}
+
+	committedSupply, err := c.cfg.SupplyFetcher.FetchIssuedSupply(
+		ctx, schedule.GroupKey,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(committedSupply, schedule.TotalMinted), nil
+}
+
+// trancheSeedling creates the seedling of a new tranche of the given mint
+// schedule.
+func (c *ChainPlanter) trancheSeedling(ctx context.Context,
+	schedule *MintSchedule, tranche uint32, amount uint64) (*Seedling,
+	error) {
+
+	group, err := c.cfg.Log.FetchGroupByGroupKey(ctx, &schedule.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch group: %w", err)
+	}
+
+	anchorMeta, err := c.cfg.Log.FetchAssetMeta(ctx, group.Genesis.ID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch group anchor meta: %w",
+			err)
+	}
+
+	meta, err := schedule.trancheMeta(tranche, anchorMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Seedling{
+		AssetVersion: schedule.AssetVersion,
+		AssetType:    group.Genesis.Type,
+		AssetName:    group.Genesis.Tag,
+		Meta:         meta,
+		Amount:       amount,
+		GroupInfo: &asset.AssetGroup{
+			GroupKey: &asset.GroupKey{
+				GroupPubKey: schedule.GroupKey,
+			},
+		},
+		SupplyCommitments: anchorMeta != nil &&
+			anchorMeta.UniverseCommitments,
+		updates: make(SeedlingUpdates, 1),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package tapgarden
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMintScheduleTranches tests that the tranches of a mint schedule respect
+// the maximum total supply and the cadence of the schedule.
+func TestMintScheduleTranches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	schedule := &MintSchedule{
+		Amount:         400,
+		Cadence:        time.Hour,
+		MaxTotalSupply: 1_000,
+		NextMintTime:   now,
+		State:          MintScheduleActive,
+	}
+
+	require.True(t, schedule.isDue(now))
+	require.False(t, schedule.isDue(now.Add(-time.Second)))
+
+	// The last tranche is capped so the max total supply isn't exceeded.
+	require.EqualValues(t, 400, schedule.trancheAmount(0))
+	require.EqualValues(t, 400, schedule.trancheAmount(600))
+	require.EqualValues(t, 300, schedule.trancheAmount(700))
+	require.Zero(t, schedule.trancheAmount(1_000))
+	require.Zero(t, schedule.trancheAmount(1_500))
+
+	// The next tranche follows the cadence, unless tranches were missed,
+	// in which case it is scheduled one cadence from now.
+	require.Equal(t, now.Add(time.Hour), schedule.nextMintTime(now))
+
+	later := now.Add(5 * time.Hour)
+	require.Equal(t, later.Add(time.Hour), schedule.nextMintTime(later))
+
+	// A cancelled schedule is never due.
+	schedule.State = MintScheduleCancelled
+	require.False(t, schedule.isDue(now))
+}
+
+// TestMintScheduleTrancheMeta tests that the tranche metadata is rendered from
+// the template and carries over the decimal display of the group anchor.
+func TestMintScheduleTrancheMeta(t *testing.T) {
+	t.Parallel()
+
+	schedule := &MintSchedule{
+		MetaType:     proof.MetaOpaque,
+		MetaTemplate: []byte("tranche {{tranche}} of {{tranche}}"),
+	}
+
+	meta, err := schedule.trancheMeta(7, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("tranche 7 of 7"), meta.Data)
+	require.True(t, meta.DecimalDisplay.IsNone())
+
+	anchorMeta := &proof.MetaReveal{
+		Type:           proof.MetaOpaque,
+		DecimalDisplay: fn.Some[uint32](2),
+	}
+	meta, err = schedule.trancheMeta(1, anchorMeta)
+	require.NoError(t, err)
+	require.Equal(t, []byte("tranche 1 of 1"), meta.Data)
+	require.Equal(t, fn.Some[uint32](2), meta.DecimalDisplay)
+	require.NoError(t, meta.Validate())
+}
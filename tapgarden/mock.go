@@ -579,6 +579,11 @@ type MockChainBridge struct {
 	ReqCount atomic.Int32
 	ConfReqs map[int]*chainntnfs.ConfirmationEvent
 
+	// ReOrgChans holds the re-org channels given to the confirmation
+	// requests, keyed by the request number. They are used to simulate
+	// re-orgs that evict a transaction from the chain.
+	ReOrgChans map[int]chan struct{}
+
 	Blocks map[chainhash.Hash]*wire.MsgBlock
 
 	failFeeEstimates atomic.Bool
@@ -592,6 +597,7 @@ func NewMockChainBridge() *MockChainBridge {
 		FeeEstimateSignal: make(chan struct{}),
 		PublishReq:        make(chan *wire.MsgTx),
 		ConfReqs:          make(map[int]*chainntnfs.ConfirmationEvent),
+		ReOrgChans:        make(map[int]chan struct{}),
 		ConfReqSignal:     make(chan int),
 		BlockEpochSignal:  make(chan struct{}, 1),
 		NewBlocks:         make(chan int32),
@@ -642,7 +648,8 @@ func (m *MockChainBridge) SendConfNtfn(reqNo int, blockHash *chainhash.Hash,
 
 func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	_ *chainhash.Hash, _ []byte, _, _ uint32, _ bool,
-	_ chan struct{}) (*chainntnfs.ConfirmationEvent, chan error, error) {
+	reOrgChan chan struct{}) (*chainntnfs.ConfirmationEvent, chan error,
+	error) {
 
 	select {
 	case <-ctx.Done():
@@ -662,6 +669,7 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 
 	currentReqCount := m.ReqCount.Load()
 	m.ConfReqs[int(currentReqCount)] = req
+	m.ReOrgChans[int(currentReqCount)] = reOrgChan
 
 	select {
 	case m.ConfReqSignal <- int(currentReqCount):
@@ -677,6 +685,15 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, m.confErr, nil
 }
 
+// SimulateReOrg simulates a re-org that evicts the transaction of the
+// confirmation request with the given number from the chain.
+func (m *MockChainBridge) SimulateReOrg(reqNum int) {
+	reOrgChan := m.ReOrgChans[reqNum]
+	if reOrgChan != nil {
+		reOrgChan <- struct{}{}
+	}
+}
+
 func (m *MockChainBridge) RegisterBlockEpochNtfn(
 	ctx context.Context) (chan int32, chan error, error) {
 
@@ -1125,12 +1142,14 @@ type MockProofWatcher struct {
 }
 
 func (m *MockProofWatcher) WatchProofs([]*proof.Proof,
-	proof.UpdateCallback) error {
+	proof.UpdateCallback, ...proof.WatchOption) error {
 
 	return nil
 }
 
-func (m *MockProofWatcher) MaybeWatch(*proof.File, proof.UpdateCallback) error {
+func (m *MockProofWatcher) MaybeWatch(*proof.File, proof.UpdateCallback,
+	...proof.WatchOption) error {
+
 	return nil
 }
 
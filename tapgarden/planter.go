@@ -96,6 +96,10 @@ type GardenKit struct {
 	// a proof should be ignored.
 	IgnoreChecker lfn.Option[proof.IgnoreChecker]
 
+	// VerificationCache is an optional cache of already verified proofs
+	// that is used to speed up the verification of proof files.
+	VerificationCache lfn.Option[proof.VerificationCache]
+
 	// MintSupplyCommitter is used to commit the minting of new assets to
 	// the supply commitment state machine.
 	MintSupplyCommitter MintSupplyCommitter
@@ -122,6 +126,20 @@ type PlanterConfig struct {
 	// critical errors to the main server.
 	ErrChan chan<- error
 
+	// MintSchedules is an optional store for recurring mint schedules. If
+	// nil, mint schedules aren't supported.
+	MintSchedules MintScheduleStore
+
+	// SupplyFetcher is an optional source of the issued supply of an
+	// asset group, used to enforce the maximum total supply of mint
+	// schedules.
+	SupplyFetcher GroupSupplyFetcher
+
+	// MintSchedulePollInterval is the interval at which due mint
+	// schedules are executed. If zero, DefaultMintSchedulePollInterval is
+	// used.
+	MintSchedulePollInterval time.Duration
+
 	// TODO(roasbeef): something notification related?
 }
 
@@ -390,6 +408,9 @@ const (
 	reqTypeCancelBatch
 	reqTypeFundBatch
 	reqTypeSealBatch
+	reqTypeAddMintSchedule
+	reqTypeListMintSchedules
+	reqTypeCancelMintSchedule
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -1864,6 +1885,21 @@ func (c *ChainPlanter) gardener() {
 
 	log.Infof("Gardener for ChainPlanter now active!")
 
+	// If mint schedules are supported, we periodically check for
+	// schedules that are due.
+	var scheduleTicks <-chan time.Time
+	if c.cfg.MintSchedules != nil {
+		pollInterval := c.cfg.MintSchedulePollInterval
+		if pollInterval == 0 {
+			pollInterval = DefaultMintSchedulePollInterval
+		}
+
+		scheduleTicker := time.NewTicker(pollInterval)
+		defer scheduleTicker.Stop()
+
+		scheduleTicks = scheduleTicker.C
+	}
+
 	for {
 		select {
 		// A request for new asset issuance just arrived, add this to
@@ -1925,6 +1961,17 @@ func (c *ChainPlanter) gardener() {
 
 			// TODO(roasbeef): send completion signal?
 
+		// It's time to check whether any of the mint schedules is due.
+		case <-scheduleTicks:
+			err := c.executeMintSchedules()
+			if errors.Is(err, errPlanterShuttingDown) {
+				return
+			}
+			if err != nil {
+				log.Errorf("Unable to execute mint schedules: "+
+					"%v", err)
+			}
+
 		// A new request just came along to query our internal state.
 		case req := <-c.stateReqs:
 			switch req.Type() {
@@ -2040,10 +2087,6 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
-				batchKey := c.pendingBatch.BatchKey.PubKey
-				batchKeySerial := asset.ToSerialized(batchKey)
-				log.Infof("Finalizing batch %x", batchKeySerial)
-
 				finalizeReqParams, err :=
 					typedParam[FinalizeParams](req)
 				if err != nil {
@@ -2052,46 +2095,24 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
-				caretaker, err := c.finalizeBatch(
+				batch, err := c.finalizeAndBroadcast(
 					*finalizeReqParams,
 				)
-				if err != nil {
-					freezeErr := fmt.Errorf("unable to "+
-						"finalize minting batch: %w",
-						err)
-					log.Warnf(freezeErr.Error())
-					req.Error(freezeErr)
-					break
-				}
-
-				// We now wait for the caretaker to either
-				// broadcast the batch or fail to do so.
-				select {
-				case <-caretaker.cfg.BroadcastCompleteChan:
-					req.Resolve(caretaker.cfg.Batch)
+				switch {
+				case errors.Is(err, errPlanterShuttingDown):
+					return
 
-				case err := <-caretaker.cfg.BroadcastErrChan:
+				case err != nil:
 					req.Error(err)
-					// Unrecoverable error, stop caretaker
-					// directly. The pending batch will not
-					// be saved.
-					stopErr := caretaker.Stop()
-					if stopErr != nil {
-						log.Warnf("Unable to stop "+
-							"caretaker "+
-							"gracefully: %v", err)
-					}
-
-					delete(c.caretakers, batchKeySerial)
 
-				case <-c.Quit:
-					return
+				default:
+					req.Resolve(batch)
 				}
 
-				// Now that we have a caretaker launched for
-				// this batch and broadcast its minting
-				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+			case reqTypeAddMintSchedule, reqTypeListMintSchedules,
+				reqTypeCancelMintSchedule:
+
+				c.handleMintScheduleReq(req)
 
 			case reqTypeCancelBatch:
 				batchKey, err := c.canCancelBatch()
@@ -2693,6 +2714,60 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	return caretaker, nil
 }
 
+// errPlanterShuttingDown is returned if the planter is shutting down while
+// waiting for a batch to be broadcast.
+var errPlanterShuttingDown = errors.New("chain planter shutting down")
+
+// finalizeAndBroadcast finalizes the pending batch and waits for its caretaker
+// to broadcast the minting transaction. Once the caretaker either broadcast the
+// transaction or failed to do so, the pending batch is removed.
+func (c *ChainPlanter) finalizeAndBroadcast(
+	params FinalizeParams) (*MintingBatch, error) {
+
+	batchKey := c.pendingBatch.BatchKey.PubKey
+	batchKeySerial := asset.ToSerialized(batchKey)
+	log.Infof("Finalizing batch %x", batchKeySerial)
+
+	caretaker, err := c.finalizeBatch(params)
+	if err != nil {
+		freezeErr := fmt.Errorf("unable to finalize minting batch: %w",
+			err)
+		log.Warnf(freezeErr.Error())
+		return nil, freezeErr
+	}
+
+	// We now wait for the caretaker to either broadcast the batch or fail
+	// to do so.
+	var broadcastErr error
+	select {
+	case <-caretaker.cfg.BroadcastCompleteChan:
+
+	case broadcastErr = <-caretaker.cfg.BroadcastErrChan:
+		// Unrecoverable error, stop caretaker directly. The pending
+		// batch will not be saved.
+		stopErr := caretaker.Stop()
+		if stopErr != nil {
+			log.Warnf("Unable to stop caretaker gracefully: %v",
+				stopErr)
+		}
+
+		delete(c.caretakers, batchKeySerial)
+
+	case <-c.Quit:
+		return nil, errPlanterShuttingDown
+	}
+
+	// Now that we have a caretaker launched for this batch and broadcast
+	// its minting transaction, we can remove the pending batch.
+	c.pendingBatch = nil
+
+	if broadcastErr != nil {
+		return nil, broadcastErr
+	}
+
+	return caretaker.cfg.Batch, nil
+}
+
 // PendingBatch returns the current pending batch, or nil if no batch is
 // pending.
 func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
@@ -3215,11 +3290,12 @@ func (c *ChainPlanter) verifierCtx(ctx context.Context) proof.VerifierCtx {
 	groupVerifier := GenGroupVerifier(ctx, c.cfg.Log)
 
 	return proof.VerifierCtx{
-		HeaderVerifier: headerVerifier,
-		MerkleVerifier: merkleVerifier,
-		GroupVerifier:  groupVerifier,
-		ChainLookupGen: c.cfg.ChainBridge,
-		IgnoreChecker:  c.cfg.IgnoreChecker,
+		HeaderVerifier:    headerVerifier,
+		MerkleVerifier:    merkleVerifier,
+		GroupVerifier:     groupVerifier,
+		ChainLookupGen:    c.cfg.ChainBridge,
+		IgnoreChecker:     c.cfg.IgnoreChecker,
+		VerificationCache: c.cfg.VerificationCache,
 	}
 }
 
@@ -28,6 +28,8 @@ type proofRegistration struct {
 	blockHeight int32
 
 	updateCb proof.UpdateCallback
+
+	evictionCb proof.EvictionCallback
 }
 
 // anchorTxNotification is a struct that holds all proof watch subscriptions for
@@ -36,6 +38,10 @@ type proofRegistration struct {
 type anchorTxNotification struct {
 	proofsRegistrations []*proofRegistration
 
+	// evicted is true if the anchor transaction was evicted from the chain
+	// by a re-org and hasn't confirmed again yet.
+	evicted bool
+
 	cancel context.CancelFunc
 }
 
@@ -56,6 +62,20 @@ func (a *anchorTxNotification) firstRegistration() *proofRegistration {
 	return a.proofsRegistrations[0]
 }
 
+// AnchorTxStore is used by the re-org watcher to update the confirmation
+// status of anchor transactions that were affected by a re-org.
+type AnchorTxStore interface {
+	// MarkAnchorTxUnconfirmed removes the block information of the given
+	// anchor transaction, which marks all assets anchored in it as
+	// unconfirmed.
+	MarkAnchorTxUnconfirmed(ctx context.Context, txid chainhash.Hash) error
+
+	// MarkAnchorTxConfirmed sets the block information of the given anchor
+	// transaction after it confirmed in a new block.
+	MarkAnchorTxConfirmed(ctx context.Context, txid chainhash.Hash,
+		blockHash chainhash.Hash, blockHeight, txIndex uint32) error
+}
+
 // ReOrgWatcherConfig houses all the items that the re-org watcher needs to
 // carry out its duties.
 type ReOrgWatcherConfig struct {
@@ -71,10 +91,19 @@ type ReOrgWatcherConfig struct {
 	// updated proofs.
 	ProofArchive proof.Archiver
 
+	// AnchorTxStore is used to update the confirmation status of anchor
+	// transactions that are evicted from the chain or confirm in a new
+	// block because of a re-org.
+	AnchorTxStore AnchorTxStore
+
 	// IgnoreChecker is an optional function that can be used to check if
 	// a proof should be ignored.
 	IgnoreChecker lfn.Option[proof.IgnoreChecker]
 
+	// VerificationCache is an optional cache of already verified proofs
+	// that is invalidated when a re-org is detected.
+	VerificationCache lfn.Option[proof.VerificationCache]
+
 	// NonBuriedAssetFetcher is a function that returns all assets that are
 	// not yet sufficiently deep buried.
 	NonBuriedAssetFetcher func(ctx context.Context,
@@ -91,7 +120,9 @@ type ReOrgWatcherConfig struct {
 
 // ReOrgWatcher is responsible for watching initially confirmed transactions
 // until they reach a safe confirmation depth. If a re-org happens, it will
-// update the proof and store it in the proof archive.
+// update the proof and store it in the proof archive. If an anchor transaction
+// is evicted from the chain entirely, its assets are marked as unconfirmed and
+// the transaction is broadcast again.
 type ReOrgWatcher struct {
 	startOnce sync.Once
 	stopOnce  sync.Once
@@ -102,6 +133,7 @@ type ReOrgWatcher struct {
 
 	incomingProofs chan *proofRegistration
 	incomingConfs  chan *chainntnfs.TxConfirmation
+	evictedTxs     chan chainhash.Hash
 
 	// pendingProofs is a list of all proofs that are currently being
 	// watched for re-orgs, keyed by their anchor transaction hash.
@@ -118,6 +150,7 @@ func NewReOrgWatcher(cfg *ReOrgWatcherConfig) *ReOrgWatcher {
 		cfg:            cfg,
 		incomingProofs: make(chan *proofRegistration),
 		incomingConfs:  make(chan *chainntnfs.TxConfirmation),
+		evictedTxs:     make(chan chainhash.Hash),
 		pendingProofs:  make(map[chainhash.Hash]*anchorTxNotification),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
@@ -277,10 +310,16 @@ func (w *ReOrgWatcher) waitForConf(ctx context.Context, txHash chainhash.Hash,
 
 				// We continue to watch the transaction until
 				// it reaches a safe confirmation depth. We
-				// basically only use this signal to log the
-				// message above. We expect another confirmation
-				// to come in once the transaction is included
-				// in a new block in the re-organized chain.
+				// expect another confirmation to come in once
+				// the transaction is included in a new block
+				// in the re-organized chain. Until then, the
+				// main event loop marks the assets as
+				// unconfirmed and broadcasts the transaction
+				// again.
+				select {
+				case w.evictedTxs <- txHash:
+				case <-w.Quit:
+				}
 
 			case err := <-errChan:
 				if !fn.IsRpcErr(
@@ -314,6 +353,83 @@ func (w *ReOrgWatcher) waitForConf(ctx context.Context, txHash chainhash.Hash,
 	return nil
 }
 
+// invalidateVerifiedProofs removes all proofs from the verification cache that
+// might have been affected by a re-org that moved an anchor transaction from or
+// to the given height. Because the re-org could have started below that
+// height, we also invalidate the proofs within the safe depth below it.
+func (w *ReOrgWatcher) invalidateVerifiedProofs(height int32) error {
+	fromHeight := max(height-w.cfg.SafeDepth, 0)
+
+	return lfn.MapOptionZ(
+		w.cfg.VerificationCache,
+		func(cache proof.VerificationCache) error {
+			ctxt, cancel := w.WithCtxQuit()
+			defer cancel()
+
+			log.Infof("Invalidating verified proofs from height "+
+				"%d after re-org", fromHeight)
+
+			return cache.InvalidateFromHeight(
+				ctxt, uint32(fromHeight),
+			)
+		},
+	)
+}
+
+// handleEviction handles the eviction of an anchor transaction from the chain
+// by a re-org. The assets anchored in the transaction are marked as
+// unconfirmed, the transaction is broadcast again and the registered eviction
+// callbacks are called. The proofs are updated once the transaction confirms
+// again.
+func (w *ReOrgWatcher) handleEviction(txNtfn *anchorTxNotification,
+	txHash chainhash.Hash) error {
+
+	ctxt, cancel := w.WithCtxQuit()
+	defer cancel()
+
+	firstReg := txNtfn.firstRegistration()
+	txNtfn.evicted = true
+
+	// Any proofs that were verified against the blocks of the old chain
+	// must be verified again.
+	err := w.invalidateVerifiedProofs(firstReg.blockHeight)
+	if err != nil {
+		return fmt.Errorf("error invalidating verified proofs: %w",
+			err)
+	}
+
+	err = w.cfg.AnchorTxStore.MarkAnchorTxUnconfirmed(ctxt, txHash)
+	if err != nil {
+		return fmt.Errorf("unable to mark anchor TX %v as "+
+			"unconfirmed: %w", txHash, err)
+	}
+
+	// The transaction most likely is still valid in the new chain, so we
+	// broadcast it again to make sure it confirms. If one of its inputs
+	// was double spent in the new chain, this fails and the transaction
+	// will never confirm again, which we can't do anything about.
+	err = w.cfg.ChainBridge.PublishTransaction(
+		ctxt, &firstReg.anchorTx, ReOrgRebroadcastTxLabel,
+	)
+	if err != nil {
+		log.Warnf("Unable to re-broadcast evicted anchor TX %v: %v",
+			txHash, err)
+	}
+
+	for _, r := range txNtfn.proofsRegistrations {
+		if r.evictionCb == nil {
+			continue
+		}
+
+		if err := r.evictionCb(r.proofs); err != nil {
+			return fmt.Errorf("unable to notify about evicted "+
+				"anchor TX %v: %w", txHash, err)
+		}
+	}
+
+	return nil
+}
+
 // updateProofs updates the given proofs with the new block and merkle proof and
 // then informs the caller about the update.
 func (w *ReOrgWatcher) updateProofs(proofNtfn *anchorTxNotification,
@@ -339,6 +455,10 @@ func (w *ReOrgWatcher) updateProofs(proofNtfn *anchorTxNotification,
 			p.TxMerkleProof = *merkleProof
 		}
 
+		// The safe depth is now counted from the new block.
+		r.blockHash = conf.Block.BlockHash()
+		r.blockHeight = int32(conf.BlockHeight)
+
 		if err := r.updateCb(r.proofs); err != nil {
 			err := fmt.Errorf("unable to update proof after "+
 				"re-org: %w", err)
@@ -434,7 +554,7 @@ func (w *ReOrgWatcher) watchTransactions() {
 			// normal circumstances and would be an indication of
 			// the chain backend being misconfigured).
 			firstReg := txNtfn.firstRegistration()
-			if firstReg.blockHash == confHash {
+			if firstReg.blockHash == confHash && !txNtfn.evicted {
 				log.Debugf("Anchor TX %v was already "+
 					"confirmed in block %v, ignoring "+
 					"confirmation for block %v", txHash,
@@ -442,6 +562,18 @@ func (w *ReOrgWatcher) watchTransactions() {
 				continue
 			}
 
+			// Any proofs that were verified against the blocks of
+			// the old chain must be verified again.
+			err := w.invalidateVerifiedProofs(
+				min(firstReg.blockHeight,
+					int32(conf.BlockHeight)),
+			)
+			if err != nil {
+				w.reportErr(fmt.Errorf("error invalidating "+
+					"verified proofs: %w", err))
+				return
+			}
+
 			// We can now update the proofs with the new block and
 			// inform the caller if necessary.
 			err = w.updateProofs(txNtfn, conf)
@@ -451,12 +583,47 @@ func (w *ReOrgWatcher) watchTransactions() {
 				return
 			}
 
+			// The assets anchored in the transaction are confirmed
+			// again, now in the new block.
+			err = w.cfg.AnchorTxStore.MarkAnchorTxConfirmed(
+				runCtx, txHash, confHash, conf.BlockHeight,
+				conf.TxIndex,
+			)
+			if err != nil {
+				w.reportErr(fmt.Errorf("error confirming "+
+					"anchor TX %v: %w", txHash, err))
+				return
+			}
+			txNtfn.evicted = false
+
+		case txHash := <-w.evictedTxs:
+			txNtfn, ok := w.pendingProofs[txHash]
+			if !ok {
+				log.Debugf("Anchor TX %v we're (no longer?) "+
+					"watching was evicted", txHash)
+				continue
+			}
+
+			err := w.handleEviction(txNtfn, txHash)
+			if err != nil {
+				w.reportErr(fmt.Errorf("error handling "+
+					"evicted anchor TX: %w", err))
+				return
+			}
+
 		case newBlock := <-newBlockChan:
 			log.Infof("New block at height %d", newBlock)
 			w.bestHeight.Store(newBlock)
 
 			for txid := range w.pendingProofs {
 				proofNtfn := w.pendingProofs[txid]
+
+				// An evicted transaction needs to be watched
+				// until it confirms again.
+				if proofNtfn.evicted {
+					continue
+				}
+
 				firstReg := proofNtfn.firstRegistration()
 				confs := newBlock - firstReg.blockHeight
 
@@ -493,7 +660,12 @@ func (w *ReOrgWatcher) watchTransactions() {
 // WatchProofs adds new proofs to the re-org watcher for their anchor
 // transaction to be watched until it reaches a safe confirmation depth.
 func (w *ReOrgWatcher) WatchProofs(newProofs []*proof.Proof,
-	onProofUpdate proof.UpdateCallback) error {
+	onProofUpdate proof.UpdateCallback, opts ...proof.WatchOption) error {
+
+	var params proof.WatchParams
+	for _, opt := range opts {
+		opt(&params)
+	}
 
 	if len(newProofs) == 0 {
 		return fmt.Errorf("cannot watch empty proof slice")
@@ -526,6 +698,7 @@ func (w *ReOrgWatcher) WatchProofs(newProofs []*proof.Proof,
 		blockHash:   blockHash,
 		blockHeight: int32(blockHeight),
 		updateCb:    onProofUpdate,
+		evictionCb:  params.OnEviction,
 	}:
 	case <-w.Quit:
 		return fmt.Errorf("re-org watcher was stopped")
@@ -537,7 +710,7 @@ func (w *ReOrgWatcher) WatchProofs(newProofs []*proof.Proof,
 // MaybeWatch inspects the given proof file for any proofs that are not
 // yet buried sufficiently deep and adds them to the re-org watcher.
 func (w *ReOrgWatcher) MaybeWatch(file *proof.File,
-	onProofUpdate proof.UpdateCallback) error {
+	onProofUpdate proof.UpdateCallback, opts ...proof.WatchOption) error {
 
 	// We walk backward through the file and start watching all proofs that
 	// are not yet sufficiently buried.
@@ -552,7 +725,9 @@ func (w *ReOrgWatcher) MaybeWatch(file *proof.File,
 		// watcher expects only proofs to be grouped that are committed
 		// in the same anchor transaction.
 		if w.ShouldWatch(p) {
-			err := w.WatchProofs([]*proof.Proof{p}, onProofUpdate)
+			err := w.WatchProofs(
+				[]*proof.Proof{p}, onProofUpdate, opts...,
+			)
 			if err != nil {
 				return fmt.Errorf("error watching proof: %w",
 					err)
@@ -589,10 +764,11 @@ func (w *ReOrgWatcher) DefaultUpdateCallback() proof.UpdateCallback {
 			HeaderVerifier: GenHeaderVerifier(
 				ctxt, w.cfg.ChainBridge,
 			),
-			MerkleVerifier: proof.DefaultMerkleVerifier,
-			GroupVerifier:  w.cfg.GroupVerifier,
-			ChainLookupGen: w.cfg.ChainBridge,
-			IgnoreChecker:  w.cfg.IgnoreChecker,
+			MerkleVerifier:    proof.DefaultMerkleVerifier,
+			GroupVerifier:     w.cfg.GroupVerifier,
+			ChainLookupGen:    w.cfg.ChainBridge,
+			IgnoreChecker:     w.cfg.IgnoreChecker,
+			VerificationCache: w.cfg.VerificationCache,
 		}
 		for idx := range proofs {
 			err := proof.ReplaceProofInBlob(
@@ -2,6 +2,7 @@ package tapgarden
 
 import (
 	"context"
+	"crypto/sha256"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	lfn "github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +32,70 @@ type reOrgWatcherHarness struct {
 	w           *ReOrgWatcher
 	cfg         *ReOrgWatcherConfig
 	chainBridge *MockChainBridge
+	cache       *mockVerificationCache
+	txStore     *mockAnchorTxStore
+}
+
+// anchorTxConf is a confirmation status update of an anchor transaction.
+type anchorTxConf struct {
+	txid        chainhash.Hash
+	confirmed   bool
+	blockHash   chainhash.Hash
+	blockHeight uint32
+}
+
+// mockAnchorTxStore is an anchor transaction store that records the
+// confirmation status updates it receives.
+type mockAnchorTxStore struct {
+	updates chan anchorTxConf
+}
+
+func (m *mockAnchorTxStore) MarkAnchorTxUnconfirmed(_ context.Context,
+	txid chainhash.Hash) error {
+
+	m.updates <- anchorTxConf{
+		txid: txid,
+	}
+	return nil
+}
+
+func (m *mockAnchorTxStore) MarkAnchorTxConfirmed(_ context.Context,
+	txid chainhash.Hash, blockHash chainhash.Hash, blockHeight,
+	_ uint32) error {
+
+	m.updates <- anchorTxConf{
+		txid:        txid,
+		confirmed:   true,
+		blockHash:   blockHash,
+		blockHeight: blockHeight,
+	}
+	return nil
+}
+
+// mockVerificationCache is a proof verification cache that records the
+// heights it was invalidated from.
+type mockVerificationCache struct {
+	invalidatedHeights chan uint32
+}
+
+func (m *mockVerificationCache) FetchVerified(context.Context,
+	...[sha256.Size]byte) (map[[sha256.Size]byte]proof.VerifiedProof,
+	error) {
+
+	return nil, nil
+}
+
+func (m *mockVerificationCache) AddVerified(context.Context,
+	...proof.VerifiedProof) error {
+
+	return nil
+}
+
+func (m *mockVerificationCache) InvalidateFromHeight(_ context.Context,
+	height uint32) error {
+
+	m.invalidatedHeights <- height
+	return nil
 }
 
 // assertStartup makes sure the custodian was started correctly.
@@ -49,9 +115,19 @@ func (h *reOrgWatcherHarness) eventually(fn func() bool) {
 
 func newReOrgWatcherHarness(t *testing.T) *reOrgWatcherHarness {
 	chainBridge := NewMockChainBridge()
+	cache := &mockVerificationCache{
+		invalidatedHeights: make(chan uint32, 1),
+	}
+	txStore := &mockAnchorTxStore{
+		updates: make(chan anchorTxConf, 1),
+	}
 	cfg := &ReOrgWatcherConfig{
 		ChainBridge:   chainBridge,
 		GroupVerifier: GenMockGroupVerifier(),
+		AnchorTxStore: txStore,
+		VerificationCache: lfn.Some[proof.VerificationCache](
+			cache,
+		),
 		NonBuriedAssetFetcher: func(ctx context.Context,
 			minHeight int32) ([]*asset.ChainAsset, error) {
 
@@ -65,9 +141,29 @@ func newReOrgWatcherHarness(t *testing.T) *reOrgWatcherHarness {
 		w:           NewReOrgWatcher(cfg),
 		cfg:         cfg,
 		chainBridge: chainBridge,
+		cache:       cache,
+		txStore:     txStore,
 	}
 }
 
+// assertInvalidated makes sure the verification cache was invalidated from the
+// given height.
+func (h *reOrgWatcherHarness) assertInvalidated(height uint32) {
+	invalidatedHeight, err := fn.RecvOrTimeout(
+		h.cache.invalidatedHeights, testTimeout,
+	)
+	require.NoError(h.t, err)
+	require.Equal(h.t, height, *invalidatedHeight)
+}
+
+// assertTxStoreUpdate makes sure the anchor transaction store received the
+// given confirmation status update.
+func (h *reOrgWatcherHarness) assertTxStoreUpdate(expected anchorTxConf) {
+	update, err := fn.RecvOrTimeout(h.txStore.updates, testTimeout)
+	require.NoError(h.t, err)
+	require.Equal(h.t, expected, *update)
+}
+
 func makeTx() *wire.MsgTx {
 	anchorTx := wire.NewMsgTx(2)
 	anchorTx.TxOut = []*wire.TxOut{{
@@ -178,6 +274,19 @@ func TestWatchProofs(t *testing.T) {
 		Block:       newBlock,
 	}
 
+	// The verification cache must have been invalidated from the original
+	// block height minus the safe depth, as the re-org might have started
+	// below the original block.
+	h.assertInvalidated(testInitialBlockHeight - testSafeDepth)
+
+	// The anchor TX must be marked as confirmed in the new block.
+	h.assertTxStoreUpdate(anchorTxConf{
+		txid:        anchorTx1.TxHash(),
+		confirmed:   true,
+		blockHash:   newBlockHash,
+		blockHeight: testReOrgBlockHeight,
+	})
+
 	// The callback for TX1 should have been called twice, once per slice of
 	// proofs we submitted.
 	h.eventually(func() bool {
@@ -199,3 +308,93 @@ func TestWatchProofs(t *testing.T) {
 		return len(h.w.pendingProofs) == 0
 	})
 }
+
+// TestEvictedAnchorTx makes sure that an anchor transaction that is evicted
+// from the chain by a re-org is marked as unconfirmed, broadcast again and
+// watched until it confirms again, at which point its proofs are updated.
+func TestEvictedAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	h := newReOrgWatcherHarness(t)
+	require.NoError(t, h.w.Start())
+	h.assertStartup()
+
+	anchorTx := makeTx()
+	txHash := anchorTx.TxHash()
+	proofs := []*proof.Proof{makeProof(anchorTx)}
+
+	var updateCalled, evictionCalled atomic.Int32
+	onUpdate := func(proofs []*proof.Proof) error {
+		updateCalled.Add(1)
+		return nil
+	}
+	onEviction := func(proofs []*proof.Proof) error {
+		evictionCalled.Add(1)
+		return nil
+	}
+
+	require.NoError(t, h.w.WatchProofs(
+		proofs, onUpdate, proof.WithEvictionCallback(onEviction),
+	))
+	reqNum, err := fn.RecvOrTimeout(
+		h.chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	// We now evict the anchor TX from the chain. The watcher must mark it
+	// as unconfirmed, broadcast it again and notify us.
+	h.chainBridge.SimulateReOrg(*reqNum)
+
+	h.assertInvalidated(testInitialBlockHeight - testSafeDepth)
+	h.assertTxStoreUpdate(anchorTxConf{
+		txid: txHash,
+	})
+
+	publishedTx, err := fn.RecvOrTimeout(
+		h.chainBridge.PublishReq, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, txHash, (*publishedTx).TxHash())
+
+	h.eventually(func() bool {
+		return evictionCalled.Load() == 1
+	})
+	require.EqualValues(t, 0, updateCalled.Load())
+
+	// Even though the original block is now buried deep enough, the
+	// evicted transaction must still be watched, as it isn't confirmed
+	// anymore.
+	h.chainBridge.NewBlocks <- testInitialBlockHeight + (testSafeDepth * 2)
+
+	// Once the transaction confirms again, the proofs are updated with the
+	// new block and the transaction is marked as confirmed.
+	newBlock := makeBlock(anchorTx)
+	newBlockHash := newBlock.BlockHash()
+	h.chainBridge.ConfReqs[*reqNum].Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHash:   &newBlockHash,
+		BlockHeight: testReOrgBlockHeight,
+		TxIndex:     1,
+		Tx:          anchorTx,
+		Block:       newBlock,
+	}
+
+	h.assertInvalidated(testInitialBlockHeight - testSafeDepth)
+	h.assertTxStoreUpdate(anchorTxConf{
+		txid:        txHash,
+		confirmed:   true,
+		blockHash:   newBlockHash,
+		blockHeight: testReOrgBlockHeight,
+	})
+
+	h.eventually(func() bool {
+		return updateCalled.Load() == 1
+	})
+	require.Equal(t, newBlock.Header, proofs[0].BlockHeader)
+	require.EqualValues(t, testReOrgBlockHeight, proofs[0].BlockHeight)
+
+	// The safe depth is now counted from the new block.
+	h.chainBridge.NewBlocks <- testReOrgBlockHeight + 1
+
+	require.NoError(t, h.w.Stop())
+	require.Len(t, h.w.pendingProofs, 1)
+}
@@ -88,6 +88,58 @@ func (BatchState) EnumDescriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{0}
 }
 
+type MintScheduleState int32
+
+const (
+	// The schedule mints new tranches.
+	MintScheduleState_MINT_SCHEDULE_STATE_ACTIVE MintScheduleState = 0
+	// The schedule reached the maximum total supply of its asset group.
+	MintScheduleState_MINT_SCHEDULE_STATE_COMPLETED MintScheduleState = 1
+	// The schedule was cancelled.
+	MintScheduleState_MINT_SCHEDULE_STATE_CANCELLED MintScheduleState = 2
+)
+
+// Enum value maps for MintScheduleState.
+var (
+	MintScheduleState_name = map[int32]string{
+		0: "MINT_SCHEDULE_STATE_ACTIVE",
+		1: "MINT_SCHEDULE_STATE_COMPLETED",
+		2: "MINT_SCHEDULE_STATE_CANCELLED",
+	}
+	MintScheduleState_value = map[string]int32{
+		"MINT_SCHEDULE_STATE_ACTIVE":    0,
+		"MINT_SCHEDULE_STATE_COMPLETED": 1,
+		"MINT_SCHEDULE_STATE_CANCELLED": 2,
+	}
+)
+
+func (x MintScheduleState) Enum() *MintScheduleState {
+	p := new(MintScheduleState)
+	*p = x
+	return p
+}
+
+func (x MintScheduleState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MintScheduleState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mintrpc_mint_proto_enumTypes[1].Descriptor()
+}
+
+func (MintScheduleState) Type() protoreflect.EnumType {
+	return &file_mintrpc_mint_proto_enumTypes[1]
+}
+
+func (x MintScheduleState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MintScheduleState.Descriptor instead.
+func (MintScheduleState) EnumDescriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{1}
+}
+
 type PendingAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1581,6 +1633,510 @@ func (x *MintEvent) GetError() string {
 	return ""
 }
 
+type MintSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tweaked group key of the asset group the tranches are minted into.
+	GroupKey []byte `protobuf:"bytes,2,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The asset version of the minted tranches.
+	AssetVersion taprpc.AssetVersion `protobuf:"varint,3,opt,name=asset_version,json=assetVersion,proto3,enum=taprpc.AssetVersion" json:"asset_version,omitempty"`
+	// The amount minted in each tranche. The last tranche may be smaller, so
+	// the maximum total supply isn't exceeded.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The type of the metadata of each tranche.
+	MetaType taprpc.AssetMetaType `protobuf:"varint,5,opt,name=meta_type,json=metaType,proto3,enum=taprpc.AssetMetaType" json:"meta_type,omitempty"`
+	// The metadata template of each tranche. Any occurrence of "{{tranche}}"
+	// is replaced with the number of the tranche, starting at 1.
+	MetaTemplate []byte `protobuf:"bytes,6,opt,name=meta_template,json=metaTemplate,proto3" json:"meta_template,omitempty"`
+	// The interval between two tranches in seconds.
+	CadenceSeconds uint64 `protobuf:"varint,7,opt,name=cadence_seconds,json=cadenceSeconds,proto3" json:"cadence_seconds,omitempty"`
+	// The maximum issued supply of the asset group.
+	MaxTotalSupply uint64 `protobuf:"varint,8,opt,name=max_total_supply,json=maxTotalSupply,proto3" json:"max_total_supply,omitempty"`
+	// The UTC Unix timestamp in seconds at or after which the next tranche is
+	// minted.
+	NextMintTimestamp int64 `protobuf:"varint,9,opt,name=next_mint_timestamp,json=nextMintTimestamp,proto3" json:"next_mint_timestamp,omitempty"`
+	// The number of tranches minted by the schedule.
+	NumTranches uint32 `protobuf:"varint,10,opt,name=num_tranches,json=numTranches,proto3" json:"num_tranches,omitempty"`
+	// The total amount minted by the schedule.
+	TotalMinted uint64 `protobuf:"varint,11,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	// The current state of the schedule.
+	State MintScheduleState `protobuf:"varint,12,opt,name=state,proto3,enum=mintrpc.MintScheduleState" json:"state,omitempty"`
+	// The error of the last failed mint attempt, if any.
+	LastError string `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The UTC Unix timestamp in seconds the schedule was created.
+	CreationTimestamp int64 `protobuf:"varint,14,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+}
+
+func (x *MintSchedule) Reset() {
+	*x = MintSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintSchedule) ProtoMessage() {}
+
+func (x *MintSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintSchedule.ProtoReflect.Descriptor instead.
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MintSchedule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *MintSchedule) GetAssetVersion() taprpc.AssetVersion {
+	if x != nil {
+		return x.AssetVersion
+	}
+	return taprpc.AssetVersion(0)
+}
+
+func (x *MintSchedule) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetMetaType() taprpc.AssetMetaType {
+	if x != nil {
+		return x.MetaType
+	}
+	return taprpc.AssetMetaType(0)
+}
+
+func (x *MintSchedule) GetMetaTemplate() []byte {
+	if x != nil {
+		return x.MetaTemplate
+	}
+	return nil
+}
+
+func (x *MintSchedule) GetCadenceSeconds() uint64 {
+	if x != nil {
+		return x.CadenceSeconds
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetMaxTotalSupply() uint64 {
+	if x != nil {
+		return x.MaxTotalSupply
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetNextMintTimestamp() int64 {
+	if x != nil {
+		return x.NextMintTimestamp
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetNumTranches() uint32 {
+	if x != nil {
+		return x.NumTranches
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetTotalMinted() uint64 {
+	if x != nil {
+		return x.TotalMinted
+	}
+	return 0
+}
+
+func (x *MintSchedule) GetState() MintScheduleState {
+	if x != nil {
+		return x.State
+	}
+	return MintScheduleState_MINT_SCHEDULE_STATE_ACTIVE
+}
+
+func (x *MintSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *MintSchedule) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type AddMintScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tweaked group key of an existing asset group controlled by this
+	// node.
+	GroupKey []byte `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The asset version of the minted tranches.
+	AssetVersion taprpc.AssetVersion `protobuf:"varint,2,opt,name=asset_version,json=assetVersion,proto3,enum=taprpc.AssetVersion" json:"asset_version,omitempty"`
+	// The amount minted in each tranche. Must be 1 for collectible groups.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The type of the metadata of each tranche.
+	MetaType taprpc.AssetMetaType `protobuf:"varint,4,opt,name=meta_type,json=metaType,proto3,enum=taprpc.AssetMetaType" json:"meta_type,omitempty"`
+	// The metadata template of each tranche. Any occurrence of "{{tranche}}"
+	// is replaced with the number of the tranche, starting at 1.
+	MetaTemplate []byte `protobuf:"bytes,5,opt,name=meta_template,json=metaTemplate,proto3" json:"meta_template,omitempty"`
+	// The interval between two tranches in seconds. Must be at least 600
+	// seconds.
+	CadenceSeconds uint64 `protobuf:"varint,6,opt,name=cadence_seconds,json=cadenceSeconds,proto3" json:"cadence_seconds,omitempty"`
+	// The maximum issued supply of the asset group. No tranche is minted that
+	// would increase the issued supply of the group above this amount.
+	MaxTotalSupply uint64 `protobuf:"varint,7,opt,name=max_total_supply,json=maxTotalSupply,proto3" json:"max_total_supply,omitempty"`
+	// The optional UTC Unix timestamp in seconds at or after which the first
+	// tranche is minted. If zero, the first tranche is minted right away.
+	StartTimestamp int64 `protobuf:"varint,8,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+}
+
+func (x *AddMintScheduleRequest) Reset() {
+	*x = AddMintScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMintScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMintScheduleRequest) ProtoMessage() {}
+
+func (x *AddMintScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMintScheduleRequest.ProtoReflect.Descriptor instead.
+func (*AddMintScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddMintScheduleRequest) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *AddMintScheduleRequest) GetAssetVersion() taprpc.AssetVersion {
+	if x != nil {
+		return x.AssetVersion
+	}
+	return taprpc.AssetVersion(0)
+}
+
+func (x *AddMintScheduleRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddMintScheduleRequest) GetMetaType() taprpc.AssetMetaType {
+	if x != nil {
+		return x.MetaType
+	}
+	return taprpc.AssetMetaType(0)
+}
+
+func (x *AddMintScheduleRequest) GetMetaTemplate() []byte {
+	if x != nil {
+		return x.MetaTemplate
+	}
+	return nil
+}
+
+func (x *AddMintScheduleRequest) GetCadenceSeconds() uint64 {
+	if x != nil {
+		return x.CadenceSeconds
+	}
+	return 0
+}
+
+func (x *AddMintScheduleRequest) GetMaxTotalSupply() uint64 {
+	if x != nil {
+		return x.MaxTotalSupply
+	}
+	return 0
+}
+
+func (x *AddMintScheduleRequest) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+type AddMintScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The newly added mint schedule.
+	MintSchedule *MintSchedule `protobuf:"bytes,1,opt,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule,omitempty"`
+}
+
+func (x *AddMintScheduleResponse) Reset() {
+	*x = AddMintScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMintScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMintScheduleResponse) ProtoMessage() {}
+
+func (x *AddMintScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMintScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AddMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddMintScheduleResponse) GetMintSchedule() *MintSchedule {
+	if x != nil {
+		return x.MintSchedule
+	}
+	return nil
+}
+
+type ListMintSchedulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMintSchedulesRequest) Reset() {
+	*x = ListMintSchedulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMintSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMintSchedulesRequest) ProtoMessage() {}
+
+func (x *ListMintSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMintSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListMintSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{22}
+}
+
+type ListMintSchedulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The mint schedules.
+	MintSchedules []*MintSchedule `protobuf:"bytes,1,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules,omitempty"`
+}
+
+func (x *ListMintSchedulesResponse) Reset() {
+	*x = ListMintSchedulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMintSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMintSchedulesResponse) ProtoMessage() {}
+
+func (x *ListMintSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMintSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListMintSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMintSchedulesResponse) GetMintSchedules() []*MintSchedule {
+	if x != nil {
+		return x.MintSchedules
+	}
+	return nil
+}
+
+type CancelMintScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the active mint schedule to cancel.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelMintScheduleRequest) Reset() {
+	*x = CancelMintScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMintScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMintScheduleRequest) ProtoMessage() {}
+
+func (x *CancelMintScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMintScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelMintScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelMintScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelMintScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMintScheduleResponse) Reset() {
+	*x = CancelMintScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMintScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMintScheduleResponse) ProtoMessage() {}
+
+func (x *CancelMintScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMintScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelMintScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{25}
+}
+
 var File_mintrpc_mint_proto protoreflect.FileDescriptor
 
 var file_mintrpc_mint_proto_rawDesc = []byte{
@@ -1794,60 +2350,160 @@ var file_mintrpc_mint_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
 	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63,
 	0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43,
-	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02,
-	0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10,
-	0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44,
-	0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07,
-	0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
-	0x10, 0x08, 0x32, 0x84, 0x04, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d,
-	0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x42, 0x0a, 0x09, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x6d,
-	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23,
-	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
-	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb0, 0x04, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x61, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xdd, 0x02, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b,
+	0x65, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x55, 0x0a, 0x17, 0x41, 0x64,
+	0x64, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x6d, 0x69,
+	0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a,
+	0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e,
+	0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f,
+	0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x79,
+	0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0x95, 0x06, 0x0a, 0x04, 0x4d, 0x69,
+	0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75,
+	0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65,
+	0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x54, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69,
+	0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x69, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x69, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61,
+	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1862,92 +2518,114 @@ func file_mintrpc_mint_proto_rawDescGZIP() []byte {
 	return file_mintrpc_mint_proto_rawDescData
 }
 
-var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mintrpc_mint_proto_goTypes = []any{
 	(BatchState)(0),                    // 0: mintrpc.BatchState
-	(*PendingAsset)(nil),               // 1: mintrpc.PendingAsset
-	(*UnsealedAsset)(nil),              // 2: mintrpc.UnsealedAsset
-	(*MintAsset)(nil),                  // 3: mintrpc.MintAsset
-	(*MintAssetRequest)(nil),           // 4: mintrpc.MintAssetRequest
-	(*MintAssetResponse)(nil),          // 5: mintrpc.MintAssetResponse
-	(*MintingBatch)(nil),               // 6: mintrpc.MintingBatch
-	(*VerboseBatch)(nil),               // 7: mintrpc.VerboseBatch
-	(*FundBatchRequest)(nil),           // 8: mintrpc.FundBatchRequest
-	(*FundBatchResponse)(nil),          // 9: mintrpc.FundBatchResponse
-	(*SealBatchRequest)(nil),           // 10: mintrpc.SealBatchRequest
-	(*SealBatchResponse)(nil),          // 11: mintrpc.SealBatchResponse
-	(*FinalizeBatchRequest)(nil),       // 12: mintrpc.FinalizeBatchRequest
-	(*FinalizeBatchResponse)(nil),      // 13: mintrpc.FinalizeBatchResponse
-	(*CancelBatchRequest)(nil),         // 14: mintrpc.CancelBatchRequest
-	(*CancelBatchResponse)(nil),        // 15: mintrpc.CancelBatchResponse
-	(*ListBatchRequest)(nil),           // 16: mintrpc.ListBatchRequest
-	(*ListBatchResponse)(nil),          // 17: mintrpc.ListBatchResponse
-	(*SubscribeMintEventsRequest)(nil), // 18: mintrpc.SubscribeMintEventsRequest
-	(*MintEvent)(nil),                  // 19: mintrpc.MintEvent
-	(taprpc.AssetVersion)(0),           // 20: taprpc.AssetVersion
-	(taprpc.AssetType)(0),              // 21: taprpc.AssetType
-	(*taprpc.AssetMeta)(nil),           // 22: taprpc.AssetMeta
-	(*taprpc.KeyDescriptor)(nil),       // 23: taprpc.KeyDescriptor
-	(*taprpc.ScriptKey)(nil),           // 24: taprpc.ScriptKey
-	(*taprpc.GroupKeyRequest)(nil),     // 25: taprpc.GroupKeyRequest
-	(*taprpc.GroupVirtualTx)(nil),      // 26: taprpc.GroupVirtualTx
-	(*taprpc.ExternalKey)(nil),         // 27: taprpc.ExternalKey
-	(*taprpc.TapscriptFullTree)(nil),   // 28: taprpc.TapscriptFullTree
-	(*taprpc.TapBranch)(nil),           // 29: taprpc.TapBranch
-	(*taprpc.GroupWitness)(nil),        // 30: taprpc.GroupWitness
+	(MintScheduleState)(0),             // 1: mintrpc.MintScheduleState
+	(*PendingAsset)(nil),               // 2: mintrpc.PendingAsset
+	(*UnsealedAsset)(nil),              // 3: mintrpc.UnsealedAsset
+	(*MintAsset)(nil),                  // 4: mintrpc.MintAsset
+	(*MintAssetRequest)(nil),           // 5: mintrpc.MintAssetRequest
+	(*MintAssetResponse)(nil),          // 6: mintrpc.MintAssetResponse
+	(*MintingBatch)(nil),               // 7: mintrpc.MintingBatch
+	(*VerboseBatch)(nil),               // 8: mintrpc.VerboseBatch
+	(*FundBatchRequest)(nil),           // 9: mintrpc.FundBatchRequest
+	(*FundBatchResponse)(nil),          // 10: mintrpc.FundBatchResponse
+	(*SealBatchRequest)(nil),           // 11: mintrpc.SealBatchRequest
+	(*SealBatchResponse)(nil),          // 12: mintrpc.SealBatchResponse
+	(*FinalizeBatchRequest)(nil),       // 13: mintrpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),      // 14: mintrpc.FinalizeBatchResponse
+	(*CancelBatchRequest)(nil),         // 15: mintrpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),        // 16: mintrpc.CancelBatchResponse
+	(*ListBatchRequest)(nil),           // 17: mintrpc.ListBatchRequest
+	(*ListBatchResponse)(nil),          // 18: mintrpc.ListBatchResponse
+	(*SubscribeMintEventsRequest)(nil), // 19: mintrpc.SubscribeMintEventsRequest
+	(*MintEvent)(nil),                  // 20: mintrpc.MintEvent
+	(*MintSchedule)(nil),               // 21: mintrpc.MintSchedule
+	(*AddMintScheduleRequest)(nil),     // 22: mintrpc.AddMintScheduleRequest
+	(*AddMintScheduleResponse)(nil),    // 23: mintrpc.AddMintScheduleResponse
+	(*ListMintSchedulesRequest)(nil),   // 24: mintrpc.ListMintSchedulesRequest
+	(*ListMintSchedulesResponse)(nil),  // 25: mintrpc.ListMintSchedulesResponse
+	(*CancelMintScheduleRequest)(nil),  // 26: mintrpc.CancelMintScheduleRequest
+	(*CancelMintScheduleResponse)(nil), // 27: mintrpc.CancelMintScheduleResponse
+	(taprpc.AssetVersion)(0),           // 28: taprpc.AssetVersion
+	(taprpc.AssetType)(0),              // 29: taprpc.AssetType
+	(*taprpc.AssetMeta)(nil),           // 30: taprpc.AssetMeta
+	(*taprpc.KeyDescriptor)(nil),       // 31: taprpc.KeyDescriptor
+	(*taprpc.ScriptKey)(nil),           // 32: taprpc.ScriptKey
+	(*taprpc.GroupKeyRequest)(nil),     // 33: taprpc.GroupKeyRequest
+	(*taprpc.GroupVirtualTx)(nil),      // 34: taprpc.GroupVirtualTx
+	(*taprpc.ExternalKey)(nil),         // 35: taprpc.ExternalKey
+	(*taprpc.TapscriptFullTree)(nil),   // 36: taprpc.TapscriptFullTree
+	(*taprpc.TapBranch)(nil),           // 37: taprpc.TapBranch
+	(*taprpc.GroupWitness)(nil),        // 38: taprpc.GroupWitness
+	(taprpc.AssetMetaType)(0),          // 39: taprpc.AssetMetaType
 }
 var file_mintrpc_mint_proto_depIdxs = []int32{
-	20, // 0: mintrpc.PendingAsset.asset_version:type_name -> taprpc.AssetVersion
-	21, // 1: mintrpc.PendingAsset.asset_type:type_name -> taprpc.AssetType
-	22, // 2: mintrpc.PendingAsset.asset_meta:type_name -> taprpc.AssetMeta
-	23, // 3: mintrpc.PendingAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
-	24, // 4: mintrpc.PendingAsset.script_key:type_name -> taprpc.ScriptKey
-	1,  // 5: mintrpc.UnsealedAsset.asset:type_name -> mintrpc.PendingAsset
-	25, // 6: mintrpc.UnsealedAsset.group_key_request:type_name -> taprpc.GroupKeyRequest
-	26, // 7: mintrpc.UnsealedAsset.group_virtual_tx:type_name -> taprpc.GroupVirtualTx
-	20, // 8: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
-	21, // 9: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
-	22, // 10: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
-	23, // 11: mintrpc.MintAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
-	24, // 12: mintrpc.MintAsset.script_key:type_name -> taprpc.ScriptKey
-	27, // 13: mintrpc.MintAsset.external_group_key:type_name -> taprpc.ExternalKey
-	3,  // 14: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
-	6,  // 15: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	28, // 0: mintrpc.PendingAsset.asset_version:type_name -> taprpc.AssetVersion
+	29, // 1: mintrpc.PendingAsset.asset_type:type_name -> taprpc.AssetType
+	30, // 2: mintrpc.PendingAsset.asset_meta:type_name -> taprpc.AssetMeta
+	31, // 3: mintrpc.PendingAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
+	32, // 4: mintrpc.PendingAsset.script_key:type_name -> taprpc.ScriptKey
+	2,  // 5: mintrpc.UnsealedAsset.asset:type_name -> mintrpc.PendingAsset
+	33, // 6: mintrpc.UnsealedAsset.group_key_request:type_name -> taprpc.GroupKeyRequest
+	34, // 7: mintrpc.UnsealedAsset.group_virtual_tx:type_name -> taprpc.GroupVirtualTx
+	28, // 8: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
+	29, // 9: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
+	30, // 10: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
+	31, // 11: mintrpc.MintAsset.group_internal_key:type_name -> taprpc.KeyDescriptor
+	32, // 12: mintrpc.MintAsset.script_key:type_name -> taprpc.ScriptKey
+	35, // 13: mintrpc.MintAsset.external_group_key:type_name -> taprpc.ExternalKey
+	4,  // 14: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
+	7,  // 15: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
 	0,  // 16: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
-	1,  // 17: mintrpc.MintingBatch.assets:type_name -> mintrpc.PendingAsset
-	6,  // 18: mintrpc.VerboseBatch.batch:type_name -> mintrpc.MintingBatch
-	2,  // 19: mintrpc.VerboseBatch.unsealed_assets:type_name -> mintrpc.UnsealedAsset
-	28, // 20: mintrpc.FundBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
-	29, // 21: mintrpc.FundBatchRequest.branch:type_name -> taprpc.TapBranch
-	7,  // 22: mintrpc.FundBatchResponse.batch:type_name -> mintrpc.VerboseBatch
-	30, // 23: mintrpc.SealBatchRequest.group_witnesses:type_name -> taprpc.GroupWitness
-	6,  // 24: mintrpc.SealBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	28, // 25: mintrpc.FinalizeBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
-	29, // 26: mintrpc.FinalizeBatchRequest.branch:type_name -> taprpc.TapBranch
-	6,  // 27: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	7,  // 28: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.VerboseBatch
+	2,  // 17: mintrpc.MintingBatch.assets:type_name -> mintrpc.PendingAsset
+	7,  // 18: mintrpc.VerboseBatch.batch:type_name -> mintrpc.MintingBatch
+	3,  // 19: mintrpc.VerboseBatch.unsealed_assets:type_name -> mintrpc.UnsealedAsset
+	36, // 20: mintrpc.FundBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
+	37, // 21: mintrpc.FundBatchRequest.branch:type_name -> taprpc.TapBranch
+	8,  // 22: mintrpc.FundBatchResponse.batch:type_name -> mintrpc.VerboseBatch
+	38, // 23: mintrpc.SealBatchRequest.group_witnesses:type_name -> taprpc.GroupWitness
+	7,  // 24: mintrpc.SealBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	36, // 25: mintrpc.FinalizeBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
+	37, // 26: mintrpc.FinalizeBatchRequest.branch:type_name -> taprpc.TapBranch
+	7,  // 27: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	8,  // 28: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.VerboseBatch
 	0,  // 29: mintrpc.MintEvent.batch_state:type_name -> mintrpc.BatchState
-	6,  // 30: mintrpc.MintEvent.batch:type_name -> mintrpc.MintingBatch
-	4,  // 31: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
-	8,  // 32: mintrpc.Mint.FundBatch:input_type -> mintrpc.FundBatchRequest
-	10, // 33: mintrpc.Mint.SealBatch:input_type -> mintrpc.SealBatchRequest
-	12, // 34: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
-	14, // 35: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
-	16, // 36: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
-	18, // 37: mintrpc.Mint.SubscribeMintEvents:input_type -> mintrpc.SubscribeMintEventsRequest
-	5,  // 38: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
-	9,  // 39: mintrpc.Mint.FundBatch:output_type -> mintrpc.FundBatchResponse
-	11, // 40: mintrpc.Mint.SealBatch:output_type -> mintrpc.SealBatchResponse
-	13, // 41: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
-	15, // 42: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
-	17, // 43: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
-	19, // 44: mintrpc.Mint.SubscribeMintEvents:output_type -> mintrpc.MintEvent
-	38, // [38:45] is the sub-list for method output_type
-	31, // [31:38] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	7,  // 30: mintrpc.MintEvent.batch:type_name -> mintrpc.MintingBatch
+	28, // 31: mintrpc.MintSchedule.asset_version:type_name -> taprpc.AssetVersion
+	39, // 32: mintrpc.MintSchedule.meta_type:type_name -> taprpc.AssetMetaType
+	1,  // 33: mintrpc.MintSchedule.state:type_name -> mintrpc.MintScheduleState
+	28, // 34: mintrpc.AddMintScheduleRequest.asset_version:type_name -> taprpc.AssetVersion
+	39, // 35: mintrpc.AddMintScheduleRequest.meta_type:type_name -> taprpc.AssetMetaType
+	21, // 36: mintrpc.AddMintScheduleResponse.mint_schedule:type_name -> mintrpc.MintSchedule
+	21, // 37: mintrpc.ListMintSchedulesResponse.mint_schedules:type_name -> mintrpc.MintSchedule
+	5,  // 38: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
+	9,  // 39: mintrpc.Mint.FundBatch:input_type -> mintrpc.FundBatchRequest
+	11, // 40: mintrpc.Mint.SealBatch:input_type -> mintrpc.SealBatchRequest
+	13, // 41: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
+	15, // 42: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
+	17, // 43: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
+	19, // 44: mintrpc.Mint.SubscribeMintEvents:input_type -> mintrpc.SubscribeMintEventsRequest
+	22, // 45: mintrpc.Mint.AddMintSchedule:input_type -> mintrpc.AddMintScheduleRequest
+	24, // 46: mintrpc.Mint.ListMintSchedules:input_type -> mintrpc.ListMintSchedulesRequest
+	26, // 47: mintrpc.Mint.CancelMintSchedule:input_type -> mintrpc.CancelMintScheduleRequest
+	6,  // 48: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
+	10, // 49: mintrpc.Mint.FundBatch:output_type -> mintrpc.FundBatchResponse
+	12, // 50: mintrpc.Mint.SealBatch:output_type -> mintrpc.SealBatchResponse
+	14, // 51: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
+	16, // 52: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
+	18, // 53: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
+	20, // 54: mintrpc.Mint.SubscribeMintEvents:output_type -> mintrpc.MintEvent
+	23, // 55: mintrpc.Mint.AddMintSchedule:output_type -> mintrpc.AddMintScheduleResponse
+	25, // 56: mintrpc.Mint.ListMintSchedules:output_type -> mintrpc.ListMintSchedulesResponse
+	27, // 57: mintrpc.Mint.CancelMintSchedule:output_type -> mintrpc.CancelMintScheduleResponse
+	48, // [48:58] is the sub-list for method output_type
+	38, // [38:48] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_mintrpc_mint_proto_init() }
@@ -2184,6 +2862,90 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
+		file_mintrpc_mint_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*MintSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*AddMintScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*AddMintScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMintSchedulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMintSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelMintScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelMintScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mintrpc_mint_proto_msgTypes[7].OneofWrappers = []any{
 		(*FundBatchRequest_FullTree)(nil),
@@ -2202,8 +2964,8 @@ func file_mintrpc_mint_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintrpc_mint_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -53,6 +53,19 @@ type MintClient interface {
 	// SubscribeMintEvents allows a caller to subscribe to mint events for asset
 	// creation batches.
 	SubscribeMintEvents(ctx context.Context, in *SubscribeMintEventsRequest, opts ...grpc.CallOption) (Mint_SubscribeMintEventsClient, error)
+	// tapcli: `assets mint schedule add`
+	// AddMintSchedule adds a recurring mint schedule that mints a new tranche of
+	// an existing asset group at a fixed cadence, until the maximum total supply
+	// of the group is reached.
+	AddMintSchedule(ctx context.Context, in *AddMintScheduleRequest, opts ...grpc.CallOption) (*AddMintScheduleResponse, error)
+	// tapcli: `assets mint schedule list`
+	// ListMintSchedules lists all mint schedules, including completed and
+	// cancelled ones.
+	ListMintSchedules(ctx context.Context, in *ListMintSchedulesRequest, opts ...grpc.CallOption) (*ListMintSchedulesResponse, error)
+	// tapcli: `assets mint schedule cancel`
+	// CancelMintSchedule cancels an active mint schedule. Tranches that were
+	// already minted aren't affected.
+	CancelMintSchedule(ctx context.Context, in *CancelMintScheduleRequest, opts ...grpc.CallOption) (*CancelMintScheduleResponse, error)
 }
 
 type mintClient struct {
@@ -149,6 +162,33 @@ func (x *mintSubscribeMintEventsClient) Recv() (*MintEvent, error) {
 	return m, nil
 }
 
+func (c *mintClient) AddMintSchedule(ctx context.Context, in *AddMintScheduleRequest, opts ...grpc.CallOption) (*AddMintScheduleResponse, error) {
+	out := new(AddMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/AddMintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ListMintSchedules(ctx context.Context, in *ListMintSchedulesRequest, opts ...grpc.CallOption) (*ListMintSchedulesResponse, error) {
+	out := new(ListMintSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListMintSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) CancelMintSchedule(ctx context.Context, in *CancelMintScheduleRequest, opts ...grpc.CallOption) (*CancelMintScheduleResponse, error) {
+	out := new(CancelMintScheduleResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CancelMintSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MintServer is the server API for Mint service.
 // All implementations must embed UnimplementedMintServer
 // for forward compatibility
@@ -188,6 +228,19 @@ type MintServer interface {
 	// SubscribeMintEvents allows a caller to subscribe to mint events for asset
 	// creation batches.
 	SubscribeMintEvents(*SubscribeMintEventsRequest, Mint_SubscribeMintEventsServer) error
+	// tapcli: `assets mint schedule add`
+	// AddMintSchedule adds a recurring mint schedule that mints a new tranche of
+	// an existing asset group at a fixed cadence, until the maximum total supply
+	// of the group is reached.
+	AddMintSchedule(context.Context, *AddMintScheduleRequest) (*AddMintScheduleResponse, error)
+	// tapcli: `assets mint schedule list`
+	// ListMintSchedules lists all mint schedules, including completed and
+	// cancelled ones.
+	ListMintSchedules(context.Context, *ListMintSchedulesRequest) (*ListMintSchedulesResponse, error)
+	// tapcli: `assets mint schedule cancel`
+	// CancelMintSchedule cancels an active mint schedule. Tranches that were
+	// already minted aren't affected.
+	CancelMintSchedule(context.Context, *CancelMintScheduleRequest) (*CancelMintScheduleResponse, error)
 	mustEmbedUnimplementedMintServer()
 }
 
@@ -216,6 +269,15 @@ func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (
 func (UnimplementedMintServer) SubscribeMintEvents(*SubscribeMintEventsRequest, Mint_SubscribeMintEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeMintEvents not implemented")
 }
+func (UnimplementedMintServer) AddMintSchedule(context.Context, *AddMintScheduleRequest) (*AddMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMintSchedule not implemented")
+}
+func (UnimplementedMintServer) ListMintSchedules(context.Context, *ListMintSchedulesRequest) (*ListMintSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMintSchedules not implemented")
+}
+func (UnimplementedMintServer) CancelMintSchedule(context.Context, *CancelMintScheduleRequest) (*CancelMintScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMintSchedule not implemented")
+}
 func (UnimplementedMintServer) mustEmbedUnimplementedMintServer() {}
 
 // UnsafeMintServer may be embedded to opt out of forward compatibility for this service.
@@ -358,6 +420,60 @@ func (x *mintSubscribeMintEventsServer) Send(m *MintEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Mint_AddMintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMintScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).AddMintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/AddMintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).AddMintSchedule(ctx, req.(*AddMintScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ListMintSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMintSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListMintSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListMintSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListMintSchedules(ctx, req.(*ListMintSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_CancelMintSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMintScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).CancelMintSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/CancelMintSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).CancelMintSchedule(ctx, req.(*CancelMintScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mint_ServiceDesc is the grpc.ServiceDesc for Mint service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,6 +505,18 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
 		},
+		{
+			MethodName: "AddMintSchedule",
+			Handler:    _Mint_AddMintSchedule_Handler,
+		},
+		{
+			MethodName: "ListMintSchedules",
+			Handler:    _Mint_ListMintSchedules_Handler,
+		},
+		{
+			MethodName: "CancelMintSchedule",
+			Handler:    _Mint_CancelMintSchedule_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
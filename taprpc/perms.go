@@ -76,6 +76,22 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/SendAssetBatch": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ScheduleSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ListScheduledSends": {{
+			Entity: "assets",
+			Action: "read",
+		}},
+		"/taprpc.TaprootAssets/CancelScheduledSend": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/BurnAsset": {{
 			Entity: "assets",
 			Action: "write",
@@ -103,6 +119,18 @@ var (
 			Entity: "proofs",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/ConsolidateAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/CreateBackup": {{
+			Entity: "daemon",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/RestoreBackup": {{
+			Entity: "daemon",
+			Action: "write",
+		}},
 		"/assetwalletrpc.AssetWallet/FundVirtualPsbt": {{
 			Entity: "assets",
 			Action: "write",
@@ -183,6 +211,18 @@ var (
 			Entity: "mint",
 			Action: "read",
 		}},
+		"/mintrpc.Mint/AddMintSchedule": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ListMintSchedules": {{
+			Entity: "mint",
+			Action: "read",
+		}},
+		"/mintrpc.Mint/CancelMintSchedule": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/universerpc.Universe/Info": {{
 			Entity: "universe",
 			Action: "read",
@@ -207,6 +247,10 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/UniverseSubtrees": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetLeaves": {{
 			Entity: "universe",
 			Action: "read",
@@ -227,6 +271,10 @@ var (
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/SyncProgress": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/ListFederationServers": {{
 			Entity: "universe",
 			Action: "read",
@@ -239,6 +287,18 @@ var (
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/SetWriteQuota": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/ListWriteQuotas": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/DeleteWriteQuota": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/universerpc.Universe/UniverseStats": {{
 			Entity: "universe",
 			Action: "read",
@@ -247,6 +307,14 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SearchAssets": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SearchLeaves": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/QueryEvents": {{
 			Entity: "universe",
 			Action: "read",
@@ -299,6 +367,10 @@ var (
 			Entity: "rfq",
 			Action: "read",
 		}},
+		"/rfqrpc.Rfq/QueryCollectedForwardFees": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
 		"/rfqrpc.Rfq/SubscribeRfqEventNtfns": {{
 			Entity: "rfq",
 			Action: "write",
@@ -319,6 +391,22 @@ var (
 			Entity: "channels",
 			Action: "read",
 		}},
+		"/tapchannelrpc.TaprootAssetChannels/RebalanceAssetChannels": {{
+			Entity: "channels",
+			Action: "write",
+		}},
+		"/tapchannelrpc.TaprootAssetChannels/AssetChannelBalanceHistory": {{
+			Entity: "channels",
+			Action: "read",
+		}},
+		"/tapchannelrpc.TaprootAssetChannels/AssetChannelPnL": {{
+			Entity: "channels",
+			Action: "read",
+		}},
+		"/tapchannelrpc.TaprootAssetChannels/ExportAssetChannelHistory": {{
+			Entity: "channels",
+			Action: "read",
+		}},
 		"/tapchannelrpc.TaprootAssetChannels/EncodeCustomRecords": {
 			// This RPC is completely stateless and doesn't require
 			// any permissions to use.
@@ -373,6 +461,7 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 			"/universerpc.Universe/AssetRoots",
 			"/universerpc.Universe/QueryAssetRoots",
 			"/universerpc.Universe/AssetLeafKeys",
+			"/universerpc.Universe/UniverseSubtrees",
 			"/universerpc.Universe/AssetLeaves",
 			"/universerpc.Universe/QueryProof",
 
@@ -399,6 +488,8 @@ func MacaroonWhitelist(allowUniPublicAccessRead bool,
 			"/universerpc.Universe/QueryAssetStats",
 			"/universerpc.Universe/UniverseStats",
 			"/universerpc.Universe/QueryEvents",
+			"/universerpc.Universe/SearchAssets",
+			"/universerpc.Universe/SearchLeaves",
 		)
 	}
 
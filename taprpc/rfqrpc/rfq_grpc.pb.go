@@ -61,6 +61,11 @@ type RfqClient interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAcceptedQuotesRequest, opts ...grpc.CallOption) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq forwardfees`
+	// QueryCollectedForwardFees is used to query for the forwarding fees in asset
+	// units that our node collected for the settled asset HTLCs it forwarded
+	// under its accepted quotes, summed up per asset.
+	QueryCollectedForwardFees(ctx context.Context, in *QueryCollectedForwardFeesRequest, opts ...grpc.CallOption) (*QueryCollectedForwardFeesResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error)
 }
@@ -118,6 +123,15 @@ func (c *rfqClient) QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAc
 	return out, nil
 }
 
+func (c *rfqClient) QueryCollectedForwardFees(ctx context.Context, in *QueryCollectedForwardFeesRequest, opts ...grpc.CallOption) (*QueryCollectedForwardFeesResponse, error) {
+	out := new(QueryCollectedForwardFeesResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/QueryCollectedForwardFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rfqClient) SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Rfq_ServiceDesc.Streams[0], "/rfqrpc.Rfq/SubscribeRfqEventNtfns", opts...)
 	if err != nil {
@@ -197,6 +211,11 @@ type RfqServer interface {
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq forwardfees`
+	// QueryCollectedForwardFees is used to query for the forwarding fees in asset
+	// units that our node collected for the settled asset HTLCs it forwarded
+	// under its accepted quotes, summed up per asset.
+	QueryCollectedForwardFees(context.Context, *QueryCollectedForwardFeesRequest) (*QueryCollectedForwardFeesResponse, error)
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error
 	mustEmbedUnimplementedRfqServer()
@@ -221,6 +240,9 @@ func (UnimplementedRfqServer) AddAssetBuyOffer(context.Context, *AddAssetBuyOffe
 func (UnimplementedRfqServer) QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPeerAcceptedQuotes not implemented")
 }
+func (UnimplementedRfqServer) QueryCollectedForwardFees(context.Context, *QueryCollectedForwardFeesRequest) (*QueryCollectedForwardFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCollectedForwardFees not implemented")
+}
 func (UnimplementedRfqServer) SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeRfqEventNtfns not implemented")
 }
@@ -327,6 +349,24 @@ func _Rfq_QueryPeerAcceptedQuotes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rfq_QueryCollectedForwardFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedForwardFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).QueryCollectedForwardFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/QueryCollectedForwardFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).QueryCollectedForwardFees(ctx, req.(*QueryCollectedForwardFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rfq_SubscribeRfqEventNtfns_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRfqEventNtfnsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -375,6 +415,10 @@ var Rfq_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPeerAcceptedQuotes",
 			Handler:    _Rfq_QueryPeerAcceptedQuotes_Handler,
 		},
+		{
+			MethodName: "QueryCollectedForwardFees",
+			Handler:    _Rfq_QueryCollectedForwardFees_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -23,7 +23,6 @@ type TaprootAssetChannelsClient interface {
 	// creation of a channel that contains a specified amount of a given asset.
 	FundChannel(ctx context.Context, in *FundChannelRequest, opts ...grpc.CallOption) (*FundChannelResponse, error)
 	// Deprecated: Do not use.
-	//
 	// Deprecated.
 	// EncodeCustomRecords allows RPC users to encode Taproot Asset channel related
 	// data into the TLV format that is used in the custom records of the lnd
@@ -49,6 +48,28 @@ type TaprootAssetChannelsClient interface {
 	// asset ID or group key and returns the invoice amount expressed in asset
 	// units along side the normal information.
 	DecodeAssetPayReq(ctx context.Context, in *AssetPayReq, opts ...grpc.CallOption) (*AssetPayReqResponse, error)
+	// tapcli: `channels rebalance`
+	// RebalanceAssetChannels shifts asset liquidity from one of our asset
+	// channels into another one with a circular payment. The payment leaves
+	// through the outgoing channel, paid for with assets using a sell quote, and
+	// comes back through the incoming channel, where the peer converts it to
+	// assets using a buy quote. Failed attempts are retried with fresh quotes.
+	// The result of each attempt is returned.
+	RebalanceAssetChannels(ctx context.Context, in *RebalanceAssetChannelsRequest, opts ...grpc.CallOption) (*RebalanceAssetChannelsResponse, error)
+	// tapcli: `channels balancehistory`
+	// AssetChannelBalanceHistory returns the recorded asset balance snapshots of
+	// our asset channels, together with the settled asset HTLCs that moved the
+	// balance.
+	AssetChannelBalanceHistory(ctx context.Context, in *AssetChannelBalanceHistoryRequest, opts ...grpc.CallOption) (*AssetChannelBalanceHistoryResponse, error)
+	// tapcli: `channels pnl`
+	// AssetChannelPnL returns the asset denominated profit and loss of our asset
+	// channels over a period of time, both per channel and per RFQ quote.
+	AssetChannelPnL(ctx context.Context, in *AssetChannelPnLRequest, opts ...grpc.CallOption) (*AssetChannelPnLResponse, error)
+	// tapcli: `channels exporthistory`
+	// ExportAssetChannelHistory exports the balance history, the profit and loss
+	// per channel or the profit and loss per RFQ quote of our asset channels as
+	// CSV.
+	ExportAssetChannelHistory(ctx context.Context, in *ExportAssetChannelHistoryRequest, opts ...grpc.CallOption) (*ExportAssetChannelHistoryResponse, error)
 }
 
 type taprootAssetChannelsClient struct {
@@ -128,6 +149,42 @@ func (c *taprootAssetChannelsClient) DecodeAssetPayReq(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *taprootAssetChannelsClient) RebalanceAssetChannels(ctx context.Context, in *RebalanceAssetChannelsRequest, opts ...grpc.CallOption) (*RebalanceAssetChannelsResponse, error) {
+	out := new(RebalanceAssetChannelsResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/RebalanceAssetChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetChannelsClient) AssetChannelBalanceHistory(ctx context.Context, in *AssetChannelBalanceHistoryRequest, opts ...grpc.CallOption) (*AssetChannelBalanceHistoryResponse, error) {
+	out := new(AssetChannelBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/AssetChannelBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetChannelsClient) AssetChannelPnL(ctx context.Context, in *AssetChannelPnLRequest, opts ...grpc.CallOption) (*AssetChannelPnLResponse, error) {
+	out := new(AssetChannelPnLResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/AssetChannelPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetChannelsClient) ExportAssetChannelHistory(ctx context.Context, in *ExportAssetChannelHistoryRequest, opts ...grpc.CallOption) (*ExportAssetChannelHistoryResponse, error) {
+	out := new(ExportAssetChannelHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tapchannelrpc.TaprootAssetChannels/ExportAssetChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaprootAssetChannelsServer is the server API for TaprootAssetChannels service.
 // All implementations must embed UnimplementedTaprootAssetChannelsServer
 // for forward compatibility
@@ -137,7 +194,6 @@ type TaprootAssetChannelsServer interface {
 	// creation of a channel that contains a specified amount of a given asset.
 	FundChannel(context.Context, *FundChannelRequest) (*FundChannelResponse, error)
 	// Deprecated: Do not use.
-	//
 	// Deprecated.
 	// EncodeCustomRecords allows RPC users to encode Taproot Asset channel related
 	// data into the TLV format that is used in the custom records of the lnd
@@ -163,6 +219,28 @@ type TaprootAssetChannelsServer interface {
 	// asset ID or group key and returns the invoice amount expressed in asset
 	// units along side the normal information.
 	DecodeAssetPayReq(context.Context, *AssetPayReq) (*AssetPayReqResponse, error)
+	// tapcli: `channels rebalance`
+	// RebalanceAssetChannels shifts asset liquidity from one of our asset
+	// channels into another one with a circular payment. The payment leaves
+	// through the outgoing channel, paid for with assets using a sell quote, and
+	// comes back through the incoming channel, where the peer converts it to
+	// assets using a buy quote. Failed attempts are retried with fresh quotes.
+	// The result of each attempt is returned.
+	RebalanceAssetChannels(context.Context, *RebalanceAssetChannelsRequest) (*RebalanceAssetChannelsResponse, error)
+	// tapcli: `channels balancehistory`
+	// AssetChannelBalanceHistory returns the recorded asset balance snapshots of
+	// our asset channels, together with the settled asset HTLCs that moved the
+	// balance.
+	AssetChannelBalanceHistory(context.Context, *AssetChannelBalanceHistoryRequest) (*AssetChannelBalanceHistoryResponse, error)
+	// tapcli: `channels pnl`
+	// AssetChannelPnL returns the asset denominated profit and loss of our asset
+	// channels over a period of time, both per channel and per RFQ quote.
+	AssetChannelPnL(context.Context, *AssetChannelPnLRequest) (*AssetChannelPnLResponse, error)
+	// tapcli: `channels exporthistory`
+	// ExportAssetChannelHistory exports the balance history, the profit and loss
+	// per channel or the profit and loss per RFQ quote of our asset channels as
+	// CSV.
+	ExportAssetChannelHistory(context.Context, *ExportAssetChannelHistoryRequest) (*ExportAssetChannelHistoryResponse, error)
 	mustEmbedUnimplementedTaprootAssetChannelsServer()
 }
 
@@ -185,6 +263,18 @@ func (UnimplementedTaprootAssetChannelsServer) AddInvoice(context.Context, *AddI
 func (UnimplementedTaprootAssetChannelsServer) DecodeAssetPayReq(context.Context, *AssetPayReq) (*AssetPayReqResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeAssetPayReq not implemented")
 }
+func (UnimplementedTaprootAssetChannelsServer) RebalanceAssetChannels(context.Context, *RebalanceAssetChannelsRequest) (*RebalanceAssetChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceAssetChannels not implemented")
+}
+func (UnimplementedTaprootAssetChannelsServer) AssetChannelBalanceHistory(context.Context, *AssetChannelBalanceHistoryRequest) (*AssetChannelBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetChannelBalanceHistory not implemented")
+}
+func (UnimplementedTaprootAssetChannelsServer) AssetChannelPnL(context.Context, *AssetChannelPnLRequest) (*AssetChannelPnLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetChannelPnL not implemented")
+}
+func (UnimplementedTaprootAssetChannelsServer) ExportAssetChannelHistory(context.Context, *ExportAssetChannelHistoryRequest) (*ExportAssetChannelHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAssetChannelHistory not implemented")
+}
 func (UnimplementedTaprootAssetChannelsServer) mustEmbedUnimplementedTaprootAssetChannelsServer() {}
 
 // UnsafeTaprootAssetChannelsServer may be embedded to opt out of forward compatibility for this service.
@@ -291,6 +381,78 @@ func _TaprootAssetChannels_DecodeAssetPayReq_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssetChannels_RebalanceAssetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceAssetChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).RebalanceAssetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/RebalanceAssetChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).RebalanceAssetChannels(ctx, req.(*RebalanceAssetChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssetChannels_AssetChannelBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetChannelBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).AssetChannelBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/AssetChannelBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).AssetChannelBalanceHistory(ctx, req.(*AssetChannelBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssetChannels_AssetChannelPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetChannelPnLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).AssetChannelPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/AssetChannelPnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).AssetChannelPnL(ctx, req.(*AssetChannelPnLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssetChannels_ExportAssetChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAssetChannelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetChannelsServer).ExportAssetChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tapchannelrpc.TaprootAssetChannels/ExportAssetChannelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetChannelsServer).ExportAssetChannelHistory(ctx, req.(*ExportAssetChannelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaprootAssetChannels_ServiceDesc is the grpc.ServiceDesc for TaprootAssetChannels service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -314,6 +476,22 @@ var TaprootAssetChannels_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeAssetPayReq",
 			Handler:    _TaprootAssetChannels_DecodeAssetPayReq_Handler,
 		},
+		{
+			MethodName: "RebalanceAssetChannels",
+			Handler:    _TaprootAssetChannels_RebalanceAssetChannels_Handler,
+		},
+		{
+			MethodName: "AssetChannelBalanceHistory",
+			Handler:    _TaprootAssetChannels_AssetChannelBalanceHistory_Handler,
+		},
+		{
+			MethodName: "AssetChannelPnL",
+			Handler:    _TaprootAssetChannels_AssetChannelPnL_Handler,
+		},
+		{
+			MethodName: "ExportAssetChannelHistory",
+			Handler:    _TaprootAssetChannels_ExportAssetChannelHistory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
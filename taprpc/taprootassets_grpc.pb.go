@@ -82,6 +82,28 @@ type TaprootAssetsClient interface {
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tapcli: `assets send-batch`
+	// SendAssetBatch sends assets to many recipients, for example for a payroll
+	// or an airdrop. The recipients are grouped by asset and split into chunks of
+	// at most max_recipients_per_tx recipients. Each chunk is sent in its own
+	// anchor transaction and tracked as a separate transfer labeled
+	// '<label>-<batch ID>-<chunk number>'. The response reports the batch ID and
+	// the proof delivery status of each recipient.
+	SendAssetBatch(ctx context.Context, in *SendAssetBatchRequest, opts ...grpc.CallOption) (*SendAssetBatchResponse, error)
+	// tapcli: `assets schedulesend`
+	// ScheduleSend schedules an asset send to one or more Taproot Asset
+	// addresses that is only executed once a block height and/or a point in time
+	// is reached. The asset coins of the send are only selected when it is
+	// executed.
+	ScheduleSend(ctx context.Context, in *ScheduleSendRequest, opts ...grpc.CallOption) (*ScheduleSendResponse, error)
+	// tapcli: `assets listscheduledsends`
+	// ListScheduledSends lists all scheduled asset sends, including the ones
+	// that were already executed, failed or were cancelled.
+	ListScheduledSends(ctx context.Context, in *ListScheduledSendsRequest, opts ...grpc.CallOption) (*ListScheduledSendsResponse, error)
+	// tapcli: `assets cancelscheduledsend`
+	// CancelScheduledSend cancels a pending scheduled asset send. Sends that are
+	// already being executed or completed can't be cancelled.
+	CancelScheduledSend(ctx context.Context, in *CancelScheduledSendRequest, opts ...grpc.CallOption) (*CancelScheduledSendResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -118,6 +140,24 @@ type TaprootAssetsClient interface {
 	// the universe proof courier and universe sync mechanisms) and this call
 	// simply instructs the daemon to detect the transfer as an asset it owns.
 	RegisterTransfer(ctx context.Context, in *RegisterTransferRequest, opts ...grpc.CallOption) (*RegisterTransferResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges many small outputs of the same asset into a
+	// single output per asset ID by spending them back to the local node. If
+	// dry_run is set, no transaction is created and only the expected number of
+	// inputs and outputs and the expected chain fees are returned.
+	ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error)
+	// tapcli: `backup create`
+	// CreateBackup creates a consistent backup of the asset database while the
+	// daemon is running. The backup covers all assets, proofs, addresses,
+	// transfers, key derivations and supply commitment state and is returned as
+	// a checksummed archive that is independent of the database backend.
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error)
+	// tapcli: `backup restore`
+	// RestoreBackup verifies a backup archive and stages it to be restored on
+	// the next start of the daemon. The archive is refused if it was created for
+	// a node with a different lnd seed or if the daemon already holds wallet
+	// data. The daemon must be restarted to complete the restore.
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
 }
 
 type taprootAssetsClient struct {
@@ -272,6 +312,42 @@ func (c *taprootAssetsClient) SendAsset(ctx context.Context, in *SendAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) SendAssetBatch(ctx context.Context, in *SendAssetBatchRequest, opts ...grpc.CallOption) (*SendAssetBatchResponse, error) {
+	out := new(SendAssetBatchResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/SendAssetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ScheduleSend(ctx context.Context, in *ScheduleSendRequest, opts ...grpc.CallOption) (*ScheduleSendResponse, error) {
+	out := new(ScheduleSendResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ScheduleSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ListScheduledSends(ctx context.Context, in *ListScheduledSendsRequest, opts ...grpc.CallOption) (*ListScheduledSendsResponse, error) {
+	out := new(ListScheduledSendsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ListScheduledSends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CancelScheduledSend(ctx context.Context, in *CancelScheduledSendRequest, opts ...grpc.CallOption) (*CancelScheduledSendResponse, error) {
+	out := new(CancelScheduledSendResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CancelScheduledSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
 	out := new(BurnAssetResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BurnAsset", in, out, opts...)
@@ -381,6 +457,33 @@ func (c *taprootAssetsClient) RegisterTransfer(ctx context.Context, in *Register
 	return out, nil
 }
 
+func (c *taprootAssetsClient) ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error) {
+	out := new(ConsolidateAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ConsolidateAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error) {
+	out := new(CreateBackupResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/CreateBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/RestoreBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaprootAssetsServer is the server API for TaprootAssets service.
 // All implementations must embed UnimplementedTaprootAssetsServer
 // for forward compatibility
@@ -449,6 +552,28 @@ type TaprootAssetsServer interface {
 	// send, as well as the proof file information the receiver needs to fully
 	// receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tapcli: `assets send-batch`
+	// SendAssetBatch sends assets to many recipients, for example for a payroll
+	// or an airdrop. The recipients are grouped by asset and split into chunks of
+	// at most max_recipients_per_tx recipients. Each chunk is sent in its own
+	// anchor transaction and tracked as a separate transfer labeled
+	// '<label>-<batch ID>-<chunk number>'. The response reports the batch ID and
+	// the proof delivery status of each recipient.
+	SendAssetBatch(context.Context, *SendAssetBatchRequest) (*SendAssetBatchResponse, error)
+	// tapcli: `assets schedulesend`
+	// ScheduleSend schedules an asset send to one or more Taproot Asset
+	// addresses that is only executed once a block height and/or a point in time
+	// is reached. The asset coins of the send are only selected when it is
+	// executed.
+	ScheduleSend(context.Context, *ScheduleSendRequest) (*ScheduleSendResponse, error)
+	// tapcli: `assets listscheduledsends`
+	// ListScheduledSends lists all scheduled asset sends, including the ones
+	// that were already executed, failed or were cancelled.
+	ListScheduledSends(context.Context, *ListScheduledSendsRequest) (*ListScheduledSendsResponse, error)
+	// tapcli: `assets cancelscheduledsend`
+	// CancelScheduledSend cancels a pending scheduled asset send. Sends that are
+	// already being executed or completed can't be cancelled.
+	CancelScheduledSend(context.Context, *CancelScheduledSendRequest) (*CancelScheduledSendResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
 	// to a provably un-spendable script key. Burning means irrevocably destroying
@@ -485,6 +610,24 @@ type TaprootAssetsServer interface {
 	// the universe proof courier and universe sync mechanisms) and this call
 	// simply instructs the daemon to detect the transfer as an asset it owns.
 	RegisterTransfer(context.Context, *RegisterTransferRequest) (*RegisterTransferResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges many small outputs of the same asset into a
+	// single output per asset ID by spending them back to the local node. If
+	// dry_run is set, no transaction is created and only the expected number of
+	// inputs and outputs and the expected chain fees are returned.
+	ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error)
+	// tapcli: `backup create`
+	// CreateBackup creates a consistent backup of the asset database while the
+	// daemon is running. The backup covers all assets, proofs, addresses,
+	// transfers, key derivations and supply commitment state and is returned as
+	// a checksummed archive that is independent of the database backend.
+	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error)
+	// tapcli: `backup restore`
+	// RestoreBackup verifies a backup archive and stages it to be restored on
+	// the next start of the daemon. The archive is refused if it was created for
+	// a node with a different lnd seed or if the daemon already holds wallet
+	// data. The daemon must be restarted to complete the restore.
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
 	mustEmbedUnimplementedTaprootAssetsServer()
 }
 
@@ -540,6 +683,18 @@ func (UnimplementedTaprootAssetsServer) UnpackProofFile(context.Context, *Unpack
 func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) SendAssetBatch(context.Context, *SendAssetBatchRequest) (*SendAssetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAssetBatch not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ScheduleSend(context.Context, *ScheduleSendRequest) (*ScheduleSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSend not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ListScheduledSends(context.Context, *ListScheduledSendsRequest) (*ListScheduledSendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledSends not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CancelScheduledSend(context.Context, *CancelScheduledSendRequest) (*CancelScheduledSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledSend not implemented")
+}
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
@@ -561,6 +716,15 @@ func (UnimplementedTaprootAssetsServer) SubscribeSendEvents(*SubscribeSendEvents
 func (UnimplementedTaprootAssetsServer) RegisterTransfer(context.Context, *RegisterTransferRequest) (*RegisterTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterTransfer not implemented")
 }
+func (UnimplementedTaprootAssetsServer) ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateAssets not implemented")
+}
+func (UnimplementedTaprootAssetsServer) CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (UnimplementedTaprootAssetsServer) RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
 func (UnimplementedTaprootAssetsServer) mustEmbedUnimplementedTaprootAssetsServer() {}
 
 // UnsafeTaprootAssetsServer may be embedded to opt out of forward compatibility for this service.
@@ -862,6 +1026,78 @@ func _TaprootAssets_SendAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_SendAssetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAssetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).SendAssetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/SendAssetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).SendAssetBatch(ctx, req.(*SendAssetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ScheduleSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ScheduleSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ScheduleSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ScheduleSend(ctx, req.(*ScheduleSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ListScheduledSends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledSendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ListScheduledSends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ListScheduledSends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ListScheduledSends(ctx, req.(*ListScheduledSendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CancelScheduledSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CancelScheduledSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CancelScheduledSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CancelScheduledSend(ctx, req.(*CancelScheduledSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BurnAssetRequest)
 	if err := dec(in); err != nil {
@@ -994,6 +1230,60 @@ func _TaprootAssets_RegisterTransfer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_ConsolidateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ConsolidateAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, req.(*ConsolidateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).CreateBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/CreateBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).CreateBackup(ctx, req.(*CreateBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/RestoreBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaprootAssets_ServiceDesc is the grpc.ServiceDesc for TaprootAssets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1065,6 +1355,22 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendAsset",
 			Handler:    _TaprootAssets_SendAsset_Handler,
 		},
+		{
+			MethodName: "SendAssetBatch",
+			Handler:    _TaprootAssets_SendAssetBatch_Handler,
+		},
+		{
+			MethodName: "ScheduleSend",
+			Handler:    _TaprootAssets_ScheduleSend_Handler,
+		},
+		{
+			MethodName: "ListScheduledSends",
+			Handler:    _TaprootAssets_ListScheduledSends_Handler,
+		},
+		{
+			MethodName: "CancelScheduledSend",
+			Handler:    _TaprootAssets_CancelScheduledSend_Handler,
+		},
 		{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
@@ -1085,6 +1391,18 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterTransfer",
 			Handler:    _TaprootAssets_RegisterTransfer_Handler,
 		},
+		{
+			MethodName: "ConsolidateAssets",
+			Handler:    _TaprootAssets_ConsolidateAssets_Handler,
+		},
+		{
+			MethodName: "CreateBackup",
+			Handler:    _TaprootAssets_CreateBackup_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _TaprootAssets_RestoreBackup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -43,6 +43,12 @@ type UniverseClient interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(ctx context.Context, in *AssetLeafKeysRequest, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error)
+	// tapcli: `universe subtrees`
+	// UniverseSubtrees returns the roots of the subtrees of a Universe tree at
+	// the given depth, one for each of the given paths. Comparing the subtree
+	// roots of two Universe trees level by level allows a syncer to only fetch
+	// the keys of the leaves that differ, instead of all leaf keys.
+	UniverseSubtrees(ctx context.Context, in *UniverseSubtreesRequest, opts ...grpc.CallOption) (*UniverseSubtreesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -81,6 +87,11 @@ type UniverseClient interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// tapcli: `universe sync progress`
+	// SyncProgress returns the progress of the scheduled syncs with each
+	// Universe server that was synced with since tapd was started. Syncs that
+	// are triggered on demand, for example with SyncUniverse, aren't included.
+	SyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error)
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -95,6 +106,20 @@ type UniverseClient interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(ctx context.Context, in *DeleteFederationServerRequest, opts ...grpc.CallOption) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe writequota set`
+	// SetWriteQuota adds an issuer to the write allowlist of the local Universe
+	// server, or updates the limits of the write quota of an issuer that is
+	// already on the allowlist. The usage of an existing quota is kept. The
+	// allowlist is only enforced if universe.require-write-auth is set.
+	SetWriteQuota(ctx context.Context, in *SetWriteQuotaRequest, opts ...grpc.CallOption) (*SetWriteQuotaResponse, error)
+	// tapcli: `universe writequota list`
+	// ListWriteQuotas lists the write quotas of all issuers on the write
+	// allowlist of the local Universe server, including their usage.
+	ListWriteQuotas(ctx context.Context, in *ListWriteQuotasRequest, opts ...grpc.CallOption) (*ListWriteQuotasResponse, error)
+	// tapcli: `universe writequota delete`
+	// DeleteWriteQuota removes an issuer from the write allowlist of the local
+	// Universe server.
+	DeleteWriteQuota(ctx context.Context, in *DeleteWriteQuotaRequest, opts ...grpc.CallOption) (*DeleteWriteQuotaResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -110,6 +135,16 @@ type UniverseClient interface {
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
 	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+	// tapcli: `universe search assets`
+	// SearchAssets returns a page of the assets known to the Universe that match
+	// all of the given filters. Results are returned in a stable order, and the
+	// next_cursor of a response can be used to fetch the next page.
+	SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error)
+	// tapcli: `universe search leaves`
+	// SearchLeaves returns a page of the leaves across all Universes that have
+	// the given script key or outpoint. The next_cursor of a response can be
+	// used to fetch the next page.
+	SearchLeaves(ctx context.Context, in *SearchLeavesRequest, opts ...grpc.CallOption) (*SearchLeavesResponse, error)
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
 	SetFederationSyncConfig(ctx context.Context, in *SetFederationSyncConfigRequest, opts ...grpc.CallOption) (*SetFederationSyncConfigResponse, error)
@@ -195,6 +230,15 @@ func (c *universeClient) AssetLeafKeys(ctx context.Context, in *AssetLeafKeysReq
 	return out, nil
 }
 
+func (c *universeClient) UniverseSubtrees(ctx context.Context, in *UniverseSubtreesRequest, opts ...grpc.CallOption) (*UniverseSubtreesResponse, error) {
+	out := new(UniverseSubtreesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/UniverseSubtrees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
 	out := new(AssetLeafResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeaves", in, out, opts...)
@@ -249,6 +293,15 @@ func (c *universeClient) SyncUniverse(ctx context.Context, in *SyncRequest, opts
 	return out, nil
 }
 
+func (c *universeClient) SyncProgress(ctx context.Context, in *SyncProgressRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error) {
+	out := new(SyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) ListFederationServers(ctx context.Context, in *ListFederationServersRequest, opts ...grpc.CallOption) (*ListFederationServersResponse, error) {
 	out := new(ListFederationServersResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationServers", in, out, opts...)
@@ -276,6 +329,33 @@ func (c *universeClient) DeleteFederationServer(ctx context.Context, in *DeleteF
 	return out, nil
 }
 
+func (c *universeClient) SetWriteQuota(ctx context.Context, in *SetWriteQuotaRequest, opts ...grpc.CallOption) (*SetWriteQuotaResponse, error) {
+	out := new(SetWriteQuotaResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetWriteQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ListWriteQuotas(ctx context.Context, in *ListWriteQuotasRequest, opts ...grpc.CallOption) (*ListWriteQuotasResponse, error) {
+	out := new(ListWriteQuotasResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListWriteQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) DeleteWriteQuota(ctx context.Context, in *DeleteWriteQuotaRequest, opts ...grpc.CallOption) (*DeleteWriteQuotaResponse, error) {
+	out := new(DeleteWriteQuotaResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/DeleteWriteQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) UniverseStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	out := new(StatsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/UniverseStats", in, out, opts...)
@@ -303,6 +383,24 @@ func (c *universeClient) QueryEvents(ctx context.Context, in *QueryEventsRequest
 	return out, nil
 }
 
+func (c *universeClient) SearchAssets(ctx context.Context, in *SearchAssetsRequest, opts ...grpc.CallOption) (*SearchAssetsResponse, error) {
+	out := new(SearchAssetsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SearchAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) SearchLeaves(ctx context.Context, in *SearchLeavesRequest, opts ...grpc.CallOption) (*SearchLeavesResponse, error) {
+	out := new(SearchLeavesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SearchLeaves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) SetFederationSyncConfig(ctx context.Context, in *SetFederationSyncConfigRequest, opts ...grpc.CallOption) (*SetFederationSyncConfigResponse, error) {
 	out := new(SetFederationSyncConfigResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetFederationSyncConfig", in, out, opts...)
@@ -395,6 +493,12 @@ type UniverseServer interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error)
+	// tapcli: `universe subtrees`
+	// UniverseSubtrees returns the roots of the subtrees of a Universe tree at
+	// the given depth, one for each of the given paths. Comparing the subtree
+	// roots of two Universe trees level by level allows a syncer to only fetch
+	// the keys of the leaves that differ, instead of all leaf keys.
+	UniverseSubtrees(context.Context, *UniverseSubtreesRequest) (*UniverseSubtreesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -433,6 +537,11 @@ type UniverseServer interface {
 	// the latest known root for each asset, performing tree based reconciliation
 	// to arrive at a new shared root.
 	SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error)
+	// tapcli: `universe sync progress`
+	// SyncProgress returns the progress of the scheduled syncs with each
+	// Universe server that was synced with since tapd was started. Syncs that
+	// are triggered on demand, for example with SyncUniverse, aren't included.
+	SyncProgress(context.Context, *SyncProgressRequest) (*SyncProgressResponse, error)
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
@@ -447,6 +556,20 @@ type UniverseServer interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe writequota set`
+	// SetWriteQuota adds an issuer to the write allowlist of the local Universe
+	// server, or updates the limits of the write quota of an issuer that is
+	// already on the allowlist. The usage of an existing quota is kept. The
+	// allowlist is only enforced if universe.require-write-auth is set.
+	SetWriteQuota(context.Context, *SetWriteQuotaRequest) (*SetWriteQuotaResponse, error)
+	// tapcli: `universe writequota list`
+	// ListWriteQuotas lists the write quotas of all issuers on the write
+	// allowlist of the local Universe server, including their usage.
+	ListWriteQuotas(context.Context, *ListWriteQuotasRequest) (*ListWriteQuotasResponse, error)
+	// tapcli: `universe writequota delete`
+	// DeleteWriteQuota removes an issuer from the write allowlist of the local
+	// Universe server.
+	DeleteWriteQuota(context.Context, *DeleteWriteQuotaRequest) (*DeleteWriteQuotaResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -462,6 +585,16 @@ type UniverseServer interface {
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+	// tapcli: `universe search assets`
+	// SearchAssets returns a page of the assets known to the Universe that match
+	// all of the given filters. Results are returned in a stable order, and the
+	// next_cursor of a response can be used to fetch the next page.
+	SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error)
+	// tapcli: `universe search leaves`
+	// SearchLeaves returns a page of the leaves across all Universes that have
+	// the given script key or outpoint. The next_cursor of a response can be
+	// used to fetch the next page.
+	SearchLeaves(context.Context, *SearchLeavesRequest) (*SearchLeavesResponse, error)
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
 	SetFederationSyncConfig(context.Context, *SetFederationSyncConfigRequest) (*SetFederationSyncConfigResponse, error)
@@ -514,6 +647,9 @@ func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQ
 func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeys not implemented")
 }
+func (UnimplementedUniverseServer) UniverseSubtrees(context.Context, *UniverseSubtreesRequest) (*UniverseSubtreesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UniverseSubtrees not implemented")
+}
 func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
@@ -532,6 +668,9 @@ func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoRes
 func (UnimplementedUniverseServer) SyncUniverse(context.Context, *SyncRequest) (*SyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncUniverse not implemented")
 }
+func (UnimplementedUniverseServer) SyncProgress(context.Context, *SyncProgressRequest) (*SyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncProgress not implemented")
+}
 func (UnimplementedUniverseServer) ListFederationServers(context.Context, *ListFederationServersRequest) (*ListFederationServersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFederationServers not implemented")
 }
@@ -541,6 +680,15 @@ func (UnimplementedUniverseServer) AddFederationServer(context.Context, *AddFede
 func (UnimplementedUniverseServer) DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationServer not implemented")
 }
+func (UnimplementedUniverseServer) SetWriteQuota(context.Context, *SetWriteQuotaRequest) (*SetWriteQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWriteQuota not implemented")
+}
+func (UnimplementedUniverseServer) ListWriteQuotas(context.Context, *ListWriteQuotasRequest) (*ListWriteQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWriteQuotas not implemented")
+}
+func (UnimplementedUniverseServer) DeleteWriteQuota(context.Context, *DeleteWriteQuotaRequest) (*DeleteWriteQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWriteQuota not implemented")
+}
 func (UnimplementedUniverseServer) UniverseStats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UniverseStats not implemented")
 }
@@ -550,6 +698,12 @@ func (UnimplementedUniverseServer) QueryAssetStats(context.Context, *AssetStatsQ
 func (UnimplementedUniverseServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
 }
+func (UnimplementedUniverseServer) SearchAssets(context.Context, *SearchAssetsRequest) (*SearchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAssets not implemented")
+}
+func (UnimplementedUniverseServer) SearchLeaves(context.Context, *SearchLeavesRequest) (*SearchLeavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLeaves not implemented")
+}
 func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *SetFederationSyncConfigRequest) (*SetFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetFederationSyncConfig not implemented")
 }
@@ -674,6 +828,24 @@ func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_UniverseSubtrees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniverseSubtreesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).UniverseSubtrees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/UniverseSubtrees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).UniverseSubtrees(ctx, req.(*UniverseSubtreesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -782,6 +954,24 @@ func _Universe_SyncUniverse_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SyncProgress(ctx, req.(*SyncProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_ListFederationServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFederationServersRequest)
 	if err := dec(in); err != nil {
@@ -836,6 +1026,60 @@ func _Universe_DeleteFederationServer_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SetWriteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWriteQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SetWriteQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SetWriteQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SetWriteQuota(ctx, req.(*SetWriteQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_ListWriteQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWriteQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListWriteQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListWriteQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListWriteQuotas(ctx, req.(*ListWriteQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_DeleteWriteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWriteQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).DeleteWriteQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/DeleteWriteQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).DeleteWriteQuota(ctx, req.(*DeleteWriteQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_UniverseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatsRequest)
 	if err := dec(in); err != nil {
@@ -890,6 +1134,42 @@ func _Universe_QueryEvents_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SearchAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SearchAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SearchAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SearchAssets(ctx, req.(*SearchAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_SearchLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLeavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SearchLeaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SearchLeaves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SearchLeaves(ctx, req.(*SearchLeavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_SetFederationSyncConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetFederationSyncConfigRequest)
 	if err := dec(in); err != nil {
@@ -1043,6 +1323,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssetLeafKeys",
 			Handler:    _Universe_AssetLeafKeys_Handler,
 		},
+		{
+			MethodName: "UniverseSubtrees",
+			Handler:    _Universe_UniverseSubtrees_Handler,
+		},
 		{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
@@ -1067,6 +1351,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncUniverse",
 			Handler:    _Universe_SyncUniverse_Handler,
 		},
+		{
+			MethodName: "SyncProgress",
+			Handler:    _Universe_SyncProgress_Handler,
+		},
 		{
 			MethodName: "ListFederationServers",
 			Handler:    _Universe_ListFederationServers_Handler,
@@ -1079,6 +1367,18 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFederationServer",
 			Handler:    _Universe_DeleteFederationServer_Handler,
 		},
+		{
+			MethodName: "SetWriteQuota",
+			Handler:    _Universe_SetWriteQuota_Handler,
+		},
+		{
+			MethodName: "ListWriteQuotas",
+			Handler:    _Universe_ListWriteQuotas_Handler,
+		},
+		{
+			MethodName: "DeleteWriteQuota",
+			Handler:    _Universe_DeleteWriteQuota_Handler,
+		},
 		{
 			MethodName: "UniverseStats",
 			Handler:    _Universe_UniverseStats_Handler,
@@ -1091,6 +1391,14 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryEvents",
 			Handler:    _Universe_QueryEvents_Handler,
 		},
+		{
+			MethodName: "SearchAssets",
+			Handler:    _Universe_SearchAssets_Handler,
+		},
+		{
+			MethodName: "SearchLeaves",
+			Handler:    _Universe_SearchLeaves_Handler,
+		},
 		{
 			MethodName: "SetFederationSyncConfig",
 			Handler:    _Universe_SetFederationSyncConfig_Handler,
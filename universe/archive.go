@@ -53,6 +53,16 @@ type ArchiveConfig struct {
 	// a proof should be ignored.
 	IgnoreChecker lfn.Option[proof.IgnoreChecker]
 
+	// VerificationCache is an optional cache of already verified proofs
+	// that is used to speed up the verification of proof files.
+	VerificationCache lfn.Option[proof.VerificationCache]
+
+	// ReadOnly, if true, refuses all writes to the archive with
+	// ErrReadOnlyReplica. This is used when the universe server runs as a
+	// read-only replica, in which case only the replica itself writes to
+	// the local universes through its own archive.
+	ReadOnly bool
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -244,6 +254,10 @@ func (a *Archive) MultiverseRoot(ctx context.Context, proofType ProofType,
 func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 	key LeafKey, leaf *Leaf) (*Proof, error) {
 
+	if a.cfg.ReadOnly {
+		return nil, ErrReadOnlyReplica
+	}
+
 	log.Debugf("Inserting new proof into Universe: id=%v, base_key=%v",
 		id.StringForLog(), spew.Sdump(key))
 
@@ -356,11 +370,12 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 	prevAssetSnapshot *proof.AssetSnapshot) (*proof.AssetSnapshot, error) {
 
 	vCtx := proof.VerifierCtx{
-		HeaderVerifier: a.cfg.HeaderVerifier,
-		MerkleVerifier: a.cfg.MerkleVerifier,
-		GroupVerifier:  a.cfg.GroupVerifier,
-		ChainLookupGen: a.cfg.ChainLookupGenerator,
-		IgnoreChecker:  a.cfg.IgnoreChecker,
+		HeaderVerifier:    a.cfg.HeaderVerifier,
+		MerkleVerifier:    a.cfg.MerkleVerifier,
+		GroupVerifier:     a.cfg.GroupVerifier,
+		ChainLookupGen:    a.cfg.ChainLookupGenerator,
+		IgnoreChecker:     a.cfg.IgnoreChecker,
+		VerificationCache: a.cfg.VerificationCache,
 	}
 
 	lookup, err := a.cfg.ChainLookupGenerator.GenProofChainLookup(newProof)
@@ -429,6 +444,10 @@ func extractBatchDeps(batch []*Item) map[UniverseKey]*asset.Asset {
 func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 	items []*Item) error {
 
+	if a.cfg.ReadOnly {
+		return ErrReadOnlyReplica
+	}
+
 	log.InfoS(ctx, "Verifying new proofs for insertion into Universe",
 		"count", len(items))
 
@@ -718,6 +737,47 @@ func (a *Archive) UniverseLeafKeys(ctx context.Context,
 	return a.cfg.Multiverse.UniverseLeafKeys(ctx, q)
 }
 
+// IssuanceScriptKey returns the script key the given non-grouped asset was
+// issued to, as recorded in the issuance universe of the asset.
+func (a *Archive) IssuanceScriptKey(ctx context.Context,
+	assetID asset.ID) (*btcec.PublicKey, error) {
+
+	leafKeys, err := a.UniverseLeafKeys(ctx, UniverseLeafKeysQuery{
+		Id: Identifier{
+			AssetID:   assetID,
+			ProofType: ProofTypeIssuance,
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leafKeys) == 0 {
+		return nil, fmt.Errorf("%w: no issuance proof for asset %v",
+			ErrNoUniverseProofFound, assetID)
+	}
+
+	scriptKey := leafKeys[0].LeafScriptKey()
+	if scriptKey.PubKey == nil {
+		return nil, fmt.Errorf("issuance proof of asset %v has no "+
+			"script key", assetID)
+	}
+
+	return scriptKey.PubKey, nil
+}
+
+// UniverseSubtrees returns the subtree roots of the given universe at the given
+// depth for each of the given paths, in the same order as the paths.
+func (a *Archive) UniverseSubtrees(ctx context.Context,
+	q UniverseSubtreesQuery) ([]UniverseSubtree, error) {
+
+	log.Debugf("Retrieving %d subtrees at depth %d for Universe: id=%v",
+		len(q.Paths), q.Depth, q.Id.StringForLog())
+
+	return a.cfg.Multiverse.UniverseSubtrees(ctx, q)
+}
+
 // FetchLeaves returns the set of leaves which correspond to the given universe
 // identifier.
 func (a *Archive) FetchLeaves(ctx context.Context,
@@ -738,6 +798,10 @@ func (a *Archive) FetchLeaves(ctx context.Context,
 func (a *Archive) DeleteRoot(ctx context.Context,
 	id Identifier) (string, error) {
 
+	if a.cfg.ReadOnly {
+		return "", ErrReadOnlyReplica
+	}
+
 	log.Debugf("Deleting universe tree for Universe: id=%v", id.String())
 
 	uniStr, err := a.cfg.Multiverse.DeleteUniverse(ctx, id)
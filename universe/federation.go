@@ -12,6 +12,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -57,6 +58,16 @@ type FederationConfig struct {
 	// ServerChecker is a function that can be used to check if a server is
 	// operational and not the local daemon.
 	ServerChecker func(ServerAddr) error
+
+	// DisableSync, if true, prevents the envoy from periodically syncing
+	// with the federation servers. This is set for read replicas, which
+	// only sync with their primary universe server.
+	DisableSync bool
+
+	// WriteAuthSigner is an optional signer that authenticates the proofs
+	// pushed to the federation on behalf of the issuer of the asset, for
+	// universe servers that require write authentication.
+	WriteAuthSigner WriteAuthSigner
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -194,25 +205,13 @@ func (f *FederationEnvoy) Stop() error {
 	return nil
 }
 
-// syncServerState attempts to sync Universe state with the target server.
-// If the sync is successful (even if no diff is generated), then a new sync
-// event will be logged.
-func (f *FederationEnvoy) syncServerState(ctx context.Context,
-	addr ServerAddr, syncConfigs SyncConfigs) error {
-
-	log.Infof("Syncing Universe state with server=%s", addr.HostStr())
-
-	// Attempt to sync with the remote Universe server, if this errors then
-	// we'll bail out early as something wrong happened.
-	diff, err := f.cfg.UniverseSyncer.SyncUniverse(
-		ctx, addr, SyncFull, syncConfigs,
-	)
-	if err != nil {
-		return err
-	}
+// logServerSync logs a new sync event for the given server if the sync with
+// it resulted in a diff.
+func (f *FederationEnvoy) logServerSync(addr ServerAddr,
+	diff []AssetSyncDiff) {
 
 	if len(diff) == 0 {
-		return nil
+		return
 	}
 
 	// If we synced anything from the server, then we'll log that here.
@@ -233,8 +232,6 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 			log.Warnf("unable to log new sync: %v", err)
 		}
 	}()
-
-	return nil
 }
 
 // pushProofToServer attempts to push out a new proof to the target server.
@@ -249,6 +246,22 @@ func (f *FederationEnvoy) pushProofToServer(ctx context.Context,
 
 	defer remoteUniverseServer.Close()
 
+	// If we can sign for the issuer of the asset, we authenticate the
+	// insert in case the remote server requires it.
+	if f.cfg.WriteAuthSigner != nil {
+		auth, err := f.cfg.WriteAuthSigner.SignWriteAuth(
+			ctx, uniID, key, leaf,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot push proof, unable to sign "+
+				"write auth: %w", err)
+		}
+
+		if auth != nil {
+			ctx = metadata.NewOutgoingContext(ctx, auth.Metadata())
+		}
+	}
+
 	_, err = remoteUniverseServer.UpsertProofLeaf(
 		ctx, uniID, key, leaf,
 	)
@@ -464,11 +477,14 @@ func (f *FederationEnvoy) handleTickEvent() error {
 			"%w", err)
 	}
 
-	log.Infof("Synchronizing with %v federation members", len(fedServers))
-	err = f.SyncServers(fedServers)
-	if err != nil {
-		return fmt.Errorf("unable to sync with federation server: %w",
-			err)
+	if !f.cfg.DisableSync {
+		log.Infof("Synchronizing with %v federation members",
+			len(fedServers))
+		err = f.SyncServers(fedServers)
+		if err != nil {
+			return fmt.Errorf("unable to sync with federation "+
+				"server: %w", err)
+		}
 	}
 
 	// After we've synced with the federation, we'll attempt to push out any
@@ -748,18 +764,21 @@ func (f *FederationEnvoy) SyncServers(serverAddrs []ServerAddr) error {
 		return err
 	}
 
-	syncServer := func(ctx context.Context, serverAddr ServerAddr) error {
-		err := f.syncServerState(ctx, serverAddr, *syncConfigs)
-		if err != nil {
-			log.WarnS(ctx, "Error syncing with universe", err,
-				"server", serverAddr.HostStr())
+	// The syncer takes care of syncing with multiple servers at once and
+	// backing off from servers that fail to sync, so a failure of one
+	// server doesn't affect the others.
+	results := f.cfg.UniverseSyncer.SyncServers(
+		ctx, serverAddrs, SyncFull, *syncConfigs,
+	)
+	for _, result := range results {
+		if result.Err != nil {
+			log.WarnS(ctx, "Error syncing with universe",
+				result.Err, "server", result.Server.HostStr())
+
+			continue
 		}
-		return nil
-	}
 
-	err = fn.ParSlice(ctx, serverAddrs, syncServer)
-	if err != nil {
-		log.Warnf("unable to sync with server: %w", err)
+		f.logServerSync(result.Server, result.Diffs)
 	}
 
 	return nil
@@ -526,6 +526,12 @@ type MultiverseArchive interface {
 	UniverseLeafKeys(ctx context.Context,
 		q UniverseLeafKeysQuery) ([]LeafKey, error)
 
+	// UniverseSubtrees returns the subtree roots of the given universe at
+	// the given depth for each of the given paths, in the same order as
+	// the paths.
+	UniverseSubtrees(ctx context.Context,
+		q UniverseSubtreesQuery) ([]UniverseSubtree, error)
+
 	// FetchLeaves returns the set of multiverse leaves that satisfy the set
 	// of universe targets. If the set of targets is empty, all leaves for
 	// the given proof type will be returned.
@@ -745,10 +751,34 @@ func (a *AssetSyncDiff) HasRootChanged() (bool, error) {
 type Syncer interface {
 	// SyncUniverse attempts to synchronize the local universe with the
 	// remote universe, governed by the sync type and the set of universe
-	// IDs to sync.
+	// IDs to sync. This is an on-demand sync that isn't throttled.
 	SyncUniverse(ctx context.Context, host ServerAddr,
 		syncType SyncType, syncConfigs SyncConfigs,
 		idsToSync ...Identifier) ([]AssetSyncDiff, error)
+
+	// SyncServers attempts to synchronize the local universe with each of
+	// the given remote universes concurrently. The requests sent to each
+	// server are throttled, and servers that recently failed to sync may
+	// be skipped. A result is returned for each server that was synced
+	// with.
+	SyncServers(ctx context.Context, hosts []ServerAddr,
+		syncType SyncType, syncConfigs SyncConfigs) []ServerSyncResult
+
+	// SyncProgress returns a snapshot of the sync progress with each
+	// universe server.
+	SyncProgress() []ServerSyncProgress
+}
+
+// ServerSyncResult is the result of a sync with a single universe server.
+type ServerSyncResult struct {
+	// Server is the universe server that was synced with.
+	Server ServerAddr
+
+	// Diffs is the set of diffs that resulted from the sync.
+	Diffs []AssetSyncDiff
+
+	// Err is the error the sync failed with, if any.
+	Err error
 }
 
 // DiffEngine is a Universe diff engine that can be used to compare the state
@@ -777,6 +807,42 @@ type DiffEngine interface {
 	Close() error
 }
 
+// UniverseSubtreesQuery is used to query the roots of a set of subtrees within
+// a universe tree.
+type UniverseSubtreesQuery struct {
+	// Id is the identifier of the universe tree.
+	Id Identifier
+
+	// Depth is the depth of the subtree roots within the universe tree.
+	Depth int
+
+	// Paths are the paths from the root of the universe tree to the
+	// subtree roots.
+	Paths [][32]byte
+}
+
+// UniverseSubtree is the root of a subtree within a universe tree.
+type UniverseSubtree struct {
+	mssmt.SubtreeNode
+
+	// Key is the universe leaf key of the only leaf in the subtree. This
+	// is only set if the subtree contains exactly one leaf.
+	Key LeafKey
+}
+
+// SubtreeDiffEngine is a DiffEngine that can also return the roots of the
+// subtrees of a universe tree. Comparing subtree roots level by level allows
+// the syncer to only fetch the keys of the leaves that differ, instead of all
+// the leaf keys of a universe.
+type SubtreeDiffEngine interface {
+	DiffEngine
+
+	// UniverseSubtrees returns the subtree roots at the given depth for
+	// each of the given paths, in the same order as the paths.
+	UniverseSubtrees(ctx context.Context,
+		q UniverseSubtreesQuery) ([]UniverseSubtree, error)
+}
+
 // FederationLog is used to keep track of the set Universe servers that
 // comprise our current federation. This'll be used by the AutoSyncer to
 // periodically push and sync new proof events against the federation.
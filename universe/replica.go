@@ -0,0 +1,464 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// DefaultReplicaSyncInterval is the default interval at which a read
+	// replica syncs with its primary universe server.
+	DefaultReplicaSyncInterval = 30 * time.Second
+)
+
+var (
+	// ErrReadOnlyReplica is returned when a write is attempted on a
+	// universe server that runs as a read-only replica.
+	ErrReadOnlyReplica = errors.New("universe server is a read-only " +
+		"replica")
+
+	// replicaSyncConfigs are the sync configs a replica uses when syncing
+	// with its primary. A replica mirrors all universes of the primary,
+	// independent of the local federation sync configs.
+	replicaSyncConfigs = SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{
+			{
+				ProofType:       ProofTypeIssuance,
+				AllowSyncInsert: true,
+			},
+			{
+				ProofType:       ProofTypeTransfer,
+				AllowSyncInsert: true,
+			},
+		},
+	}
+)
+
+// ReplicaConfig is the configuration of a universe server that runs as a
+// read-only replica of a primary universe server.
+//
+//nolint:lll
+type ReplicaConfig struct {
+	Primary string `long:"primary" description:"The host:port of the primary universe server to replicate. If set, this universe server runs as a read-only replica that tails the primary, serves reads from its own database and forwards proof inserts and pushes to the primary."`
+
+	SyncInterval time.Duration `long:"syncinterval" description:"The interval at which the replica syncs with the primary universe server. Valid time units are {s, m, h}."`
+}
+
+// DefaultReplicaConfig returns the default replica configuration, which
+// doesn't enable the replica mode.
+func DefaultReplicaConfig() ReplicaConfig {
+	return ReplicaConfig{
+		SyncInterval: DefaultReplicaSyncInterval,
+	}
+}
+
+// Enabled returns true if the universe server should run as a read-only
+// replica.
+func (c *ReplicaConfig) Enabled() bool {
+	return c.Primary != ""
+}
+
+// Validate returns an error if the replica configuration is invalid.
+func (c *ReplicaConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if _, _, err := net.SplitHostPort(c.Primary); err != nil {
+		return fmt.Errorf("invalid universe replica primary %q: %w",
+			c.Primary, err)
+	}
+
+	if c.SyncInterval <= 0 {
+		return fmt.Errorf("universe replica sync interval must be " +
+			"positive")
+	}
+
+	return nil
+}
+
+// ReplicaCfg is the config that the Replica uses to tail the primary universe
+// server and forward writes to it.
+type ReplicaCfg struct {
+	// Primary is the address of the primary universe server.
+	Primary ServerAddr
+
+	// Syncer is used to sync the local universe with the primary.
+	Syncer Syncer
+
+	// NewRemoteRegistrar is a function that returns a new registrar
+	// instance for the primary universe server. This is used to forward
+	// proof inserts to the primary.
+	NewRemoteRegistrar func(ServerAddr) (Registrar, error)
+
+	// SyncInterval is the interval at which we sync with the primary.
+	SyncInterval time.Duration
+
+	// WriteAuthSigner is an optional signer that authenticates the proofs
+	// of our own issuances that are forwarded to the primary, in case it
+	// requires write authentication.
+	WriteAuthSigner WriteAuthSigner
+}
+
+// ReplicaStatus is a snapshot of the sync state of a replica.
+type ReplicaStatus struct {
+	// Primary is the address of the primary universe server.
+	Primary ServerAddr
+
+	// NumSyncs is the number of completed sync attempts.
+	NumSyncs uint64
+
+	// LastSyncStart is the time the last sync attempt started.
+	LastSyncStart time.Time
+
+	// LastSyncEnd is the time the last sync attempt finished.
+	LastSyncEnd time.Time
+
+	// LastSyncErr is the error of the last sync attempt, if any.
+	LastSyncErr error
+
+	// LastSyncDiffs is the number of universes that were updated by the
+	// last sync attempt.
+	LastSyncDiffs int
+
+	// CaughtUp is true once the first full sync with the primary
+	// succeeded. Until then, the replica may be missing universes and
+	// leaves the primary has, so it shouldn't receive any reads.
+	CaughtUp bool
+
+	// LastFullSync is the time the last successful full sync with the
+	// primary finished.
+	LastFullSync time.Time
+}
+
+// Replica runs a universe server as a read-only replica of a primary universe
+// server. It periodically syncs all universes from the primary into the local
+// database, which is then used to serve reads. Writes are forwarded to the
+// primary and show up locally with the next sync.
+type Replica struct {
+	cfg ReplicaCfg
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+
+	// syncRequests is a channel that is sent the universes that should be
+	// synced with the primary outside the regular sync interval.
+	syncRequests chan Identifier
+
+	registrarMtx sync.Mutex
+	registrar    Registrar
+
+	statusMtx sync.RWMutex
+	status    ReplicaStatus
+}
+
+// NewReplica creates a new read replica from the passed config.
+func NewReplica(cfg ReplicaCfg) *Replica {
+	return &Replica{
+		cfg:          cfg,
+		syncRequests: make(chan Identifier, 1),
+		status: ReplicaStatus{
+			Primary: cfg.Primary,
+		},
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start launches the goroutine that tails the primary universe server.
+func (r *Replica) Start() error {
+	r.startOnce.Do(func() {
+		log.Infof("Starting universe replica of primary %v",
+			r.cfg.Primary.HostStr())
+
+		r.Wg.Add(1)
+		go r.tailPrimary()
+	})
+
+	return nil
+}
+
+// Stop stops the replica and closes the connection to the primary.
+func (r *Replica) Stop() error {
+	var err error
+	r.stopOnce.Do(func() {
+		log.Infof("Stopping universe replica")
+
+		close(r.Quit)
+		r.Wg.Wait()
+
+		err = r.Close()
+	})
+
+	return err
+}
+
+// Primary returns the address of the primary universe server.
+func (r *Replica) Primary() ServerAddr {
+	return r.cfg.Primary
+}
+
+// Status returns a snapshot of the sync state of the replica.
+func (r *Replica) Status() ReplicaStatus {
+	r.statusMtx.RLock()
+	defer r.statusMtx.RUnlock()
+
+	return r.status
+}
+
+// tailPrimary syncs with the primary universe server on start up, at each
+// sync interval and whenever a sync of a single universe is requested.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Replica) tailPrimary() {
+	defer r.Wg.Done()
+
+	syncTicker := time.NewTicker(r.cfg.SyncInterval)
+	defer syncTicker.Stop()
+
+	r.syncPrimary(SyncFull)
+
+	for {
+		select {
+		case <-syncTicker.C:
+			r.syncPrimary(SyncFull)
+
+		case id := <-r.syncRequests:
+			syncType := SyncIssuance
+			if id.ProofType == ProofTypeTransfer {
+				syncType = SyncFull
+			}
+
+			r.syncPrimary(syncType, id)
+
+		case <-r.Quit:
+			return
+		}
+	}
+}
+
+// syncPrimary syncs the given universes, or all universes if none are given,
+// from the primary into the local database. The sync is neither bounded by a
+// timeout nor throttled, as the first sync of a replica copies all universes
+// of the primary and can take a long time.
+func (r *Replica) syncPrimary(syncType SyncType, ids ...Identifier) {
+	ctx, cancel := r.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	start := time.Now()
+	r.statusMtx.Lock()
+	r.status.LastSyncStart = start
+	r.statusMtx.Unlock()
+
+	diffs, err := r.cfg.Syncer.SyncUniverse(
+		ctx, r.cfg.Primary, syncType, replicaSyncConfigs, ids...,
+	)
+
+	end := time.Now()
+	r.statusMtx.Lock()
+	r.status.NumSyncs++
+	r.status.LastSyncEnd = end
+	r.status.LastSyncErr = err
+	r.status.LastSyncDiffs = len(diffs)
+
+	// Only a successful sync of all universes means that the replica has
+	// caught up with the primary.
+	if err == nil && len(ids) == 0 && syncType == SyncFull {
+		r.status.CaughtUp = true
+		r.status.LastFullSync = end
+	}
+	r.statusMtx.Unlock()
+
+	if err != nil {
+		log.Warnf("Unable to sync with universe primary %v: %v",
+			r.cfg.Primary.HostStr(), err)
+		return
+	}
+
+	log.Debugf("Synced %d universes from primary %v in %v", len(diffs),
+		r.cfg.Primary.HostStr(), time.Since(start))
+}
+
+// RequestSync requests a sync of the given universe with the primary outside
+// the regular sync interval. If a sync request is already pending, the
+// request is dropped, as the pending sync will pick up the changes anyway.
+func (r *Replica) RequestSync(id Identifier) {
+	select {
+	case r.syncRequests <- id:
+	default:
+	}
+}
+
+// primaryRegistrar returns the registrar of the primary universe server,
+// connecting to it if needed.
+func (r *Replica) primaryRegistrar() (Registrar, error) {
+	r.registrarMtx.Lock()
+	defer r.registrarMtx.Unlock()
+
+	if r.registrar != nil {
+		return r.registrar, nil
+	}
+
+	registrar, err := r.cfg.NewRemoteRegistrar(r.cfg.Primary)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe "+
+			"primary %v: %w", r.cfg.Primary.HostStr(), err)
+	}
+
+	r.registrar = registrar
+
+	return registrar, nil
+}
+
+// UpsertProofLeaf forwards the proof leaf to the primary universe server. The
+// leaf is inserted into the local database once the replica syncs the
+// universe from the primary, which is requested right away.
+//
+// NOTE: This is part of the Registrar interface.
+func (r *Replica) UpsertProofLeaf(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	registrar, err := r.primaryRegistrar()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := registrar.UpsertProofLeaf(ctx, id, key, leaf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to forward proof to universe "+
+			"primary: %w", err)
+	}
+
+	r.RequestSync(id)
+
+	return proof, nil
+}
+
+// UpsertProofLeafBatch forwards the proof leaves of the batch to the primary
+// universe server one by one, and then requests a sync of the affected
+// universes. This is used by the minter, so each insert is authenticated with
+// the write auth signer if one is configured.
+//
+// NOTE: This is part of the BatchRegistrar interface.
+func (r *Replica) UpsertProofLeafBatch(ctx context.Context,
+	items []*Item) error {
+
+	registrar, err := r.primaryRegistrar()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		itemCtx := ctx
+		if r.cfg.WriteAuthSigner != nil {
+			auth, err := r.cfg.WriteAuthSigner.SignWriteAuth(
+				ctx, item.ID, item.Key, item.Leaf,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to sign write "+
+					"auth: %w", err)
+			}
+
+			if auth != nil {
+				itemCtx = metadata.NewOutgoingContext(
+					ctx, auth.Metadata(),
+				)
+			}
+		}
+
+		_, err := registrar.UpsertProofLeaf(
+			itemCtx, item.ID, item.Key, item.Leaf,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to forward proof to "+
+				"universe primary: %w", err)
+		}
+	}
+
+	for _, item := range items {
+		r.RequestSync(item.ID)
+	}
+
+	return nil
+}
+
+// Close closes the connection to the primary universe server, if any.
+//
+// NOTE: This is part of the Registrar interface.
+func (r *Replica) Close() error {
+	r.registrarMtx.Lock()
+	defer r.registrarMtx.Unlock()
+
+	if r.registrar == nil {
+		return nil
+	}
+
+	err := r.registrar.Close()
+	r.registrar = nil
+
+	return err
+}
+
+// A compile-time check to ensure that Replica meets the BatchRegistrar
+// interface.
+var _ BatchRegistrar = (*Replica)(nil)
+
+// ReadOnlyFederationDB wraps the federation database of a read-only replica.
+// A replica only syncs with its primary, so changes to the federation servers
+// and sync configs are refused with ErrReadOnlyReplica, while all reads and
+// the sync logs are passed through.
+type ReadOnlyFederationDB struct {
+	FederationDB
+}
+
+// NewReadOnlyFederationDB wraps the given federation database so that it
+// refuses changes to the federation.
+func NewReadOnlyFederationDB(db FederationDB) *ReadOnlyFederationDB {
+	return &ReadOnlyFederationDB{
+		FederationDB: db,
+	}
+}
+
+// AddServers refuses to add servers to the federation of a replica.
+//
+// NOTE: This is part of the FederationLog interface.
+func (d *ReadOnlyFederationDB) AddServers(context.Context,
+	...ServerAddr) error {
+
+	return ErrReadOnlyReplica
+}
+
+// RemoveServers refuses to remove servers from the federation of a replica.
+//
+// NOTE: This is part of the FederationLog interface.
+func (d *ReadOnlyFederationDB) RemoveServers(context.Context,
+	...ServerAddr) error {
+
+	return ErrReadOnlyReplica
+}
+
+// UpsertFederationSyncConfig refuses to change the federation sync configs of
+// a replica.
+//
+// NOTE: This is part of the FederationSyncConfigDB interface.
+func (d *ReadOnlyFederationDB) UpsertFederationSyncConfig(context.Context,
+	[]*FedGlobalSyncConfig, []*FedUniSyncConfig) error {
+
+	return ErrReadOnlyReplica
+}
+
+// A compile-time check to ensure that ReadOnlyFederationDB meets the
+// FederationDB interface.
+var _ FederationDB = (*ReadOnlyFederationDB)(nil)
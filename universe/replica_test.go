@@ -0,0 +1,302 @@
+package universe
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+)
+
+// syncCall records a single call to SyncUniverse.
+type syncCall struct {
+	host     ServerAddr
+	syncType SyncType
+	ids      []Identifier
+}
+
+// mockSyncer is a Syncer that records the sync calls it receives. Each sync
+// fails with err, if set.
+type mockSyncer struct {
+	calls chan syncCall
+
+	err error
+}
+
+func (m *mockSyncer) SyncUniverse(_ context.Context, host ServerAddr,
+	syncType SyncType, _ SyncConfigs,
+	ids ...Identifier) ([]AssetSyncDiff, error) {
+
+	m.calls <- syncCall{
+		host:     host,
+		syncType: syncType,
+		ids:      ids,
+	}
+
+	return nil, m.err
+}
+
+func (m *mockSyncer) SyncServers(context.Context, []ServerAddr, SyncType,
+	SyncConfigs) []ServerSyncResult {
+
+	return nil
+}
+
+func (m *mockSyncer) SyncProgress() []ServerSyncProgress {
+	return nil
+}
+
+// mockRegistrar is a Registrar that records the leaves it receives.
+type mockRegistrar struct {
+	sync.Mutex
+
+	leaves []*Leaf
+	closed bool
+}
+
+func (m *mockRegistrar) UpsertProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.leaves = append(m.leaves, leaf)
+
+	return &Proof{
+		LeafKey: key,
+		Leaf:    leaf,
+	}, nil
+}
+
+func (m *mockRegistrar) Close() error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.closed = true
+
+	return nil
+}
+
+// TestReplica tests that a replica tails its primary and forwards proof
+// inserts to it.
+func TestReplica(t *testing.T) {
+	t.Parallel()
+
+	var (
+		primary   = NewServerAddrFromStr("primary.example.com:10029")
+		syncer    = &mockSyncer{calls: make(chan syncCall, 10)}
+		registrar = &mockRegistrar{}
+	)
+	replica := NewReplica(ReplicaCfg{
+		Primary: primary,
+		Syncer:  syncer,
+		NewRemoteRegistrar: func(addr ServerAddr) (Registrar, error) {
+			require.Equal(t, primary, addr)
+			return registrar, nil
+		},
+		SyncInterval: time.Hour,
+	})
+	require.NoError(t, replica.Start())
+
+	// The replica syncs all universes with the primary on start up.
+	var call syncCall
+	select {
+	case call = <-syncer.calls:
+	case <-time.After(time.Second):
+		t.Fatalf("no initial sync with primary")
+	}
+	require.Equal(t, primary, call.host)
+	require.Equal(t, SyncFull, call.syncType)
+	require.Empty(t, call.ids)
+
+	// A proof insert is forwarded to the primary, and a sync of the
+	// universe is requested right away.
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	leaf := &Leaf{}
+	_, err := replica.UpsertProofLeaf(
+		context.Background(), id, randLeafKey(t), leaf,
+	)
+	require.NoError(t, err)
+
+	registrar.Lock()
+	require.Equal(t, []*Leaf{leaf}, registrar.leaves)
+	registrar.Unlock()
+
+	select {
+	case call = <-syncer.calls:
+	case <-time.After(time.Second):
+		t.Fatalf("no sync after forwarded insert")
+	}
+	require.Equal(t, SyncIssuance, call.syncType)
+	require.Equal(t, []Identifier{id}, call.ids)
+
+	require.Eventually(t, func() bool {
+		return replica.Status().NumSyncs == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// The successful initial full sync caught the replica up.
+	status := replica.Status()
+	require.True(t, status.CaughtUp)
+	require.False(t, status.LastFullSync.IsZero())
+
+	// Stopping the replica closes the connection to the primary.
+	require.NoError(t, replica.Stop())
+
+	registrar.Lock()
+	require.True(t, registrar.closed)
+	registrar.Unlock()
+}
+
+// TestReplicaNotCaughtUp tests that a replica only reports being caught up
+// with its primary once a full sync succeeded.
+func TestReplicaNotCaughtUp(t *testing.T) {
+	t.Parallel()
+
+	var (
+		primary = NewServerAddrFromStr("primary.example.com:10029")
+		syncer  = &mockSyncer{
+			calls: make(chan syncCall, 10),
+			err:   errServerDown,
+		}
+	)
+	replica := NewReplica(ReplicaCfg{
+		Primary: primary,
+		Syncer:  syncer,
+		NewRemoteRegistrar: func(addr ServerAddr) (Registrar, error) {
+			return &mockRegistrar{}, nil
+		},
+		SyncInterval: time.Hour,
+	})
+	require.NoError(t, replica.Start())
+	t.Cleanup(func() {
+		require.NoError(t, replica.Stop())
+	})
+
+	require.Eventually(t, func() bool {
+		return replica.Status().NumSyncs == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// The initial full sync failed, so the replica isn't caught up.
+	status := replica.Status()
+	require.ErrorIs(t, status.LastSyncErr, errServerDown)
+	require.False(t, status.CaughtUp)
+	require.True(t, status.LastFullSync.IsZero())
+}
+
+// TestReplicaConfigValidate tests the validation of the replica config.
+func TestReplicaConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultReplicaConfig()
+	require.False(t, cfg.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Primary = "primary.example.com"
+	require.ErrorContains(t, cfg.Validate(), "invalid universe replica")
+
+	cfg.Primary = "primary.example.com:10029"
+	require.True(t, cfg.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	cfg.SyncInterval = 0
+	require.ErrorContains(t, cfg.Validate(), "must be positive")
+}
+
+// TestReplicaReadOnlyArchive tests that the local archive of a replica refuses
+// all writes, so that only the replica's own syncer changes the local
+// universes.
+func TestReplicaReadOnlyArchive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	archive := NewArchive(ArchiveConfig{
+		ReadOnly: true,
+	})
+
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+	key := randLeafKey(t)
+	leaf := &Leaf{}
+
+	_, err := archive.UpsertProofLeaf(ctx, id, key, leaf)
+	require.ErrorIs(t, err, ErrReadOnlyReplica)
+
+	err = archive.UpsertProofLeafBatch(ctx, []*Item{{
+		ID:   id,
+		Key:  key,
+		Leaf: leaf,
+	}})
+	require.ErrorIs(t, err, ErrReadOnlyReplica)
+
+	_, err = archive.DeleteRoot(ctx, id)
+	require.ErrorIs(t, err, ErrReadOnlyReplica)
+
+	// The federation of a replica can't be changed either.
+	fedDB := NewReadOnlyFederationDB(nil)
+	addr := NewServerAddrFromStr("universe.example.com:10029")
+	require.ErrorIs(
+		t, fedDB.AddServers(ctx, addr), ErrReadOnlyReplica,
+	)
+	require.ErrorIs(
+		t, fedDB.RemoveServers(ctx, addr), ErrReadOnlyReplica,
+	)
+	require.ErrorIs(
+		t, fedDB.UpsertFederationSyncConfig(ctx, nil, nil),
+		ErrReadOnlyReplica,
+	)
+}
+
+// TestReplicaBatchInsert tests that a batch insert of the minter is forwarded
+// to the primary leaf by leaf.
+func TestReplicaBatchInsert(t *testing.T) {
+	t.Parallel()
+
+	registrar := &mockRegistrar{}
+	replica := NewReplica(ReplicaCfg{
+		Primary: NewServerAddrFromStr("primary.example.com:10029"),
+		NewRemoteRegistrar: func(ServerAddr) (Registrar, error) {
+			return registrar, nil
+		},
+		SyncInterval: time.Hour,
+	})
+
+	items := []*Item{{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		},
+		Key:  randLeafKey(t),
+		Leaf: &Leaf{},
+	}, {
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		},
+		Key:  randLeafKey(t),
+		Leaf: &Leaf{},
+	}}
+	err := replica.UpsertProofLeafBatch(context.Background(), items)
+	require.NoError(t, err)
+
+	registrar.Lock()
+	require.Equal(
+		t, []*Leaf{items[0].Leaf, items[1].Leaf}, registrar.leaves,
+	)
+	registrar.Unlock()
+
+	// A sync of the inserted universes is requested, of which only one
+	// can be pending at a time.
+	select {
+	case id := <-replica.syncRequests:
+		require.Equal(t, items[0].ID, id)
+	default:
+		t.Fatalf("no sync requested after batch insert")
+	}
+}
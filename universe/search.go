@@ -0,0 +1,196 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// AssetSearchQuery packages a set of filters to search the assets known to
+// the universe. All filters are optional and are combined with a logical AND.
+// Assets are returned in a stable order, and the cursor of a result page can
+// be used to fetch the next page.
+type AssetSearchQuery struct {
+	// NamePrefix only returns assets whose name starts with the prefix.
+	NamePrefix fn.Option[string]
+
+	// AssetType only returns assets of the given type.
+	AssetType fn.Option[asset.Type]
+
+	// MetaType only returns assets with a meta reveal of the given type.
+	MetaType fn.Option[proof.MetaType]
+
+	// DecimalDisplay only returns assets with the given decimal display.
+	// Assets without a decimal display have a decimal display of zero.
+	DecimalDisplay fn.Option[uint32]
+
+	// MinGenesisHeight only returns assets issued at or after the given
+	// block height.
+	MinGenesisHeight fn.Option[uint32]
+
+	// MaxGenesisHeight only returns assets issued at or before the given
+	// block height.
+	MaxGenesisHeight fn.Option[uint32]
+
+	// GroupKey only returns assets of the given asset group.
+	GroupKey *btcec.PublicKey
+
+	// Grouped, if set, only returns grouped or only ungrouped assets.
+	Grouped fn.Option[bool]
+
+	// Cursor is the cursor of the previous result page. Results start
+	// after the cursor. A zero cursor starts at the first result.
+	Cursor uint64
+
+	// Limit is the maximum number of assets to return. If zero,
+	// RequestPageSize is used.
+	Limit int
+}
+
+// Validate returns an error if the asset search query is invalid.
+func (q *AssetSearchQuery) Validate() error {
+	minHeight := q.MinGenesisHeight.UnwrapOr(0)
+	maxHeight := q.MaxGenesisHeight.UnwrapOr(math.MaxUint32)
+	if maxHeight < minHeight {
+		return fmt.Errorf("max genesis height %d below min genesis "+
+			"height %d", maxHeight, minHeight)
+	}
+
+	return validateSearchLimit(q.Limit)
+}
+
+// AssetSearchEntry is a single asset that matched an asset search query.
+type AssetSearchEntry struct {
+	// AssetID is the ID of the asset.
+	AssetID asset.ID
+
+	// AssetName is the name of the asset.
+	AssetName string
+
+	// AssetType is the type of the asset.
+	AssetType asset.Type
+
+	// GroupKey is the optional group key of the asset.
+	GroupKey *btcec.PublicKey
+
+	// GenesisPoint is the first previous output that created the asset.
+	GenesisPoint wire.OutPoint
+
+	// AnchorPoint is the outpoint of the transaction that created the
+	// asset.
+	AnchorPoint wire.OutPoint
+
+	// GenesisHeight is the height of the block that the asset was created
+	// in.
+	GenesisHeight uint32
+
+	// MetaType is the type of the asset's meta reveal, if it's known.
+	MetaType fn.Option[proof.MetaType]
+
+	// DecimalDisplay is the decimal display of the asset.
+	DecimalDisplay uint32
+}
+
+// AssetSearchResult is a page of assets that matched an asset search query.
+type AssetSearchResult struct {
+	// Assets is the set of assets of this result page.
+	Assets []AssetSearchEntry
+
+	// NextCursor is the cursor to fetch the next result page with. This
+	// is zero if there are no more results.
+	NextCursor uint64
+}
+
+// LeafSearchQuery packages a set of filters to search the leaves of all
+// universes. At least one of the script key or outpoint must be set.
+type LeafSearchQuery struct {
+	// ScriptKey only returns leaves with the given script key.
+	ScriptKey *btcec.PublicKey
+
+	// OutPoint only returns leaves with the given outpoint. For issuance
+	// proofs this is the genesis anchor outpoint, for transfer proofs the
+	// anchor outpoint of the transfer.
+	OutPoint *wire.OutPoint
+
+	// ProofType only returns leaves of universes of the given proof type.
+	// If unspecified, leaves of all proof types are returned.
+	ProofType ProofType
+
+	// Cursor is the cursor of the previous result page. Results start
+	// after the cursor. A zero cursor starts at the first result.
+	Cursor uint64
+
+	// Limit is the maximum number of leaves to return. If zero,
+	// RequestPageSize is used.
+	Limit int
+}
+
+// Validate returns an error if the leaf search query is invalid.
+func (q *LeafSearchQuery) Validate() error {
+	if q.ScriptKey == nil && q.OutPoint == nil {
+		return fmt.Errorf("script key or outpoint must be specified")
+	}
+
+	return validateSearchLimit(q.Limit)
+}
+
+// LeafSearchEntry is a single universe leaf that matched a leaf search query.
+type LeafSearchEntry struct {
+	// ID is the identifier of the universe the leaf is part of.
+	ID Identifier
+
+	// Key is the key of the leaf within the universe.
+	Key LeafKey
+
+	// AssetID is the ID of the asset of the leaf.
+	AssetID asset.ID
+}
+
+// LeafSearchResult is a page of leaves that matched a leaf search query.
+type LeafSearchResult struct {
+	// Leaves is the set of leaves of this result page.
+	Leaves []LeafSearchEntry
+
+	// NextCursor is the cursor to fetch the next result page with. This
+	// is zero if there are no more results.
+	NextCursor uint64
+}
+
+// validateSearchLimit returns an error if the given search limit is invalid.
+func validateSearchLimit(limit int) error {
+	if limit < 0 || limit > MaxPageSize {
+		return fmt.Errorf("invalid search limit %d, must be between 0 "+
+			"and %d", limit, MaxPageSize)
+	}
+
+	return nil
+}
+
+// SearchLimit returns the number of results a search with the given limit
+// returns at most.
+func SearchLimit(limit int) int {
+	if limit == 0 {
+		return RequestPageSize
+	}
+
+	return limit
+}
+
+// Searcher is used to search the assets and leaves known to the universe.
+type Searcher interface {
+	// SearchAssets returns a page of the assets known to the universe
+	// that match the given query.
+	SearchAssets(ctx context.Context,
+		q AssetSearchQuery) (*AssetSearchResult, error)
+
+	// SearchLeaves returns a page of the leaves across all universes that
+	// match the given query.
+	SearchLeaves(ctx context.Context,
+		q LeafSearchQuery) (*LeafSearchResult, error)
+}
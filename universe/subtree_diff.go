@@ -0,0 +1,142 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+const (
+	// subtreeDiffLevels is the number of tree levels the subtree diff
+	// descends with each round trip. With four levels, each differing
+	// subtree is split into 16 subtrees in the next round.
+	subtreeDiffLevels = 4
+
+	// maxSubtreePaths is the maximum number of subtree paths we query in a
+	// single request.
+	maxSubtreePaths = RequestPageSize
+)
+
+var (
+	// ErrSubtreeDiffUnsupported is returned by a diff engine that can't
+	// return the subtrees of a universe tree.
+	ErrSubtreeDiffUnsupported = errors.New("subtree diff not supported")
+)
+
+// fetchSubtrees fetches the subtree roots at the given depth for each of the
+// given paths, splitting the paths into multiple requests if needed.
+func fetchSubtrees(ctx context.Context, engine SubtreeDiffEngine,
+	id Identifier, depth int, paths [][32]byte) ([]UniverseSubtree, error) {
+
+	subtrees := make([]UniverseSubtree, 0, len(paths))
+	for start := 0; start < len(paths); start += maxSubtreePaths {
+		end := min(start+maxSubtreePaths, len(paths))
+
+		page, err := engine.UniverseSubtrees(ctx, UniverseSubtreesQuery{
+			Id:    id,
+			Depth: depth,
+			Paths: paths[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) != end-start {
+			return nil, fmt.Errorf("expected %d subtrees, got %d",
+				end-start, len(page))
+		}
+
+		subtrees = append(subtrees, page...)
+	}
+
+	return subtrees, nil
+}
+
+// subtreeDiff finds the keys of the leaves the remote universe tree has that
+// are missing in the local universe tree. Instead of fetching all leaf keys of
+// both trees, the subtree roots of both trees are compared level by level, and
+// only the subtrees that differ are descended into. This reduces the number of
+// requests from O(n) to O(changes * log n).
+func subtreeDiff(ctx context.Context, id Identifier, local,
+	remote SubtreeDiffEngine) ([]LeafKey, error) {
+
+	var (
+		depth    = 0
+		paths    = [][32]byte{{}}
+		diffKeys []LeafKey
+	)
+	for len(paths) > 0 {
+		remoteSubtrees, err := fetchSubtrees(
+			ctx, remote, id, depth, paths,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch remote "+
+				"subtrees: %w", err)
+		}
+
+		localSubtrees, err := fetchSubtrees(
+			ctx, local, id, depth, paths,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch local "+
+				"subtrees: %w", err)
+		}
+
+		var nextPaths [][32]byte
+		for idx, remoteSubtree := range remoteSubtrees {
+			localSubtree := localSubtrees[idx]
+
+			switch {
+			// If both subtrees match, or the remote subtree is
+			// empty, there's nothing to fetch below this path.
+			case remoteSubtree.NodeHash == localSubtree.NodeHash,
+				remoteSubtree.IsEmpty():
+
+				continue
+
+			// If the remote subtree only contains a single leaf
+			// and the local subtree contains at most one leaf,
+			// we've found a leaf to fetch, unless we already have
+			// it locally with a different value. We don't replace
+			// existing leaves during a sync.
+			case remoteSubtree.LeafKey != nil &&
+				(localSubtree.LeafKey != nil ||
+					localSubtree.IsEmpty()):
+
+				localKey := localSubtree.LeafKey
+				if localKey != nil &&
+					*localKey == *remoteSubtree.LeafKey {
+
+					continue
+				}
+
+				if remoteSubtree.Key == nil {
+					return nil, fmt.Errorf("missing leaf "+
+						"key for subtree leaf %x",
+						remoteSubtree.LeafKey[:])
+				}
+
+				diffKeys = append(diffKeys, remoteSubtree.Key)
+
+			// Otherwise, the subtrees differ somewhere further
+			// down, so we'll compare their children next.
+			default:
+				childPaths, err := mssmt.ChildPaths(
+					paths[idx], depth, subtreeDiffLevels,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				nextPaths = append(nextPaths, childPaths...)
+			}
+		}
+
+		depth = min(depth+subtreeDiffLevels, mssmt.MaxTreeLevels)
+		paths = nextPaths
+	}
+
+	return diffKeys, nil
+}
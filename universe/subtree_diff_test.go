@@ -0,0 +1,148 @@
+package universe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// mockSubtreeEngine is a SubtreeDiffEngine that is backed by an in-memory
+// universe tree.
+type mockSubtreeEngine struct {
+	mockDiffEngine
+
+	tree *mssmt.CompactedTree
+
+	keys map[[32]byte]LeafKey
+
+	numQueries int
+}
+
+// newMockSubtreeEngine creates a new mock subtree engine with an empty tree.
+func newMockSubtreeEngine() *mockSubtreeEngine {
+	return &mockSubtreeEngine{
+		tree: mssmt.NewCompactedTree(mssmt.NewDefaultStore()),
+		keys: make(map[[32]byte]LeafKey),
+	}
+}
+
+// insert inserts the given leaf at the given key.
+func (m *mockSubtreeEngine) insert(t *testing.T, key LeafKey,
+	leaf *mssmt.LeafNode) {
+
+	_, err := m.tree.Insert(context.Background(), key.UniverseKey(), leaf)
+	require.NoError(t, err)
+
+	m.keys[key.UniverseKey()] = key
+}
+
+func (m *mockSubtreeEngine) UniverseSubtrees(ctx context.Context,
+	q UniverseSubtreesQuery) ([]UniverseSubtree, error) {
+
+	m.numQueries++
+
+	nodes, err := m.tree.SubtreeNodes(ctx, q.Depth, q.Paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.Map(nodes, func(node mssmt.SubtreeNode) UniverseSubtree {
+		subtree := UniverseSubtree{
+			SubtreeNode: node,
+		}
+		if node.LeafKey != nil {
+			subtree.Key = m.keys[*node.LeafKey]
+		}
+
+		return subtree
+	}), nil
+}
+
+// randLeaf returns a leaf with a random value.
+func randLeaf() *mssmt.LeafNode {
+	return mssmt.NewLeafNode(test.RandBytes(32), 1)
+}
+
+// randLeafKey returns a random universe leaf key.
+func randLeafKey(t *testing.T) LeafKey {
+	return BaseLeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: fn.Ptr(asset.RandScriptKey(t)),
+	}
+}
+
+// TestSubtreeDiff tests that the subtree diff finds exactly the leaves that
+// the remote universe has and the local universe is missing.
+func TestSubtreeDiff(t *testing.T) {
+	t.Parallel()
+
+	const numLeaves = 500
+
+	var (
+		ctx    = context.Background()
+		id     = Identifier{AssetID: asset.RandID(t)}
+		local  = newMockSubtreeEngine()
+		remote = newMockSubtreeEngine()
+	)
+
+	// Both universes share most of their leaves.
+	sharedKeys := make([]LeafKey, 0, numLeaves)
+	for range numLeaves {
+		key, leaf := randLeafKey(t), randLeaf()
+		sharedKeys = append(sharedKeys, key)
+		local.insert(t, key, leaf)
+		remote.insert(t, key, leaf)
+	}
+
+	// Without any differences, no leaves need to be fetched and only the
+	// roots are compared.
+	diffKeys, err := subtreeDiff(ctx, id, local, remote)
+	require.NoError(t, err)
+	require.Empty(t, diffKeys)
+	require.Equal(t, 1, remote.numQueries)
+
+	// We add a few leaves that are only known to the remote universe,
+	// some that are only known locally and one that has a different value
+	// in both universes.
+	var missingKeys []LeafKey
+	for range 3 {
+		key := randLeafKey(t)
+		missingKeys = append(missingKeys, key)
+		remote.insert(t, key, randLeaf())
+	}
+	for range 2 {
+		local.insert(t, randLeafKey(t), randLeaf())
+	}
+	remote.insert(t, sharedKeys[0], randLeaf())
+
+	remote.numQueries = 0
+	diffKeys, err = subtreeDiff(ctx, id, local, remote)
+	require.NoError(t, err)
+
+	toUniverseKey := func(key LeafKey) [32]byte {
+		return key.UniverseKey()
+	}
+	require.ElementsMatch(
+		t, fn.Map(missingKeys, toUniverseKey),
+		fn.Map(diffKeys, toUniverseKey),
+	)
+
+	// Each round of the diff descends four levels, so we need a handful
+	// of round trips to single out the leaves among the 500 leaves.
+	require.Less(t, remote.numQueries, 10)
+
+	// A remote diff engine that doesn't support subtree queries results in
+	// an error that the syncer can fall back on.
+	throttledEngine := newThrottledDiffEngine(
+		&mockDiffEngine{}, rate.NewLimiter(rate.Inf, 1), time.Minute,
+	)
+	_, err = subtreeDiff(ctx, id, local, throttledEngine)
+	require.ErrorIs(t, err, ErrSubtreeDiffUnsupported)
+}
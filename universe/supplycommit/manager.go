@@ -89,6 +89,11 @@ type ManagerCfg struct {
 	// IgnoreCheckerCache is used to invalidate the ignore cache when a new
 	// supply commitment is created.
 	IgnoreCheckerCache IgnoreCheckerCache
+
+	// ReadOnly, if set, refuses new ignore tuples with
+	// universe.ErrReadOnlyReplica, as the universe of a read-only replica
+	// only mirrors its primary.
+	ReadOnly bool
 }
 
 // Manager is a manager for multiple supply commitment state
@@ -289,6 +294,10 @@ func (m *Manager) IgnoreAssetOutPoint(ctx context.Context,
 
 	var zero universe.SignedIgnoreTuple
 
+	if m.cfg.ReadOnly {
+		return zero, universe.ErrReadOnlyReplica
+	}
+
 	assetID, err := assetSpec.UnwrapIdOrErr()
 	if err != nil {
 		return zero, err
@@ -624,6 +633,36 @@ func (m *Manager) FetchSubTrees(ctx context.Context,
 	return subtrees, nil
 }
 
+// FetchIssuedSupply returns the total amount of assets minted for the given
+// asset group, which is the sum of the mint supply subtree of the group.
+func (m *Manager) FetchIssuedSupply(ctx context.Context,
+	groupKey btcec.PublicKey) (uint64, error) {
+
+	subTrees, err := m.FetchSubTrees(
+		ctx, asset.NewSpecifierFromGroupKey(groupKey),
+		fn.None[uint32](),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	mintTree, ok := subTrees[MintTreeType]
+	if !ok {
+		return 0, nil
+	}
+
+	mintRoot, err := mintTree.Root(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch mint tree root: %w", err)
+	}
+
+	return mintRoot.NodeSum(), nil
+}
+
+// A compile-time assertion to ensure that Manager implements the
+// tapgarden.GroupSupplyFetcher interface.
+var _ tapgarden.GroupSupplyFetcher = (*Manager)(nil)
+
 // stateMachineCache is a thread-safe cache mapping an asset group's public key
 // to its supply commitment state machine.
 type stateMachineCache struct {
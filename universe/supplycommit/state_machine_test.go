@@ -2144,3 +2144,20 @@ func TestSyncSupplyUpdateEventEdgeCases(t *testing.T) {
 		require.ErrorIs(t, err, ErrNilDoneChannel)
 	})
 }
+
+// TestManagerIgnoreReadOnly tests that a read-only manager refuses to ignore
+// asset outpoints.
+func TestManagerIgnoreReadOnly(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(ManagerCfg{
+		ReadOnly: true,
+	})
+
+	assetID := asset.RandID(t)
+	_, err := manager.IgnoreAssetOutPoint(
+		context.Background(), asset.NewSpecifierFromId(assetID),
+		asset.AnchorPoint{ID: assetID}, 1,
+	)
+	require.ErrorIs(t, err, universe.ErrReadOnlyReplica)
+}
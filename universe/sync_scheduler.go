@@ -0,0 +1,566 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMaxConcurrentServers is the default number of universe
+	// servers we sync with at the same time.
+	DefaultMaxConcurrentServers = 4
+
+	// DefaultPerServerConcurrency is the default number of concurrent
+	// requests we send to a single universe server while syncing.
+	DefaultPerServerConcurrency = 8
+
+	// DefaultPerServerBurst is the default burst budget of requests we can
+	// send to a single universe server before the rate limit applies.
+	DefaultPerServerBurst = 10
+
+	// DefaultSyncRequestTimeout is the default maximum amount of time a
+	// single request to a universe server is allowed to take while
+	// syncing.
+	DefaultSyncRequestTimeout = time.Minute
+
+	// DefaultSyncInitialBackoff is the default amount of time we wait
+	// before syncing with a server again after its first failed sync.
+	DefaultSyncInitialBackoff = time.Minute
+
+	// DefaultSyncMaxBackoff is the default maximum amount of time we wait
+	// before syncing with a repeatedly failing server again.
+	DefaultSyncMaxBackoff = time.Hour
+)
+
+var (
+	// ErrServerSyncInProgress is returned when a sync with a universe
+	// server is requested while a sync with the same server is still in
+	// progress.
+	ErrServerSyncInProgress = errors.New("sync with server is already " +
+		"in progress, please wait for it to finish")
+)
+
+// SyncSchedulerConfig houses the parameters that govern how scheduled syncs
+// with multiple universe servers are throttled. Syncs that are requested on
+// demand, for example over RPC, are never throttled.
+//
+//nolint:lll
+type SyncSchedulerConfig struct {
+	// MaxConcurrentServers is the number of universe servers we sync with
+	// at the same time.
+	MaxConcurrentServers int `long:"max-concurrent-servers" description:"The maximum number of universe servers that are synced with at the same time."`
+
+	// PerServerConcurrency is the number of concurrent requests we send to
+	// a single universe server while syncing.
+	PerServerConcurrency int `long:"per-server-concurrency" description:"The maximum number of concurrent requests sent to a single universe server while syncing."`
+
+	// PerServerQPS is the maximum number of requests per second we send to
+	// a single universe server while syncing. Zero means no limit.
+	PerServerQPS rate.Limit `long:"per-server-qps" description:"The maximum number of requests per second sent to a single universe server while syncing. Zero means no limit."`
+
+	// PerServerBurst is the burst budget of requests we can send to a
+	// single universe server before PerServerQPS applies.
+	PerServerBurst int `long:"per-server-burst" description:"The burst budget of requests sent to a single universe server before per-server-qps applies."`
+
+	// RequestTimeout is the maximum amount of time a single request to a
+	// universe server is allowed to take while syncing. There is no limit
+	// for the time the whole sync takes, as the initial sync of a large
+	// universe can take a long time.
+	RequestTimeout time.Duration `long:"request-timeout" description:"The maximum amount of time a single request to a universe server is allowed to take while syncing. Valid time units are {s, m, h}."`
+
+	// InitialBackoff is the amount of time we wait before syncing with a
+	// server again after its first failed sync. The backoff doubles with
+	// each consecutive failure.
+	InitialBackoff time.Duration `long:"initial-backoff" description:"The amount of time to wait before syncing with a universe server again after a failed sync. Doubles with each consecutive failure. Valid time units are {s, m, h}."`
+
+	// MaxBackoff is the maximum amount of time we wait before syncing
+	// with a repeatedly failing server again.
+	MaxBackoff time.Duration `long:"max-backoff" description:"The maximum amount of time to wait before syncing with a repeatedly failing universe server again. Valid time units are {s, m, h}."`
+}
+
+// DefaultSyncSchedulerConfig returns the default sync scheduler
+// configuration.
+func DefaultSyncSchedulerConfig() SyncSchedulerConfig {
+	return SyncSchedulerConfig{
+		MaxConcurrentServers: DefaultMaxConcurrentServers,
+		PerServerConcurrency: DefaultPerServerConcurrency,
+		PerServerBurst:       DefaultPerServerBurst,
+		RequestTimeout:       DefaultSyncRequestTimeout,
+		InitialBackoff:       DefaultSyncInitialBackoff,
+		MaxBackoff:           DefaultSyncMaxBackoff,
+	}
+}
+
+// Validate checks that the sync scheduler configuration is sane.
+func (c *SyncSchedulerConfig) Validate() error {
+	switch {
+	case c.MaxConcurrentServers <= 0:
+		return fmt.Errorf("max concurrent servers must be positive")
+
+	case c.PerServerConcurrency <= 0:
+		return fmt.Errorf("per server concurrency must be positive")
+
+	case c.PerServerQPS < 0:
+		return fmt.Errorf("per server QPS must not be negative")
+
+	case c.PerServerBurst <= 0:
+		return fmt.Errorf("per server burst must be positive")
+
+	case c.RequestTimeout <= 0:
+		return fmt.Errorf("request timeout must be positive")
+
+	case c.InitialBackoff < 0:
+		return fmt.Errorf("initial backoff must not be negative")
+
+	case c.MaxBackoff < c.InitialBackoff:
+		return fmt.Errorf("max backoff must not be smaller than the " +
+			"initial backoff")
+	}
+
+	return nil
+}
+
+// withDefaults returns a copy of the config where all unset values are
+// replaced with their default. An unset PerServerQPS stays unlimited.
+func (c SyncSchedulerConfig) withDefaults() SyncSchedulerConfig {
+	defaults := DefaultSyncSchedulerConfig()
+
+	if c.MaxConcurrentServers == 0 {
+		c.MaxConcurrentServers = defaults.MaxConcurrentServers
+	}
+	if c.PerServerConcurrency == 0 {
+		c.PerServerConcurrency = defaults.PerServerConcurrency
+	}
+	if c.PerServerBurst == 0 {
+		c.PerServerBurst = defaults.PerServerBurst
+	}
+	if c.RequestTimeout == 0 {
+		c.RequestTimeout = defaults.RequestTimeout
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = defaults.InitialBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = defaults.MaxBackoff
+	}
+
+	return c
+}
+
+// ServerSyncState is the state of the sync with a single universe server.
+type ServerSyncState uint8
+
+const (
+	// ServerSyncIdle indicates that no sync with the server is in progress
+	// and the server can be synced with on the next sync attempt.
+	ServerSyncIdle ServerSyncState = iota
+
+	// ServerSyncActive indicates that a sync with the server is in
+	// progress.
+	ServerSyncActive
+
+	// ServerSyncBackoff indicates that the last sync with the server
+	// failed and that scheduled syncs skip the server until its backoff
+	// period expires.
+	ServerSyncBackoff
+)
+
+// String returns a human-readable string for the server sync state.
+func (s ServerSyncState) String() string {
+	switch s {
+	case ServerSyncIdle:
+		return "idle"
+
+	case ServerSyncActive:
+		return "active"
+
+	case ServerSyncBackoff:
+		return "backoff"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// ServerSyncProgress is a snapshot of the sync progress with a single
+// universe server.
+type ServerSyncProgress struct {
+	// Server is the universe server this progress belongs to.
+	Server ServerAddr
+
+	// State is the current sync state of the server.
+	State ServerSyncState
+
+	// RootsTotal is the number of universe roots that are synced in the
+	// current (or last) sync with the server.
+	RootsTotal int
+
+	// RootsSynced is the number of universe roots that were synced so far
+	// in the current (or last) sync with the server.
+	RootsSynced int
+
+	// LeavesSynced is the number of new leaves that were inserted so far
+	// in the current (or last) sync with the server.
+	LeavesSynced int
+
+	// LastSyncStart is the time the current (or last) sync with the server
+	// started.
+	LastSyncStart time.Time
+
+	// LastSyncEnd is the time the last sync with the server finished. This
+	// is zero if no sync has finished yet.
+	LastSyncEnd time.Time
+
+	// LastSyncErr is the error of the last sync with the server, if it
+	// failed.
+	LastSyncErr error
+
+	// ConsecutiveFailures is the number of syncs with the server that
+	// failed in a row.
+	ConsecutiveFailures int
+
+	// NextAttempt is the earliest time a scheduled sync will attempt to
+	// sync with the server again. This is only set if the server is in the
+	// ServerSyncBackoff state.
+	NextAttempt time.Time
+}
+
+// serverSync tracks the sync state of a single universe server.
+type serverSync struct {
+	// limiter throttles the requests we send to the server.
+	limiter *rate.Limiter
+
+	// mu protects the progress below, which is updated concurrently while
+	// syncing the roots of the server.
+	mu sync.Mutex
+
+	// progress is the current sync progress with the server.
+	progress ServerSyncProgress
+}
+
+// setRootsTotal sets the number of roots that are synced with the server.
+func (s *serverSync) setRootsTotal(numRoots int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress.RootsTotal = numRoots
+}
+
+// rootSynced records that a root was synced with the server, resulting in the
+// given number of new leaves.
+func (s *serverSync) rootSynced(numNewLeaves int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress.RootsSynced++
+	s.progress.LeavesSynced += numNewLeaves
+}
+
+// snapshot returns a copy of the current sync progress with the server.
+func (s *serverSync) snapshot() ServerSyncProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.progress
+}
+
+// syncScheduler keeps track of the sync state of all universe servers we sync
+// with. It makes sure that we only sync with a server once at a time, and
+// that we back off from servers that fail to sync.
+type syncScheduler struct {
+	cfg SyncSchedulerConfig
+
+	clock clock.Clock
+
+	// mu protects the servers map.
+	mu sync.Mutex
+
+	// servers maps the host string of a universe server to its sync state.
+	servers map[string]*serverSync
+}
+
+// newSyncScheduler creates a new sync scheduler.
+func newSyncScheduler(cfg SyncSchedulerConfig,
+	clock clock.Clock) *syncScheduler {
+
+	return &syncScheduler{
+		cfg:     cfg,
+		clock:   clock,
+		servers: make(map[string]*serverSync),
+	}
+}
+
+// server returns the sync state of the given server, creating it if it
+// doesn't exist yet.
+//
+// NOTE: The scheduler mutex must be held when calling this method.
+func (s *syncScheduler) server(host ServerAddr) *serverSync {
+	srv, ok := s.servers[host.HostStr()]
+	if !ok {
+		qps := s.cfg.PerServerQPS
+		if qps == 0 {
+			qps = rate.Inf
+		}
+
+		srv = &serverSync{
+			limiter: rate.NewLimiter(qps, s.cfg.PerServerBurst),
+			progress: ServerSyncProgress{
+				Server: host,
+			},
+		}
+		s.servers[host.HostStr()] = srv
+	}
+
+	return srv
+}
+
+// inBackoff returns true if scheduled syncs should skip the given server
+// because its backoff period hasn't expired yet.
+func (s *syncScheduler) inBackoff(host ServerAddr) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	srv, ok := s.servers[host.HostStr()]
+	if !ok {
+		return false
+	}
+
+	progress := srv.snapshot()
+
+	return progress.State == ServerSyncBackoff &&
+		s.clock.Now().Before(progress.NextAttempt)
+}
+
+// startSync marks the sync with the given server as active and resets its
+// progress. An error is returned if a sync with the server is already in
+// progress.
+func (s *syncScheduler) startSync(host ServerAddr) (*serverSync, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	srv := s.server(host)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if srv.progress.State == ServerSyncActive {
+		return nil, fmt.Errorf("%w: server=%v", ErrServerSyncInProgress,
+			host.HostStr())
+	}
+
+	srv.progress.State = ServerSyncActive
+	srv.progress.RootsTotal = 0
+	srv.progress.RootsSynced = 0
+	srv.progress.LeavesSynced = 0
+	srv.progress.LastSyncStart = s.clock.Now()
+
+	return srv, nil
+}
+
+// finishSync records the outcome of the sync with the given server. If the
+// sync failed, the server is put into backoff, with the backoff period
+// doubling for each consecutive failure.
+func (s *syncScheduler) finishSync(srv *serverSync, syncErr error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	now := s.clock.Now()
+	srv.progress.LastSyncEnd = now
+	srv.progress.LastSyncErr = syncErr
+
+	if syncErr == nil {
+		srv.progress.State = ServerSyncIdle
+		srv.progress.ConsecutiveFailures = 0
+		srv.progress.NextAttempt = time.Time{}
+
+		return
+	}
+
+	srv.progress.ConsecutiveFailures++
+	backoff := s.backoff(srv.progress.ConsecutiveFailures)
+
+	srv.progress.State = ServerSyncBackoff
+	srv.progress.NextAttempt = now.Add(backoff)
+
+	log.Debugf("Backing off from universe server=%v for %v after %d "+
+		"failed sync(s)", srv.progress.Server.HostStr(), backoff,
+		srv.progress.ConsecutiveFailures)
+}
+
+// abortSync marks the sync with the given server as no longer active without
+// counting it as a failure. This is used if the sync was interrupted by the
+// caller, for example on shutdown.
+func (s *syncScheduler) abortSync(srv *serverSync, syncErr error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.progress.LastSyncEnd = s.clock.Now()
+	srv.progress.LastSyncErr = syncErr
+
+	srv.progress.State = ServerSyncIdle
+	if srv.progress.ConsecutiveFailures > 0 {
+		srv.progress.State = ServerSyncBackoff
+	}
+}
+
+// backoff returns the backoff period after the given number of consecutive
+// failures.
+func (s *syncScheduler) backoff(numFailures int) time.Duration {
+	backoff := s.cfg.InitialBackoff
+	for i := 1; i < numFailures && backoff < s.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, s.cfg.MaxBackoff)
+}
+
+// progress returns a snapshot of the sync progress of all servers the
+// scheduler knows about, sorted by host.
+func (s *syncScheduler) progress() []ServerSyncProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	progress := make([]ServerSyncProgress, 0, len(s.servers))
+	for _, srv := range s.servers {
+		progress = append(progress, srv.snapshot())
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		return progress[i].Server.HostStr() <
+			progress[j].Server.HostStr()
+	})
+
+	return progress
+}
+
+// throttledDiffEngine is a DiffEngine that waits for the rate limiter of a
+// universe server before each request it forwards to the server, and bounds
+// the time each request is allowed to take.
+type throttledDiffEngine struct {
+	DiffEngine
+
+	limiter *rate.Limiter
+
+	requestTimeout time.Duration
+}
+
+// newThrottledDiffEngine wraps the given diff engine with the given rate
+// limiter and request timeout.
+func newThrottledDiffEngine(engine DiffEngine, limiter *rate.Limiter,
+	requestTimeout time.Duration) *throttledDiffEngine {
+
+	return &throttledDiffEngine{
+		DiffEngine:     engine,
+		limiter:        limiter,
+		requestTimeout: requestTimeout,
+	}
+}
+
+// wait blocks until the rate limiter allows another request, or the context
+// is canceled. It returns the context the request must be sent with.
+func (t *throttledDiffEngine) wait(ctx context.Context) (context.Context,
+	context.CancelFunc, error) {
+
+	if err := t.limiter.Wait(ctx); err != nil {
+		return nil, nil, fmt.Errorf("unable to wait for rate "+
+			"limiter: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, t.requestTimeout)
+
+	return reqCtx, cancel, nil
+}
+
+// RootNode returns the root node for a given base universe.
+//
+// NOTE: This is part of the DiffEngine interface.
+func (t *throttledDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	ctx, cancel, err := t.wait(ctx)
+	if err != nil {
+		return Root{}, err
+	}
+	defer cancel()
+
+	return t.DiffEngine.RootNode(ctx, id)
+}
+
+// RootNodes returns the set of root nodes for all known universes.
+//
+// NOTE: This is part of the DiffEngine interface.
+func (t *throttledDiffEngine) RootNodes(ctx context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	ctx, cancel, err := t.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return t.DiffEngine.RootNodes(ctx, q)
+}
+
+// UniverseLeafKeys returns all the keys inserted in the universe.
+//
+// NOTE: This is part of the DiffEngine interface.
+func (t *throttledDiffEngine) UniverseLeafKeys(ctx context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	ctx, cancel, err := t.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return t.DiffEngine.UniverseLeafKeys(ctx, q)
+}
+
+// FetchProofLeaf attempts to fetch a proof leaf for the target leaf key and
+// given a universe identifier (assetID/groupKey).
+//
+// NOTE: This is part of the DiffEngine interface.
+func (t *throttledDiffEngine) FetchProofLeaf(ctx context.Context,
+	id Identifier, key LeafKey) ([]*Proof, error) {
+
+	ctx, cancel, err := t.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return t.DiffEngine.FetchProofLeaf(ctx, id, key)
+}
+
+// UniverseSubtrees returns the subtree roots of the given universe at the given
+// depth for each of the given paths. ErrSubtreeDiffUnsupported is returned if
+// the wrapped diff engine doesn't support subtree queries.
+//
+// NOTE: This is part of the SubtreeDiffEngine interface.
+func (t *throttledDiffEngine) UniverseSubtrees(ctx context.Context,
+	q UniverseSubtreesQuery) ([]UniverseSubtree, error) {
+
+	engine, ok := t.DiffEngine.(SubtreeDiffEngine)
+	if !ok {
+		return nil, ErrSubtreeDiffUnsupported
+	}
+
+	ctx, cancel, err := t.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return engine.UniverseSubtrees(ctx, q)
+}
+
+// A compile-time assertion to ensure throttledDiffEngine satisfies the
+// SubtreeDiffEngine interface.
+var _ SubtreeDiffEngine = (*throttledDiffEngine)(nil)
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -14,6 +13,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/multimutex"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -45,6 +46,15 @@ type SimpleSyncCfg struct {
 
 	// SyncBatchSize is the number of items to sync in a single batch.
 	SyncBatchSize int
+
+	// Scheduler governs how syncs with multiple universe servers are
+	// scheduled and throttled. Unset values are replaced with their
+	// default.
+	Scheduler SyncSchedulerConfig
+
+	// Clock is used to determine the backoff period of universe servers
+	// that failed to sync. If unset, the system clock is used.
+	Clock clock.Clock
 }
 
 // SyncDiffEvent is sent to subscribers after a universe sync completes,
@@ -71,20 +81,32 @@ var _ fn.Event = (*SyncDiffEvent)(nil)
 type SimpleSyncer struct {
 	cfg SimpleSyncCfg
 
-	// isSyncing keeps track of whether we're currently syncing the local
-	// Universe with a remote Universe. This is used to prevent concurrent
-	// syncs.
-	isSyncing atomic.Bool
+	// scheduler keeps track of the sync state of each remote Universe
+	// server. This is used to prevent concurrent syncs with the same
+	// server and to back off from failing servers.
+	scheduler *syncScheduler
 
 	// eventDistributor is used to distribute sync events to subscribers.
 	eventDistributor *fn.EventDistributor[fn.Event]
+
+	// uniLocks serializes the syncs of the same universe, as the same
+	// universe can be synced from multiple servers, or by a scheduled and
+	// an on-demand sync, at the same time.
+	uniLocks *multimutex.Mutex[string]
 }
 
 // NewSimpleSyncer creates a new SimpleSyncer instance.
 func NewSimpleSyncer(cfg SimpleSyncCfg) *SimpleSyncer {
+	cfg.Scheduler = cfg.Scheduler.withDefaults()
+	if cfg.Clock == nil {
+		cfg.Clock = clock.NewDefaultClock()
+	}
+
 	return &SimpleSyncer{
 		cfg:              cfg,
+		scheduler:        newSyncScheduler(cfg.Scheduler, cfg.Clock),
 		eventDistributor: fn.NewEventDistributor[fn.Event](),
+		uniLocks:         multimutex.NewMutex[string](),
 	}
 }
 
@@ -119,20 +141,10 @@ var _ fn.EventPublisher[fn.Event, bool] = (*SimpleSyncer)(nil)
 // executeSync attempts to sync the local Universe with the remote diff engine.
 // A simple approach where a set difference is used to find the set of assets
 // that need to be synced is used.
-func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
-	syncType SyncType, syncConfigs SyncConfigs,
+func (s *SimpleSyncer) executeSync(ctx context.Context, srv *serverSync,
+	diffEngine DiffEngine, syncType SyncType, syncConfigs SyncConfigs,
 	idsToSync []Identifier) ([]AssetSyncDiff, error) {
 
-	// Prevent the syncer from running twice.
-	if !s.isSyncing.CompareAndSwap(false, true) {
-		return nil, fmt.Errorf("sync is already in progress, please " +
-			"wait for it to finish")
-	}
-
-	defer func() {
-		s.isSyncing.Store(false)
-	}()
-
 	// Examine config to ascertain whether global insertion for either proof
 	// type is allowed.
 	globalInsertEnabled := fn.Any(
@@ -166,7 +178,9 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	// only fetch roots for those universes. We wont filter out any
 	// Universes here as we assume that the caller has already done so.
 	case len(idsToSync) != 0:
-		targetRoots, err = fetchRootsForIDs(ctx, idsToSync, diffEngine)
+		targetRoots, err = s.fetchRootsForIDs(
+			ctx, idsToSync, diffEngine,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -208,7 +222,7 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 		}
 
 		// Retrieve roots for the gathered set of universes.
-		targetRoots, err = fetchRootsForIDs(ctx, uniIDs, diffEngine)
+		targetRoots, err = s.fetchRootsForIDs(ctx, uniIDs, diffEngine)
 		if err != nil {
 			return nil, err
 		}
@@ -217,12 +231,15 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	log.Infof("Obtained %v roots from remote Universe server",
 		len(targetRoots))
 
+	srv.setRootsTotal(len(targetRoots))
+
 	// Now that we know the set of Universes we need to sync, we'll execute
 	// the diff operation for each of them.
 	syncDiffs := make(chan AssetSyncDiff, len(targetRoots))
-	err = fn.ParSlice(
-		ctx, targetRoots, func(ctx context.Context, r Root) error {
-			return s.syncRoot(ctx, r, diffEngine, syncDiffs)
+	err = fn.ParSliceN(
+		ctx, targetRoots, s.cfg.Scheduler.PerServerConcurrency,
+		func(ctx context.Context, r Root) error {
+			return s.syncRoot(ctx, srv, r, diffEngine, syncDiffs)
 		},
 	)
 	if err != nil {
@@ -234,8 +251,8 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 }
 
 // fetchRootsForIDs fetches the roots for a specific set of universe IDs.
-func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
-	diffEngine DiffEngine) ([]Root, error) {
+func (s *SimpleSyncer) fetchRootsForIDs(ctx context.Context,
+	idsToSync []Identifier, diffEngine DiffEngine) ([]Root, error) {
 
 	log.Infof("Fetching %v roots", len(idsToSync))
 	log.Tracef("Fetching %v roots for IDs: %v", len(idsToSync),
@@ -244,8 +261,8 @@ func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
 	// We'll use an error group to fetch each Universe root we need
 	// as a series of parallel requests backed by a worker pool.
 	rootsToSync := make(chan Root, len(idsToSync))
-	err := fn.ParSlice(
-		ctx, idsToSync,
+	err := fn.ParSliceN(
+		ctx, idsToSync, s.cfg.Scheduler.PerServerConcurrency,
 		func(ctx context.Context, id Identifier) error {
 			root, err := diffEngine.RootNode(ctx, id)
 			switch {
@@ -287,11 +304,17 @@ func fetchRootsForIDs(ctx context.Context, idsToSync []Identifier,
 
 // syncRoot attempts to sync the local Universe with the remote diff engine for
 // a specific base root.
-func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
-	diffEngine DiffEngine, result chan<- AssetSyncDiff) error {
+func (s *SimpleSyncer) syncRoot(ctx context.Context, srv *serverSync,
+	remoteRoot Root, diffEngine DiffEngine,
+	result chan<- AssetSyncDiff) error {
 
-	// First, we'll compare the remote root against the local root.
+	// Only one sync of this universe can run at a time, so concurrent
+	// syncs don't fetch and insert the same leaves twice.
 	uniID := remoteRoot.ID
+	s.uniLocks.Lock(uniID.String())
+	defer s.uniLocks.Unlock(uniID.String())
+
+	// First, we'll compare the remote root against the local root.
 	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
 	switch {
 	// If we don't have this root, then we don't have anything to compare
@@ -304,6 +327,8 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		log.DebugS(ctx, "Universe roots match, no sync needed",
 			"universe", uniID.StringForLog())
 
+		srv.rootSynced(0)
+
 		return nil
 
 	case err != nil:
@@ -314,27 +339,12 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		uniID.String())
 
 	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch. We'll start by fetching the set of keys from
-	// both the local and remote Universe.
-	var (
-		remoteUniKeys []LeafKey
-		localUniKeys  []LeafKey
-	)
-
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
-	if err != nil {
-		return err
-	}
-
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
+	// keys we need to fetch.
+	keysToFetch, err := s.diffLeafKeys(ctx, diffEngine, uniID)
 	if err != nil {
 		return err
 	}
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
-
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
 	log.Tracef("UniverseRoot(%v): diff_size=%v, diff=%v", uniID.String(),
@@ -356,8 +366,9 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 
 	// Now that we know where the divergence is, we can fetch the issuance
 	// proofs from the remote party.
-	fetchErrs, err := fn.ParSliceErrCollect(
-		ctx, keysToFetch, func(ctx context.Context, key LeafKey) error {
+	fetchErrs, err := fn.ParSliceErrCollectN(
+		ctx, keysToFetch, s.cfg.Scheduler.PerServerConcurrency,
+		func(ctx context.Context, key LeafKey) error {
 			newProof, err := diffEngine.FetchProofLeaf(
 				ctx, uniID, key,
 			)
@@ -498,6 +509,8 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 
 	// TODO(roabseef): sanity check local and remote roots match now?
 
+	srv.rootSynced(len(newLeafProofs))
+
 	// To wrap up, we'll collect the set of leaves then convert them into a
 	// final sync diff.
 	result <- AssetSyncDiff{
@@ -513,6 +526,53 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	return nil
 }
 
+// diffLeafKeys returns the keys of the leaves the remote Universe has that are
+// missing in the local Universe. If both diff engines support it, only the
+// subtrees in which the universes differ are walked. Otherwise, all the leaf
+// keys of both universes are fetched and compared.
+func (s *SimpleSyncer) diffLeafKeys(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier) ([]LeafKey, error) {
+
+	remoteEngine, remoteOK := diffEngine.(SubtreeDiffEngine)
+	localEngine, localOK := s.cfg.LocalDiffEngine.(SubtreeDiffEngine)
+	if remoteOK && localOK {
+		keys, err := subtreeDiff(ctx, uniID, localEngine, remoteEngine)
+		switch {
+		// If the remote Universe doesn't support subtree queries,
+		// we'll fall back to comparing all leaf keys below.
+		case errors.Is(err, ErrSubtreeDiffUnsupported):
+			log.Debugf("UniverseRoot(%v): subtree diff not "+
+				"supported, comparing all leaf keys",
+				uniID.String())
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to diff subtrees: %w",
+				err)
+
+		default:
+			return keys, nil
+		}
+	}
+
+	// We'll start by fetching the set of keys from both the local and
+	// remote Universe.
+	remoteUniKeys, err := s.fetchAllLeafKeys(ctx, diffEngine, uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	localUniKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the set of keys fetched, we can now find the set of keys that
+	// need to be synced.
+	return fn.SetDiff(remoteUniKeys, localUniKeys), nil
+}
+
 // hasGroupKeyReveal determines whether a proof has a group key reveal. This is
 // used to determine whether we should insert the proof right away, or batch it
 // with other proofs.
@@ -582,6 +642,9 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 
 // SyncUniverse attempts to synchronize the local universe with the remote
 // universe, governed by the sync type and the set of universe IDs to sync.
+// This is an on-demand sync, for example requested over RPC, so it's neither
+// throttled nor skipped if the server is backing off or a scheduled sync
+// with the same server is in progress.
 func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 	syncType SyncType, syncConfigs SyncConfigs,
 	idsToSync ...Identifier) ([]AssetSyncDiff, error) {
@@ -591,24 +654,78 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// Next, we'll attempt to create a new diff engine for the remote
 	// Universe.
-	diffEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	remoteEngine, err := s.cfg.NewRemoteDiffEngine(host)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create remote diff "+
 			"engine: %w", err)
 	}
-	defer diffEngine.Close()
+	defer remoteEngine.Close()
+
+	// On-demand syncs aren't tracked by the scheduler, so their progress
+	// is only recorded in this throwaway state.
+	srv := &serverSync{
+		progress: ServerSyncProgress{
+			Server: host,
+		},
+	}
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
 	diffs, err := s.executeSync(
-		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+		ctx, srv, remoteEngine, syncType, syncConfigs, idsToSync,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute sync: %w", err)
 	}
 
-	// Notify subscribers of the new sync diffs where the old and
-	// new Universe roots differ.
+	if err := s.notifySyncDiffs(diffs); err != nil {
+		return nil, err
+	}
+
+	return diffs, nil
+}
+
+// syncScheduled performs a scheduled sync with the given remote Universe
+// server. Only one scheduled sync with a server can be in progress at a time,
+// and a failed sync puts the server into backoff.
+func (s *SimpleSyncer) syncScheduled(ctx context.Context, host ServerAddr,
+	syncType SyncType, syncConfigs SyncConfigs) ([]AssetSyncDiff, error) {
+
+	log.Infof("Attempting scheduled sync with universe: host=%v, "+
+		"sync_type=%v", host.HostStr(), syncType)
+
+	// We only sync with a server once at a time, so we'll first mark the
+	// sync with this server as active.
+	srv, err := s.scheduler.startSync(host)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := s.syncServer(ctx, srv, host, syncType, syncConfigs)
+	switch {
+	// If the caller canceled the sync, for example on shutdown, then the
+	// server isn't at fault, so we won't back off from it.
+	case err != nil && ctx.Err() != nil:
+		s.scheduler.abortSync(srv, err)
+		return nil, err
+
+	case err != nil:
+		s.scheduler.finishSync(srv, err)
+		return nil, err
+	}
+
+	s.scheduler.finishSync(srv, nil)
+
+	if err := s.notifySyncDiffs(diffs); err != nil {
+		return nil, err
+	}
+
+	return diffs, nil
+}
+
+// notifySyncDiffs notifies subscribers of the given sync diffs where the old
+// and new Universe roots differ.
+func (s *SimpleSyncer) notifySyncDiffs(diffs []AssetSyncDiff) error {
 	var events []fn.Event
 	for idx := range diffs {
 		diff := diffs[idx]
@@ -617,8 +734,8 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 		// differ.
 		rootChange, err := diff.HasRootChanged()
 		if err != nil {
-			return nil, fmt.Errorf("unable to determine "+
-				"if root has changed: %w", err)
+			return fmt.Errorf("unable to determine if root has "+
+				"changed: %w", err)
 		}
 
 		if rootChange {
@@ -634,9 +751,94 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	s.eventDistributor.NotifySubscribers(events...)
 
+	return nil
+}
+
+// syncServer syncs the local Universe with the given remote Universe server,
+// throttling the requests sent to the server and bounding the time each
+// request is allowed to take.
+func (s *SimpleSyncer) syncServer(ctx context.Context, srv *serverSync,
+	host ServerAddr, syncType SyncType,
+	syncConfigs SyncConfigs) ([]AssetSyncDiff, error) {
+
+	// Next, we'll attempt to create a new diff engine for the remote
+	// Universe.
+	remoteEngine, err := s.cfg.NewRemoteDiffEngine(host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create remote diff "+
+			"engine: %w", err)
+	}
+	defer remoteEngine.Close()
+
+	diffEngine := newThrottledDiffEngine(
+		remoteEngine, srv.limiter, s.cfg.Scheduler.RequestTimeout,
+	)
+
+	// With the engine created, we can now sync the local Universe with the
+	// remote instance.
+	diffs, err := s.executeSync(
+		ctx, srv, diffEngine, syncType, syncConfigs, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sync: %w", err)
+	}
+
 	return diffs, nil
 }
 
+// SyncServers attempts to synchronize the local universe with each of the
+// given remote universes. Up to MaxConcurrentServers servers are synced with
+// concurrently, so a slow server doesn't stall the sync with all others.
+// Servers that recently failed to sync are skipped until their backoff period
+// expires. A result is returned for each server that was synced with.
+func (s *SimpleSyncer) SyncServers(ctx context.Context, hosts []ServerAddr,
+	syncType SyncType, syncConfigs SyncConfigs) []ServerSyncResult {
+
+	hosts = fn.Filter(hosts, func(host ServerAddr) bool {
+		if s.scheduler.inBackoff(host) {
+			log.Debugf("Skipping sync with universe server=%v, "+
+				"still backing off", host.HostStr())
+
+			return false
+		}
+
+		return true
+	})
+
+	results := make(chan ServerSyncResult, len(hosts))
+	syncHost := func(ctx context.Context, host ServerAddr) error {
+		diffs, err := s.syncScheduled(ctx, host, syncType, syncConfigs)
+		results <- ServerSyncResult{
+			Server: host,
+			Diffs:  diffs,
+			Err:    err,
+		}
+
+		// A failed server must not abort the sync with the other
+		// servers, so the error is only returned as part of the
+		// result.
+		return nil
+	}
+
+	// We never return an error from the closure above, so we can ignore
+	// the error here.
+	_ = fn.ParSliceN(
+		ctx, hosts, s.cfg.Scheduler.MaxConcurrentServers, syncHost,
+	)
+
+	return fn.Collect(results)
+}
+
+// SyncProgress returns a snapshot of the sync progress with each universe
+// server that was synced with since start up.
+func (s *SimpleSyncer) SyncProgress() []ServerSyncProgress {
+	return s.scheduler.progress()
+}
+
+// A compile-time assertion to ensure SimpleSyncer satisfies the Syncer
+// interface.
+var _ Syncer = (*SimpleSyncer)(nil)
+
 // fetchAllRoots fetches all the roots from the remote Universe. This function
 // is used in order to isolate any logic related to the specifics of how we
 // fetch the data from the universe server.
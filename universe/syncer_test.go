@@ -0,0 +1,380 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// errServerDown is returned by the mock diff engine of a server that is
+	// down.
+	errServerDown = errors.New("server down")
+
+	// syncAllConfigs is a sync config that syncs all universes.
+	syncAllConfigs = SyncConfigs{
+		GlobalSyncConfigs: []*FedGlobalSyncConfig{{
+			ProofType:       ProofTypeIssuance,
+			AllowSyncInsert: true,
+		}},
+	}
+)
+
+// mockDiffEngine is a DiffEngine of a remote universe with the given roots.
+// The rootNodes hook is called on each RootNodes request.
+type mockDiffEngine struct {
+	roots []Root
+
+	rootNodes func(ctx context.Context) error
+}
+
+func (m *mockDiffEngine) RootNode(context.Context, Identifier) (Root, error) {
+	return Root{}, ErrNoUniverseRoot
+}
+
+func (m *mockDiffEngine) RootNodes(ctx context.Context,
+	_ RootNodesQuery) ([]Root, error) {
+
+	if m.rootNodes != nil {
+		if err := m.rootNodes(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.roots, nil
+}
+
+func (m *mockDiffEngine) UniverseLeafKeys(context.Context,
+	UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	return nil, nil
+}
+
+func (m *mockDiffEngine) FetchProofLeaf(context.Context, Identifier,
+	LeafKey) ([]*Proof, error) {
+
+	return nil, ErrNoUniverseProofFound
+}
+
+func (m *mockDiffEngine) Close() error {
+	return nil
+}
+
+// newTestSyncer creates a SimpleSyncer that uses the given diff engines for
+// the remote servers. Servers without a diff engine are down.
+func newTestSyncer(cfg SyncSchedulerConfig, testClock clock.Clock,
+	engines map[string]*mockDiffEngine) *SimpleSyncer {
+
+	return NewSimpleSyncer(SimpleSyncCfg{
+		NewRemoteDiffEngine: func(addr ServerAddr) (DiffEngine, error) {
+			engine, ok := engines[addr.HostStr()]
+			if !ok {
+				return nil, errServerDown
+			}
+
+			return engine, nil
+		},
+		SyncBatchSize: 10,
+		Scheduler:     cfg,
+		Clock:         testClock,
+	})
+}
+
+// TestSyncServersConcurrently tests that the syncer syncs with multiple
+// servers at the same time.
+func TestSyncServersConcurrently(t *testing.T) {
+	t.Parallel()
+
+	const numServers = 3
+
+	// Each server only returns its roots once all servers were contacted,
+	// which only happens if the servers are synced concurrently.
+	var allContacted sync.WaitGroup
+	allContacted.Add(numServers)
+
+	engines := make(map[string]*mockDiffEngine)
+	hosts := make([]ServerAddr, 0, numServers)
+	for _, host := range []string{"a:1", "b:1", "c:1"} {
+		engines[host] = &mockDiffEngine{
+			rootNodes: func(ctx context.Context) error {
+				allContacted.Done()
+				allContacted.Wait()
+
+				return nil
+			},
+		}
+		hosts = append(hosts, NewServerAddrFromStr(host))
+	}
+
+	cfg := DefaultSyncSchedulerConfig()
+	cfg.MaxConcurrentServers = numServers
+	syncer := newTestSyncer(cfg, clock.NewDefaultClock(), engines)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, numServers)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+	}
+
+	progress := syncer.SyncProgress()
+	require.Len(t, progress, numServers)
+	for idx, serverProgress := range progress {
+		require.Equal(t, hosts[idx].HostStr(),
+			serverProgress.Server.HostStr())
+		require.Equal(t, ServerSyncIdle, serverProgress.State)
+		require.Zero(t, serverProgress.ConsecutiveFailures)
+		require.NoError(t, serverProgress.LastSyncErr)
+	}
+}
+
+// TestSyncServerInProgress tests that the syncer refuses to start a scheduled
+// sync with a server while a scheduled sync with the same server is in
+// progress, but still allows on-demand syncs with the server.
+func TestSyncServerInProgress(t *testing.T) {
+	t.Parallel()
+
+	var (
+		started  = make(chan struct{})
+		release  = make(chan struct{})
+		numCalls atomic.Int32
+	)
+	engines := map[string]*mockDiffEngine{
+		"a:1": {
+			// Only the first request blocks, so the on-demand sync
+			// below can complete while the scheduled sync is
+			// still in progress.
+			rootNodes: func(ctx context.Context) error {
+				if numCalls.Add(1) != 1 {
+					return nil
+				}
+
+				close(started)
+				<-release
+
+				return nil
+			},
+		},
+	}
+	host := NewServerAddrFromStr("a:1")
+	syncer := newTestSyncer(
+		SyncSchedulerConfig{}, clock.NewDefaultClock(), engines,
+	)
+
+	ctx := context.Background()
+
+	hosts := []ServerAddr{host}
+
+	resultChan := make(chan []ServerSyncResult, 1)
+	go func() {
+		resultChan <- syncer.SyncServers(
+			ctx, hosts, SyncFull, syncAllConfigs,
+		)
+	}()
+
+	<-started
+	require.Equal(t, ServerSyncActive, syncer.SyncProgress()[0].State)
+
+	results := syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err, ErrServerSyncInProgress)
+
+	// An on-demand sync isn't blocked by the scheduled sync.
+	_, err := syncer.SyncUniverse(ctx, host, SyncFull, syncAllConfigs)
+	require.NoError(t, err)
+
+	close(release)
+	results = <-resultChan
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, ServerSyncIdle, syncer.SyncProgress()[0].State)
+}
+
+// mockLocalDiffEngine is a DiffEngine of a local universe that has the given
+// root for every universe. It keeps track of the maximum number of concurrent
+// RootNode requests.
+type mockLocalDiffEngine struct {
+	mockDiffEngine
+
+	root Root
+
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func (m *mockLocalDiffEngine) RootNode(context.Context, Identifier) (Root,
+	error) {
+
+	active := m.active.Add(1)
+	defer m.active.Add(-1)
+
+	for {
+		maxActive := m.maxActive.Load()
+		if active <= maxActive ||
+			m.maxActive.CompareAndSwap(maxActive, active) {
+
+			break
+		}
+	}
+
+	// Give concurrent syncs of the same universe a chance to overlap.
+	time.Sleep(10 * time.Millisecond)
+
+	return m.root, nil
+}
+
+// TestSyncUniverseSerialized tests that syncs of the same universe from
+// multiple servers are serialized.
+func TestSyncUniverseSerialized(t *testing.T) {
+	t.Parallel()
+
+	const numServers = 3
+
+	root := Root{
+		ID: Identifier{
+			AssetID:   asset.ID{1},
+			ProofType: ProofTypeIssuance,
+		},
+		Node: mssmt.NewComputedBranch(mssmt.NodeHash{1}, 1),
+	}
+
+	engines := make(map[string]*mockDiffEngine)
+	hosts := make([]ServerAddr, 0, numServers)
+	for _, host := range []string{"a:1", "b:1", "c:1"} {
+		engines[host] = &mockDiffEngine{
+			roots: []Root{root},
+		}
+		hosts = append(hosts, NewServerAddrFromStr(host))
+	}
+
+	cfg := DefaultSyncSchedulerConfig()
+	cfg.MaxConcurrentServers = numServers
+	syncer := newTestSyncer(cfg, clock.NewDefaultClock(), engines)
+
+	localEngine := &mockLocalDiffEngine{
+		root: root,
+	}
+	syncer.cfg.LocalDiffEngine = localEngine
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, numServers)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+	}
+
+	require.EqualValues(t, 1, localEngine.maxActive.Load())
+}
+
+// TestSyncServerBackoff tests that the syncer backs off from servers that fail
+// to sync, doubling the backoff period with each failure.
+func TestSyncServerBackoff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		startTime = time.Unix(1_000_000, 0)
+		testClock = clock.NewTestClock(startTime)
+		cfg       = SyncSchedulerConfig{
+			InitialBackoff: time.Minute,
+			MaxBackoff:     3 * time.Minute,
+			RequestTimeout: 50 * time.Millisecond,
+		}
+		slowHost = NewServerAddrFromStr("slow:1")
+		downHost = NewServerAddrFromStr("down:1")
+		hosts    = []ServerAddr{slowHost, downHost}
+	)
+
+	// The slow server never answers, so its sync times out.
+	engines := map[string]*mockDiffEngine{
+		slowHost.HostStr(): {
+			rootNodes: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		},
+	}
+	syncer := newTestSyncer(cfg, testClock, engines)
+
+	ctx := context.Background()
+
+	assertBackoff := func(numFailures int, backoff time.Duration) {
+		t.Helper()
+
+		progress := syncer.SyncProgress()
+		require.Len(t, progress, 2)
+		for _, serverProgress := range progress {
+			require.Equal(
+				t, ServerSyncBackoff, serverProgress.State,
+			)
+			require.Equal(
+				t, numFailures,
+				serverProgress.ConsecutiveFailures,
+			)
+			require.Error(t, serverProgress.LastSyncErr)
+			require.Equal(
+				t, testClock.Now().Add(backoff),
+				serverProgress.NextAttempt,
+			)
+		}
+	}
+
+	// Both servers fail to sync on the first attempt.
+	results := syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		switch result.Server.HostStr() {
+		case slowHost.HostStr():
+			require.ErrorIs(
+				t, result.Err, context.DeadlineExceeded,
+			)
+
+		case downHost.HostStr():
+			require.ErrorIs(t, result.Err, errServerDown)
+		}
+	}
+	assertBackoff(1, time.Minute)
+
+	// While the servers are backing off, they are skipped.
+	results = syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Empty(t, results)
+
+	// Once the backoff period expired, the servers are synced with again,
+	// with the backoff doubling on each failure until it reaches the
+	// maximum.
+	testClock.SetTime(startTime.Add(time.Minute))
+	results = syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, 2)
+	assertBackoff(2, 2*time.Minute)
+
+	testClock.SetTime(startTime.Add(3 * time.Minute))
+	results = syncer.SyncServers(ctx, hosts, SyncFull, syncAllConfigs)
+	require.Len(t, results, 2)
+	assertBackoff(3, 3*time.Minute)
+
+	// A successful sync resets the backoff.
+	engines[downHost.HostStr()] = &mockDiffEngine{}
+	testClock.SetTime(startTime.Add(6 * time.Minute))
+	results = syncer.SyncServers(
+		ctx, []ServerAddr{downHost}, SyncFull, syncAllConfigs,
+	)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+
+	progress := syncer.SyncProgress()
+	require.Equal(t, downHost.HostStr(), progress[0].Server.HostStr())
+	require.Equal(t, ServerSyncIdle, progress[0].State)
+	require.Zero(t, progress[0].ConsecutiveFailures)
+	require.True(t, progress[0].NextAttempt.IsZero())
+}
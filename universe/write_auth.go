@@ -0,0 +1,377 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/multimutex"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// WriteAuthSigMetadataKey is the gRPC metadata key that carries the
+	// hex encoded Schnorr signature of the issuer of the asset over a
+	// proof insert, if the universe server requires write authentication.
+	WriteAuthSigMetadataKey = "universe-write-auth-sig"
+
+	// WriteAuthKeyMetadataKey is the gRPC metadata key that carries the
+	// hex encoded BIP-0086 internal key of the issuer key, if the write
+	// authentication signature was created with the internal key instead
+	// of the issuer key itself.
+	WriteAuthKeyMetadataKey = "universe-write-auth-key"
+)
+
+var (
+	// ErrNoWriteQuota is returned when an issuer that isn't on the write
+	// allowlist of the universe attempts to insert a proof.
+	ErrNoWriteQuota = errors.New("no universe write quota for issuer")
+
+	// ErrWriteQuotaExceeded is returned when inserting a proof would
+	// exceed the write quota of its issuer.
+	ErrWriteQuotaExceeded = errors.New("universe write quota exceeded")
+
+	// ErrInvalidWriteAuth is returned when the write authentication
+	// signature of a proof insert is missing or invalid.
+	ErrInvalidWriteAuth = errors.New("invalid universe write " +
+		"authentication signature")
+
+	// writeAuthTag is the tag of the tagged hash that issuers sign to
+	// authenticate a proof insert.
+	writeAuthTag = []byte("taproot-assets/universe-write-auth")
+)
+
+// WriteQuota is the write quota of a single issuer. An issuer is identified
+// by its group key for grouped assets, or by the script key the asset was
+// issued to for non-grouped assets. A limit of zero means that the respective
+// dimension of the quota is unlimited.
+type WriteQuota struct {
+	// IssuerKey is the key the issuer authenticates proof inserts with.
+	IssuerKey *btcec.PublicKey
+
+	// MaxProofs is the maximum number of proofs the issuer may insert.
+	MaxProofs uint64
+
+	// MaxBytes is the maximum number of raw proof bytes the issuer may
+	// insert.
+	MaxBytes uint64
+
+	// UsedProofs is the number of proofs the issuer inserted so far.
+	UsedProofs uint64
+
+	// UsedBytes is the number of raw proof bytes the issuer inserted so
+	// far.
+	UsedBytes uint64
+}
+
+// Allows returns true if inserting a proof of the given size doesn't exceed
+// the quota.
+func (q *WriteQuota) Allows(numBytes uint64) bool {
+	if q.MaxProofs != 0 && q.UsedProofs+1 > q.MaxProofs {
+		return false
+	}
+
+	if q.MaxBytes != 0 && q.UsedBytes+numBytes > q.MaxBytes {
+		return false
+	}
+
+	return true
+}
+
+// WriteQuotaStore is used to persist the write quotas of issuers.
+type WriteQuotaStore interface {
+	// UpsertWriteQuota adds the issuer of the given quota to the write
+	// allowlist, or updates the limits of an existing quota. The usage of
+	// an existing quota is left untouched.
+	UpsertWriteQuota(ctx context.Context, quota WriteQuota) error
+
+	// FetchWriteQuota returns the write quota of the given issuer. If the
+	// issuer isn't on the allowlist, ErrNoWriteQuota is returned.
+	FetchWriteQuota(ctx context.Context,
+		issuerKey *btcec.PublicKey) (*WriteQuota, error)
+
+	// ListWriteQuotas returns the write quotas of all issuers on the
+	// allowlist.
+	ListWriteQuotas(ctx context.Context) ([]WriteQuota, error)
+
+	// DeleteWriteQuota removes the given issuer from the write allowlist.
+	DeleteWriteQuota(ctx context.Context,
+		issuerKey *btcec.PublicKey) error
+
+	// ConsumeWriteQuota atomically accounts a single proof of the given
+	// size against the quota of the given issuer. If the issuer isn't on
+	// the allowlist, ErrNoWriteQuota is returned. If the proof would
+	// exceed the quota, ErrWriteQuotaExceeded is returned and the usage
+	// is left untouched.
+	ConsumeWriteQuota(ctx context.Context, issuerKey *btcec.PublicKey,
+		numBytes uint64) error
+}
+
+// IssuanceScriptKeyFetcher returns the script key the given non-grouped asset
+// was issued to.
+type IssuanceScriptKeyFetcher func(ctx context.Context,
+	assetID asset.ID) (*btcec.PublicKey, error)
+
+// IssuerKey returns the key that authenticates proof inserts for the given
+// asset. This is the group key for grouped assets. For non-grouped assets this
+// is the script key the asset was issued to, which is the script key of the
+// asset itself for genesis assets, and is looked up with the given fetcher for
+// transferred assets. The current script key of a transferred asset belongs
+// to its owner, not to its issuer.
+func IssuerKey(ctx context.Context, a *asset.Asset,
+	fetchIssuanceKey IssuanceScriptKeyFetcher) (*btcec.PublicKey, error) {
+
+	if a.GroupKey != nil {
+		return &a.GroupKey.GroupPubKey, nil
+	}
+
+	var issuanceKey *btcec.PublicKey
+	switch {
+	case a.IsGenesisAsset():
+		issuanceKey = a.ScriptKey.PubKey
+
+	case fetchIssuanceKey != nil:
+		var err error
+		issuanceKey, err = fetchIssuanceKey(ctx, a.ID())
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch issuance "+
+				"script key of asset %v: %w", a.ID(), err)
+		}
+	}
+
+	if issuanceKey == nil {
+		return nil, fmt.Errorf("unable to determine issuer key of "+
+			"asset %v", a.ID())
+	}
+
+	// Script keys are only ever committed to in their x-only form, so we
+	// normalize the key to make sure it matches the allowlist.
+	return schnorr.ParsePubKey(schnorr.SerializePubKey(issuanceKey))
+}
+
+// WriteAuthDigest returns the digest an issuer signs to authenticate the
+// insert of the given raw proof at the given leaf key of the given universe.
+func WriteAuthDigest(id Identifier, key LeafKey,
+	rawProof []byte) chainhash.Hash {
+
+	var (
+		idBytes   = id.Bytes()
+		leafBytes = key.UniverseKey()
+		proofHash = sha256.Sum256(rawProof)
+	)
+
+	return *chainhash.TaggedHash(
+		writeAuthTag, idBytes[:], []byte{byte(id.ProofType)},
+		leafBytes[:], proofHash[:],
+	)
+}
+
+// writeAuthSigHash returns the hash the write authentication signature
+// commits to. The signature is over the SHA256 of the digest, as that is what
+// lnd's SignMessage RPC signs for a given message.
+func writeAuthSigHash(id Identifier, key LeafKey, rawProof []byte) [32]byte {
+	digest := WriteAuthDigest(id, key, rawProof)
+	return sha256.Sum256(digest[:])
+}
+
+// WriteAuth is the authentication of a proof insert by the issuer of the
+// asset.
+type WriteAuth struct {
+	// Sig is the Schnorr signature over the write auth digest.
+	Sig []byte
+
+	// InternalKey is the optional BIP-0086 internal key of the issuer
+	// key. If set, Sig is a signature by the internal key instead of the
+	// issuer key itself. This allows issuers to authenticate with the key
+	// of their wallet if their issuer key is a BIP-0086 script key.
+	InternalKey *btcec.PublicKey
+}
+
+// Metadata returns the gRPC metadata that carries the write authentication.
+func (w *WriteAuth) Metadata() metadata.MD {
+	md := metadata.Pairs(WriteAuthSigMetadataKey, hex.EncodeToString(w.Sig))
+	if w.InternalKey != nil {
+		md.Set(WriteAuthKeyMetadataKey, hex.EncodeToString(
+			w.InternalKey.SerializeCompressed(),
+		))
+	}
+
+	return md
+}
+
+// WriteAuthFromIncomingContext returns the write authentication from the
+// incoming gRPC metadata of the given context. Nil is returned if the metadata
+// doesn't contain a write authentication signature.
+func WriteAuthFromIncomingContext(ctx context.Context) (*WriteAuth, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	sigValues := md.Get(WriteAuthSigMetadataKey)
+	if len(sigValues) == 0 {
+		return nil, nil
+	}
+
+	sig, err := hex.DecodeString(sigValues[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWriteAuth, err)
+	}
+
+	auth := &WriteAuth{
+		Sig: sig,
+	}
+
+	keyValues := md.Get(WriteAuthKeyMetadataKey)
+	if len(keyValues) == 0 {
+		return auth, nil
+	}
+
+	keyBytes, err := hex.DecodeString(keyValues[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWriteAuth, err)
+	}
+
+	auth.InternalKey, err = btcec.ParsePubKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWriteAuth, err)
+	}
+
+	return auth, nil
+}
+
+// VerifyWriteAuth verifies that the given write authentication authenticates
+// the insert of the given leaf by the given issuer key.
+func VerifyWriteAuth(id Identifier, key LeafKey, leaf *Leaf,
+	issuerKey *btcec.PublicKey, auth *WriteAuth) error {
+
+	if auth == nil || len(auth.Sig) == 0 {
+		return fmt.Errorf("%w: signature missing", ErrInvalidWriteAuth)
+	}
+
+	schnorrSig, err := schnorr.ParseSignature(auth.Sig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWriteAuth, err)
+	}
+
+	// If the signature was created with the internal key of the issuer
+	// key, the issuer key must be the BIP-0086 output key of it.
+	signingKey := issuerKey
+	if auth.InternalKey != nil {
+		outputKey := txscript.ComputeTaprootKeyNoScript(
+			auth.InternalKey,
+		)
+		if !bytes.Equal(
+			schnorr.SerializePubKey(outputKey),
+			schnorr.SerializePubKey(issuerKey),
+		) {
+
+			return fmt.Errorf("%w: internal key %x doesn't match "+
+				"issuer key %x", ErrInvalidWriteAuth,
+				auth.InternalKey.SerializeCompressed(),
+				issuerKey.SerializeCompressed())
+		}
+
+		signingKey = auth.InternalKey
+	}
+
+	sigHash := writeAuthSigHash(id, key, leaf.RawProof)
+	if !schnorrSig.Verify(sigHash[:], signingKey) {
+		return fmt.Errorf("%w: signature doesn't match issuer key %x",
+			ErrInvalidWriteAuth, issuerKey.SerializeCompressed())
+	}
+
+	return nil
+}
+
+// WriteAuthenticator authenticates proof inserts by their issuers and
+// enforces the write quotas of the issuers.
+type WriteAuthenticator struct {
+	store WriteQuotaStore
+
+	fetchIssuanceKey IssuanceScriptKeyFetcher
+
+	// issuerLocks serializes the inserts of each issuer, so concurrent
+	// inserts can't exceed the issuer's quota.
+	issuerLocks *multimutex.Mutex[asset.SerializedKey]
+}
+
+// NewWriteAuthenticator creates a new write authenticator backed by the given
+// quota store. The issuance script keys of transferred non-grouped assets are
+// looked up with the given fetcher.
+func NewWriteAuthenticator(store WriteQuotaStore,
+	fetchIssuanceKey IssuanceScriptKeyFetcher) *WriteAuthenticator {
+
+	return &WriteAuthenticator{
+		store:            store,
+		fetchIssuanceKey: fetchIssuanceKey,
+		issuerLocks:      multimutex.NewMutex[asset.SerializedKey](),
+	}
+}
+
+// AuthorizedInsert verifies the write authentication of the given leaf and
+// makes sure its issuer has enough write quota left, before the leaf is
+// validated and inserted with the given insert function. The insert function
+// returns true if the leaf was newly inserted. The leaf is only accounted
+// against the write quota of its issuer if that is the case, so rejected
+// proofs and proofs that are already known don't count against the quota. An
+// error is returned if the insert must be rejected or failed.
+func (w *WriteAuthenticator) AuthorizedInsert(ctx context.Context,
+	id Identifier, key LeafKey, leaf *Leaf, auth *WriteAuth,
+	insert func() (bool, error)) error {
+
+	if leaf.Asset == nil {
+		return fmt.Errorf("proof leaf has no asset")
+	}
+
+	issuerKey, err := IssuerKey(ctx, leaf.Asset, w.fetchIssuanceKey)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyWriteAuth(id, key, leaf, issuerKey, auth)
+	if err != nil {
+		return err
+	}
+
+	// The quota is checked before and consumed after the insert, so we
+	// hold the issuer's lock for the whole insert.
+	lockKey := asset.ToSerialized(issuerKey)
+	w.issuerLocks.Lock(lockKey)
+	defer w.issuerLocks.Unlock(lockKey)
+
+	numBytes := uint64(len(leaf.RawProof))
+	quota, err := w.store.FetchWriteQuota(ctx, issuerKey)
+	if err != nil {
+		return fmt.Errorf("unable to fetch write quota of issuer "+
+			"%x: %w", issuerKey.SerializeCompressed(), err)
+	}
+	if !quota.Allows(numBytes) {
+		return fmt.Errorf("%w: issuer %x", ErrWriteQuotaExceeded,
+			issuerKey.SerializeCompressed())
+	}
+
+	inserted, err := insert()
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return nil
+	}
+
+	err = w.store.ConsumeWriteQuota(ctx, issuerKey, numBytes)
+	if err != nil {
+		return fmt.Errorf("unable to consume write quota of issuer "+
+			"%x: %w", issuerKey.SerializeCompressed(), err)
+	}
+
+	return nil
+}
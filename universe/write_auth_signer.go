@@ -0,0 +1,237 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"google.golang.org/grpc/metadata"
+)
+
+// WriteAuthSigner signs proof inserts into remote universe servers on behalf
+// of the issuer of the asset.
+type WriteAuthSigner interface {
+	// SignWriteAuth returns the write authentication of the insert of the
+	// given leaf into the given universe. If the local node can't sign for
+	// the issuer of the asset, nil is returned.
+	SignWriteAuth(ctx context.Context, id Identifier, key LeafKey,
+		leaf *Leaf) (*WriteAuth, error)
+}
+
+// IssuerKeyStore is used to look up the wallet keys of the assets issued by
+// the local node.
+type IssuerKeyStore interface {
+	// FetchGroupByGroupKey fetches the asset group with a matching tweaked
+	// key, including the genesis information used to create the group.
+	FetchGroupByGroupKey(ctx context.Context,
+		groupKey *btcec.PublicKey) (*asset.AssetGroup, error)
+
+	// FetchScriptKeyByTweakedKey fetches the populated script key given
+	// the tweaked script key.
+	FetchScriptKeyByTweakedKey(ctx context.Context,
+		tweakedKey *btcec.PublicKey) (*asset.TweakedScriptKey, error)
+}
+
+// IssuerSignerCfg is the config of the IssuerSigner.
+type IssuerSignerCfg struct {
+	// Signer is used to sign with the keys of the local wallet.
+	Signer lndclient.SignerClient
+
+	// KeyStore is used to look up the wallet keys of local issuers.
+	KeyStore IssuerKeyStore
+
+	// FetchIssuanceScriptKey is used to look up the script key a
+	// transferred non-grouped asset was issued to.
+	FetchIssuanceScriptKey IssuanceScriptKeyFetcher
+}
+
+// IssuerSigner is a WriteAuthSigner that signs with the group keys and the
+// issuance script keys of the assets issued by the local wallet.
+type IssuerSigner struct {
+	cfg IssuerSignerCfg
+}
+
+// NewIssuerSigner creates a new issuer signer from the given config.
+func NewIssuerSigner(cfg IssuerSignerCfg) *IssuerSigner {
+	return &IssuerSigner{
+		cfg: cfg,
+	}
+}
+
+// A compile-time assertion to ensure that IssuerSigner meets the
+// WriteAuthSigner interface.
+var _ WriteAuthSigner = (*IssuerSigner)(nil)
+
+// SignWriteAuth returns the write authentication of the insert of the given
+// leaf into the given universe. If the local node can't sign for the issuer of
+// the asset, nil is returned.
+//
+// NOTE: This is part of the WriteAuthSigner interface.
+func (s *IssuerSigner) SignWriteAuth(ctx context.Context, id Identifier,
+	key LeafKey, leaf *Leaf) (*WriteAuth, error) {
+
+	if leaf.Asset == nil {
+		return nil, fmt.Errorf("proof leaf has no asset")
+	}
+
+	issuerKey, err := IssuerKey(
+		ctx, leaf.Asset, s.cfg.FetchIssuanceScriptKey,
+	)
+	if err != nil {
+		log.Debugf("Not signing universe write auth: %v", err)
+		return nil, nil
+	}
+
+	// lnd's SignMessage RPC signs the SHA256 of the message, which is
+	// what the write auth signature commits to.
+	digest := WriteAuthDigest(id, key, leaf.RawProof)
+
+	var auth *WriteAuth
+	if leaf.Asset.GroupKey != nil {
+		auth, err = s.signGroupKey(ctx, issuerKey, digest[:])
+	} else {
+		auth, err = s.signScriptKey(ctx, issuerKey, digest[:])
+	}
+	if err != nil || auth == nil {
+		return nil, err
+	}
+
+	// Assets that were received from others are known to the wallet too,
+	// but their keys don't belong to it. So we only return signatures
+	// that are actually valid for the issuer key.
+	err = VerifyWriteAuth(id, key, leaf, issuerKey, auth)
+	if err != nil {
+		log.Debugf("Not signing universe write auth for issuer %x, "+
+			"not a local issuer: %v",
+			issuerKey.SerializeCompressed(), err)
+		return nil, nil
+	}
+
+	return auth, nil
+}
+
+// signGroupKey signs the given message with the given group key, if it is a
+// group key of the local wallet.
+func (s *IssuerSigner) signGroupKey(ctx context.Context,
+	groupKey *btcec.PublicKey, msg []byte) (*WriteAuth, error) {
+
+	group, err := s.cfg.KeyStore.FetchGroupByGroupKey(ctx, groupKey)
+	if err != nil || group.GroupKey == nil {
+		log.Debugf("Not signing universe write auth, group key %x "+
+			"unknown: %v", groupKey.SerializeCompressed(), err)
+		return nil, nil
+	}
+
+	// A V1 group key is the taproot output key of the raw key, so lnd can
+	// sign for it directly. A V0 group key is tweaked with the genesis
+	// first, which lnd's SignMessage RPC doesn't support.
+	if group.GroupKey.Version != asset.GroupKeyV1 {
+		log.Debugf("Not signing universe write auth, group key %x "+
+			"isn't a V1 group key", groupKey.SerializeCompressed())
+		return nil, nil
+	}
+
+	sig, err := s.cfg.Signer.SignMessage(
+		ctx, msg, group.GroupKey.RawKey.KeyLocator,
+		lndclient.SignSchnorr(group.GroupKey.TapscriptRoot),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign universe write auth: "+
+			"%w", err)
+	}
+
+	return &WriteAuth{
+		Sig: sig,
+	}, nil
+}
+
+// signScriptKey signs the given message with the given issuance script key, if
+// it is a script key of the local wallet.
+func (s *IssuerSigner) signScriptKey(ctx context.Context,
+	scriptKey *btcec.PublicKey, msg []byte) (*WriteAuth, error) {
+
+	tweakedKey, err := s.cfg.KeyStore.FetchScriptKeyByTweakedKey(
+		ctx, scriptKey,
+	)
+	if err != nil {
+		log.Debugf("Not signing universe write auth, script key %x "+
+			"unknown: %v", scriptKey.SerializeCompressed(), err)
+		return nil, nil
+	}
+
+	// lnd's SignMessage RPC can't apply the empty BIP-0086 tweak, so we
+	// sign with the internal key instead and reveal it to the server.
+	if len(tweakedKey.Tweak) == 0 {
+		sig, err := s.cfg.Signer.SignMessage(
+			ctx, msg, tweakedKey.RawKey.KeyLocator,
+			lndclient.SignSchnorr(nil),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign universe write "+
+				"auth: %w", err)
+		}
+
+		return &WriteAuth{
+			Sig:         sig,
+			InternalKey: tweakedKey.RawKey.PubKey,
+		}, nil
+	}
+
+	sig, err := s.cfg.Signer.SignMessage(
+		ctx, msg, tweakedKey.RawKey.KeyLocator,
+		lndclient.SignSchnorr(tweakedKey.Tweak),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign universe write auth: "+
+			"%w", err)
+	}
+
+	return &WriteAuth{
+		Sig: sig,
+	}, nil
+}
+
+// ProofWriteAuth returns the gRPC metadata that authenticates the insert of
+// the given proof into a universe server. Nil is returned if the local node
+// can't sign for the issuer of the asset.
+func (s *IssuerSigner) ProofWriteAuth(ctx context.Context, p *proof.Proof,
+	rawProof []byte) (metadata.MD, error) {
+
+	proofAsset := p.Asset
+	proofType, err := NewProofTypeFromAsset(&proofAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	id := Identifier{
+		AssetID:   proofAsset.ID(),
+		ProofType: proofType,
+	}
+	if proofAsset.GroupKey != nil {
+		id.GroupKey = &proofAsset.GroupKey.GroupPubKey
+	}
+
+	leafKey := BaseLeafKey{
+		OutPoint:  p.OutPoint(),
+		ScriptKey: &proofAsset.ScriptKey,
+	}
+	leaf := &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis:  proofAsset.Genesis,
+			GroupKey: proofAsset.GroupKey,
+		},
+		RawProof: rawProof,
+		Asset:    &proofAsset,
+		Amt:      proofAsset.Amount,
+	}
+
+	auth, err := s.SignWriteAuth(ctx, id, leafKey, leaf)
+	if err != nil || auth == nil {
+		return nil, err
+	}
+
+	return auth.Metadata(), nil
+}
@@ -0,0 +1,683 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockWriteQuotaStore is an in-memory WriteQuotaStore.
+type mockWriteQuotaStore struct {
+	sync.Mutex
+
+	quotas map[asset.SerializedKey]*WriteQuota
+}
+
+func newMockWriteQuotaStore() *mockWriteQuotaStore {
+	return &mockWriteQuotaStore{
+		quotas: make(map[asset.SerializedKey]*WriteQuota),
+	}
+}
+
+func (m *mockWriteQuotaStore) UpsertWriteQuota(_ context.Context,
+	quota WriteQuota) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.quotas[asset.ToSerialized(quota.IssuerKey)] = &quota
+
+	return nil
+}
+
+func (m *mockWriteQuotaStore) FetchWriteQuota(_ context.Context,
+	issuerKey *btcec.PublicKey) (*WriteQuota, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	quota, ok := m.quotas[asset.ToSerialized(issuerKey)]
+	if !ok {
+		return nil, ErrNoWriteQuota
+	}
+
+	quotaCopy := *quota
+	return &quotaCopy, nil
+}
+
+func (m *mockWriteQuotaStore) ListWriteQuotas(
+	context.Context) ([]WriteQuota, error) {
+
+	return nil, nil
+}
+
+func (m *mockWriteQuotaStore) DeleteWriteQuota(_ context.Context,
+	issuerKey *btcec.PublicKey) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.quotas, asset.ToSerialized(issuerKey))
+
+	return nil
+}
+
+func (m *mockWriteQuotaStore) ConsumeWriteQuota(_ context.Context,
+	issuerKey *btcec.PublicKey, numBytes uint64) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	quota, ok := m.quotas[asset.ToSerialized(issuerKey)]
+	switch {
+	case !ok:
+		return ErrNoWriteQuota
+
+	case !quota.Allows(numBytes):
+		return ErrWriteQuotaExceeded
+	}
+
+	quota.UsedProofs++
+	quota.UsedBytes += numBytes
+
+	return nil
+}
+
+// signWriteAuth returns the write authentication signature of the given leaf.
+func signWriteAuth(t *testing.T, key *btcec.PrivateKey, id Identifier,
+	leafKey LeafKey, leaf *Leaf) []byte {
+
+	sigHash := writeAuthSigHash(id, leafKey, leaf.RawProof)
+	sig, err := schnorr.Sign(key, sigHash[:])
+	require.NoError(t, err)
+
+	return sig.Serialize()
+}
+
+// xOnly returns the given key in the x-only form script keys are committed to
+// in.
+func xOnly(t *testing.T, key *btcec.PublicKey) *btcec.PublicKey {
+	xOnlyKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(key))
+	require.NoError(t, err)
+
+	return xOnlyKey
+}
+
+// TestWriteAuthenticator tests that proof inserts are only authorized if they
+// are signed by their issuer and within the issuer's quota.
+func TestWriteAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		store      = newMockWriteQuotaStore()
+		groupKey   = test.RandPrivKey()
+		scriptKey  = test.RandPrivKey()
+		ownerKey   = test.RandPrivKey()
+		otherKey   = test.RandPrivKey()
+		issuanceID asset.ID
+		fetchKey   = func(_ context.Context,
+			assetID asset.ID) (*btcec.PublicKey, error) {
+
+			if assetID != issuanceID {
+				return nil, ErrNoUniverseProofFound
+			}
+
+			return scriptKey.PubKey(), nil
+		}
+		auth        = NewWriteAuthenticator(store, fetchKey)
+		groupedLeaf = &Leaf{
+			Asset: &asset.Asset{
+				GroupKey: &asset.GroupKey{
+					GroupPubKey: *groupKey.PubKey(),
+				},
+				ScriptKey: asset.NewScriptKey(
+					scriptKey.PubKey(),
+				),
+			},
+			RawProof: test.RandBytes(100),
+		}
+		genesisLeaf = &Leaf{
+			Asset: &asset.Asset{
+				ScriptKey: asset.NewScriptKey(
+					scriptKey.PubKey(),
+				),
+				PrevWitnesses: []asset.Witness{{
+					PrevID: &asset.ZeroPrevID,
+				}},
+			},
+			RawProof: test.RandBytes(100),
+		}
+		transferLeaf = &Leaf{
+			Asset: &asset.Asset{
+				Genesis: asset.Genesis{
+					FirstPrevOut: test.RandOp(t),
+				},
+				ScriptKey: asset.NewScriptKey(
+					ownerKey.PubKey(),
+				),
+			},
+			RawProof: test.RandBytes(100),
+		}
+		id = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		}
+		leafKey = randLeafKey(t)
+	)
+
+	// The issuance script key is looked up by the asset ID.
+	issuanceID = transferLeaf.Asset.ID()
+
+	// authorize authorizes an insert that always succeeds.
+	authorize := func(id Identifier, key LeafKey, leaf *Leaf,
+		writeAuth *WriteAuth) error {
+
+		return auth.AuthorizedInsert(
+			ctx, id, key, leaf, writeAuth, func() (bool, error) {
+				return true, nil
+			},
+		)
+	}
+
+	// Grouped assets are authenticated by their group key, non-grouped
+	// assets by the script key they were issued to, regardless of their
+	// current script key.
+	issuerKey, err := IssuerKey(ctx, groupedLeaf.Asset, fetchKey)
+	require.NoError(t, err)
+	require.True(t, groupKey.PubKey().IsEqual(issuerKey))
+
+	issuerKey, err = IssuerKey(ctx, genesisLeaf.Asset, fetchKey)
+	require.NoError(t, err)
+	require.True(t, xOnly(t, scriptKey.PubKey()).IsEqual(issuerKey))
+
+	issuerKey, err = IssuerKey(ctx, transferLeaf.Asset, fetchKey)
+	require.NoError(t, err)
+	require.True(t, xOnly(t, scriptKey.PubKey()).IsEqual(issuerKey))
+
+	// Without a way to look up the issuance script key, the issuer of a
+	// transferred non-grouped asset can't be determined.
+	_, err = IssuerKey(ctx, transferLeaf.Asset, nil)
+	require.Error(t, err)
+
+	// A missing signature, a signature by the wrong key or a signature
+	// over a different universe are rejected.
+	err = authorize(id, leafKey, groupedLeaf, nil)
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	sig := signWriteAuth(t, scriptKey, id, leafKey, groupedLeaf)
+	err = authorize(id, leafKey, groupedLeaf, &WriteAuth{
+		Sig: sig,
+	})
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	sig = signWriteAuth(t, groupKey, id, leafKey, groupedLeaf)
+	transferID := id
+	transferID.ProofType = ProofTypeTransfer
+	err = authorize(transferID, leafKey, groupedLeaf, &WriteAuth{
+		Sig: sig,
+	})
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	// A valid signature of an issuer that isn't on the allowlist is
+	// rejected as well.
+	groupedAuth := &WriteAuth{
+		Sig: sig,
+	}
+	err = authorize(id, leafKey, groupedLeaf, groupedAuth)
+	require.ErrorIs(t, err, ErrNoWriteQuota)
+
+	// Once the issuer is allowed to insert a single proof, the first
+	// insert succeeds and the second one exceeds the quota.
+	require.NoError(t, store.UpsertWriteQuota(ctx, WriteQuota{
+		IssuerKey: groupKey.PubKey(),
+		MaxProofs: 1,
+	}))
+	require.NoError(t, authorize(
+		id, leafKey, groupedLeaf, groupedAuth,
+	))
+
+	err = authorize(id, leafKey, groupedLeaf, groupedAuth)
+	require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+
+	// The byte quota is accounted with the size of the raw proof. The
+	// issuance and the transfer proofs of a non-grouped asset both count
+	// against the quota of the issuance script key.
+	require.NoError(t, store.UpsertWriteQuota(ctx, WriteQuota{
+		IssuerKey: xOnly(t, scriptKey.PubKey()),
+		MaxBytes:  250,
+	}))
+
+	sig = signWriteAuth(t, scriptKey, id, leafKey, genesisLeaf)
+	require.NoError(t, authorize(
+		id, leafKey, genesisLeaf, &WriteAuth{Sig: sig},
+	))
+
+	// The current owner of a transferred asset isn't its issuer.
+	sig = signWriteAuth(t, ownerKey, transferID, leafKey, transferLeaf)
+	err = authorize(
+		transferID, leafKey, transferLeaf, &WriteAuth{Sig: sig},
+	)
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	sig = signWriteAuth(t, scriptKey, transferID, leafKey, transferLeaf)
+	transferAuth := &WriteAuth{
+		Sig: sig,
+	}
+	require.NoError(t, authorize(
+		transferID, leafKey, transferLeaf, transferAuth,
+	))
+
+	err = authorize(transferID, leafKey, transferLeaf, transferAuth)
+	require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+
+	quota, err := store.FetchWriteQuota(ctx, xOnly(t, scriptKey.PubKey()))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, quota.UsedProofs)
+	require.EqualValues(t, 200, quota.UsedBytes)
+
+	// A signature by an unrelated key is never accepted.
+	sig = signWriteAuth(t, otherKey, id, leafKey, genesisLeaf)
+	err = authorize(id, leafKey, genesisLeaf, &WriteAuth{
+		Sig: sig,
+	})
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+}
+
+// TestWriteAuthQuotaOnInsert tests that the write quota of an issuer is only
+// consumed by proofs that are newly inserted.
+func TestWriteAuthQuotaOnInsert(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		store    = newMockWriteQuotaStore()
+		auth     = NewWriteAuthenticator(store, nil)
+		groupKey = test.RandPrivKey()
+		leaf     = &Leaf{
+			Asset: &asset.Asset{
+				GroupKey: &asset.GroupKey{
+					GroupPubKey: *groupKey.PubKey(),
+				},
+			},
+			RawProof: test.RandBytes(100),
+		}
+		id = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		}
+		leafKey   = randLeafKey(t)
+		writeAuth = &WriteAuth{
+			Sig: signWriteAuth(t, groupKey, id, leafKey, leaf),
+		}
+		errInvalidProof = errors.New("invalid proof")
+	)
+	require.NoError(t, store.UpsertWriteQuota(ctx, WriteQuota{
+		IssuerKey: groupKey.PubKey(),
+		MaxProofs: 1,
+	}))
+
+	var numInserts int
+	insertWith := func(inserted bool, err error) error {
+		insert := func() (bool, error) {
+			numInserts++
+			return inserted, err
+		}
+
+		return auth.AuthorizedInsert(
+			ctx, id, leafKey, leaf, writeAuth, insert,
+		)
+	}
+	assertUsedProofs := func(expected uint64) {
+		quota, err := store.FetchWriteQuota(ctx, groupKey.PubKey())
+		require.NoError(t, err)
+		require.Equal(t, expected, quota.UsedProofs)
+	}
+
+	// A proof that is rejected or already known doesn't count against
+	// the quota.
+	err := insertWith(false, errInvalidProof)
+	require.ErrorIs(t, err, errInvalidProof)
+	assertUsedProofs(0)
+
+	require.NoError(t, insertWith(false, nil))
+	assertUsedProofs(0)
+
+	// A newly inserted proof does, and once the quota is used up, the
+	// proof isn't even validated anymore.
+	require.NoError(t, insertWith(true, nil))
+	assertUsedProofs(1)
+	require.Equal(t, 3, numInserts)
+
+	err = insertWith(true, nil)
+	require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+	require.Equal(t, 3, numInserts)
+
+	// An insert without a valid signature is rejected before the proof is
+	// validated.
+	err = auth.AuthorizedInsert(
+		ctx, id, leafKey, leaf, nil, func() (bool, error) {
+			numInserts++
+			return true, nil
+		},
+	)
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+	require.Equal(t, 3, numInserts)
+}
+
+// TestWriteAuthInternalKey tests that issuers with a BIP-0086 issuance script
+// key can authenticate with the internal key of the script key.
+func TestWriteAuthInternalKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalKey = test.RandPrivKey()
+		otherKey    = test.RandPrivKey()
+		scriptKey   = asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+			PubKey: internalKey.PubKey(),
+		})
+		leaf = &Leaf{
+			Asset: &asset.Asset{
+				ScriptKey: scriptKey,
+			},
+			RawProof: test.RandBytes(100),
+		}
+		id = Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		}
+		leafKey = randLeafKey(t)
+	)
+
+	sig := signWriteAuth(t, internalKey, id, leafKey, leaf)
+
+	// Without the internal key, the signature doesn't match the script
+	// key.
+	err := VerifyWriteAuth(id, leafKey, leaf, scriptKey.PubKey, &WriteAuth{
+		Sig: sig,
+	})
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	// With the internal key, it does.
+	auth := &WriteAuth{
+		Sig:         sig,
+		InternalKey: internalKey.PubKey(),
+	}
+	require.NoError(t, VerifyWriteAuth(
+		id, leafKey, leaf, scriptKey.PubKey, auth,
+	))
+
+	// An internal key that doesn't tweak to the script key is rejected,
+	// even if the signature is valid for it.
+	sig = signWriteAuth(t, otherKey, id, leafKey, leaf)
+	err = VerifyWriteAuth(id, leafKey, leaf, scriptKey.PubKey, &WriteAuth{
+		Sig:         sig,
+		InternalKey: otherKey.PubKey(),
+	})
+	require.ErrorIs(t, err, ErrInvalidWriteAuth)
+
+	// The write auth survives the round trip through the gRPC metadata.
+	ctx := metadata.NewIncomingContext(
+		context.Background(), auth.Metadata(),
+	)
+	parsedAuth, err := WriteAuthFromIncomingContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, auth.Sig, parsedAuth.Sig)
+	require.True(t, auth.InternalKey.IsEqual(parsedAuth.InternalKey))
+
+	parsedAuth, err = WriteAuthFromIncomingContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, parsedAuth)
+}
+
+// mockWalletSigner is a SignerClient that signs messages like lnd does, with
+// the keys of an in-memory wallet.
+type mockWalletSigner struct {
+	lndclient.SignerClient
+
+	keys map[keychain.KeyLocator]*btcec.PrivateKey
+}
+
+func (m *mockWalletSigner) SignMessage(_ context.Context, msg []byte,
+	locator keychain.KeyLocator,
+	opts ...lndclient.SignMessageOption) ([]byte, error) {
+
+	var req signrpc.SignMessageReq
+	for _, opt := range opts {
+		opt(&req)
+	}
+
+	privKey, ok := m.keys[locator]
+	if !ok {
+		return nil, fmt.Errorf("unknown key locator %v", locator)
+	}
+
+	if len(req.SchnorrSigTapTweak) > 0 {
+		privKey = txscript.TweakTaprootPrivKey(
+			*privKey, req.SchnorrSigTapTweak,
+		)
+	}
+
+	sig, err := schnorr.Sign(privKey, chainhash.HashB(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig.Serialize(), nil
+}
+
+// mockIssuerKeyStore is an in-memory IssuerKeyStore.
+type mockIssuerKeyStore struct {
+	groups     map[asset.SerializedKey]*asset.AssetGroup
+	scriptKeys map[asset.SerializedKey]*asset.TweakedScriptKey
+}
+
+func (m *mockIssuerKeyStore) FetchGroupByGroupKey(_ context.Context,
+	groupKey *btcec.PublicKey) (*asset.AssetGroup, error) {
+
+	group, ok := m.groups[asset.ToSerialized(groupKey)]
+	if !ok {
+		return nil, fmt.Errorf("group not found")
+	}
+
+	return group, nil
+}
+
+func (m *mockIssuerKeyStore) FetchScriptKeyByTweakedKey(_ context.Context,
+	tweakedKey *btcec.PublicKey) (*asset.TweakedScriptKey, error) {
+
+	scriptKey, ok := m.scriptKeys[asset.ToSerialized(tweakedKey)]
+	if !ok {
+		return nil, fmt.Errorf("script key not found")
+	}
+
+	return scriptKey, nil
+}
+
+// TestIssuerSigner tests that the issuer signer creates valid write
+// authentications for the group keys and script keys of the local wallet, and
+// none for the assets of other issuers.
+func TestIssuerSigner(t *testing.T) {
+	t.Parallel()
+
+	var (
+		groupLoc = keychain.KeyLocator{Family: 1, Index: 1}
+		bip86Loc = keychain.KeyLocator{Family: 1, Index: 2}
+		tweakLoc = keychain.KeyLocator{Family: 1, Index: 3}
+		foreign  = keychain.KeyLocator{Family: 1, Index: 4}
+
+		groupRaw   = test.RandPrivKey()
+		bip86Raw   = test.RandPrivKey()
+		tweakRaw   = test.RandPrivKey()
+		foreignRaw = test.RandPrivKey()
+
+		groupRoot   = test.RandBytes(32)
+		scriptTweak = test.RandBytes(32)
+
+		groupKey = &asset.GroupKey{
+			Version: asset.GroupKeyV1,
+			RawKey: keychain.KeyDescriptor{
+				KeyLocator: groupLoc,
+				PubKey:     groupRaw.PubKey(),
+			},
+			GroupPubKey: *txscript.ComputeTaprootOutputKey(
+				groupRaw.PubKey(), groupRoot,
+			),
+			TapscriptRoot: groupRoot,
+		}
+		bip86Key = asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+			KeyLocator: bip86Loc,
+			PubKey:     bip86Raw.PubKey(),
+		})
+		tweakedKey = asset.NewScriptKey(
+			txscript.ComputeTaprootOutputKey(
+				tweakRaw.PubKey(), scriptTweak,
+			),
+		)
+
+		// The wallet knows the raw key of the foreign script key, but
+		// the locator doesn't belong to it.
+		foreignKey = asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+			KeyLocator: foreign,
+			PubKey:     foreignRaw.PubKey(),
+		})
+	)
+
+	type scriptKeyMap = map[asset.SerializedKey]*asset.TweakedScriptKey
+
+	signer := NewIssuerSigner(IssuerSignerCfg{
+		Signer: &mockWalletSigner{
+			keys: map[keychain.KeyLocator]*btcec.PrivateKey{
+				groupLoc: groupRaw,
+				bip86Loc: bip86Raw,
+				tweakLoc: tweakRaw,
+				foreign:  test.RandPrivKey(),
+			},
+		},
+		KeyStore: &mockIssuerKeyStore{
+			groups: map[asset.SerializedKey]*asset.AssetGroup{
+				asset.ToSerialized(&groupKey.GroupPubKey): {
+					GroupKey: groupKey,
+				},
+			},
+			scriptKeys: scriptKeyMap{
+				asset.ToSerialized(bip86Key.PubKey): {
+					RawKey: keychain.KeyDescriptor{
+						KeyLocator: bip86Loc,
+						PubKey:     bip86Raw.PubKey(),
+					},
+				},
+				asset.ToSerialized(tweakedKey.PubKey): {
+					RawKey: keychain.KeyDescriptor{
+						KeyLocator: tweakLoc,
+						PubKey:     tweakRaw.PubKey(),
+					},
+					Tweak: scriptTweak,
+				},
+				asset.ToSerialized(foreignKey.PubKey): {
+					RawKey: keychain.KeyDescriptor{
+						KeyLocator: foreign,
+						PubKey:     foreignRaw.PubKey(),
+					},
+				},
+			},
+		},
+	})
+
+	newGenesisLeaf := func(a *asset.Asset) *Leaf {
+		a.PrevWitnesses = []asset.Witness{{
+			PrevID: &asset.ZeroPrevID,
+		}}
+
+		return &Leaf{
+			Asset:    a,
+			RawProof: test.RandBytes(100),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		leaf     *Leaf
+		expectOK bool
+	}{{
+		name: "v1 group key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			GroupKey:  groupKey,
+			ScriptKey: asset.RandScriptKey(t),
+		}),
+		expectOK: true,
+	}, {
+		name: "bip86 script key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			ScriptKey: bip86Key,
+		}),
+		expectOK: true,
+	}, {
+		name: "tweaked script key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			ScriptKey: tweakedKey,
+		}),
+		expectOK: true,
+	}, {
+		name: "foreign script key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			ScriptKey: foreignKey,
+		}),
+	}, {
+		name: "unknown group key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			GroupKey: &asset.GroupKey{
+				GroupPubKey: *test.RandPubKey(t),
+			},
+			ScriptKey: bip86Key,
+		}),
+	}, {
+		name: "unknown script key",
+		leaf: newGenesisLeaf(&asset.Asset{
+			ScriptKey: asset.RandScriptKey(t),
+		}),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			id := Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeIssuance,
+			}
+			leafKey := randLeafKey(t)
+
+			auth, err := signer.SignWriteAuth(
+				ctx, id, leafKey, tc.leaf,
+			)
+			require.NoError(t, err)
+
+			if !tc.expectOK {
+				require.Nil(t, auth)
+				return
+			}
+
+			require.NotNil(t, auth)
+
+			issuerKey, err := IssuerKey(ctx, tc.leaf.Asset, nil)
+			require.NoError(t, err)
+			require.NoError(t, VerifyWriteAuth(
+				id, leafKey, tc.leaf, issuerKey, auth,
+			))
+		})
+	}
+}
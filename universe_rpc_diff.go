@@ -12,6 +12,8 @@ import (
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // RpcUniverseDiff is an implementation of the universe.DiffEngine interface
@@ -170,6 +172,92 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 	return keys, nil
 }
 
+// UniverseSubtrees returns the subtree roots of the given universe at the given
+// depth for each of the given paths, in the same order as the paths. If the
+// remote universe server doesn't support subtree queries yet,
+// universe.ErrSubtreeDiffUnsupported is returned.
+//
+// NOTE: This is part of the universe.SubtreeDiffEngine interface.
+func (r *RpcUniverseDiff) UniverseSubtrees(ctx context.Context,
+	q universe.UniverseSubtreesQuery) ([]universe.UniverseSubtree, error) {
+
+	uniID, err := MarshalUniID(q.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.conn.UniverseSubtrees(
+		ctx, &unirpc.UniverseSubtreesRequest{
+			Id:    uniID,
+			Depth: int32(q.Depth),
+			Paths: fn.Map(q.Paths, fn.ByteSlice[[32]byte]),
+		},
+	)
+
+	// Older universe servers don't know the subtree RPC, so the syncer
+	// needs to fall back to comparing all leaf keys.
+	statusErr, ok := status.FromError(err)
+	switch {
+	case err != nil && ok && statusErr.Code() == codes.Unimplemented:
+		return nil, universe.ErrSubtreeDiffUnsupported
+
+	case err != nil:
+		return nil, err
+	}
+
+	return fn.MapErr(resp.Subtrees, unmarshalUniverseSubtree)
+}
+
+// unmarshalUniverseSubtree converts an RPC universe subtree root to its native
+// counterpart.
+func unmarshalUniverseSubtree(
+	rpcSubtree *unirpc.UniverseSubtree) (universe.UniverseSubtree, error) {
+
+	var subtree universe.UniverseSubtree
+
+	if rpcSubtree.Depth < 0 || rpcSubtree.Depth > mssmt.MaxTreeLevels {
+		return subtree, fmt.Errorf("invalid subtree depth: %d",
+			rpcSubtree.Depth)
+	}
+	subtree.Depth = int(rpcSubtree.Depth)
+
+	if len(rpcSubtree.Path) != len(subtree.Path) {
+		return subtree, fmt.Errorf("invalid subtree path length: %d",
+			len(rpcSubtree.Path))
+	}
+	copy(subtree.Path[:], rpcSubtree.Path)
+
+	if len(rpcSubtree.NodeHash) != len(subtree.NodeHash) {
+		return subtree, fmt.Errorf("invalid subtree node hash "+
+			"length: %d", len(rpcSubtree.NodeHash))
+	}
+	copy(subtree.NodeHash[:], rpcSubtree.NodeHash)
+
+	subtree.Sum = rpcSubtree.Sum
+
+	if len(rpcSubtree.LeafKey) != 0 {
+		var leafKey [32]byte
+		if len(rpcSubtree.LeafKey) != len(leafKey) {
+			return subtree, fmt.Errorf("invalid subtree leaf key "+
+				"length: %d", len(rpcSubtree.LeafKey))
+		}
+		copy(leafKey[:], rpcSubtree.LeafKey)
+
+		subtree.LeafKey = &leafKey
+	}
+
+	if rpcSubtree.AssetKey != nil {
+		key, err := unmarshalLeafKey(rpcSubtree.AssetKey)
+		if err != nil {
+			return subtree, err
+		}
+
+		subtree.Key = key
+	}
+
+	return subtree, nil
+}
+
 // FetchProofLeaf attempts to fetch a proof leaf for the target leaf key
 // and given a universe identifier (assetID/groupKey).
 //
@@ -238,5 +326,5 @@ func (r *RpcUniverseDiff) Close() error {
 }
 
 // A compile time interface to ensure that RpcUniverseDiff implements the
-// universe.DiffEngine interface.
-var _ universe.DiffEngine = (*RpcUniverseDiff)(nil)
+// universe.SubtreeDiffEngine interface.
+var _ universe.SubtreeDiffEngine = (*RpcUniverseDiff)(nil)